@@ -0,0 +1,115 @@
+// Command import-accounts bulk-loads accounts from the JSON export of another identity provider.
+//
+// Password hashes are stored as exported and upgraded to Argon2id on each user's first login:
+//
+//	go run ./cmd/import-accounts -format firebase -file users.json
+//	go run ./cmd/import-accounts -format auth0 -file users.ndjson
+//
+// Accounts whose email or phone number already exists are skipped. Users whose email the provider
+// didn't verify are imported without their password and must reset it, unverified or malformed
+// phone numbers are dropped.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+
+	"server/internal/config"
+	"server/internal/domain/account"
+	"server/internal/infrastructure/db"
+	"server/internal/logger"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+func main() {
+	format := flag.String("format", account.ImportFormatFirebase, "export format, \"firebase\" or \"auth0\"")
+	file := flag.String("file", "", "path of the export file")
+	dryRun := flag.Bool("dry-run", false, "validate the export without creating accounts")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatal("cannot open export: ", err)
+	}
+	defer f.Close()
+
+	accounts, err := account.ParseAccountExport(*format, f)
+	if err != nil {
+		log.Fatal("cannot read export: ", err)
+	}
+
+	var (
+		accountRepo     account.AccountRepo
+		passwordHashers *account.PasswordHasherRegistry
+		zapLogger       *zap.Logger
+	)
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(config.SetupConfig()),
+		fx.Provide(
+			db.NewDB,
+			logger.New,
+			account.NewPasswordHasherProvider,
+			account.NewPasswordHasherRegistryProvider,
+			account.NewAccountRepo,
+		),
+		fx.Populate(&accountRepo, &passwordHashers, &zapLogger),
+	)
+
+	ctx := context.Background()
+	if err := app.Start(ctx); err != nil {
+		log.Fatal("cannot start: ", err)
+	}
+	defer app.Stop(ctx)
+
+	var imported, skipped, failed int
+	for _, acc := range accounts {
+		if acc.Email == "" {
+			zapLogger.Warn("skipping user without email")
+			skipped++
+			continue
+		}
+		if !acc.EmailVerified {
+			zapLogger.Info("importing user with unverified email without password", zap.String("email", acc.Email))
+		}
+		if acc.PasswordHash != nil && !passwordHashers.Supports(*acc.PasswordHash) {
+			zapLogger.Error("unsupported password hash format", zap.String("email", acc.Email))
+			failed++
+			continue
+		}
+		if *dryRun {
+			imported++
+			continue
+		}
+
+		_, err := accountRepo.Import(ctx, acc.Email, acc.FullName, acc.PasswordHash, acc.PhoneNumber)
+		if errors.Is(err, account.ErrEmailAlreadyExists) || errors.Is(err, account.ErrPhoneAlreadyExists) {
+			zapLogger.Info("skipping existing account", zap.String("email", acc.Email), zap.Error(err))
+			skipped++
+			continue
+		}
+		if err != nil {
+			zapLogger.Error("failed to import account", zap.String("email", acc.Email), zap.Error(err))
+			failed++
+			continue
+		}
+		imported++
+	}
+
+	zapLogger.Info("account import finished",
+		zap.Bool("dry_run", *dryRun),
+		zap.Int("imported", imported),
+		zap.Int("skipped", skipped),
+		zap.Int("failed", failed),
+	)
+}
@@ -13,7 +13,11 @@ import (
 	"server/internal/infrastructure/captcha"
 	"server/internal/infrastructure/db"
 	"server/internal/infrastructure/email"
+	"server/internal/infrastructure/geoip"
+	"server/internal/infrastructure/pwnedpasswords"
+	"server/internal/infrastructure/ratelimit"
 	"server/internal/infrastructure/s3client"
+	"server/internal/infrastructure/tokenhash"
 	"server/internal/logger"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -28,14 +32,14 @@ import (
 	"go.uber.org/zap"
 )
 
-func AddGraphQLHandler(r *chi.Mux, cfg *config.Config) {
-	resolver := &resolver.Resolver{}
-
+func AddGraphQLHandler(r *chi.Mux, cfg *config.Config, resolver *resolver.Resolver, rateLimitStore ratelimit.Store) {
 	srv := handler.New(generated.NewExecutableSchema(generated.Config{
 		Resolvers: resolver,
 		Directives: generated.DirectiveRoot{
 			IsAuthenticated:  graph.IsAuthenticated,
 			RequiresSudoMode: graph.RequiresSudoMode,
+			RequiresScope:    graph.RequiresScope,
+			RateLimit:        graph.RateLimit(rateLimitStore),
 		},
 	}))
 
@@ -83,6 +87,14 @@ func NewApp() *fx.App {
 			email.ProviderModule,
 			// Captcha infrastructure
 			captcha.ProviderModule,
+			// Token hashing infrastructure
+			tokenhash.ProviderModule,
+			// Breached password check infrastructure
+			pwnedpasswords.ProviderModule,
+			// GraphQL rate limit infrastructure
+			ratelimit.ProviderModule,
+			// Session IP address location infrastructure
+			geoip.ProviderModule,
 			// Account domain repositories
 			account.AccountDomainModule,
 			// Auth domain repositories
@@ -90,6 +102,7 @@ func NewApp() *fx.App {
 		),
 		fx.Invoke(
 			AddGraphQLHandler,
+			serverhttp.AddOAuthHandlers,
 			func(*chi.Mux) {},
 		),
 	)
@@ -0,0 +1,81 @@
+// Command unlock-account lifts brute-force lockouts on behalf of an administrator.
+//
+// It forgets the failed attempts against an account, unlocking it, or from an IP address:
+//
+//	go run ./cmd/unlock-account -email user@example.com
+//	go run ./cmd/unlock-account -ip 203.0.113.7
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"server/internal/config"
+	"server/internal/domain/auth"
+	"server/internal/infrastructure/db"
+	"server/internal/infrastructure/tokenhash"
+	"server/internal/logger"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+func main() {
+	email := flag.String("email", "", "email address of the account to unlock")
+	ipAddress := flag.String("ip", "", "IP address to lift the backoff of")
+	flag.Parse()
+
+	if *email == "" && *ipAddress == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var (
+		attemptLimiter *auth.AttemptLimiter
+		zapLogger      *zap.Logger
+	)
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(config.SetupConfig()),
+		fx.Provide(
+			db.NewDB,
+			logger.New,
+			tokenhash.NewTokenHasherProvider,
+			auth.NewAuthAttemptRepo,
+			auth.NewAttemptLimiter,
+		),
+		fx.Populate(&attemptLimiter, &zapLogger),
+	)
+
+	ctx := context.Background()
+	if err := app.Start(ctx); err != nil {
+		log.Fatal("cannot start: ", err)
+	}
+	defer app.Stop(ctx)
+
+	// os.Exit skips the deferred stop, so the app is stopped before exiting
+	fail := func(msg string, fields ...zap.Field) {
+		zapLogger.Error(msg, fields...)
+		_ = app.Stop(ctx)
+		os.Exit(1)
+	}
+
+	if *email != "" {
+		// Attempts are tracked by the normalized address
+		address := strings.ToLower(strings.TrimSpace(*email))
+		if err := attemptLimiter.Reset(ctx, address); err != nil {
+			fail("failed to unlock account", zap.String("email", address), zap.Error(err))
+		}
+		zapLogger.Info("account unlocked", zap.String("email", address))
+	}
+
+	if *ipAddress != "" {
+		if err := attemptLimiter.ResetIPAddress(ctx, *ipAddress); err != nil {
+			fail("failed to lift IP address backoff", zap.String("ip_address", *ipAddress), zap.Error(err))
+		}
+		zapLogger.Info("IP address backoff lifted", zap.String("ip_address", *ipAddress))
+	}
+}
@@ -3,9 +3,18 @@ package graph
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
+	"server/graph/model"
+	httpmiddleware "server/internal/http/middleware"
+	"server/internal/infrastructure/ratelimit"
+
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // Authentication errors
@@ -14,8 +23,21 @@ var (
 	ErrRequiresSudoMode = errors.New("Action requires sudo mode")
 )
 
+// ErrInsufficientScope is returned when a personal access token was not granted the scope a field requires
+var ErrInsufficientScope = errors.New("Personal access token lacks the required scope")
+
+// ErrRateLimitExceeded is returned when a field is requested more often than its @rateLimit allows
+var ErrRateLimitExceeded = errors.New("Too many requests, please try again later")
+
+// RateLimitDirective is the signature of the @rateLimit directive
+type RateLimitDirective func(ctx context.Context, obj interface{}, next graphql.Resolver, limit int32, window string, key model.RateLimitKey, arg *string) (interface{}, error)
+
 // IsAuthenticated directive protects fields to ensure only authenticated users can access them
 // Based on Python IsAuthenticated permission class
+//
+// Cookie and bearer token viewers carry the same token data, set by the auth middleware,
+// so both are accepted alike. Personal access tokens are denied by default: they only reach
+// the fields that also declare @requiresScope.
 func IsAuthenticated(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
 	// Get session token data from context (from SessionMiddleware)
 	sessionTokenData := ctx.Value("session_token_data")
@@ -34,9 +56,22 @@ func IsAuthenticated(ctx context.Context, obj interface{}, next graphql.Resolver
 		return nil, ErrNotAuthenticated
 	}
 
+	if _, isPersonalAccessToken := tokenData["personal_access_token_id"]; isPersonalAccessToken && !fieldRequiresScope(ctx) {
+		return nil, ErrInsufficientScope
+	}
+
 	return next(ctx)
 }
 
+// fieldRequiresScope tells whether the field being resolved declares @requiresScope
+func fieldRequiresScope(ctx context.Context) bool {
+	fieldCtx := graphql.GetFieldContext(ctx)
+	if fieldCtx == nil || fieldCtx.Field.Definition == nil {
+		return false
+	}
+	return fieldCtx.Field.Definition.Directives.ForName("requiresScope") != nil
+}
+
 // RequiresSudoMode directive protects fields that require elevated privileges
 // Based on Python RequiresSudoMode permission class
 func RequiresSudoMode(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
@@ -82,4 +117,105 @@ func RequiresSudoMode(ctx context.Context, obj interface{}, next graphql.Resolve
 	}
 
 	return next(ctx)
-}
\ No newline at end of file
+}
+
+// RequiresScope directive restricts personal access tokens to the fields of their scopes
+//
+// Only personal access token viewers carry scopes in their token data. Session viewers and
+// anonymous requests pass, authentication being left to @isAuthenticated.
+func RequiresScope(ctx context.Context, obj interface{}, next graphql.Resolver, scope model.PersonalAccessTokenScope) (interface{}, error) {
+	tokenData, ok := ctx.Value("session_token_data").(map[string]interface{})
+	if !ok {
+		return next(ctx)
+	}
+
+	scopesRaw, exists := tokenData["scopes"]
+	if !exists {
+		return next(ctx)
+	}
+
+	// Scopes are stored the way they are granted, in lower case
+	scopes, ok := scopesRaw.([]string)
+	if !ok || !slices.Contains(scopes, strings.ToLower(string(scope))) {
+		return nil, ErrInsufficientScope
+	}
+
+	return next(ctx)
+}
+
+// RateLimit returns the @rateLimit directive, counting requests in the given store
+//
+// Requests over the limit fail with ErrRateLimitExceeded, whose error extensions carry
+// the "RATE_LIMITED" code and the seconds to wait in "retryAfterSeconds".
+func RateLimit(store ratelimit.Store) RateLimitDirective {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, limit int32, window string, key model.RateLimitKey, arg *string) (interface{}, error) {
+		windowDuration, err := time.ParseDuration(window)
+		if err != nil || windowDuration <= 0 {
+			return nil, fmt.Errorf("invalid @rateLimit window %q", window)
+		}
+
+		fieldCtx := graphql.GetFieldContext(ctx)
+		if fieldCtx == nil {
+			return nil, errors.New("@rateLimit used outside of a field")
+		}
+
+		keyValue, err := rateLimitKey(ctx, fieldCtx, key, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		// Limits of different fields and windows are counted apart
+		storeKey := fmt.Sprintf("%s:%s:%s", fieldCtx.Field.Name, windowDuration, keyValue)
+		retryAfter, err := store.Allow(ctx, storeKey, int(limit), windowDuration)
+		if err != nil {
+			return nil, err
+		}
+
+		if retryAfter > 0 {
+			return nil, &gqlerror.Error{
+				Err:     ErrRateLimitExceeded,
+				Message: ErrRateLimitExceeded.Error(),
+				Extensions: map[string]interface{}{
+					"code":              "RATE_LIMITED",
+					"retryAfterSeconds": int(math.Ceil(retryAfter.Seconds())),
+				},
+			}
+		}
+
+		return next(ctx)
+	}
+}
+
+// rateLimitKey returns the value requests are counted by
+func rateLimitKey(ctx context.Context, fieldCtx *graphql.FieldContext, key model.RateLimitKey, arg *string) (string, error) {
+	switch key {
+	case model.RateLimitKeyAccount:
+		if tokenData, ok := ctx.Value("session_token_data").(map[string]interface{}); ok {
+			if userID, exists := tokenData["user_id"]; exists {
+				return fmt.Sprintf("account:%v", userID), nil
+			}
+		}
+		// Anonymous requests are counted by their IP address
+		return "ip:" + httpmiddleware.GetRequestInfo(ctx).IPAddress, nil
+
+	case model.RateLimitKeyArg:
+		if arg == nil {
+			return "", errors.New("@rateLimit with the ARG key requires arg")
+		}
+		value, exists := fieldCtx.Args[*arg]
+		if !exists {
+			return "", fmt.Errorf("@rateLimit argument %q does not exist", *arg)
+		}
+		if pointer, ok := value.(*string); ok {
+			value = ""
+			if pointer != nil {
+				value = *pointer
+			}
+		}
+		// Variations in case and whitespace don't get a fresh limit
+		return fmt.Sprintf("arg:%s:%s", *arg, strings.ToLower(strings.TrimSpace(fmt.Sprint(value)))), nil
+
+	default:
+		return "ip:" + httpmiddleware.GetRequestInfo(ctx).IPAddress, nil
+	}
+}
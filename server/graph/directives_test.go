@@ -2,11 +2,24 @@ package graph
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"server/graph/model"
+	"server/internal/domain/auth"
+	"server/internal/domain/core"
+	httpmiddleware "server/internal/http/middleware"
+	"server/internal/infrastructure/ratelimit"
+
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
 )
 
 // MockResolver is a mock implementation of a GraphQL resolver
@@ -110,6 +123,95 @@ func TestIsAuthenticated_WithoutUserID(t *testing.T) {
 	mockResolver.AssertNotCalled(t, "Resolve")
 }
 
+// bearerSessionLoader resolves one access token or personal access token, and no session token
+type bearerSessionLoader struct {
+	accessToken         string
+	session             *auth.Session
+	personalAccessToken *auth.PersonalAccessToken
+}
+
+func (l bearerSessionLoader) GetViewerSession(ctx context.Context, sessionToken string) (*auth.Session, error) {
+	return nil, auth.ErrSessionNotFound
+}
+
+func (l bearerSessionLoader) GetBearerViewerSession(ctx context.Context, accessToken string) (*auth.Session, error) {
+	if accessToken != l.accessToken {
+		return nil, auth.ErrSessionNotFound
+	}
+	return l.session, nil
+}
+
+func (l bearerSessionLoader) GetPersonalAccessTokenViewer(ctx context.Context, token string) (*auth.PersonalAccessToken, error) {
+	if token != l.accessToken || l.personalAccessToken == nil {
+		return nil, auth.ErrPersonalAccessTokenNotFound
+	}
+	return l.personalAccessToken, nil
+}
+
+func TestIsAuthenticated_WithBearerViewer(t *testing.T) {
+	loader := bearerSessionLoader{accessToken: "access-token", session: &auth.Session{CoreModel: core.CoreModel{ID: 5}, AccountId: 123}}
+
+	// Resolve the viewer through the auth middleware, like a native client's request
+	var ctx context.Context
+	handler := httpmiddleware.NewAuthMiddleware(loader, zap.NewNop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	}))
+	req := httptest.NewRequest("POST", "/graphql", nil)
+	req.Header.Set("Authorization", "Bearer access-token")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	// Mock resolver
+	mockResolver := &MockResolver{}
+	mockResolver.On("Resolve", ctx).Return("success", nil)
+
+	// Test directive
+	result, err := IsAuthenticated(ctx, nil, mockResolver.Resolve)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "success", result)
+	mockResolver.AssertExpectations(t)
+}
+
+func TestIsAuthenticated_WithPersonalAccessToken(t *testing.T) {
+	token := auth.PersonalAccessTokenPrefix + "token"
+	loader := bearerSessionLoader{accessToken: token, personalAccessToken: &auth.PersonalAccessToken{
+		CoreModel: core.CoreModel{ID: 9},
+		AccountId: 123,
+		Scopes:    []string{auth.ScopeReadAccount, auth.ScopeWriteAccount},
+	}}
+
+	// Resolve the viewer through the auth middleware, like a script's request
+	var ctx context.Context
+	handler := httpmiddleware.NewAuthMiddleware(loader, zap.NewNop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	}))
+	req := httptest.NewRequest("POST", "/graphql", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	fieldContext := func(name string, directives ...*ast.Directive) context.Context {
+		return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Field: graphql.CollectedField{Field: &ast.Field{
+				Name:       name,
+				Definition: &ast.FieldDefinition{Name: name, Directives: directives},
+			}},
+		})
+	}
+
+	mockResolver := &MockResolver{}
+	mockResolver.On("Resolve", mock.Anything).Return("success", nil)
+
+	// Fields without @requiresScope are denied whatever the token's scopes
+	result, err := IsAuthenticated(fieldContext("revokePersonalAccessToken", &ast.Directive{Name: "isAuthenticated"}), nil, mockResolver.Resolve)
+	assert.ErrorIs(t, err, ErrInsufficientScope)
+	assert.Nil(t, result)
+	mockResolver.AssertNotCalled(t, "Resolve", mock.Anything)
+
+	result, err = IsAuthenticated(fieldContext("updateAccount", &ast.Directive{Name: "isAuthenticated"}, &ast.Directive{Name: "requiresScope"}), nil, mockResolver.Resolve)
+	assert.NoError(t, err)
+	assert.Equal(t, "success", result)
+}
+
 func TestRequiresSudoMode_WithValidSudoMode(t *testing.T) {
 	// Create session token data with valid sudo mode
 	futureTime := time.Now().UTC().Add(15 * time.Minute)
@@ -233,4 +335,146 @@ func TestRequiresSudoMode_CallsIsAuthenticatedFirst(t *testing.T) {
 	assert.Nil(t, result)
 	assert.ErrorIs(t, err, ErrNotAuthenticated)
 	mockResolver.AssertNotCalled(t, "Resolve")
-}
\ No newline at end of file
+}
+
+// rateLimitContext creates the context of a request to the named field from the IP address
+func rateLimitContext(fieldName string, ipAddress string, args map[string]interface{}) context.Context {
+	ctx := context.WithValue(context.Background(), "request_info", httpmiddleware.RequestInfo{IPAddress: ipAddress})
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Field: graphql.CollectedField{Field: &ast.Field{Name: fieldName}},
+		Args:  args,
+	})
+}
+
+func TestRequiresScope_WithSessionViewer(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "session_token_data", map[string]interface{}{
+		"user_id": int64(123),
+	})
+
+	mockResolver := &MockResolver{}
+	mockResolver.On("Resolve", ctx).Return("success", nil)
+
+	result, err := RequiresScope(ctx, nil, mockResolver.Resolve, model.PersonalAccessTokenScopeWriteAccount)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "success", result)
+	mockResolver.AssertExpectations(t)
+}
+
+func TestRequiresScope_WithPersonalAccessToken(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "session_token_data", map[string]interface{}{
+		"user_id":                  int64(123),
+		"personal_access_token_id": int64(9),
+		"scopes":                   []string{auth.ScopeReadAccount},
+	})
+
+	mockResolver := &MockResolver{}
+	mockResolver.On("Resolve", ctx).Return("success", nil)
+
+	result, err := RequiresScope(ctx, nil, mockResolver.Resolve, model.PersonalAccessTokenScopeReadAccount)
+	assert.NoError(t, err)
+	assert.Equal(t, "success", result)
+
+	result, err = RequiresScope(ctx, nil, mockResolver.Resolve, model.PersonalAccessTokenScopeWriteAccount)
+	assert.ErrorIs(t, err, ErrInsufficientScope)
+	assert.Nil(t, result)
+
+	mockResolver.AssertNumberOfCalls(t, "Resolve", 1)
+}
+
+func TestRateLimit_ByIPAddress(t *testing.T) {
+	rateLimit := RateLimit(ratelimit.NewMemoryStore())
+	ctx := rateLimitContext("requestEmailLoginCode", "203.0.113.7", nil)
+
+	mockResolver := &MockResolver{}
+	mockResolver.On("Resolve", mock.Anything).Return("success", nil)
+
+	for range 2 {
+		result, err := rateLimit(ctx, nil, mockResolver.Resolve, 2, "1h", model.RateLimitKeyIP, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "success", result)
+	}
+
+	result, err := rateLimit(ctx, nil, mockResolver.Resolve, 2, "1h", model.RateLimitKeyIP, nil)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrRateLimitExceeded)
+	mockResolver.AssertNumberOfCalls(t, "Resolve", 2)
+
+	// The remaining time is reported in the error extensions
+	var gqlErr *gqlerror.Error
+	require.ErrorAs(t, err, &gqlErr)
+	assert.Equal(t, "RATE_LIMITED", gqlErr.Extensions["code"])
+	assert.InDelta(t, 3600, gqlErr.Extensions["retryAfterSeconds"], 5)
+
+	// Other IP addresses and fields are counted apart
+	_, err = rateLimit(rateLimitContext("requestEmailLoginCode", "198.51.100.1", nil), nil, mockResolver.Resolve, 2, "1h", model.RateLimitKeyIP, nil)
+	assert.NoError(t, err)
+	_, err = rateLimit(rateLimitContext("requestPasswordReset", "203.0.113.7", nil), nil, mockResolver.Resolve, 2, "1h", model.RateLimitKeyIP, nil)
+	assert.NoError(t, err)
+}
+
+func TestRateLimit_ByArgument(t *testing.T) {
+	rateLimit := RateLimit(ratelimit.NewMemoryStore())
+	arg := "email"
+
+	mockResolver := &MockResolver{}
+	mockResolver.On("Resolve", mock.Anything).Return("success", nil)
+
+	// The same address from different IP addresses, in different cases
+	_, err := rateLimit(rateLimitContext("requestPasswordReset", "203.0.113.7", map[string]interface{}{"email": "test@example.com"}), nil, mockResolver.Resolve, 1, "15m", model.RateLimitKeyArg, &arg)
+	require.NoError(t, err)
+
+	_, err = rateLimit(rateLimitContext("requestPasswordReset", "198.51.100.1", map[string]interface{}{"email": " Test@Example.com"}), nil, mockResolver.Resolve, 1, "15m", model.RateLimitKeyArg, &arg)
+	assert.ErrorIs(t, err, ErrRateLimitExceeded)
+
+	_, err = rateLimit(rateLimitContext("requestPasswordReset", "198.51.100.1", map[string]interface{}{"email": "other@example.com"}), nil, mockResolver.Resolve, 1, "15m", model.RateLimitKeyArg, &arg)
+	assert.NoError(t, err)
+}
+
+func TestRateLimit_ByAccount(t *testing.T) {
+	rateLimit := RateLimit(ratelimit.NewMemoryStore())
+
+	mockResolver := &MockResolver{}
+	mockResolver.On("Resolve", mock.Anything).Return("success", nil)
+
+	accountContext := func(userID float64) context.Context {
+		return context.WithValue(rateLimitContext("requestPhoneNumberVerificationToken", "203.0.113.7", nil), "session_token_data", map[string]interface{}{
+			"user_id": userID,
+		})
+	}
+
+	_, err := rateLimit(accountContext(123), nil, mockResolver.Resolve, 1, "1h", model.RateLimitKeyAccount, nil)
+	require.NoError(t, err)
+	_, err = rateLimit(accountContext(123), nil, mockResolver.Resolve, 1, "1h", model.RateLimitKeyAccount, nil)
+	assert.ErrorIs(t, err, ErrRateLimitExceeded)
+
+	// Accounts behind the same IP address are counted apart
+	_, err = rateLimit(accountContext(456), nil, mockResolver.Resolve, 1, "1h", model.RateLimitKeyAccount, nil)
+	assert.NoError(t, err)
+
+	// Anonymous requests are counted by IP address
+	ctx := rateLimitContext("requestPhoneNumberVerificationToken", "203.0.113.7", nil)
+	_, err = rateLimit(ctx, nil, mockResolver.Resolve, 1, "1h", model.RateLimitKeyAccount, nil)
+	assert.NoError(t, err)
+	_, err = rateLimit(ctx, nil, mockResolver.Resolve, 1, "1h", model.RateLimitKeyAccount, nil)
+	assert.ErrorIs(t, err, ErrRateLimitExceeded)
+}
+
+func TestRateLimit_WithInvalidArguments(t *testing.T) {
+	rateLimit := RateLimit(ratelimit.NewMemoryStore())
+	ctx := rateLimitContext("requestPasswordReset", "203.0.113.7", map[string]interface{}{"email": "test@example.com"})
+	missingArg := "phoneNumber"
+
+	mockResolver := &MockResolver{}
+
+	_, err := rateLimit(ctx, nil, mockResolver.Resolve, 1, "an hour", model.RateLimitKeyIP, nil)
+	assert.Error(t, err)
+
+	_, err = rateLimit(ctx, nil, mockResolver.Resolve, 1, "1h", model.RateLimitKeyArg, nil)
+	assert.Error(t, err)
+
+	_, err = rateLimit(ctx, nil, mockResolver.Resolve, 1, "1h", model.RateLimitKeyArg, &missingArg)
+	assert.Error(t, err)
+
+	mockResolver.AssertNotCalled(t, "Resolve")
+}
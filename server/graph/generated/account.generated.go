@@ -16,10 +16,71 @@ import (
 
 // region    ************************** generated!.gotpl **************************
 
+type AccountResolver interface {
+	SudoModeExpiresAt(ctx context.Context, obj *model.Account) (*string, error)
+	CurrentSession(ctx context.Context, obj *model.Account) (*model.Session, error)
+	Sessions(ctx context.Context, obj *model.Account, before *string, after *string, first *int32, last *int32) (*model.SessionConnection, error)
+	WebAuthnCredentials(ctx context.Context, obj *model.Account, before *string, after *string, first *int32, last *int32) (*model.WebAuthnCredentialConnection, error)
+	PersonalAccessTokens(ctx context.Context, obj *model.Account, before *string, after *string, first *int32, last *int32) (*model.PersonalAccessTokenConnection, error)
+	OauthIdentities(ctx context.Context, obj *model.Account, before *string, after *string, first *int32, last *int32) (*model.OAuthIdentityConnection, error)
+}
+
 // endregion ************************** generated!.gotpl **************************
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Account_oauthIdentities_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "before", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["before"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "last", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["last"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Account_personalAccessTokens_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "before", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["before"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "first", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "last", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["last"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Account_sessions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -420,7 +481,7 @@ func (ec *executionContext) _Account_sudoModeExpiresAt(ctx context.Context, fiel
 		field,
 		ec.fieldContext_Account_sudoModeExpiresAt,
 		func(ctx context.Context) (any, error) {
-			return obj.SudoModeExpiresAt, nil
+			return ec.resolvers.Account().SudoModeExpiresAt(ctx, obj)
 		},
 		nil,
 		ec.marshalODateTime2ᚖstring,
@@ -433,8 +494,8 @@ func (ec *executionContext) fieldContext_Account_sudoModeExpiresAt(_ context.Con
 	fc = &graphql.FieldContext{
 		Object:     "Account",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type DateTime does not have child fields")
 		},
@@ -449,7 +510,7 @@ func (ec *executionContext) _Account_currentSession(ctx context.Context, field g
 		field,
 		ec.fieldContext_Account_currentSession,
 		func(ctx context.Context) (any, error) {
-			return obj.CurrentSession, nil
+			return ec.resolvers.Account().CurrentSession(ctx, obj)
 		},
 		nil,
 		ec.marshalNSession2ᚖserverᚋgraphᚋmodelᚐSession,
@@ -462,8 +523,8 @@ func (ec *executionContext) fieldContext_Account_currentSession(_ context.Contex
 	fc = &graphql.FieldContext{
 		Object:     "Account",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -474,6 +535,14 @@ func (ec *executionContext) fieldContext_Account_currentSession(_ context.Contex
 				return ec.fieldContext_Session_ipAddress(ctx, field)
 			case "createdAt":
 				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActiveAt":
+				return ec.fieldContext_Session_lastActiveAt(ctx, field)
+			case "isCurrent":
+				return ec.fieldContext_Session_isCurrent(ctx, field)
+			case "device":
+				return ec.fieldContext_Session_device(ctx, field)
+			case "location":
+				return ec.fieldContext_Session_location(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
 		},
@@ -488,7 +557,8 @@ func (ec *executionContext) _Account_sessions(ctx context.Context, field graphql
 		field,
 		ec.fieldContext_Account_sessions,
 		func(ctx context.Context) (any, error) {
-			return obj.Sessions, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Account().Sessions(ctx, obj, fc.Args["before"].(*string), fc.Args["after"].(*string), fc.Args["first"].(*int32), fc.Args["last"].(*int32))
 		},
 		nil,
 		ec.marshalNSessionConnection2ᚖserverᚋgraphᚋmodelᚐSessionConnection,
@@ -501,8 +571,8 @@ func (ec *executionContext) fieldContext_Account_sessions(ctx context.Context, f
 	fc = &graphql.FieldContext{
 		Object:     "Account",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "pageInfo":
@@ -536,7 +606,8 @@ func (ec *executionContext) _Account_webAuthnCredentials(ctx context.Context, fi
 		field,
 		ec.fieldContext_Account_webAuthnCredentials,
 		func(ctx context.Context) (any, error) {
-			return obj.WebAuthnCredentials, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Account().WebAuthnCredentials(ctx, obj, fc.Args["before"].(*string), fc.Args["after"].(*string), fc.Args["first"].(*int32), fc.Args["last"].(*int32))
 		},
 		nil,
 		ec.marshalNWebAuthnCredentialConnection2ᚖserverᚋgraphᚋmodelᚐWebAuthnCredentialConnection,
@@ -549,8 +620,8 @@ func (ec *executionContext) fieldContext_Account_webAuthnCredentials(ctx context
 	fc = &graphql.FieldContext{
 		Object:     "Account",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "pageInfo":
@@ -577,6 +648,104 @@ func (ec *executionContext) fieldContext_Account_webAuthnCredentials(ctx context
 	return fc, nil
 }
 
+func (ec *executionContext) _Account_personalAccessTokens(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Account_personalAccessTokens,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Account().PersonalAccessTokens(ctx, obj, fc.Args["before"].(*string), fc.Args["after"].(*string), fc.Args["first"].(*int32), fc.Args["last"].(*int32))
+		},
+		nil,
+		ec.marshalNPersonalAccessTokenConnection2ᚖserverᚋgraphᚋmodelᚐPersonalAccessTokenConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Account_personalAccessTokens(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "pageInfo":
+				return ec.fieldContext_PersonalAccessTokenConnection_pageInfo(ctx, field)
+			case "edges":
+				return ec.fieldContext_PersonalAccessTokenConnection_edges(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_PersonalAccessTokenConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PersonalAccessTokenConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Account_personalAccessTokens_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Account_oauthIdentities(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Account_oauthIdentities,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Account().OauthIdentities(ctx, obj, fc.Args["before"].(*string), fc.Args["after"].(*string), fc.Args["first"].(*int32), fc.Args["last"].(*int32))
+		},
+		nil,
+		ec.marshalNOAuthIdentityConnection2ᚖserverᚋgraphᚋmodelᚐOAuthIdentityConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Account_oauthIdentities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "pageInfo":
+				return ec.fieldContext_OAuthIdentityConnection_pageInfo(ctx, field)
+			case "edges":
+				return ec.fieldContext_OAuthIdentityConnection_edges(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_OAuthIdentityConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OAuthIdentityConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Account_oauthIdentities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _AnalyticsPreference_type(ctx context.Context, field graphql.CollectedField, obj *model.AnalyticsPreference) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -1055,7 +1224,7 @@ func (ec *executionContext) _RequestPhoneNumberVerificationTokenPayload(ctx cont
 
 // region    **************************** object.gotpl ****************************
 
-var accountImplementors = []string{"Account", "Node", "RemoveAccountPhoneNumberPayload", "DeletePasswordPayload", "DisableAccount2FAWithAuthenticatorPayload", "LoginWithPasskeyPayload", "LoginWithPasswordPayload", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "VerifyGoogleTokenPayload", "ViewerPayload", "UpdateAccountPayload", "UpdateAccountPhoneNumberPayload", "RequestSudoModeWithAuthenticatorPayload", "RequestSudoModeWithPasskeyPayload", "RequestSudoModeWithPasswordPayload", "ResetPasswordPayload", "RegisterWithPasskeyPayload", "RegisterWithPasswordPayload", "UpdatePasswordPayload"}
+var accountImplementors = []string{"Account", "Node", "RemoveAccountPhoneNumberPayload", "DeletePasswordPayload", "DisableAccount2FAWithAuthenticatorPayload", "LoginWithPasskeyPayload", "LoginWithEmailCodePayload", "LoginWithSmsCodePayload", "LoginWithPasswordPayload", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "VerifyGoogleTokenPayload", "ViewerPayload", "UpdateAccountPayload", "UpdateAccountPhoneNumberPayload", "RequestSudoModeWithAuthenticatorPayload", "RequestSudoModeWithPasskeyPayload", "RequestSudoModeWithPasswordPayload", "ResetPasswordPayload", "RegisterWithPasskeyPayload", "RegisterWithPasswordPayload", "UpdatePasswordPayload"}
 
 func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet, obj *model.Account) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, accountImplementors)
@@ -1069,22 +1238,22 @@ func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet,
 		case "id":
 			out.Values[i] = ec._Account_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "fullName":
 			out.Values[i] = ec._Account_fullName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "email":
 			out.Values[i] = ec._Account_email(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "avatarUrl":
 			out.Values[i] = ec._Account_avatarUrl(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "phoneNumber":
 			out.Values[i] = ec._Account_phoneNumber(ctx, field, obj)
@@ -1093,45 +1262,241 @@ func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet,
 		case "authProviders":
 			out.Values[i] = ec._Account_authProviders(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "twoFactorProviders":
 			out.Values[i] = ec._Account_twoFactorProviders(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "has2faEnabled":
 			out.Values[i] = ec._Account_has2faEnabled(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "termsAndPolicy":
 			out.Values[i] = ec._Account_termsAndPolicy(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "analyticsPreference":
 			out.Values[i] = ec._Account_analyticsPreference(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "sudoModeExpiresAt":
-			out.Values[i] = ec._Account_sudoModeExpiresAt(ctx, field, obj)
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_sudoModeExpiresAt(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "currentSession":
-			out.Values[i] = ec._Account_currentSession(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_currentSession(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "sessions":
-			out.Values[i] = ec._Account_sessions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_sessions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "webAuthnCredentials":
-			out.Values[i] = ec._Account_webAuthnCredentials(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_webAuthnCredentials(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "personalAccessTokens":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_personalAccessTokens(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "oauthIdentities":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_oauthIdentities(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1199,7 +1564,7 @@ func (ec *executionContext) _AnalyticsPreference(ctx context.Context, sel ast.Se
 	return out
 }
 
-var invalidPhoneNumberErrorImplementors = []string{"InvalidPhoneNumberError", "Error", "RequestPhoneNumberVerificationTokenPayload", "UpdateAccountPhoneNumberPayload"}
+var invalidPhoneNumberErrorImplementors = []string{"InvalidPhoneNumberError", "Error", "RequestPhoneNumberVerificationTokenPayload", "UpdateAccountPhoneNumberPayload", "RequestSmsLoginCodePayload"}
 
 func (ec *executionContext) _InvalidPhoneNumberError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidPhoneNumberError) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, invalidPhoneNumberErrorImplementors)
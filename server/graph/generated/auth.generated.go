@@ -17,6 +17,10 @@ import (
 
 // region    ************************** generated!.gotpl **************************
 
+type SessionResolver interface {
+	IsCurrent(ctx context.Context, obj *model.Session) (bool, error)
+}
+
 // endregion ************************** generated!.gotpl **************************
 
 // region    ***************************** args.gotpl *****************************
@@ -58,6 +62,186 @@ func (ec *executionContext) fieldContext_AuthenticatorNotEnabledError_message(_
 	return fc, nil
 }
 
+func (ec *executionContext) _BearerTokens_accessToken(ctx context.Context, field graphql.CollectedField, obj *model.BearerTokens) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BearerTokens_accessToken,
+		func(ctx context.Context) (any, error) {
+			return obj.AccessToken, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BearerTokens_accessToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BearerTokens",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BearerTokens_accessTokenExpiresAt(ctx context.Context, field graphql.CollectedField, obj *model.BearerTokens) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BearerTokens_accessTokenExpiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.AccessTokenExpiresAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BearerTokens_accessTokenExpiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BearerTokens",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BearerTokens_refreshToken(ctx context.Context, field graphql.CollectedField, obj *model.BearerTokens) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BearerTokens_refreshToken,
+		func(ctx context.Context) (any, error) {
+			return obj.RefreshToken, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BearerTokens_refreshToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BearerTokens",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BearerTokens_refreshTokenExpiresAt(ctx context.Context, field graphql.CollectedField, obj *model.BearerTokens) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BearerTokens_refreshTokenExpiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.RefreshTokenExpiresAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BearerTokens_refreshTokenExpiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BearerTokens",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePersonalAccessTokenSuccess_token(ctx context.Context, field graphql.CollectedField, obj *model.CreatePersonalAccessTokenSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePersonalAccessTokenSuccess_token,
+		func(ctx context.Context) (any, error) {
+			return obj.Token, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePersonalAccessTokenSuccess_token(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePersonalAccessTokenSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreatePersonalAccessTokenSuccess_personalAccessTokenEdge(ctx context.Context, field graphql.CollectedField, obj *model.CreatePersonalAccessTokenSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreatePersonalAccessTokenSuccess_personalAccessTokenEdge,
+		func(ctx context.Context) (any, error) {
+			return obj.PersonalAccessTokenEdge, nil
+		},
+		nil,
+		ec.marshalNPersonalAccessTokenEdge2ᚖserverᚋgraphᚋmodelᚐPersonalAccessTokenEdge,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreatePersonalAccessTokenSuccess_personalAccessTokenEdge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreatePersonalAccessTokenSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_PersonalAccessTokenEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_PersonalAccessTokenEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PersonalAccessTokenEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CreateWebAuthnCredentialSuccess_webAuthnCredentialEdge(ctx context.Context, field graphql.CollectedField, obj *model.CreateWebAuthnCredentialSuccess) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -333,6 +517,10 @@ func (ec *executionContext) fieldContext_EnableAccount2FAWithAuthenticatorSucces
 				return ec.fieldContext_Account_sessions(ctx, field)
 			case "webAuthnCredentials":
 				return ec.fieldContext_Account_webAuthnCredentials(ctx, field)
+			case "personalAccessTokens":
+				return ec.fieldContext_Account_personalAccessTokens(ctx, field)
+			case "oauthIdentities":
+				return ec.fieldContext_Account_oauthIdentities(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Account", field.Name)
 		},
@@ -572,6 +760,35 @@ func (ec *executionContext) fieldContext_InsufficientAuthProvidersError_message(
 	return fc, nil
 }
 
+func (ec *executionContext) _InvalidAccountUnlockTokenError_message(ctx context.Context, field graphql.CollectedField, obj *model.InvalidAccountUnlockTokenError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InvalidAccountUnlockTokenError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InvalidAccountUnlockTokenError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InvalidAccountUnlockTokenError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _InvalidAuthenticationProviderError_message(ctx context.Context, field graphql.CollectedField, obj *model.InvalidAuthenticationProviderError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -688,6 +905,35 @@ func (ec *executionContext) fieldContext_InvalidEmailError_message(_ context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _InvalidEmailLoginCodeError_message(ctx context.Context, field graphql.CollectedField, obj *model.InvalidEmailLoginCodeError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_InvalidEmailLoginCodeError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_InvalidEmailLoginCodeError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InvalidEmailLoginCodeError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _InvalidEmailVerificationTokenError_message(ctx context.Context, field graphql.CollectedField, obj *model.InvalidEmailVerificationTokenError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -804,12 +1050,12 @@ func (ec *executionContext) fieldContext_InvalidPasswordResetTokenError_message(
 	return fc, nil
 }
 
-func (ec *executionContext) _LogoutPayload_message(ctx context.Context, field graphql.CollectedField, obj *model.LogoutPayload) (ret graphql.Marshaler) {
+func (ec *executionContext) _InvalidPersonalAccessTokenError_message(ctx context.Context, field graphql.CollectedField, obj *model.InvalidPersonalAccessTokenError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LogoutPayload_message,
+		ec.fieldContext_InvalidPersonalAccessTokenError_message,
 		func(ctx context.Context) (any, error) {
 			return obj.Message, nil
 		},
@@ -820,9 +1066,9 @@ func (ec *executionContext) _LogoutPayload_message(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_LogoutPayload_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InvalidPersonalAccessTokenError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogoutPayload",
+		Object:     "InvalidPersonalAccessTokenError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -833,12 +1079,12 @@ func (ec *executionContext) fieldContext_LogoutPayload_message(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordNotStrongError_message(ctx context.Context, field graphql.CollectedField, obj *model.PasswordNotStrongError) (ret graphql.Marshaler) {
+func (ec *executionContext) _InvalidRefreshTokenError_message(ctx context.Context, field graphql.CollectedField, obj *model.InvalidRefreshTokenError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordNotStrongError_message,
+		ec.fieldContext_InvalidRefreshTokenError_message,
 		func(ctx context.Context) (any, error) {
 			return obj.Message, nil
 		},
@@ -849,9 +1095,9 @@ func (ec *executionContext) _PasswordNotStrongError_message(ctx context.Context,
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordNotStrongError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InvalidRefreshTokenError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordNotStrongError",
+		Object:     "InvalidRefreshTokenError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -862,43 +1108,43 @@ func (ec *executionContext) fieldContext_PasswordNotStrongError_message(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetToken_id(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
+func (ec *executionContext) _InvalidSessionRevocationTokenError_message(ctx context.Context, field graphql.CollectedField, obj *model.InvalidSessionRevocationTokenError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordResetToken_id,
+		ec.fieldContext_InvalidSessionRevocationTokenError_message,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordResetToken_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InvalidSessionRevocationTokenError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordResetToken",
+		Object:     "InvalidSessionRevocationTokenError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetToken_email(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
+func (ec *executionContext) _InvalidSmsLoginCodeError_message(ctx context.Context, field graphql.CollectedField, obj *model.InvalidSmsLoginCodeError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordResetToken_email,
+		ec.fieldContext_InvalidSmsLoginCodeError_message,
 		func(ctx context.Context) (any, error) {
-			return obj.Email, nil
+			return obj.Message, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -907,9 +1153,9 @@ func (ec *executionContext) _PasswordResetToken_email(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordResetToken_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InvalidSmsLoginCodeError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordResetToken",
+		Object:     "InvalidSmsLoginCodeError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -920,101 +1166,101 @@ func (ec *executionContext) fieldContext_PasswordResetToken_email(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetToken_authProviders(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
+func (ec *executionContext) _LinkOAuthIdentitySuccess_authorizationUrl(ctx context.Context, field graphql.CollectedField, obj *model.LinkOAuthIdentitySuccess) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordResetToken_authProviders,
+		ec.fieldContext_LinkOAuthIdentitySuccess_authorizationUrl,
 		func(ctx context.Context) (any, error) {
-			return obj.AuthProviders, nil
+			return obj.AuthorizationURL, nil
 		},
 		nil,
-		ec.marshalNAuthProvider2ᚕserverᚋgraphᚋmodelᚐAuthProviderᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordResetToken_authProviders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LinkOAuthIdentitySuccess_authorizationUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordResetToken",
+		Object:     "LinkOAuthIdentitySuccess",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type AuthProvider does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetToken_twoFactorProviders(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
+func (ec *executionContext) _LogoutPayload_message(ctx context.Context, field graphql.CollectedField, obj *model.LogoutPayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordResetToken_twoFactorProviders,
+		ec.fieldContext_LogoutPayload_message,
 		func(ctx context.Context) (any, error) {
-			return obj.TwoFactorProviders, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalNTwoFactorProvider2ᚕserverᚋgraphᚋmodelᚐTwoFactorProviderᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordResetToken_twoFactorProviders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogoutPayload_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordResetToken",
+		Object:     "LogoutPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type TwoFactorProvider does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetToken_needs2fa(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentity_id(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordResetToken_needs2fa,
+		ec.fieldContext_OAuthIdentity_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Needs2fa, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordResetToken_needs2fa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentity_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordResetToken",
+		Object:     "OAuthIdentity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetTokenCooldownError_message(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetTokenCooldownError) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentity_provider(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordResetTokenCooldownError_message,
+		ec.fieldContext_OAuthIdentity_provider,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.Provider, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1023,9 +1269,9 @@ func (ec *executionContext) _PasswordResetTokenCooldownError_message(ctx context
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordResetTokenCooldownError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentity_provider(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordResetTokenCooldownError",
+		Object:     "OAuthIdentity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1036,112 +1282,128 @@ func (ec *executionContext) fieldContext_PasswordResetTokenCooldownError_message
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetTokenCooldownError_remainingSeconds(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetTokenCooldownError) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentity_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordResetTokenCooldownError_remainingSeconds,
+		ec.fieldContext_OAuthIdentity_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.RemainingSeconds, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNDateTime2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordResetTokenCooldownError_remainingSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentity_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordResetTokenCooldownError",
+		Object:     "OAuthIdentity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetTokenNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetTokenNotFoundError) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentityConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentityConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PasswordResetTokenNotFoundError_message,
+		ec.fieldContext_OAuthIdentityConnection_pageInfo,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.PageInfo, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNPageInfo2ᚖserverᚋgraphᚋmodelᚐPageInfo,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PasswordResetTokenNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentityConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PasswordResetTokenNotFoundError",
+		Object:     "OAuthIdentityConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestEmailVerificationSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.RequestEmailVerificationSuccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentityConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentityConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RequestEmailVerificationSuccess_message,
+		ec.fieldContext_OAuthIdentityConnection_edges,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.Edges, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNOAuthIdentityEdge2ᚕᚖserverᚋgraphᚋmodelᚐOAuthIdentityEdgeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RequestEmailVerificationSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentityConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestEmailVerificationSuccess",
+		Object:     "OAuthIdentityConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_OAuthIdentityEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_OAuthIdentityEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OAuthIdentityEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestEmailVerificationSuccess_remainingSeconds(ctx context.Context, field graphql.CollectedField, obj *model.RequestEmailVerificationSuccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentityConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentityConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RequestEmailVerificationSuccess_remainingSeconds,
+		ec.fieldContext_OAuthIdentityConnection_totalCount,
 		func(ctx context.Context) (any, error) {
-			return obj.RemainingSeconds, nil
+			return obj.TotalCount, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOInt2ᚖint32,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RequestEmailVerificationSuccess_remainingSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentityConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestEmailVerificationSuccess",
+		Object:     "OAuthIdentityConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1152,14 +1414,14 @@ func (ec *executionContext) fieldContext_RequestEmailVerificationSuccess_remaini
 	return fc, nil
 }
 
-func (ec *executionContext) _RequestPasswordResetSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.RequestPasswordResetSuccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentityEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentityEdge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RequestPasswordResetSuccess_message,
+		ec.fieldContext_OAuthIdentityEdge_cursor,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.Cursor, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1168,9 +1430,9 @@ func (ec *executionContext) _RequestPasswordResetSuccess_message(ctx context.Con
 	)
 }
 
-func (ec *executionContext) fieldContext_RequestPasswordResetSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentityEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RequestPasswordResetSuccess",
+		Object:     "OAuthIdentityEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1181,43 +1443,51 @@ func (ec *executionContext) fieldContext_RequestPasswordResetSuccess_message(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_id(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentityEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentityEdge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_id,
+		ec.fieldContext_OAuthIdentityEdge_node,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Node, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNOAuthIdentity2ᚖserverᚋgraphᚋmodelᚐOAuthIdentity,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentityEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "OAuthIdentityEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_OAuthIdentity_id(ctx, field)
+			case "provider":
+				return ec.fieldContext_OAuthIdentity_provider(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_OAuthIdentity_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OAuthIdentity", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_userAgent(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthIdentityNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.OAuthIdentityNotFoundError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_userAgent,
+		ec.fieldContext_OAuthIdentityNotFoundError_message,
 		func(ctx context.Context) (any, error) {
-			return obj.UserAgent, nil
+			return obj.Message, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1226,9 +1496,9 @@ func (ec *executionContext) _Session_userAgent(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_userAgent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthIdentityNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "OAuthIdentityNotFoundError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1239,14 +1509,14 @@ func (ec *executionContext) fieldContext_Session_userAgent(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_ipAddress(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+func (ec *executionContext) _OAuthProviderNotSupportedError_message(ctx context.Context, field graphql.CollectedField, obj *model.OAuthProviderNotSupportedError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_ipAddress,
+		ec.fieldContext_OAuthProviderNotSupportedError_message,
 		func(ctx context.Context) (any, error) {
-			return obj.IPAddress, nil
+			return obj.Message, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1255,9 +1525,9 @@ func (ec *executionContext) _Session_ipAddress(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_ipAddress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAuthProviderNotSupportedError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "OAuthProviderNotSupportedError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1268,241 +1538,215 @@ func (ec *executionContext) fieldContext_Session_ipAddress(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Session_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordNotStrongError_message(ctx context.Context, field graphql.CollectedField, obj *model.PasswordNotStrongError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Session_createdAt,
+		ec.fieldContext_PasswordNotStrongError_message,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalNDateTime2string,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Session_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordNotStrongError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Session",
+		Object:     "PasswordNotStrongError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SessionConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordPreviouslyUsedError_message(ctx context.Context, field graphql.CollectedField, obj *model.PasswordPreviouslyUsedError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionConnection_pageInfo,
+		ec.fieldContext_PasswordPreviouslyUsedError_message,
 		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalNPageInfo2ᚖserverᚋgraphᚋmodelᚐPageInfo,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordPreviouslyUsedError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionConnection",
+		Object:     "PasswordPreviouslyUsedError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.SessionConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordResetToken_id(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionConnection_edges,
+		ec.fieldContext_PasswordResetToken_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Edges, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNSessionEdge2ᚕᚖserverᚋgraphᚋmodelᚐSessionEdgeᚄ,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordResetToken_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionConnection",
+		Object:     "PasswordResetToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "cursor":
-				return ec.fieldContext_SessionEdge_cursor(ctx, field)
-			case "node":
-				return ec.fieldContext_SessionEdge_node(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SessionEdge", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.SessionConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordResetToken_email(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionConnection_totalCount,
+		ec.fieldContext_PasswordResetToken_email,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalCount, nil
+			return obj.Email, nil
 		},
 		nil,
-		ec.marshalOInt2ᚖint32,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordResetToken_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionConnection",
+		Object:     "PasswordResetToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.SessionEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordResetToken_authProviders(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionEdge_cursor,
+		ec.fieldContext_PasswordResetToken_authProviders,
 		func(ctx context.Context) (any, error) {
-			return obj.Cursor, nil
+			return obj.AuthProviders, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNAuthProvider2ᚕserverᚋgraphᚋmodelᚐAuthProviderᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordResetToken_authProviders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionEdge",
+		Object:     "PasswordResetToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type AuthProvider does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.SessionEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordResetToken_twoFactorProviders(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionEdge_node,
+		ec.fieldContext_PasswordResetToken_twoFactorProviders,
 		func(ctx context.Context) (any, error) {
-			return obj.Node, nil
+			return obj.TwoFactorProviders, nil
 		},
 		nil,
-		ec.marshalNSession2ᚖserverᚋgraphᚋmodelᚐSession,
+		ec.marshalNTwoFactorProvider2ᚕserverᚋgraphᚋmodelᚐTwoFactorProviderᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordResetToken_twoFactorProviders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionEdge",
+		Object:     "PasswordResetToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Session_id(ctx, field)
-			case "userAgent":
-				return ec.fieldContext_Session_userAgent(ctx, field)
-			case "ipAddress":
-				return ec.fieldContext_Session_ipAddress(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Session_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+			return nil, errors.New("field of type TwoFactorProvider does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SessionNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.SessionNotFoundError) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordResetToken_needs2fa(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SessionNotFoundError_message,
+		ec.fieldContext_PasswordResetToken_needs2fa,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.Needs2fa, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SessionNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordResetToken_needs2fa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SessionNotFoundError",
+		Object:     "PasswordResetToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TwoFactorAuthenticationChallengeNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.TwoFactorAuthenticationChallengeNotFoundError) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordResetTokenCooldownError_message(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetTokenCooldownError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TwoFactorAuthenticationChallengeNotFoundError_message,
+		ec.fieldContext_PasswordResetTokenCooldownError_message,
 		func(ctx context.Context) (any, error) {
 			return obj.Message, nil
 		},
@@ -1513,9 +1757,9 @@ func (ec *executionContext) _TwoFactorAuthenticationChallengeNotFoundError_messa
 	)
 }
 
-func (ec *executionContext) fieldContext_TwoFactorAuthenticationChallengeNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordResetTokenCooldownError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TwoFactorAuthenticationChallengeNotFoundError",
+		Object:     "PasswordResetTokenCooldownError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1526,41 +1770,41 @@ func (ec *executionContext) fieldContext_TwoFactorAuthenticationChallengeNotFoun
 	return fc, nil
 }
 
-func (ec *executionContext) _TwoFactorAuthenticationNotEnabledError_message(ctx context.Context, field graphql.CollectedField, obj *model.TwoFactorAuthenticationNotEnabledError) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordResetTokenCooldownError_remainingSeconds(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetTokenCooldownError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TwoFactorAuthenticationNotEnabledError_message,
+		ec.fieldContext_PasswordResetTokenCooldownError_remainingSeconds,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.RemainingSeconds, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_TwoFactorAuthenticationNotEnabledError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordResetTokenCooldownError_remainingSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TwoFactorAuthenticationNotEnabledError",
+		Object:     "PasswordResetTokenCooldownError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TwoFactorAuthenticationRequiredError_message(ctx context.Context, field graphql.CollectedField, obj *model.TwoFactorAuthenticationRequiredError) (ret graphql.Marshaler) {
+func (ec *executionContext) _PasswordResetTokenNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.PasswordResetTokenNotFoundError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_TwoFactorAuthenticationRequiredError_message,
+		ec.fieldContext_PasswordResetTokenNotFoundError_message,
 		func(ctx context.Context) (any, error) {
 			return obj.Message, nil
 		},
@@ -1571,9 +1815,9 @@ func (ec *executionContext) _TwoFactorAuthenticationRequiredError_message(ctx co
 	)
 }
 
-func (ec *executionContext) fieldContext_TwoFactorAuthenticationRequiredError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PasswordResetTokenNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TwoFactorAuthenticationRequiredError",
+		Object:     "PasswordResetTokenNotFoundError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1584,43 +1828,43 @@ func (ec *executionContext) fieldContext_TwoFactorAuthenticationRequiredError_me
 	return fc, nil
 }
 
-func (ec *executionContext) _VerifyEmailSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.VerifyEmailSuccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessToken_id(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_VerifyEmailSuccess_message,
+		ec.fieldContext_PersonalAccessToken_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_VerifyEmailSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessToken_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VerifyEmailSuccess",
+		Object:     "PersonalAccessToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnChallengeNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnChallengeNotFoundError) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessToken_name(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnChallengeNotFoundError_message,
+		ec.fieldContext_PersonalAccessToken_name,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.Name, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1629,9 +1873,9 @@ func (ec *executionContext) _WebAuthnChallengeNotFoundError_message(ctx context.
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnChallengeNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessToken_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnChallengeNotFoundError",
+		Object:     "PersonalAccessToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1642,83 +1886,83 @@ func (ec *executionContext) fieldContext_WebAuthnChallengeNotFoundError_message(
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredential_id(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredential) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessToken_scopes(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredential_id,
+		ec.fieldContext_PersonalAccessToken_scopes,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Scopes, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNPersonalAccessTokenScope2ᚕserverᚋgraphᚋmodelᚐPersonalAccessTokenScopeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredential_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessToken_scopes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredential",
+		Object:     "PersonalAccessToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type PersonalAccessTokenScope does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredential_nickname(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredential) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessToken_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredential_nickname,
+		ec.fieldContext_PersonalAccessToken_expiresAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Nickname, nil
+			return obj.ExpiresAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalODateTime2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredential_nickname(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessToken_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredential",
+		Object:     "PersonalAccessToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredential_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredential) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessToken_lastUsedAt(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredential_createdAt,
+		ec.fieldContext_PersonalAccessToken_lastUsedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.LastUsedAt, nil
 		},
 		nil,
-		ec.marshalNDateTime2string,
-		true,
+		ec.marshalODateTime2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredential_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessToken_lastUsedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredential",
+		Object:     "PersonalAccessToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1729,14 +1973,14 @@ func (ec *executionContext) fieldContext_WebAuthnCredential_createdAt(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredential_lastUsedAt(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredential) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessToken_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessToken) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredential_lastUsedAt,
+		ec.fieldContext_PersonalAccessToken_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.LastUsedAt, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
 		ec.marshalNDateTime2string,
@@ -1745,9 +1989,9 @@ func (ec *executionContext) _WebAuthnCredential_lastUsedAt(ctx context.Context,
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredential_lastUsedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessToken_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredential",
+		Object:     "PersonalAccessToken",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1758,12 +2002,12 @@ func (ec *executionContext) fieldContext_WebAuthnCredential_lastUsedAt(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredentialConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessTokenConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessTokenConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredentialConnection_pageInfo,
+		ec.fieldContext_PersonalAccessTokenConnection_pageInfo,
 		func(ctx context.Context) (any, error) {
 			return obj.PageInfo, nil
 		},
@@ -1774,9 +2018,9 @@ func (ec *executionContext) _WebAuthnCredentialConnection_pageInfo(ctx context.C
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredentialConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessTokenConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredentialConnection",
+		Object:     "PersonalAccessTokenConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1797,47 +2041,47 @@ func (ec *executionContext) fieldContext_WebAuthnCredentialConnection_pageInfo(_
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredentialConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessTokenConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessTokenConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredentialConnection_edges,
+		ec.fieldContext_PersonalAccessTokenConnection_edges,
 		func(ctx context.Context) (any, error) {
 			return obj.Edges, nil
 		},
 		nil,
-		ec.marshalNWebAuthnCredentialEdge2ᚕᚖserverᚋgraphᚋmodelᚐWebAuthnCredentialEdgeᚄ,
+		ec.marshalNPersonalAccessTokenEdge2ᚕᚖserverᚋgraphᚋmodelᚐPersonalAccessTokenEdgeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredentialConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessTokenConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredentialConnection",
+		Object:     "PersonalAccessTokenConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "cursor":
-				return ec.fieldContext_WebAuthnCredentialEdge_cursor(ctx, field)
+				return ec.fieldContext_PersonalAccessTokenEdge_cursor(ctx, field)
 			case "node":
-				return ec.fieldContext_WebAuthnCredentialEdge_node(ctx, field)
+				return ec.fieldContext_PersonalAccessTokenEdge_node(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type WebAuthnCredentialEdge", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PersonalAccessTokenEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredentialConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessTokenConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessTokenConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredentialConnection_totalCount,
+		ec.fieldContext_PersonalAccessTokenConnection_totalCount,
 		func(ctx context.Context) (any, error) {
 			return obj.TotalCount, nil
 		},
@@ -1848,9 +2092,9 @@ func (ec *executionContext) _WebAuthnCredentialConnection_totalCount(ctx context
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredentialConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessTokenConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredentialConnection",
+		Object:     "PersonalAccessTokenConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1861,12 +2105,12 @@ func (ec *executionContext) fieldContext_WebAuthnCredentialConnection_totalCount
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredentialEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessTokenEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessTokenEdge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredentialEdge_cursor,
+		ec.fieldContext_PersonalAccessTokenEdge_cursor,
 		func(ctx context.Context) (any, error) {
 			return obj.Cursor, nil
 		},
@@ -1877,9 +2121,9 @@ func (ec *executionContext) _WebAuthnCredentialEdge_cursor(ctx context.Context,
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredentialEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessTokenEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredentialEdge",
+		Object:     "PersonalAccessTokenEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1890,51 +2134,55 @@ func (ec *executionContext) fieldContext_WebAuthnCredentialEdge_cursor(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredentialEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessTokenEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessTokenEdge) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredentialEdge_node,
+		ec.fieldContext_PersonalAccessTokenEdge_node,
 		func(ctx context.Context) (any, error) {
 			return obj.Node, nil
 		},
 		nil,
-		ec.marshalNWebAuthnCredential2ᚖserverᚋgraphᚋmodelᚐWebAuthnCredential,
+		ec.marshalNPersonalAccessToken2ᚖserverᚋgraphᚋmodelᚐPersonalAccessToken,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredentialEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessTokenEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredentialEdge",
+		Object:     "PersonalAccessTokenEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_WebAuthnCredential_id(ctx, field)
-			case "nickname":
-				return ec.fieldContext_WebAuthnCredential_nickname(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_WebAuthnCredential_createdAt(ctx, field)
+				return ec.fieldContext_PersonalAccessToken_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PersonalAccessToken_name(ctx, field)
+			case "scopes":
+				return ec.fieldContext_PersonalAccessToken_scopes(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_PersonalAccessToken_expiresAt(ctx, field)
 			case "lastUsedAt":
-				return ec.fieldContext_WebAuthnCredential_lastUsedAt(ctx, field)
+				return ec.fieldContext_PersonalAccessToken_lastUsedAt(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_PersonalAccessToken_createdAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type WebAuthnCredential", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PersonalAccessToken", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _WebAuthnCredentialNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialNotFoundError) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalAccessTokenNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.PersonalAccessTokenNotFoundError) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_WebAuthnCredentialNotFoundError_message,
+		ec.fieldContext_PersonalAccessTokenNotFoundError_message,
 		func(ctx context.Context) (any, error) {
 			return obj.Message, nil
 		},
@@ -1945,9 +2193,9 @@ func (ec *executionContext) _WebAuthnCredentialNotFoundError_message(ctx context
 	)
 }
 
-func (ec *executionContext) fieldContext_WebAuthnCredentialNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalAccessTokenNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "WebAuthnCredentialNotFoundError",
+		Object:     "PersonalAccessTokenNotFoundError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1958,1084 +2206,3971 @@ func (ec *executionContext) fieldContext_WebAuthnCredentialNotFoundError_message
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _RequestEmailLoginCodeSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.RequestEmailLoginCodeSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RequestEmailLoginCodeSuccess_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
 
-func (ec *executionContext) _CreateWebAuthnCredentialPayload(ctx context.Context, sel ast.SelectionSet, obj model.CreateWebAuthnCredentialPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.InvalidPasskeyRegistrationCredentialError:
-		return ec._InvalidPasskeyRegistrationCredentialError(ctx, sel, &obj)
-	case *model.InvalidPasskeyRegistrationCredentialError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPasskeyRegistrationCredentialError(ctx, sel, obj)
-	case model.CreateWebAuthnCredentialSuccess:
-		return ec._CreateWebAuthnCredentialSuccess(ctx, sel, &obj)
-	case *model.CreateWebAuthnCredentialSuccess:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._CreateWebAuthnCredentialSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+func (ec *executionContext) fieldContext_RequestEmailLoginCodeSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestEmailLoginCodeSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) _DeletePasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.DeletePasswordPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.InsufficientAuthProvidersError:
-		return ec._InsufficientAuthProvidersError(ctx, sel, &obj)
-	case *model.InsufficientAuthProvidersError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InsufficientAuthProvidersError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
-	}
+func (ec *executionContext) _RequestEmailVerificationSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.RequestEmailVerificationSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RequestEmailVerificationSuccess_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
 }
 
-func (ec *executionContext) _DeleteSessionPayload(ctx context.Context, sel ast.SelectionSet, obj model.DeleteSessionPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.SessionNotFoundError:
-		return ec._SessionNotFoundError(ctx, sel, &obj)
-	case *model.SessionNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._SessionNotFoundError(ctx, sel, obj)
-	case model.DeleteSessionSuccess:
-		return ec._DeleteSessionSuccess(ctx, sel, &obj)
-	case *model.DeleteSessionSuccess:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._DeleteSessionSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+func (ec *executionContext) fieldContext_RequestEmailVerificationSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestEmailVerificationSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) _DeleteWebAuthnCredentialPayload(ctx context.Context, sel ast.SelectionSet, obj model.DeleteWebAuthnCredentialPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.WebAuthnCredentialNotFoundError:
-		return ec._WebAuthnCredentialNotFoundError(ctx, sel, &obj)
-	case *model.WebAuthnCredentialNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._WebAuthnCredentialNotFoundError(ctx, sel, obj)
-	case model.InsufficientAuthProvidersError:
-		return ec._InsufficientAuthProvidersError(ctx, sel, &obj)
-	case *model.InsufficientAuthProvidersError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InsufficientAuthProvidersError(ctx, sel, obj)
-	case model.DeleteWebAuthnCredentialSuccess:
-		return ec._DeleteWebAuthnCredentialSuccess(ctx, sel, &obj)
-	case *model.DeleteWebAuthnCredentialSuccess:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._DeleteWebAuthnCredentialSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
-	}
+func (ec *executionContext) _RequestEmailVerificationSuccess_remainingSeconds(ctx context.Context, field graphql.CollectedField, obj *model.RequestEmailVerificationSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RequestEmailVerificationSuccess_remainingSeconds,
+		func(ctx context.Context) (any, error) {
+			return obj.RemainingSeconds, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
 }
 
-func (ec *executionContext) _DisableAccount2FAWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, obj model.DisableAccount2FAWithAuthenticatorPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.AuthenticatorNotEnabledError:
-		return ec._AuthenticatorNotEnabledError(ctx, sel, &obj)
-	case *model.AuthenticatorNotEnabledError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AuthenticatorNotEnabledError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+func (ec *executionContext) fieldContext_RequestEmailVerificationSuccess_remainingSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestEmailVerificationSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) _Generate2FARecoveryCodesPayload(ctx context.Context, sel ast.SelectionSet, obj model.Generate2FARecoveryCodesPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.TwoFactorAuthenticationNotEnabledError:
-		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationNotEnabledError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, obj)
-	case model.Generate2FARecoveryCodesSuccess:
-		return ec._Generate2FARecoveryCodesSuccess(ctx, sel, &obj)
-	case *model.Generate2FARecoveryCodesSuccess:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Generate2FARecoveryCodesSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
-	}
+func (ec *executionContext) _RequestPasswordResetSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.RequestPasswordResetSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RequestPasswordResetSuccess_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
 }
 
-func (ec *executionContext) _GenerateAuthenticationOptionsPayload(ctx context.Context, sel ast.SelectionSet, obj model.GenerateAuthenticationOptionsPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.GenerateAuthenticationOptionsSuccess:
-		return ec._GenerateAuthenticationOptionsSuccess(ctx, sel, &obj)
-	case *model.GenerateAuthenticationOptionsSuccess:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._GenerateAuthenticationOptionsSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+func (ec *executionContext) fieldContext_RequestPasswordResetSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestPasswordResetSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) _GenerateAuthenticator2FAChallengePayload(ctx context.Context, sel ast.SelectionSet, obj model.GenerateAuthenticator2FAChallengePayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.GenerateAuthenticator2FAChallengeSuccess:
-		return ec._GenerateAuthenticator2FAChallengeSuccess(ctx, sel, &obj)
-	case *model.GenerateAuthenticator2FAChallengeSuccess:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._GenerateAuthenticator2FAChallengeSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
-	}
+func (ec *executionContext) _RequestSmsLoginCodeSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.RequestSmsLoginCodeSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RequestSmsLoginCodeSuccess_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
 }
 
-func (ec *executionContext) _GeneratePasskeyCreationOptionsPayload(ctx context.Context, sel ast.SelectionSet, obj model.GeneratePasskeyCreationOptionsPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.GeneratePasskeyCreationOptionsSuccess:
-		return ec._GeneratePasskeyCreationOptionsSuccess(ctx, sel, &obj)
-	case *model.GeneratePasskeyCreationOptionsSuccess:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._GeneratePasskeyCreationOptionsSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+func (ec *executionContext) fieldContext_RequestSmsLoginCodeSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RequestSmsLoginCodeSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) _GeneratePasskeyRegistrationOptionsPayload(ctx context.Context, sel ast.SelectionSet, obj model.GeneratePasskeyRegistrationOptionsPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.EmailInUseError:
-		return ec._EmailInUseError(ctx, sel, &obj)
-	case *model.EmailInUseError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._EmailInUseError(ctx, sel, obj)
-	case model.GeneratePasskeyRegistrationOptionsSuccess:
-		return ec._GeneratePasskeyRegistrationOptionsSuccess(ctx, sel, &obj)
-	case *model.GeneratePasskeyRegistrationOptionsSuccess:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._GeneratePasskeyRegistrationOptionsSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
-	}
+func (ec *executionContext) _RevokePersonalAccessTokenSuccess_personalAccessTokenEdge(ctx context.Context, field graphql.CollectedField, obj *model.RevokePersonalAccessTokenSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RevokePersonalAccessTokenSuccess_personalAccessTokenEdge,
+		func(ctx context.Context) (any, error) {
+			return obj.PersonalAccessTokenEdge, nil
+		},
+		nil,
+		ec.marshalNPersonalAccessTokenEdge2ᚖserverᚋgraphᚋmodelᚐPersonalAccessTokenEdge,
+		true,
+		true,
+	)
 }
 
-func (ec *executionContext) _LoginWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, obj model.LoginWithPasskeyPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.WebAuthnChallengeNotFoundError:
-		return ec._WebAuthnChallengeNotFoundError(ctx, sel, &obj)
-	case *model.WebAuthnChallengeNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._WebAuthnChallengeNotFoundError(ctx, sel, obj)
-	case model.InvalidPasskeyAuthenticationCredentialError:
-		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, &obj)
-	case *model.InvalidPasskeyAuthenticationCredentialError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+func (ec *executionContext) fieldContext_RevokePersonalAccessTokenSuccess_personalAccessTokenEdge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RevokePersonalAccessTokenSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_PersonalAccessTokenEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_PersonalAccessTokenEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PersonalAccessTokenEdge", field.Name)
+		},
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) _LoginWithPasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.LoginWithPasswordPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.TwoFactorAuthenticationRequiredError:
-		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationRequiredError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, obj)
-	case model.InvalidCredentialsError:
-		return ec._InvalidCredentialsError(ctx, sel, &obj)
-	case *model.InvalidCredentialsError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCredentialsError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.InvalidAuthenticationProviderError:
-		return ec._InvalidAuthenticationProviderError(ctx, sel, &obj)
-	case *model.InvalidAuthenticationProviderError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidAuthenticationProviderError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+func (ec *executionContext) _RevokeSessionsSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.RevokeSessionsSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RevokeSessionsSuccess_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RevokeSessionsSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RevokeSessionsSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
+	return fc, nil
 }
 
-func (ec *executionContext) _PasswordResetTokenPayload(ctx context.Context, sel ast.SelectionSet, obj model.PasswordResetTokenPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+func (ec *executionContext) _Session_id(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Session_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Session_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_userAgent(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Session_userAgent,
+		func(ctx context.Context) (any, error) {
+			return obj.UserAgent, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Session_userAgent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_ipAddress(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Session_ipAddress,
+		func(ctx context.Context) (any, error) {
+			return obj.IPAddress, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Session_ipAddress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Session_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Session_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_lastActiveAt(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Session_lastActiveAt,
+		func(ctx context.Context) (any, error) {
+			return obj.LastActiveAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Session_lastActiveAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_isCurrent(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Session_isCurrent,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Session().IsCurrent(ctx, obj)
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Session_isCurrent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_device(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Session_device,
+		func(ctx context.Context) (any, error) {
+			return obj.Device, nil
+		},
+		nil,
+		ec.marshalNSessionDevice2ᚖserverᚋgraphᚋmodelᚐSessionDevice,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Session_device(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "browser":
+				return ec.fieldContext_SessionDevice_browser(ctx, field)
+			case "os":
+				return ec.fieldContext_SessionDevice_os(ctx, field)
+			case "type":
+				return ec.fieldContext_SessionDevice_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionDevice", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Session_location(ctx context.Context, field graphql.CollectedField, obj *model.Session) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Session_location,
+		func(ctx context.Context) (any, error) {
+			return obj.Location, nil
+		},
+		nil,
+		ec.marshalOSessionLocation2ᚖserverᚋgraphᚋmodelᚐSessionLocation,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Session_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Session",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "city":
+				return ec.fieldContext_SessionLocation_city(ctx, field)
+			case "country":
+				return ec.fieldContext_SessionLocation_country(ctx, field)
+			case "countryCode":
+				return ec.fieldContext_SessionLocation_countryCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionLocation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SessionConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖserverᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.SessionConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionConnection_edges,
+		func(ctx context.Context) (any, error) {
+			return obj.Edges, nil
+		},
+		nil,
+		ec.marshalNSessionEdge2ᚕᚖserverᚋgraphᚋmodelᚐSessionEdgeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_SessionEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_SessionEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.SessionConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionConnection_totalCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint32,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionDevice_browser(ctx context.Context, field graphql.CollectedField, obj *model.SessionDevice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionDevice_browser,
+		func(ctx context.Context) (any, error) {
+			return obj.Browser, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionDevice_browser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionDevice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionDevice_os(ctx context.Context, field graphql.CollectedField, obj *model.SessionDevice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionDevice_os,
+		func(ctx context.Context) (any, error) {
+			return obj.Os, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionDevice_os(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionDevice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionDevice_type(ctx context.Context, field graphql.CollectedField, obj *model.SessionDevice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionDevice_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNDeviceType2serverᚋgraphᚋmodelᚐDeviceType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionDevice_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionDevice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DeviceType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.SessionEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEdge_cursor,
+		func(ctx context.Context) (any, error) {
+			return obj.Cursor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.SessionEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEdge_node,
+		func(ctx context.Context) (any, error) {
+			return obj.Node, nil
+		},
+		nil,
+		ec.marshalNSession2ᚖserverᚋgraphᚋmodelᚐSession,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Session_id(ctx, field)
+			case "userAgent":
+				return ec.fieldContext_Session_userAgent(ctx, field)
+			case "ipAddress":
+				return ec.fieldContext_Session_ipAddress(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Session_createdAt(ctx, field)
+			case "lastActiveAt":
+				return ec.fieldContext_Session_lastActiveAt(ctx, field)
+			case "isCurrent":
+				return ec.fieldContext_Session_isCurrent(ctx, field)
+			case "device":
+				return ec.fieldContext_Session_device(ctx, field)
+			case "location":
+				return ec.fieldContext_Session_location(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Session", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionLocation_city(ctx context.Context, field graphql.CollectedField, obj *model.SessionLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionLocation_city,
+		func(ctx context.Context) (any, error) {
+			return obj.City, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionLocation_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionLocation_country(ctx context.Context, field graphql.CollectedField, obj *model.SessionLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionLocation_country,
+		func(ctx context.Context) (any, error) {
+			return obj.Country, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionLocation_country(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionLocation_countryCode(ctx context.Context, field graphql.CollectedField, obj *model.SessionLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionLocation_countryCode,
+		func(ctx context.Context) (any, error) {
+			return obj.CountryCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionLocation_countryCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.SessionNotFoundError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionNotFoundError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionNotFoundError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TooManyAttemptsError_message(ctx context.Context, field graphql.CollectedField, obj *model.TooManyAttemptsError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TooManyAttemptsError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TooManyAttemptsError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TooManyAttemptsError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TooManyAttemptsError_retryAfterSeconds(ctx context.Context, field graphql.CollectedField, obj *model.TooManyAttemptsError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TooManyAttemptsError_retryAfterSeconds,
+		func(ctx context.Context) (any, error) {
+			return obj.RetryAfterSeconds, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TooManyAttemptsError_retryAfterSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TooManyAttemptsError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TwoFactorAuthenticationChallengeNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.TwoFactorAuthenticationChallengeNotFoundError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TwoFactorAuthenticationChallengeNotFoundError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TwoFactorAuthenticationChallengeNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TwoFactorAuthenticationChallengeNotFoundError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TwoFactorAuthenticationNotEnabledError_message(ctx context.Context, field graphql.CollectedField, obj *model.TwoFactorAuthenticationNotEnabledError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TwoFactorAuthenticationNotEnabledError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TwoFactorAuthenticationNotEnabledError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TwoFactorAuthenticationNotEnabledError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TwoFactorAuthenticationRequiredError_message(ctx context.Context, field graphql.CollectedField, obj *model.TwoFactorAuthenticationRequiredError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TwoFactorAuthenticationRequiredError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TwoFactorAuthenticationRequiredError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TwoFactorAuthenticationRequiredError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnlinkOAuthIdentitySuccess_oauthIdentityEdge(ctx context.Context, field graphql.CollectedField, obj *model.UnlinkOAuthIdentitySuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnlinkOAuthIdentitySuccess_oauthIdentityEdge,
+		func(ctx context.Context) (any, error) {
+			return obj.OauthIdentityEdge, nil
+		},
+		nil,
+		ec.marshalNOAuthIdentityEdge2ᚖserverᚋgraphᚋmodelᚐOAuthIdentityEdge,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnlinkOAuthIdentitySuccess_oauthIdentityEdge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnlinkOAuthIdentitySuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_OAuthIdentityEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_OAuthIdentityEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OAuthIdentityEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UnlockAccountSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.UnlockAccountSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UnlockAccountSuccess_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UnlockAccountSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UnlockAccountSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VerifyEmailSuccess_message(ctx context.Context, field graphql.CollectedField, obj *model.VerifyEmailSuccess) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VerifyEmailSuccess_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VerifyEmailSuccess_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VerifyEmailSuccess",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnChallengeNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnChallengeNotFoundError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnChallengeNotFoundError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnChallengeNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnChallengeNotFoundError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredential_id(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredential) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredential_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredential_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredential",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredential_nickname(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredential) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredential_nickname,
+		func(ctx context.Context) (any, error) {
+			return obj.Nickname, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredential_nickname(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredential",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredential_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredential) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredential_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredential_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredential",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredential_lastUsedAt(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredential) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredential_lastUsedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.LastUsedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredential_lastUsedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredential",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredentialConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredentialConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖserverᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredentialConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredentialConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredentialConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredentialConnection_edges,
+		func(ctx context.Context) (any, error) {
+			return obj.Edges, nil
+		},
+		nil,
+		ec.marshalNWebAuthnCredentialEdge2ᚕᚖserverᚋgraphᚋmodelᚐWebAuthnCredentialEdgeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredentialConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredentialConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_WebAuthnCredentialEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_WebAuthnCredentialEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebAuthnCredentialEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredentialConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredentialConnection_totalCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint32,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredentialConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredentialConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredentialEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredentialEdge_cursor,
+		func(ctx context.Context) (any, error) {
+			return obj.Cursor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredentialEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredentialEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredentialEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredentialEdge_node,
+		func(ctx context.Context) (any, error) {
+			return obj.Node, nil
+		},
+		nil,
+		ec.marshalNWebAuthnCredential2ᚖserverᚋgraphᚋmodelᚐWebAuthnCredential,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredentialEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredentialEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WebAuthnCredential_id(ctx, field)
+			case "nickname":
+				return ec.fieldContext_WebAuthnCredential_nickname(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WebAuthnCredential_createdAt(ctx, field)
+			case "lastUsedAt":
+				return ec.fieldContext_WebAuthnCredential_lastUsedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebAuthnCredential", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WebAuthnCredentialNotFoundError_message(ctx context.Context, field graphql.CollectedField, obj *model.WebAuthnCredentialNotFoundError) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WebAuthnCredentialNotFoundError_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WebAuthnCredentialNotFoundError_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WebAuthnCredentialNotFoundError",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _CreatePersonalAccessTokenPayload(ctx context.Context, sel ast.SelectionSet, obj model.CreatePersonalAccessTokenPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidPersonalAccessTokenError:
+		return ec._InvalidPersonalAccessTokenError(ctx, sel, &obj)
+	case *model.InvalidPersonalAccessTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPersonalAccessTokenError(ctx, sel, obj)
+	case model.CreatePersonalAccessTokenSuccess:
+		return ec._CreatePersonalAccessTokenSuccess(ctx, sel, &obj)
+	case *model.CreatePersonalAccessTokenSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CreatePersonalAccessTokenSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CreateWebAuthnCredentialPayload(ctx context.Context, sel ast.SelectionSet, obj model.CreateWebAuthnCredentialPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidPasskeyRegistrationCredentialError:
+		return ec._InvalidPasskeyRegistrationCredentialError(ctx, sel, &obj)
+	case *model.InvalidPasskeyRegistrationCredentialError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPasskeyRegistrationCredentialError(ctx, sel, obj)
+	case model.CreateWebAuthnCredentialSuccess:
+		return ec._CreateWebAuthnCredentialSuccess(ctx, sel, &obj)
+	case *model.CreateWebAuthnCredentialSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CreateWebAuthnCredentialSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _DeletePasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.DeletePasswordPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InsufficientAuthProvidersError:
+		return ec._InsufficientAuthProvidersError(ctx, sel, &obj)
+	case *model.InsufficientAuthProvidersError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InsufficientAuthProvidersError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _DeleteSessionPayload(ctx context.Context, sel ast.SelectionSet, obj model.DeleteSessionPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.SessionNotFoundError:
+		return ec._SessionNotFoundError(ctx, sel, &obj)
+	case *model.SessionNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SessionNotFoundError(ctx, sel, obj)
+	case model.DeleteSessionSuccess:
+		return ec._DeleteSessionSuccess(ctx, sel, &obj)
+	case *model.DeleteSessionSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DeleteSessionSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _DeleteWebAuthnCredentialPayload(ctx context.Context, sel ast.SelectionSet, obj model.DeleteWebAuthnCredentialPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.WebAuthnCredentialNotFoundError:
+		return ec._WebAuthnCredentialNotFoundError(ctx, sel, &obj)
+	case *model.WebAuthnCredentialNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._WebAuthnCredentialNotFoundError(ctx, sel, obj)
+	case model.InsufficientAuthProvidersError:
+		return ec._InsufficientAuthProvidersError(ctx, sel, &obj)
+	case *model.InsufficientAuthProvidersError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InsufficientAuthProvidersError(ctx, sel, obj)
+	case model.DeleteWebAuthnCredentialSuccess:
+		return ec._DeleteWebAuthnCredentialSuccess(ctx, sel, &obj)
+	case *model.DeleteWebAuthnCredentialSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DeleteWebAuthnCredentialSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _DisableAccount2FAWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, obj model.DisableAccount2FAWithAuthenticatorPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.AuthenticatorNotEnabledError:
+		return ec._AuthenticatorNotEnabledError(ctx, sel, &obj)
+	case *model.AuthenticatorNotEnabledError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AuthenticatorNotEnabledError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _Generate2FARecoveryCodesPayload(ctx context.Context, sel ast.SelectionSet, obj model.Generate2FARecoveryCodesPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationNotEnabledError:
+		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationNotEnabledError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, obj)
+	case model.Generate2FARecoveryCodesSuccess:
+		return ec._Generate2FARecoveryCodesSuccess(ctx, sel, &obj)
+	case *model.Generate2FARecoveryCodesSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Generate2FARecoveryCodesSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _GenerateAuthenticationOptionsPayload(ctx context.Context, sel ast.SelectionSet, obj model.GenerateAuthenticationOptionsPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.GenerateAuthenticationOptionsSuccess:
+		return ec._GenerateAuthenticationOptionsSuccess(ctx, sel, &obj)
+	case *model.GenerateAuthenticationOptionsSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._GenerateAuthenticationOptionsSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _GenerateAuthenticator2FAChallengePayload(ctx context.Context, sel ast.SelectionSet, obj model.GenerateAuthenticator2FAChallengePayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.GenerateAuthenticator2FAChallengeSuccess:
+		return ec._GenerateAuthenticator2FAChallengeSuccess(ctx, sel, &obj)
+	case *model.GenerateAuthenticator2FAChallengeSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._GenerateAuthenticator2FAChallengeSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _GeneratePasskeyCreationOptionsPayload(ctx context.Context, sel ast.SelectionSet, obj model.GeneratePasskeyCreationOptionsPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.GeneratePasskeyCreationOptionsSuccess:
+		return ec._GeneratePasskeyCreationOptionsSuccess(ctx, sel, &obj)
+	case *model.GeneratePasskeyCreationOptionsSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._GeneratePasskeyCreationOptionsSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _GeneratePasskeyRegistrationOptionsPayload(ctx context.Context, sel ast.SelectionSet, obj model.GeneratePasskeyRegistrationOptionsPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.EmailInUseError:
+		return ec._EmailInUseError(ctx, sel, &obj)
+	case *model.EmailInUseError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmailInUseError(ctx, sel, obj)
+	case model.GeneratePasskeyRegistrationOptionsSuccess:
+		return ec._GeneratePasskeyRegistrationOptionsSuccess(ctx, sel, &obj)
+	case *model.GeneratePasskeyRegistrationOptionsSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._GeneratePasskeyRegistrationOptionsSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _LinkOAuthIdentityPayload(ctx context.Context, sel ast.SelectionSet, obj model.LinkOAuthIdentityPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.OAuthProviderNotSupportedError:
+		return ec._OAuthProviderNotSupportedError(ctx, sel, &obj)
+	case *model.OAuthProviderNotSupportedError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OAuthProviderNotSupportedError(ctx, sel, obj)
+	case model.LinkOAuthIdentitySuccess:
+		return ec._LinkOAuthIdentitySuccess(ctx, sel, &obj)
+	case *model.LinkOAuthIdentitySuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._LinkOAuthIdentitySuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _LoginWithEmailCodePayload(ctx context.Context, sel ast.SelectionSet, obj model.LoginWithEmailCodePayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationRequiredError:
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationRequiredError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, obj)
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
+	case model.InvalidEmailLoginCodeError:
+		return ec._InvalidEmailLoginCodeError(ctx, sel, &obj)
+	case *model.InvalidEmailLoginCodeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidEmailLoginCodeError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _LoginWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, obj model.LoginWithPasskeyPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.WebAuthnChallengeNotFoundError:
+		return ec._WebAuthnChallengeNotFoundError(ctx, sel, &obj)
+	case *model.WebAuthnChallengeNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._WebAuthnChallengeNotFoundError(ctx, sel, obj)
+	case model.InvalidPasskeyAuthenticationCredentialError:
+		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, &obj)
+	case *model.InvalidPasskeyAuthenticationCredentialError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _LoginWithPasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.LoginWithPasswordPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationRequiredError:
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationRequiredError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, obj)
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
+	case model.InvalidCredentialsError:
+		return ec._InvalidCredentialsError(ctx, sel, &obj)
+	case *model.InvalidCredentialsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCredentialsError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.InvalidAuthenticationProviderError:
+		return ec._InvalidAuthenticationProviderError(ctx, sel, &obj)
+	case *model.InvalidAuthenticationProviderError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidAuthenticationProviderError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _LoginWithSmsCodePayload(ctx context.Context, sel ast.SelectionSet, obj model.LoginWithSmsCodePayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationRequiredError:
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationRequiredError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, obj)
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
+	case model.InvalidSmsLoginCodeError:
+		return ec._InvalidSmsLoginCodeError(ctx, sel, &obj)
+	case *model.InvalidSmsLoginCodeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidSmsLoginCodeError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _PasswordResetTokenPayload(ctx context.Context, sel ast.SelectionSet, obj model.PasswordResetTokenPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.PasswordResetTokenNotFoundError:
+		return ec._PasswordResetTokenNotFoundError(ctx, sel, &obj)
+	case *model.PasswordResetTokenNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordResetTokenNotFoundError(ctx, sel, obj)
+	case model.PasswordResetToken:
+		return ec._PasswordResetToken(ctx, sel, &obj)
+	case *model.PasswordResetToken:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordResetToken(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RefreshBearerTokensPayload(ctx context.Context, sel ast.SelectionSet, obj model.RefreshBearerTokensPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidRefreshTokenError:
+		return ec._InvalidRefreshTokenError(ctx, sel, &obj)
+	case *model.InvalidRefreshTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRefreshTokenError(ctx, sel, obj)
+	case model.BearerTokens:
+		return ec._BearerTokens(ctx, sel, &obj)
+	case *model.BearerTokens:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._BearerTokens(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RegisterWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, obj model.RegisterWithPasskeyPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidPasskeyRegistrationCredentialError:
+		return ec._InvalidPasskeyRegistrationCredentialError(ctx, sel, &obj)
+	case *model.InvalidPasskeyRegistrationCredentialError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPasskeyRegistrationCredentialError(ctx, sel, obj)
+	case model.InvalidEmailVerificationTokenError:
+		return ec._InvalidEmailVerificationTokenError(ctx, sel, &obj)
+	case *model.InvalidEmailVerificationTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidEmailVerificationTokenError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.EmailInUseError:
+		return ec._EmailInUseError(ctx, sel, &obj)
+	case *model.EmailInUseError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmailInUseError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RegisterWithPasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.RegisterWithPasswordPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.PasswordNotStrongError:
+		return ec._PasswordNotStrongError(ctx, sel, &obj)
+	case *model.PasswordNotStrongError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordNotStrongError(ctx, sel, obj)
+	case model.InvalidEmailVerificationTokenError:
+		return ec._InvalidEmailVerificationTokenError(ctx, sel, &obj)
+	case *model.InvalidEmailVerificationTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidEmailVerificationTokenError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.EmailInUseError:
+		return ec._EmailInUseError(ctx, sel, &obj)
+	case *model.EmailInUseError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmailInUseError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RequestEmailLoginCodePayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestEmailLoginCodePayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.RequestEmailLoginCodeSuccess:
+		return ec._RequestEmailLoginCodeSuccess(ctx, sel, &obj)
+	case *model.RequestEmailLoginCodeSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RequestEmailLoginCodeSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RequestEmailVerificationTokenPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestEmailVerificationTokenPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidEmailError:
+		return ec._InvalidEmailError(ctx, sel, &obj)
+	case *model.InvalidEmailError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidEmailError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.EmailVerificationTokenCooldownError:
+		return ec._EmailVerificationTokenCooldownError(ctx, sel, &obj)
+	case *model.EmailVerificationTokenCooldownError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmailVerificationTokenCooldownError(ctx, sel, obj)
+	case model.EmailInUseError:
+		return ec._EmailInUseError(ctx, sel, &obj)
+	case *model.EmailInUseError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmailInUseError(ctx, sel, obj)
+	case model.RequestEmailVerificationSuccess:
+		return ec._RequestEmailVerificationSuccess(ctx, sel, &obj)
+	case *model.RequestEmailVerificationSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RequestEmailVerificationSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RequestPasswordResetPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestPasswordResetPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.PasswordResetTokenCooldownError:
+		return ec._PasswordResetTokenCooldownError(ctx, sel, &obj)
+	case *model.PasswordResetTokenCooldownError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordResetTokenCooldownError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.RequestPasswordResetSuccess:
+		return ec._RequestPasswordResetSuccess(ctx, sel, &obj)
+	case *model.RequestPasswordResetSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RequestPasswordResetSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RequestSmsLoginCodePayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestSmsLoginCodePayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidPhoneNumberError:
+		return ec._InvalidPhoneNumberError(ctx, sel, &obj)
+	case *model.InvalidPhoneNumberError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPhoneNumberError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.RequestSmsLoginCodeSuccess:
+		return ec._RequestSmsLoginCodeSuccess(ctx, sel, &obj)
+	case *model.RequestSmsLoginCodeSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RequestSmsLoginCodeSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RequestSudoModeWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestSudoModeWithAuthenticatorPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
+	case model.InvalidCredentialsError:
+		return ec._InvalidCredentialsError(ctx, sel, &obj)
+	case *model.InvalidCredentialsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCredentialsError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.AuthenticatorNotEnabledError:
+		return ec._AuthenticatorNotEnabledError(ctx, sel, &obj)
+	case *model.AuthenticatorNotEnabledError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AuthenticatorNotEnabledError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RequestSudoModeWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestSudoModeWithPasskeyPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.WebAuthnChallengeNotFoundError:
+		return ec._WebAuthnChallengeNotFoundError(ctx, sel, &obj)
+	case *model.WebAuthnChallengeNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._WebAuthnChallengeNotFoundError(ctx, sel, obj)
+	case model.InvalidPasskeyAuthenticationCredentialError:
+		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, &obj)
+	case *model.InvalidPasskeyAuthenticationCredentialError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RequestSudoModeWithPasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestSudoModeWithPasswordPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationRequiredError:
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationRequiredError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, obj)
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
+	case model.InvalidCredentialsError:
+		return ec._InvalidCredentialsError(ctx, sel, &obj)
+	case *model.InvalidCredentialsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCredentialsError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.InvalidAuthenticationProviderError:
+		return ec._InvalidAuthenticationProviderError(ctx, sel, &obj)
+	case *model.InvalidAuthenticationProviderError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidAuthenticationProviderError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _ResetPasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.ResetPasswordPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationChallengeNotFoundError:
+		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationChallengeNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
+	case model.PasswordPreviouslyUsedError:
+		return ec._PasswordPreviouslyUsedError(ctx, sel, &obj)
+	case *model.PasswordPreviouslyUsedError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordPreviouslyUsedError(ctx, sel, obj)
+	case model.PasswordNotStrongError:
+		return ec._PasswordNotStrongError(ctx, sel, &obj)
+	case *model.PasswordNotStrongError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordNotStrongError(ctx, sel, obj)
+	case model.InvalidPasswordResetTokenError:
+		return ec._InvalidPasswordResetTokenError(ctx, sel, &obj)
+	case *model.InvalidPasswordResetTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPasswordResetTokenError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RevokePersonalAccessTokenPayload(ctx context.Context, sel ast.SelectionSet, obj model.RevokePersonalAccessTokenPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.PersonalAccessTokenNotFoundError:
+		return ec._PersonalAccessTokenNotFoundError(ctx, sel, &obj)
+	case *model.PersonalAccessTokenNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PersonalAccessTokenNotFoundError(ctx, sel, obj)
+	case model.RevokePersonalAccessTokenSuccess:
+		return ec._RevokePersonalAccessTokenSuccess(ctx, sel, &obj)
+	case *model.RevokePersonalAccessTokenSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RevokePersonalAccessTokenSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RevokeSessionsPayload(ctx context.Context, sel ast.SelectionSet, obj model.RevokeSessionsPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidSessionRevocationTokenError:
+		return ec._InvalidSessionRevocationTokenError(ctx, sel, &obj)
+	case *model.InvalidSessionRevocationTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidSessionRevocationTokenError(ctx, sel, obj)
+	case model.RevokeSessionsSuccess:
+		return ec._RevokeSessionsSuccess(ctx, sel, &obj)
+	case *model.RevokeSessionsSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RevokeSessionsSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _SetAccount2FAPayload(ctx context.Context, sel ast.SelectionSet, obj model.SetAccount2FAPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationChallengeNotFoundError:
+		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationChallengeNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
+	case model.InvalidCredentialsError:
+		return ec._InvalidCredentialsError(ctx, sel, &obj)
+	case *model.InvalidCredentialsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCredentialsError(ctx, sel, obj)
+	case model.EnableAccount2FAWithAuthenticatorSuccess:
+		return ec._EnableAccount2FAWithAuthenticatorSuccess(ctx, sel, &obj)
+	case *model.EnableAccount2FAWithAuthenticatorSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EnableAccount2FAWithAuthenticatorSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UnlinkOAuthIdentityPayload(ctx context.Context, sel ast.SelectionSet, obj model.UnlinkOAuthIdentityPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.OAuthIdentityNotFoundError:
+		return ec._OAuthIdentityNotFoundError(ctx, sel, &obj)
+	case *model.OAuthIdentityNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OAuthIdentityNotFoundError(ctx, sel, obj)
+	case model.InsufficientAuthProvidersError:
+		return ec._InsufficientAuthProvidersError(ctx, sel, &obj)
+	case *model.InsufficientAuthProvidersError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InsufficientAuthProvidersError(ctx, sel, obj)
+	case model.UnlinkOAuthIdentitySuccess:
+		return ec._UnlinkOAuthIdentitySuccess(ctx, sel, &obj)
+	case *model.UnlinkOAuthIdentitySuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UnlinkOAuthIdentitySuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UnlockAccountPayload(ctx context.Context, sel ast.SelectionSet, obj model.UnlockAccountPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidAccountUnlockTokenError:
+		return ec._InvalidAccountUnlockTokenError(ctx, sel, &obj)
+	case *model.InvalidAccountUnlockTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidAccountUnlockTokenError(ctx, sel, obj)
+	case model.UnlockAccountSuccess:
+		return ec._UnlockAccountSuccess(ctx, sel, &obj)
+	case *model.UnlockAccountSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UnlockAccountSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UpdateAccountPayload(ctx context.Context, sel ast.SelectionSet, obj model.UpdateAccountPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UpdateAccountPhoneNumberPayload(ctx context.Context, sel ast.SelectionSet, obj model.UpdateAccountPhoneNumberPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidPhoneNumberVerificationTokenError:
+		return ec._InvalidPhoneNumberVerificationTokenError(ctx, sel, &obj)
+	case *model.InvalidPhoneNumberVerificationTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPhoneNumberVerificationTokenError(ctx, sel, obj)
+	case model.InvalidPhoneNumberError:
+		return ec._InvalidPhoneNumberError(ctx, sel, &obj)
+	case *model.InvalidPhoneNumberError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPhoneNumberError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UpdatePasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.UpdatePasswordPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.PasswordPreviouslyUsedError:
+		return ec._PasswordPreviouslyUsedError(ctx, sel, &obj)
+	case *model.PasswordPreviouslyUsedError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordPreviouslyUsedError(ctx, sel, obj)
+	case model.PasswordNotStrongError:
+		return ec._PasswordNotStrongError(ctx, sel, &obj)
+	case *model.PasswordNotStrongError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordNotStrongError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UpdateWebAuthnCredentialPayload(ctx context.Context, sel ast.SelectionSet, obj model.UpdateWebAuthnCredentialPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.WebAuthnCredentialNotFoundError:
+		return ec._WebAuthnCredentialNotFoundError(ctx, sel, &obj)
+	case *model.WebAuthnCredentialNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._WebAuthnCredentialNotFoundError(ctx, sel, obj)
+	case model.WebAuthnCredential:
+		return ec._WebAuthnCredential(ctx, sel, &obj)
+	case *model.WebAuthnCredential:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._WebAuthnCredential(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _Verify2FAPasswordResetWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, obj model.Verify2FAPasswordResetWithAuthenticatorPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
+	case model.PasswordResetToken:
+		return ec._PasswordResetToken(ctx, sel, &obj)
+	case *model.PasswordResetToken:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordResetToken(ctx, sel, obj)
+	case model.InvalidPasswordResetTokenError:
+		return ec._InvalidPasswordResetTokenError(ctx, sel, &obj)
+	case *model.InvalidPasswordResetTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPasswordResetTokenError(ctx, sel, obj)
+	case model.InvalidCredentialsError:
+		return ec._InvalidCredentialsError(ctx, sel, &obj)
+	case *model.InvalidCredentialsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCredentialsError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.AuthenticatorNotEnabledError:
+		return ec._AuthenticatorNotEnabledError(ctx, sel, &obj)
+	case *model.AuthenticatorNotEnabledError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AuthenticatorNotEnabledError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _Verify2FAPasswordResetWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, obj model.Verify2FAPasswordResetWithPasskeyPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.WebAuthnChallengeNotFoundError:
+		return ec._WebAuthnChallengeNotFoundError(ctx, sel, &obj)
+	case *model.WebAuthnChallengeNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._WebAuthnChallengeNotFoundError(ctx, sel, obj)
+	case model.TwoFactorAuthenticationNotEnabledError:
+		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationNotEnabledError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, obj)
+	case model.PasswordResetToken:
+		return ec._PasswordResetToken(ctx, sel, &obj)
+	case *model.PasswordResetToken:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordResetToken(ctx, sel, obj)
+	case model.InvalidPasswordResetTokenError:
+		return ec._InvalidPasswordResetTokenError(ctx, sel, &obj)
+	case *model.InvalidPasswordResetTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPasswordResetTokenError(ctx, sel, obj)
+	case model.InvalidPasskeyAuthenticationCredentialError:
+		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, &obj)
+	case *model.InvalidPasskeyAuthenticationCredentialError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _Verify2FAWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, obj model.Verify2FAWithAuthenticatorPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationChallengeNotFoundError:
+		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationChallengeNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
+	case model.InvalidCredentialsError:
+		return ec._InvalidCredentialsError(ctx, sel, &obj)
+	case *model.InvalidCredentialsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCredentialsError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.AuthenticatorNotEnabledError:
+		return ec._AuthenticatorNotEnabledError(ctx, sel, &obj)
+	case *model.AuthenticatorNotEnabledError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AuthenticatorNotEnabledError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _Verify2FAWithRecoveryCodePayload(ctx context.Context, sel ast.SelectionSet, obj model.Verify2FAWithRecoveryCodePayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationNotEnabledError:
+		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationNotEnabledError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, obj)
+	case model.TwoFactorAuthenticationChallengeNotFoundError:
+		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationChallengeNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
+	case model.InvalidCredentialsError:
+		return ec._InvalidCredentialsError(ctx, sel, &obj)
+	case *model.InvalidCredentialsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCredentialsError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _VerifyEmailPayload(ctx context.Context, sel ast.SelectionSet, obj model.VerifyEmailPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.InvalidEmailVerificationTokenError:
+		return ec._InvalidEmailVerificationTokenError(ctx, sel, &obj)
+	case *model.InvalidEmailVerificationTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidEmailVerificationTokenError(ctx, sel, obj)
+	case model.InvalidCaptchaTokenError:
+		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
+	case *model.InvalidCaptchaTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
+	case model.EmailInUseError:
+		return ec._EmailInUseError(ctx, sel, &obj)
+	case *model.EmailInUseError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmailInUseError(ctx, sel, obj)
+	case model.VerifyEmailSuccess:
+		return ec._VerifyEmailSuccess(ctx, sel, &obj)
+	case *model.VerifyEmailSuccess:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._VerifyEmailSuccess(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _VerifyGoogleTokenPayload(ctx context.Context, sel ast.SelectionSet, obj model.VerifyGoogleTokenPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.TwoFactorAuthenticationRequiredError:
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, &obj)
+	case *model.TwoFactorAuthenticationRequiredError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, obj)
+	case model.InvalidEmailError:
+		return ec._InvalidEmailError(ctx, sel, &obj)
+	case *model.InvalidEmailError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidEmailError(ctx, sel, obj)
+	case model.InvalidCredentialsError:
+		return ec._InvalidCredentialsError(ctx, sel, &obj)
+	case *model.InvalidCredentialsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCredentialsError(ctx, sel, obj)
+	case model.EmailInUseError:
+		return ec._EmailInUseError(ctx, sel, &obj)
+	case *model.EmailInUseError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmailInUseError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _ViewerPayload(ctx context.Context, sel ast.SelectionSet, obj model.ViewerPayload) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.NotAuthenticatedError:
+		return ec._NotAuthenticatedError(ctx, sel, &obj)
+	case *model.NotAuthenticatedError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._NotAuthenticatedError(ctx, sel, obj)
+	case model.Account:
+		return ec._Account(ctx, sel, &obj)
+	case *model.Account:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Account(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var authenticatorNotEnabledErrorImplementors = []string{"AuthenticatorNotEnabledError", "Error", "DisableAccount2FAWithAuthenticatorPayload", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAWithAuthenticatorPayload", "RequestSudoModeWithAuthenticatorPayload"}
+
+func (ec *executionContext) _AuthenticatorNotEnabledError(ctx context.Context, sel ast.SelectionSet, obj *model.AuthenticatorNotEnabledError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, authenticatorNotEnabledErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuthenticatorNotEnabledError")
+		case "message":
+			out.Values[i] = ec._AuthenticatorNotEnabledError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var bearerTokensImplementors = []string{"BearerTokens", "RefreshBearerTokensPayload"}
+
+func (ec *executionContext) _BearerTokens(ctx context.Context, sel ast.SelectionSet, obj *model.BearerTokens) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bearerTokensImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BearerTokens")
+		case "accessToken":
+			out.Values[i] = ec._BearerTokens_accessToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "accessTokenExpiresAt":
+			out.Values[i] = ec._BearerTokens_accessTokenExpiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refreshToken":
+			out.Values[i] = ec._BearerTokens_refreshToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refreshTokenExpiresAt":
+			out.Values[i] = ec._BearerTokens_refreshTokenExpiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createPersonalAccessTokenSuccessImplementors = []string{"CreatePersonalAccessTokenSuccess", "CreatePersonalAccessTokenPayload"}
+
+func (ec *executionContext) _CreatePersonalAccessTokenSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.CreatePersonalAccessTokenSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createPersonalAccessTokenSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreatePersonalAccessTokenSuccess")
+		case "token":
+			out.Values[i] = ec._CreatePersonalAccessTokenSuccess_token(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "personalAccessTokenEdge":
+			out.Values[i] = ec._CreatePersonalAccessTokenSuccess_personalAccessTokenEdge(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createWebAuthnCredentialSuccessImplementors = []string{"CreateWebAuthnCredentialSuccess", "CreateWebAuthnCredentialPayload"}
+
+func (ec *executionContext) _CreateWebAuthnCredentialSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.CreateWebAuthnCredentialSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createWebAuthnCredentialSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateWebAuthnCredentialSuccess")
+		case "webAuthnCredentialEdge":
+			out.Values[i] = ec._CreateWebAuthnCredentialSuccess_webAuthnCredentialEdge(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deleteOtherSessionsPayloadImplementors = []string{"DeleteOtherSessionsPayload"}
+
+func (ec *executionContext) _DeleteOtherSessionsPayload(ctx context.Context, sel ast.SelectionSet, obj *model.DeleteOtherSessionsPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deleteOtherSessionsPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeleteOtherSessionsPayload")
+		case "deletedSessionIds":
+			out.Values[i] = ec._DeleteOtherSessionsPayload_deletedSessionIds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deleteSessionSuccessImplementors = []string{"DeleteSessionSuccess", "DeleteSessionPayload"}
+
+func (ec *executionContext) _DeleteSessionSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.DeleteSessionSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deleteSessionSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeleteSessionSuccess")
+		case "sessionEdge":
+			out.Values[i] = ec._DeleteSessionSuccess_sessionEdge(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deleteWebAuthnCredentialSuccessImplementors = []string{"DeleteWebAuthnCredentialSuccess", "DeleteWebAuthnCredentialPayload"}
+
+func (ec *executionContext) _DeleteWebAuthnCredentialSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.DeleteWebAuthnCredentialSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deleteWebAuthnCredentialSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeleteWebAuthnCredentialSuccess")
+		case "webAuthnCredentialEdge":
+			out.Values[i] = ec._DeleteWebAuthnCredentialSuccess_webAuthnCredentialEdge(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var emailInUseErrorImplementors = []string{"EmailInUseError", "Error", "GeneratePasskeyRegistrationOptionsPayload", "RequestEmailVerificationTokenPayload", "VerifyEmailPayload", "VerifyGoogleTokenPayload", "RegisterWithPasskeyPayload", "RegisterWithPasswordPayload"}
+
+func (ec *executionContext) _EmailInUseError(ctx context.Context, sel ast.SelectionSet, obj *model.EmailInUseError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, emailInUseErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EmailInUseError")
+		case "message":
+			out.Values[i] = ec._EmailInUseError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var emailVerificationTokenCooldownErrorImplementors = []string{"EmailVerificationTokenCooldownError", "Error", "RequestEmailVerificationTokenPayload"}
+
+func (ec *executionContext) _EmailVerificationTokenCooldownError(ctx context.Context, sel ast.SelectionSet, obj *model.EmailVerificationTokenCooldownError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, emailVerificationTokenCooldownErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EmailVerificationTokenCooldownError")
+		case "message":
+			out.Values[i] = ec._EmailVerificationTokenCooldownError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "remainingSeconds":
+			out.Values[i] = ec._EmailVerificationTokenCooldownError_remainingSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
 		return graphql.Null
-	case model.PasswordResetTokenNotFoundError:
-		return ec._PasswordResetTokenNotFoundError(ctx, sel, &obj)
-	case *model.PasswordResetTokenNotFoundError:
-		if obj == nil {
-			return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var enableAccount2FAWithAuthenticatorSuccessImplementors = []string{"EnableAccount2FAWithAuthenticatorSuccess", "SetAccount2FAPayload"}
+
+func (ec *executionContext) _EnableAccount2FAWithAuthenticatorSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.EnableAccount2FAWithAuthenticatorSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, enableAccount2FAWithAuthenticatorSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EnableAccount2FAWithAuthenticatorSuccess")
+		case "account":
+			out.Values[i] = ec._EnableAccount2FAWithAuthenticatorSuccess_account(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recoveryCodes":
+			out.Values[i] = ec._EnableAccount2FAWithAuthenticatorSuccess_recoveryCodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._PasswordResetTokenNotFoundError(ctx, sel, obj)
-	case model.PasswordResetToken:
-		return ec._PasswordResetToken(ctx, sel, &obj)
-	case *model.PasswordResetToken:
-		if obj == nil {
-			return graphql.Null
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var generate2FARecoveryCodesSuccessImplementors = []string{"Generate2FARecoveryCodesSuccess", "Generate2FARecoveryCodesPayload"}
+
+func (ec *executionContext) _Generate2FARecoveryCodesSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.Generate2FARecoveryCodesSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, generate2FARecoveryCodesSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Generate2FARecoveryCodesSuccess")
+		case "recoveryCodes":
+			out.Values[i] = ec._Generate2FARecoveryCodesSuccess_recoveryCodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var generateAuthenticationOptionsSuccessImplementors = []string{"GenerateAuthenticationOptionsSuccess", "GenerateAuthenticationOptionsPayload"}
+
+func (ec *executionContext) _GenerateAuthenticationOptionsSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.GenerateAuthenticationOptionsSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, generateAuthenticationOptionsSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GenerateAuthenticationOptionsSuccess")
+		case "authenticationOptions":
+			out.Values[i] = ec._GenerateAuthenticationOptionsSuccess_authenticationOptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var generateAuthenticator2FAChallengeSuccessImplementors = []string{"GenerateAuthenticator2FAChallengeSuccess", "GenerateAuthenticator2FAChallengePayload"}
+
+func (ec *executionContext) _GenerateAuthenticator2FAChallengeSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.GenerateAuthenticator2FAChallengeSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, generateAuthenticator2FAChallengeSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GenerateAuthenticator2FAChallengeSuccess")
+		case "otpUri":
+			out.Values[i] = ec._GenerateAuthenticator2FAChallengeSuccess_otpUri(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "secret":
+			out.Values[i] = ec._GenerateAuthenticator2FAChallengeSuccess_secret(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var generatePasskeyCreationOptionsSuccessImplementors = []string{"GeneratePasskeyCreationOptionsSuccess", "GeneratePasskeyCreationOptionsPayload"}
+
+func (ec *executionContext) _GeneratePasskeyCreationOptionsSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.GeneratePasskeyCreationOptionsSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, generatePasskeyCreationOptionsSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GeneratePasskeyCreationOptionsSuccess")
+		case "registrationOptions":
+			out.Values[i] = ec._GeneratePasskeyCreationOptionsSuccess_registrationOptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var generatePasskeyRegistrationOptionsSuccessImplementors = []string{"GeneratePasskeyRegistrationOptionsSuccess", "GeneratePasskeyRegistrationOptionsPayload"}
+
+func (ec *executionContext) _GeneratePasskeyRegistrationOptionsSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.GeneratePasskeyRegistrationOptionsSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, generatePasskeyRegistrationOptionsSuccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GeneratePasskeyRegistrationOptionsSuccess")
+		case "registrationOptions":
+			out.Values[i] = ec._GeneratePasskeyRegistrationOptionsSuccess_registrationOptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._PasswordResetToken(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
-
-func (ec *executionContext) _RegisterWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, obj model.RegisterWithPasskeyPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
 		return graphql.Null
-	case model.InvalidPasskeyRegistrationCredentialError:
-		return ec._InvalidPasskeyRegistrationCredentialError(ctx, sel, &obj)
-	case *model.InvalidPasskeyRegistrationCredentialError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPasskeyRegistrationCredentialError(ctx, sel, obj)
-	case model.InvalidEmailVerificationTokenError:
-		return ec._InvalidEmailVerificationTokenError(ctx, sel, &obj)
-	case *model.InvalidEmailVerificationTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidEmailVerificationTokenError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.EmailInUseError:
-		return ec._EmailInUseError(ctx, sel, &obj)
-	case *model.EmailInUseError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._EmailInUseError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _RegisterWithPasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.RegisterWithPasswordPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.PasswordNotStrongError:
-		return ec._PasswordNotStrongError(ctx, sel, &obj)
-	case *model.PasswordNotStrongError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._PasswordNotStrongError(ctx, sel, obj)
-	case model.InvalidEmailVerificationTokenError:
-		return ec._InvalidEmailVerificationTokenError(ctx, sel, &obj)
-	case *model.InvalidEmailVerificationTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidEmailVerificationTokenError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.EmailInUseError:
-		return ec._EmailInUseError(ctx, sel, &obj)
-	case *model.EmailInUseError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._EmailInUseError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
+var insufficientAuthProvidersErrorImplementors = []string{"InsufficientAuthProvidersError", "DeletePasswordPayload", "DeleteWebAuthnCredentialPayload", "Error", "UnlinkOAuthIdentityPayload"}
+
+func (ec *executionContext) _InsufficientAuthProvidersError(ctx context.Context, sel ast.SelectionSet, obj *model.InsufficientAuthProvidersError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, insufficientAuthProvidersErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InsufficientAuthProvidersError")
+		case "message":
+			out.Values[i] = ec._InsufficientAuthProvidersError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _RequestEmailVerificationTokenPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestEmailVerificationTokenPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.InvalidEmailError:
-		return ec._InvalidEmailError(ctx, sel, &obj)
-	case *model.InvalidEmailError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidEmailError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.EmailVerificationTokenCooldownError:
-		return ec._EmailVerificationTokenCooldownError(ctx, sel, &obj)
-	case *model.EmailVerificationTokenCooldownError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._EmailVerificationTokenCooldownError(ctx, sel, obj)
-	case model.EmailInUseError:
-		return ec._EmailInUseError(ctx, sel, &obj)
-	case *model.EmailInUseError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._EmailInUseError(ctx, sel, obj)
-	case model.RequestEmailVerificationSuccess:
-		return ec._RequestEmailVerificationSuccess(ctx, sel, &obj)
-	case *model.RequestEmailVerificationSuccess:
-		if obj == nil {
-			return graphql.Null
+var invalidAccountUnlockTokenErrorImplementors = []string{"InvalidAccountUnlockTokenError", "Error", "UnlockAccountPayload"}
+
+func (ec *executionContext) _InvalidAccountUnlockTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidAccountUnlockTokenError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidAccountUnlockTokenErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidAccountUnlockTokenError")
+		case "message":
+			out.Values[i] = ec._InvalidAccountUnlockTokenError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._RequestEmailVerificationSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _RequestPasswordResetPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestPasswordResetPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.PasswordResetTokenCooldownError:
-		return ec._PasswordResetTokenCooldownError(ctx, sel, &obj)
-	case *model.PasswordResetTokenCooldownError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._PasswordResetTokenCooldownError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.RequestPasswordResetSuccess:
-		return ec._RequestPasswordResetSuccess(ctx, sel, &obj)
-	case *model.RequestPasswordResetSuccess:
-		if obj == nil {
-			return graphql.Null
+var invalidAuthenticationProviderErrorImplementors = []string{"InvalidAuthenticationProviderError", "Error", "LoginWithPasswordPayload", "RequestSudoModeWithPasswordPayload"}
+
+func (ec *executionContext) _InvalidAuthenticationProviderError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidAuthenticationProviderError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidAuthenticationProviderErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidAuthenticationProviderError")
+		case "message":
+			out.Values[i] = ec._InvalidAuthenticationProviderError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "availableProviders":
+			out.Values[i] = ec._InvalidAuthenticationProviderError_availableProviders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._RequestPasswordResetSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
-
-func (ec *executionContext) _RequestSudoModeWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestSudoModeWithAuthenticatorPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
 		return graphql.Null
-	case model.InvalidCredentialsError:
-		return ec._InvalidCredentialsError(ctx, sel, &obj)
-	case *model.InvalidCredentialsError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCredentialsError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.AuthenticatorNotEnabledError:
-		return ec._AuthenticatorNotEnabledError(ctx, sel, &obj)
-	case *model.AuthenticatorNotEnabledError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AuthenticatorNotEnabledError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
 
-func (ec *executionContext) _RequestSudoModeWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestSudoModeWithPasskeyPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.WebAuthnChallengeNotFoundError:
-		return ec._WebAuthnChallengeNotFoundError(ctx, sel, &obj)
-	case *model.WebAuthnChallengeNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._WebAuthnChallengeNotFoundError(ctx, sel, obj)
-	case model.InvalidPasskeyAuthenticationCredentialError:
-		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, &obj)
-	case *model.InvalidPasskeyAuthenticationCredentialError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
+
+	return out
 }
 
-func (ec *executionContext) _RequestSudoModeWithPasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.RequestSudoModeWithPasswordPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.TwoFactorAuthenticationRequiredError:
-		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationRequiredError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, obj)
-	case model.InvalidCredentialsError:
-		return ec._InvalidCredentialsError(ctx, sel, &obj)
-	case *model.InvalidCredentialsError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCredentialsError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.InvalidAuthenticationProviderError:
-		return ec._InvalidAuthenticationProviderError(ctx, sel, &obj)
-	case *model.InvalidAuthenticationProviderError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidAuthenticationProviderError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
+var invalidCredentialsErrorImplementors = []string{"InvalidCredentialsError", "Error", "LoginWithPasswordPayload", "SetAccount2FAPayload", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "VerifyGoogleTokenPayload", "RequestSudoModeWithAuthenticatorPayload", "RequestSudoModeWithPasswordPayload"}
+
+func (ec *executionContext) _InvalidCredentialsError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidCredentialsError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidCredentialsErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidCredentialsError")
+		case "message":
+			out.Values[i] = ec._InvalidCredentialsError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
-
-func (ec *executionContext) _ResetPasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.ResetPasswordPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
 		return graphql.Null
-	case model.TwoFactorAuthenticationChallengeNotFoundError:
-		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationChallengeNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
-	case model.PasswordNotStrongError:
-		return ec._PasswordNotStrongError(ctx, sel, &obj)
-	case *model.PasswordNotStrongError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._PasswordNotStrongError(ctx, sel, obj)
-	case model.InvalidPasswordResetTokenError:
-		return ec._InvalidPasswordResetTokenError(ctx, sel, &obj)
-	case *model.InvalidPasswordResetTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPasswordResetTokenError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _SetAccount2FAPayload(ctx context.Context, sel ast.SelectionSet, obj model.SetAccount2FAPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.TwoFactorAuthenticationChallengeNotFoundError:
-		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationChallengeNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
-	case model.InvalidCredentialsError:
-		return ec._InvalidCredentialsError(ctx, sel, &obj)
-	case *model.InvalidCredentialsError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCredentialsError(ctx, sel, obj)
-	case model.EnableAccount2FAWithAuthenticatorSuccess:
-		return ec._EnableAccount2FAWithAuthenticatorSuccess(ctx, sel, &obj)
-	case *model.EnableAccount2FAWithAuthenticatorSuccess:
-		if obj == nil {
-			return graphql.Null
+var invalidEmailErrorImplementors = []string{"InvalidEmailError", "Error", "RequestEmailVerificationTokenPayload", "VerifyGoogleTokenPayload"}
+
+func (ec *executionContext) _InvalidEmailError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidEmailError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidEmailErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidEmailError")
+		case "message":
+			out.Values[i] = ec._InvalidEmailError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._EnableAccount2FAWithAuthenticatorSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _UpdateAccountPayload(ctx context.Context, sel ast.SelectionSet, obj model.UpdateAccountPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
+var invalidEmailLoginCodeErrorImplementors = []string{"InvalidEmailLoginCodeError", "Error", "LoginWithEmailCodePayload"}
+
+func (ec *executionContext) _InvalidEmailLoginCodeError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidEmailLoginCodeError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidEmailLoginCodeErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidEmailLoginCodeError")
+		case "message":
+			out.Values[i] = ec._InvalidEmailLoginCodeError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
-
-func (ec *executionContext) _UpdateAccountPhoneNumberPayload(ctx context.Context, sel ast.SelectionSet, obj model.UpdateAccountPhoneNumberPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
 		return graphql.Null
-	case model.InvalidPhoneNumberVerificationTokenError:
-		return ec._InvalidPhoneNumberVerificationTokenError(ctx, sel, &obj)
-	case *model.InvalidPhoneNumberVerificationTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPhoneNumberVerificationTokenError(ctx, sel, obj)
-	case model.InvalidPhoneNumberError:
-		return ec._InvalidPhoneNumberError(ctx, sel, &obj)
-	case *model.InvalidPhoneNumberError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPhoneNumberError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
 
-func (ec *executionContext) _UpdatePasswordPayload(ctx context.Context, sel ast.SelectionSet, obj model.UpdatePasswordPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.PasswordNotStrongError:
-		return ec._PasswordNotStrongError(ctx, sel, &obj)
-	case *model.PasswordNotStrongError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._PasswordNotStrongError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
+
+	return out
 }
 
-func (ec *executionContext) _UpdateWebAuthnCredentialPayload(ctx context.Context, sel ast.SelectionSet, obj model.UpdateWebAuthnCredentialPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.WebAuthnCredentialNotFoundError:
-		return ec._WebAuthnCredentialNotFoundError(ctx, sel, &obj)
-	case *model.WebAuthnCredentialNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._WebAuthnCredentialNotFoundError(ctx, sel, obj)
-	case model.WebAuthnCredential:
-		return ec._WebAuthnCredential(ctx, sel, &obj)
-	case *model.WebAuthnCredential:
-		if obj == nil {
-			return graphql.Null
+var invalidEmailVerificationTokenErrorImplementors = []string{"InvalidEmailVerificationTokenError", "Error", "VerifyEmailPayload", "RegisterWithPasskeyPayload", "RegisterWithPasswordPayload"}
+
+func (ec *executionContext) _InvalidEmailVerificationTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidEmailVerificationTokenError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidEmailVerificationTokenErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidEmailVerificationTokenError")
+		case "message":
+			out.Values[i] = ec._InvalidEmailVerificationTokenError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._WebAuthnCredential(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
-
-func (ec *executionContext) _Verify2FAPasswordResetWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, obj model.Verify2FAPasswordResetWithAuthenticatorPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
 		return graphql.Null
-	case model.PasswordResetToken:
-		return ec._PasswordResetToken(ctx, sel, &obj)
-	case *model.PasswordResetToken:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._PasswordResetToken(ctx, sel, obj)
-	case model.InvalidPasswordResetTokenError:
-		return ec._InvalidPasswordResetTokenError(ctx, sel, &obj)
-	case *model.InvalidPasswordResetTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPasswordResetTokenError(ctx, sel, obj)
-	case model.InvalidCredentialsError:
-		return ec._InvalidCredentialsError(ctx, sel, &obj)
-	case *model.InvalidCredentialsError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCredentialsError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.AuthenticatorNotEnabledError:
-		return ec._AuthenticatorNotEnabledError(ctx, sel, &obj)
-	case *model.AuthenticatorNotEnabledError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AuthenticatorNotEnabledError(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _Verify2FAPasswordResetWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, obj model.Verify2FAPasswordResetWithPasskeyPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.WebAuthnChallengeNotFoundError:
-		return ec._WebAuthnChallengeNotFoundError(ctx, sel, &obj)
-	case *model.WebAuthnChallengeNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._WebAuthnChallengeNotFoundError(ctx, sel, obj)
-	case model.TwoFactorAuthenticationNotEnabledError:
-		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationNotEnabledError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, obj)
-	case model.PasswordResetToken:
-		return ec._PasswordResetToken(ctx, sel, &obj)
-	case *model.PasswordResetToken:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._PasswordResetToken(ctx, sel, obj)
-	case model.InvalidPasswordResetTokenError:
-		return ec._InvalidPasswordResetTokenError(ctx, sel, &obj)
-	case *model.InvalidPasswordResetTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPasswordResetTokenError(ctx, sel, obj)
-	case model.InvalidPasskeyAuthenticationCredentialError:
-		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, &obj)
-	case *model.InvalidPasskeyAuthenticationCredentialError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidPasskeyAuthenticationCredentialError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
+var invalidPasskeyAuthenticationCredentialErrorImplementors = []string{"InvalidPasskeyAuthenticationCredentialError", "Error", "LoginWithPasskeyPayload", "Verify2FAPasswordResetWithPasskeyPayload", "RequestSudoModeWithPasskeyPayload"}
+
+func (ec *executionContext) _InvalidPasskeyAuthenticationCredentialError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidPasskeyAuthenticationCredentialError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidPasskeyAuthenticationCredentialErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidPasskeyAuthenticationCredentialError")
+		case "message":
+			out.Values[i] = ec._InvalidPasskeyAuthenticationCredentialError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _Verify2FAWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, obj model.Verify2FAWithAuthenticatorPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.TwoFactorAuthenticationChallengeNotFoundError:
-		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationChallengeNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
-	case model.InvalidCredentialsError:
-		return ec._InvalidCredentialsError(ctx, sel, &obj)
-	case *model.InvalidCredentialsError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCredentialsError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.AuthenticatorNotEnabledError:
-		return ec._AuthenticatorNotEnabledError(ctx, sel, &obj)
-	case *model.AuthenticatorNotEnabledError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._AuthenticatorNotEnabledError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
+var invalidPasskeyRegistrationCredentialErrorImplementors = []string{"InvalidPasskeyRegistrationCredentialError", "CreateWebAuthnCredentialPayload", "Error", "RegisterWithPasskeyPayload"}
+
+func (ec *executionContext) _InvalidPasskeyRegistrationCredentialError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidPasskeyRegistrationCredentialError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidPasskeyRegistrationCredentialErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidPasskeyRegistrationCredentialError")
+		case "message":
+			out.Values[i] = ec._InvalidPasskeyRegistrationCredentialError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _Verify2FAWithRecoveryCodePayload(ctx context.Context, sel ast.SelectionSet, obj model.Verify2FAWithRecoveryCodePayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.TwoFactorAuthenticationNotEnabledError:
-		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationNotEnabledError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationNotEnabledError(ctx, sel, obj)
-	case model.TwoFactorAuthenticationChallengeNotFoundError:
-		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationChallengeNotFoundError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
-	case model.InvalidCredentialsError:
-		return ec._InvalidCredentialsError(ctx, sel, &obj)
-	case *model.InvalidCredentialsError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCredentialsError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
+var invalidPasswordResetTokenErrorImplementors = []string{"InvalidPasswordResetTokenError", "Error", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAPasswordResetWithPasskeyPayload", "ResetPasswordPayload"}
+
+func (ec *executionContext) _InvalidPasswordResetTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidPasswordResetTokenError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidPasswordResetTokenErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidPasswordResetTokenError")
+		case "message":
+			out.Values[i] = ec._InvalidPasswordResetTokenError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _VerifyEmailPayload(ctx context.Context, sel ast.SelectionSet, obj model.VerifyEmailPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.InvalidEmailVerificationTokenError:
-		return ec._InvalidEmailVerificationTokenError(ctx, sel, &obj)
-	case *model.InvalidEmailVerificationTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidEmailVerificationTokenError(ctx, sel, obj)
-	case model.InvalidCaptchaTokenError:
-		return ec._InvalidCaptchaTokenError(ctx, sel, &obj)
-	case *model.InvalidCaptchaTokenError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCaptchaTokenError(ctx, sel, obj)
-	case model.EmailInUseError:
-		return ec._EmailInUseError(ctx, sel, &obj)
-	case *model.EmailInUseError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._EmailInUseError(ctx, sel, obj)
-	case model.VerifyEmailSuccess:
-		return ec._VerifyEmailSuccess(ctx, sel, &obj)
-	case *model.VerifyEmailSuccess:
-		if obj == nil {
-			return graphql.Null
+var invalidPersonalAccessTokenErrorImplementors = []string{"InvalidPersonalAccessTokenError", "Error", "CreatePersonalAccessTokenPayload"}
+
+func (ec *executionContext) _InvalidPersonalAccessTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidPersonalAccessTokenError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidPersonalAccessTokenErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidPersonalAccessTokenError")
+		case "message":
+			out.Values[i] = ec._InvalidPersonalAccessTokenError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._VerifyEmailSuccess(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _VerifyGoogleTokenPayload(ctx context.Context, sel ast.SelectionSet, obj model.VerifyGoogleTokenPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.TwoFactorAuthenticationRequiredError:
-		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, &obj)
-	case *model.TwoFactorAuthenticationRequiredError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._TwoFactorAuthenticationRequiredError(ctx, sel, obj)
-	case model.InvalidEmailError:
-		return ec._InvalidEmailError(ctx, sel, &obj)
-	case *model.InvalidEmailError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidEmailError(ctx, sel, obj)
-	case model.InvalidCredentialsError:
-		return ec._InvalidCredentialsError(ctx, sel, &obj)
-	case *model.InvalidCredentialsError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._InvalidCredentialsError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
+var invalidRefreshTokenErrorImplementors = []string{"InvalidRefreshTokenError", "Error", "RefreshBearerTokensPayload"}
+
+func (ec *executionContext) _InvalidRefreshTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidRefreshTokenError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRefreshTokenErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRefreshTokenError")
+		case "message":
+			out.Values[i] = ec._InvalidRefreshTokenError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
-
-func (ec *executionContext) _ViewerPayload(ctx context.Context, sel ast.SelectionSet, obj model.ViewerPayload) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
 		return graphql.Null
-	case model.NotAuthenticatedError:
-		return ec._NotAuthenticatedError(ctx, sel, &obj)
-	case *model.NotAuthenticatedError:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._NotAuthenticatedError(ctx, sel, obj)
-	case model.Account:
-		return ec._Account(ctx, sel, &obj)
-	case *model.Account:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Account(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
-}
 
-// endregion ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// region    **************************** object.gotpl ****************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-var authenticatorNotEnabledErrorImplementors = []string{"AuthenticatorNotEnabledError", "Error", "DisableAccount2FAWithAuthenticatorPayload", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAWithAuthenticatorPayload", "RequestSudoModeWithAuthenticatorPayload"}
+	return out
+}
 
-func (ec *executionContext) _AuthenticatorNotEnabledError(ctx context.Context, sel ast.SelectionSet, obj *model.AuthenticatorNotEnabledError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, authenticatorNotEnabledErrorImplementors)
+var invalidSessionRevocationTokenErrorImplementors = []string{"InvalidSessionRevocationTokenError", "Error", "RevokeSessionsPayload"}
+
+func (ec *executionContext) _InvalidSessionRevocationTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidSessionRevocationTokenError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidSessionRevocationTokenErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AuthenticatorNotEnabledError")
+			out.Values[i] = graphql.MarshalString("InvalidSessionRevocationTokenError")
 		case "message":
-			out.Values[i] = ec._AuthenticatorNotEnabledError_message(ctx, field, obj)
+			out.Values[i] = ec._InvalidSessionRevocationTokenError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3062,19 +6197,19 @@ func (ec *executionContext) _AuthenticatorNotEnabledError(ctx context.Context, s
 	return out
 }
 
-var createWebAuthnCredentialSuccessImplementors = []string{"CreateWebAuthnCredentialSuccess", "CreateWebAuthnCredentialPayload"}
+var invalidSmsLoginCodeErrorImplementors = []string{"InvalidSmsLoginCodeError", "Error", "LoginWithSmsCodePayload"}
 
-func (ec *executionContext) _CreateWebAuthnCredentialSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.CreateWebAuthnCredentialSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, createWebAuthnCredentialSuccessImplementors)
+func (ec *executionContext) _InvalidSmsLoginCodeError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidSmsLoginCodeError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidSmsLoginCodeErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateWebAuthnCredentialSuccess")
-		case "webAuthnCredentialEdge":
-			out.Values[i] = ec._CreateWebAuthnCredentialSuccess_webAuthnCredentialEdge(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("InvalidSmsLoginCodeError")
+		case "message":
+			out.Values[i] = ec._InvalidSmsLoginCodeError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3101,19 +6236,19 @@ func (ec *executionContext) _CreateWebAuthnCredentialSuccess(ctx context.Context
 	return out
 }
 
-var deleteOtherSessionsPayloadImplementors = []string{"DeleteOtherSessionsPayload"}
+var linkOAuthIdentitySuccessImplementors = []string{"LinkOAuthIdentitySuccess", "LinkOAuthIdentityPayload"}
 
-func (ec *executionContext) _DeleteOtherSessionsPayload(ctx context.Context, sel ast.SelectionSet, obj *model.DeleteOtherSessionsPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, deleteOtherSessionsPayloadImplementors)
+func (ec *executionContext) _LinkOAuthIdentitySuccess(ctx context.Context, sel ast.SelectionSet, obj *model.LinkOAuthIdentitySuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, linkOAuthIdentitySuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DeleteOtherSessionsPayload")
-		case "deletedSessionIds":
-			out.Values[i] = ec._DeleteOtherSessionsPayload_deletedSessionIds(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LinkOAuthIdentitySuccess")
+		case "authorizationUrl":
+			out.Values[i] = ec._LinkOAuthIdentitySuccess_authorizationUrl(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3140,19 +6275,19 @@ func (ec *executionContext) _DeleteOtherSessionsPayload(ctx context.Context, sel
 	return out
 }
 
-var deleteSessionSuccessImplementors = []string{"DeleteSessionSuccess", "DeleteSessionPayload"}
+var logoutPayloadImplementors = []string{"LogoutPayload"}
 
-func (ec *executionContext) _DeleteSessionSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.DeleteSessionSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, deleteSessionSuccessImplementors)
+func (ec *executionContext) _LogoutPayload(ctx context.Context, sel ast.SelectionSet, obj *model.LogoutPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logoutPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DeleteSessionSuccess")
-		case "sessionEdge":
-			out.Values[i] = ec._DeleteSessionSuccess_sessionEdge(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LogoutPayload")
+		case "message":
+			out.Values[i] = ec._LogoutPayload_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3179,19 +6314,29 @@ func (ec *executionContext) _DeleteSessionSuccess(ctx context.Context, sel ast.S
 	return out
 }
 
-var deleteWebAuthnCredentialSuccessImplementors = []string{"DeleteWebAuthnCredentialSuccess", "DeleteWebAuthnCredentialPayload"}
+var oAuthIdentityImplementors = []string{"OAuthIdentity", "Node"}
 
-func (ec *executionContext) _DeleteWebAuthnCredentialSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.DeleteWebAuthnCredentialSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, deleteWebAuthnCredentialSuccessImplementors)
+func (ec *executionContext) _OAuthIdentity(ctx context.Context, sel ast.SelectionSet, obj *model.OAuthIdentity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, oAuthIdentityImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DeleteWebAuthnCredentialSuccess")
-		case "webAuthnCredentialEdge":
-			out.Values[i] = ec._DeleteWebAuthnCredentialSuccess_webAuthnCredentialEdge(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("OAuthIdentity")
+		case "id":
+			out.Values[i] = ec._OAuthIdentity_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "provider":
+			out.Values[i] = ec._OAuthIdentity_provider(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._OAuthIdentity_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3218,22 +6363,29 @@ func (ec *executionContext) _DeleteWebAuthnCredentialSuccess(ctx context.Context
 	return out
 }
 
-var emailInUseErrorImplementors = []string{"EmailInUseError", "Error", "GeneratePasskeyRegistrationOptionsPayload", "RequestEmailVerificationTokenPayload", "VerifyEmailPayload", "RegisterWithPasskeyPayload", "RegisterWithPasswordPayload"}
+var oAuthIdentityConnectionImplementors = []string{"OAuthIdentityConnection"}
 
-func (ec *executionContext) _EmailInUseError(ctx context.Context, sel ast.SelectionSet, obj *model.EmailInUseError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, emailInUseErrorImplementors)
+func (ec *executionContext) _OAuthIdentityConnection(ctx context.Context, sel ast.SelectionSet, obj *model.OAuthIdentityConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, oAuthIdentityConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("EmailInUseError")
-		case "message":
-			out.Values[i] = ec._EmailInUseError_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("OAuthIdentityConnection")
+		case "pageInfo":
+			out.Values[i] = ec._OAuthIdentityConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "edges":
+			out.Values[i] = ec._OAuthIdentityConnection_edges(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "totalCount":
+			out.Values[i] = ec._OAuthIdentityConnection_totalCount(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -3257,24 +6409,24 @@ func (ec *executionContext) _EmailInUseError(ctx context.Context, sel ast.Select
 	return out
 }
 
-var emailVerificationTokenCooldownErrorImplementors = []string{"EmailVerificationTokenCooldownError", "Error", "RequestEmailVerificationTokenPayload"}
+var oAuthIdentityEdgeImplementors = []string{"OAuthIdentityEdge"}
 
-func (ec *executionContext) _EmailVerificationTokenCooldownError(ctx context.Context, sel ast.SelectionSet, obj *model.EmailVerificationTokenCooldownError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, emailVerificationTokenCooldownErrorImplementors)
+func (ec *executionContext) _OAuthIdentityEdge(ctx context.Context, sel ast.SelectionSet, obj *model.OAuthIdentityEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, oAuthIdentityEdgeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("EmailVerificationTokenCooldownError")
-		case "message":
-			out.Values[i] = ec._EmailVerificationTokenCooldownError_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("OAuthIdentityEdge")
+		case "cursor":
+			out.Values[i] = ec._OAuthIdentityEdge_cursor(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "remainingSeconds":
-			out.Values[i] = ec._EmailVerificationTokenCooldownError_remainingSeconds(ctx, field, obj)
+		case "node":
+			out.Values[i] = ec._OAuthIdentityEdge_node(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3301,24 +6453,58 @@ func (ec *executionContext) _EmailVerificationTokenCooldownError(ctx context.Con
 	return out
 }
 
-var enableAccount2FAWithAuthenticatorSuccessImplementors = []string{"EnableAccount2FAWithAuthenticatorSuccess", "SetAccount2FAPayload"}
+var oAuthIdentityNotFoundErrorImplementors = []string{"OAuthIdentityNotFoundError", "Error", "UnlinkOAuthIdentityPayload"}
 
-func (ec *executionContext) _EnableAccount2FAWithAuthenticatorSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.EnableAccount2FAWithAuthenticatorSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, enableAccount2FAWithAuthenticatorSuccessImplementors)
+func (ec *executionContext) _OAuthIdentityNotFoundError(ctx context.Context, sel ast.SelectionSet, obj *model.OAuthIdentityNotFoundError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, oAuthIdentityNotFoundErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("EnableAccount2FAWithAuthenticatorSuccess")
-		case "account":
-			out.Values[i] = ec._EnableAccount2FAWithAuthenticatorSuccess_account(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("OAuthIdentityNotFoundError")
+		case "message":
+			out.Values[i] = ec._OAuthIdentityNotFoundError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "recoveryCodes":
-			out.Values[i] = ec._EnableAccount2FAWithAuthenticatorSuccess_recoveryCodes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var oAuthProviderNotSupportedErrorImplementors = []string{"OAuthProviderNotSupportedError", "LinkOAuthIdentityPayload", "Error"}
+
+func (ec *executionContext) _OAuthProviderNotSupportedError(ctx context.Context, sel ast.SelectionSet, obj *model.OAuthProviderNotSupportedError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, oAuthProviderNotSupportedErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OAuthProviderNotSupportedError")
+		case "message":
+			out.Values[i] = ec._OAuthProviderNotSupportedError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3345,19 +6531,19 @@ func (ec *executionContext) _EnableAccount2FAWithAuthenticatorSuccess(ctx contex
 	return out
 }
 
-var generate2FARecoveryCodesSuccessImplementors = []string{"Generate2FARecoveryCodesSuccess", "Generate2FARecoveryCodesPayload"}
+var passwordNotStrongErrorImplementors = []string{"PasswordNotStrongError", "Error", "ResetPasswordPayload", "RegisterWithPasswordPayload", "UpdatePasswordPayload"}
 
-func (ec *executionContext) _Generate2FARecoveryCodesSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.Generate2FARecoveryCodesSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, generate2FARecoveryCodesSuccessImplementors)
+func (ec *executionContext) _PasswordNotStrongError(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordNotStrongError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, passwordNotStrongErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Generate2FARecoveryCodesSuccess")
-		case "recoveryCodes":
-			out.Values[i] = ec._Generate2FARecoveryCodesSuccess_recoveryCodes(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PasswordNotStrongError")
+		case "message":
+			out.Values[i] = ec._PasswordNotStrongError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3384,19 +6570,19 @@ func (ec *executionContext) _Generate2FARecoveryCodesSuccess(ctx context.Context
 	return out
 }
 
-var generateAuthenticationOptionsSuccessImplementors = []string{"GenerateAuthenticationOptionsSuccess", "GenerateAuthenticationOptionsPayload"}
+var passwordPreviouslyUsedErrorImplementors = []string{"PasswordPreviouslyUsedError", "Error", "ResetPasswordPayload", "UpdatePasswordPayload"}
 
-func (ec *executionContext) _GenerateAuthenticationOptionsSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.GenerateAuthenticationOptionsSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, generateAuthenticationOptionsSuccessImplementors)
+func (ec *executionContext) _PasswordPreviouslyUsedError(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordPreviouslyUsedError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, passwordPreviouslyUsedErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GenerateAuthenticationOptionsSuccess")
-		case "authenticationOptions":
-			out.Values[i] = ec._GenerateAuthenticationOptionsSuccess_authenticationOptions(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PasswordPreviouslyUsedError")
+		case "message":
+			out.Values[i] = ec._PasswordPreviouslyUsedError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3423,24 +6609,39 @@ func (ec *executionContext) _GenerateAuthenticationOptionsSuccess(ctx context.Co
 	return out
 }
 
-var generateAuthenticator2FAChallengeSuccessImplementors = []string{"GenerateAuthenticator2FAChallengeSuccess", "GenerateAuthenticator2FAChallengePayload"}
+var passwordResetTokenImplementors = []string{"PasswordResetToken", "Node", "PasswordResetTokenPayload", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAPasswordResetWithPasskeyPayload"}
 
-func (ec *executionContext) _GenerateAuthenticator2FAChallengeSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.GenerateAuthenticator2FAChallengeSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, generateAuthenticator2FAChallengeSuccessImplementors)
+func (ec *executionContext) _PasswordResetToken(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordResetToken) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, passwordResetTokenImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GenerateAuthenticator2FAChallengeSuccess")
-		case "otpUri":
-			out.Values[i] = ec._GenerateAuthenticator2FAChallengeSuccess_otpUri(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PasswordResetToken")
+		case "id":
+			out.Values[i] = ec._PasswordResetToken_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "secret":
-			out.Values[i] = ec._GenerateAuthenticator2FAChallengeSuccess_secret(ctx, field, obj)
+		case "email":
+			out.Values[i] = ec._PasswordResetToken_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "authProviders":
+			out.Values[i] = ec._PasswordResetToken_authProviders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "twoFactorProviders":
+			out.Values[i] = ec._PasswordResetToken_twoFactorProviders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "needs2fa":
+			out.Values[i] = ec._PasswordResetToken_needs2fa(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3467,19 +6668,24 @@ func (ec *executionContext) _GenerateAuthenticator2FAChallengeSuccess(ctx contex
 	return out
 }
 
-var generatePasskeyCreationOptionsSuccessImplementors = []string{"GeneratePasskeyCreationOptionsSuccess", "GeneratePasskeyCreationOptionsPayload"}
+var passwordResetTokenCooldownErrorImplementors = []string{"PasswordResetTokenCooldownError", "Error", "RequestPasswordResetPayload"}
 
-func (ec *executionContext) _GeneratePasskeyCreationOptionsSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.GeneratePasskeyCreationOptionsSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, generatePasskeyCreationOptionsSuccessImplementors)
+func (ec *executionContext) _PasswordResetTokenCooldownError(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordResetTokenCooldownError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, passwordResetTokenCooldownErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GeneratePasskeyCreationOptionsSuccess")
-		case "registrationOptions":
-			out.Values[i] = ec._GeneratePasskeyCreationOptionsSuccess_registrationOptions(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PasswordResetTokenCooldownError")
+		case "message":
+			out.Values[i] = ec._PasswordResetTokenCooldownError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "remainingSeconds":
+			out.Values[i] = ec._PasswordResetTokenCooldownError_remainingSeconds(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3506,19 +6712,19 @@ func (ec *executionContext) _GeneratePasskeyCreationOptionsSuccess(ctx context.C
 	return out
 }
 
-var generatePasskeyRegistrationOptionsSuccessImplementors = []string{"GeneratePasskeyRegistrationOptionsSuccess", "GeneratePasskeyRegistrationOptionsPayload"}
+var passwordResetTokenNotFoundErrorImplementors = []string{"PasswordResetTokenNotFoundError", "Error", "PasswordResetTokenPayload"}
 
-func (ec *executionContext) _GeneratePasskeyRegistrationOptionsSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.GeneratePasskeyRegistrationOptionsSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, generatePasskeyRegistrationOptionsSuccessImplementors)
+func (ec *executionContext) _PasswordResetTokenNotFoundError(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordResetTokenNotFoundError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, passwordResetTokenNotFoundErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("GeneratePasskeyRegistrationOptionsSuccess")
-		case "registrationOptions":
-			out.Values[i] = ec._GeneratePasskeyRegistrationOptionsSuccess_registrationOptions(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PasswordResetTokenNotFoundError")
+		case "message":
+			out.Values[i] = ec._PasswordResetTokenNotFoundError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3545,19 +6751,38 @@ func (ec *executionContext) _GeneratePasskeyRegistrationOptionsSuccess(ctx conte
 	return out
 }
 
-var insufficientAuthProvidersErrorImplementors = []string{"InsufficientAuthProvidersError", "DeletePasswordPayload", "DeleteWebAuthnCredentialPayload", "Error"}
+var personalAccessTokenImplementors = []string{"PersonalAccessToken", "Node"}
 
-func (ec *executionContext) _InsufficientAuthProvidersError(ctx context.Context, sel ast.SelectionSet, obj *model.InsufficientAuthProvidersError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, insufficientAuthProvidersErrorImplementors)
+func (ec *executionContext) _PersonalAccessToken(ctx context.Context, sel ast.SelectionSet, obj *model.PersonalAccessToken) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, personalAccessTokenImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InsufficientAuthProvidersError")
-		case "message":
-			out.Values[i] = ec._InsufficientAuthProvidersError_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PersonalAccessToken")
+		case "id":
+			out.Values[i] = ec._PersonalAccessToken_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._PersonalAccessToken_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "scopes":
+			out.Values[i] = ec._PersonalAccessToken_scopes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._PersonalAccessToken_expiresAt(ctx, field, obj)
+		case "lastUsedAt":
+			out.Values[i] = ec._PersonalAccessToken_lastUsedAt(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._PersonalAccessToken_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3584,27 +6809,29 @@ func (ec *executionContext) _InsufficientAuthProvidersError(ctx context.Context,
 	return out
 }
 
-var invalidAuthenticationProviderErrorImplementors = []string{"InvalidAuthenticationProviderError", "Error", "LoginWithPasswordPayload", "RequestSudoModeWithPasswordPayload"}
+var personalAccessTokenConnectionImplementors = []string{"PersonalAccessTokenConnection"}
 
-func (ec *executionContext) _InvalidAuthenticationProviderError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidAuthenticationProviderError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidAuthenticationProviderErrorImplementors)
+func (ec *executionContext) _PersonalAccessTokenConnection(ctx context.Context, sel ast.SelectionSet, obj *model.PersonalAccessTokenConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, personalAccessTokenConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidAuthenticationProviderError")
-		case "message":
-			out.Values[i] = ec._InvalidAuthenticationProviderError_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PersonalAccessTokenConnection")
+		case "pageInfo":
+			out.Values[i] = ec._PersonalAccessTokenConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "availableProviders":
-			out.Values[i] = ec._InvalidAuthenticationProviderError_availableProviders(ctx, field, obj)
+		case "edges":
+			out.Values[i] = ec._PersonalAccessTokenConnection_edges(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "totalCount":
+			out.Values[i] = ec._PersonalAccessTokenConnection_totalCount(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -3628,19 +6855,24 @@ func (ec *executionContext) _InvalidAuthenticationProviderError(ctx context.Cont
 	return out
 }
 
-var invalidCredentialsErrorImplementors = []string{"InvalidCredentialsError", "Error", "LoginWithPasswordPayload", "SetAccount2FAPayload", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "VerifyGoogleTokenPayload", "RequestSudoModeWithAuthenticatorPayload", "RequestSudoModeWithPasswordPayload"}
+var personalAccessTokenEdgeImplementors = []string{"PersonalAccessTokenEdge"}
 
-func (ec *executionContext) _InvalidCredentialsError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidCredentialsError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidCredentialsErrorImplementors)
+func (ec *executionContext) _PersonalAccessTokenEdge(ctx context.Context, sel ast.SelectionSet, obj *model.PersonalAccessTokenEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, personalAccessTokenEdgeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidCredentialsError")
-		case "message":
-			out.Values[i] = ec._InvalidCredentialsError_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PersonalAccessTokenEdge")
+		case "cursor":
+			out.Values[i] = ec._PersonalAccessTokenEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "node":
+			out.Values[i] = ec._PersonalAccessTokenEdge_node(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3667,19 +6899,19 @@ func (ec *executionContext) _InvalidCredentialsError(ctx context.Context, sel as
 	return out
 }
 
-var invalidEmailErrorImplementors = []string{"InvalidEmailError", "Error", "RequestEmailVerificationTokenPayload", "VerifyGoogleTokenPayload"}
+var personalAccessTokenNotFoundErrorImplementors = []string{"PersonalAccessTokenNotFoundError", "Error", "RevokePersonalAccessTokenPayload"}
 
-func (ec *executionContext) _InvalidEmailError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidEmailError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidEmailErrorImplementors)
+func (ec *executionContext) _PersonalAccessTokenNotFoundError(ctx context.Context, sel ast.SelectionSet, obj *model.PersonalAccessTokenNotFoundError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, personalAccessTokenNotFoundErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidEmailError")
+			out.Values[i] = graphql.MarshalString("PersonalAccessTokenNotFoundError")
 		case "message":
-			out.Values[i] = ec._InvalidEmailError_message(ctx, field, obj)
+			out.Values[i] = ec._PersonalAccessTokenNotFoundError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3706,19 +6938,19 @@ func (ec *executionContext) _InvalidEmailError(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var invalidEmailVerificationTokenErrorImplementors = []string{"InvalidEmailVerificationTokenError", "Error", "VerifyEmailPayload", "RegisterWithPasskeyPayload", "RegisterWithPasswordPayload"}
+var requestEmailLoginCodeSuccessImplementors = []string{"RequestEmailLoginCodeSuccess", "RequestEmailLoginCodePayload"}
 
-func (ec *executionContext) _InvalidEmailVerificationTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidEmailVerificationTokenError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidEmailVerificationTokenErrorImplementors)
+func (ec *executionContext) _RequestEmailLoginCodeSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.RequestEmailLoginCodeSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestEmailLoginCodeSuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidEmailVerificationTokenError")
+			out.Values[i] = graphql.MarshalString("RequestEmailLoginCodeSuccess")
 		case "message":
-			out.Values[i] = ec._InvalidEmailVerificationTokenError_message(ctx, field, obj)
+			out.Values[i] = ec._RequestEmailLoginCodeSuccess_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3745,19 +6977,24 @@ func (ec *executionContext) _InvalidEmailVerificationTokenError(ctx context.Cont
 	return out
 }
 
-var invalidPasskeyAuthenticationCredentialErrorImplementors = []string{"InvalidPasskeyAuthenticationCredentialError", "Error", "LoginWithPasskeyPayload", "Verify2FAPasswordResetWithPasskeyPayload", "RequestSudoModeWithPasskeyPayload"}
+var requestEmailVerificationSuccessImplementors = []string{"RequestEmailVerificationSuccess", "RequestEmailVerificationTokenPayload"}
 
-func (ec *executionContext) _InvalidPasskeyAuthenticationCredentialError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidPasskeyAuthenticationCredentialError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidPasskeyAuthenticationCredentialErrorImplementors)
+func (ec *executionContext) _RequestEmailVerificationSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.RequestEmailVerificationSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestEmailVerificationSuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidPasskeyAuthenticationCredentialError")
+			out.Values[i] = graphql.MarshalString("RequestEmailVerificationSuccess")
 		case "message":
-			out.Values[i] = ec._InvalidPasskeyAuthenticationCredentialError_message(ctx, field, obj)
+			out.Values[i] = ec._RequestEmailVerificationSuccess_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "remainingSeconds":
+			out.Values[i] = ec._RequestEmailVerificationSuccess_remainingSeconds(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3784,19 +7021,19 @@ func (ec *executionContext) _InvalidPasskeyAuthenticationCredentialError(ctx con
 	return out
 }
 
-var invalidPasskeyRegistrationCredentialErrorImplementors = []string{"InvalidPasskeyRegistrationCredentialError", "CreateWebAuthnCredentialPayload", "Error", "RegisterWithPasskeyPayload"}
+var requestPasswordResetSuccessImplementors = []string{"RequestPasswordResetSuccess", "RequestPasswordResetPayload"}
 
-func (ec *executionContext) _InvalidPasskeyRegistrationCredentialError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidPasskeyRegistrationCredentialError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidPasskeyRegistrationCredentialErrorImplementors)
+func (ec *executionContext) _RequestPasswordResetSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.RequestPasswordResetSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestPasswordResetSuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidPasskeyRegistrationCredentialError")
+			out.Values[i] = graphql.MarshalString("RequestPasswordResetSuccess")
 		case "message":
-			out.Values[i] = ec._InvalidPasskeyRegistrationCredentialError_message(ctx, field, obj)
+			out.Values[i] = ec._RequestPasswordResetSuccess_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3823,19 +7060,19 @@ func (ec *executionContext) _InvalidPasskeyRegistrationCredentialError(ctx conte
 	return out
 }
 
-var invalidPasswordResetTokenErrorImplementors = []string{"InvalidPasswordResetTokenError", "Error", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAPasswordResetWithPasskeyPayload", "ResetPasswordPayload"}
+var requestSmsLoginCodeSuccessImplementors = []string{"RequestSmsLoginCodeSuccess", "RequestSmsLoginCodePayload"}
 
-func (ec *executionContext) _InvalidPasswordResetTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidPasswordResetTokenError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidPasswordResetTokenErrorImplementors)
+func (ec *executionContext) _RequestSmsLoginCodeSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.RequestSmsLoginCodeSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, requestSmsLoginCodeSuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidPasswordResetTokenError")
+			out.Values[i] = graphql.MarshalString("RequestSmsLoginCodeSuccess")
 		case "message":
-			out.Values[i] = ec._InvalidPasswordResetTokenError_message(ctx, field, obj)
+			out.Values[i] = ec._RequestSmsLoginCodeSuccess_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3862,19 +7099,19 @@ func (ec *executionContext) _InvalidPasswordResetTokenError(ctx context.Context,
 	return out
 }
 
-var logoutPayloadImplementors = []string{"LogoutPayload"}
+var revokePersonalAccessTokenSuccessImplementors = []string{"RevokePersonalAccessTokenSuccess", "RevokePersonalAccessTokenPayload"}
 
-func (ec *executionContext) _LogoutPayload(ctx context.Context, sel ast.SelectionSet, obj *model.LogoutPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, logoutPayloadImplementors)
+func (ec *executionContext) _RevokePersonalAccessTokenSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.RevokePersonalAccessTokenSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, revokePersonalAccessTokenSuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LogoutPayload")
-		case "message":
-			out.Values[i] = ec._LogoutPayload_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RevokePersonalAccessTokenSuccess")
+		case "personalAccessTokenEdge":
+			out.Values[i] = ec._RevokePersonalAccessTokenSuccess_personalAccessTokenEdge(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3901,19 +7138,19 @@ func (ec *executionContext) _LogoutPayload(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var passwordNotStrongErrorImplementors = []string{"PasswordNotStrongError", "Error", "ResetPasswordPayload", "RegisterWithPasswordPayload", "UpdatePasswordPayload"}
+var revokeSessionsSuccessImplementors = []string{"RevokeSessionsSuccess", "RevokeSessionsPayload"}
 
-func (ec *executionContext) _PasswordNotStrongError(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordNotStrongError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, passwordNotStrongErrorImplementors)
+func (ec *executionContext) _RevokeSessionsSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.RevokeSessionsSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, revokeSessionsSuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PasswordNotStrongError")
+			out.Values[i] = graphql.MarshalString("RevokeSessionsSuccess")
 		case "message":
-			out.Values[i] = ec._PasswordNotStrongError_message(ctx, field, obj)
+			out.Values[i] = ec._RevokeSessionsSuccess_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3940,42 +7177,85 @@ func (ec *executionContext) _PasswordNotStrongError(ctx context.Context, sel ast
 	return out
 }
 
-var passwordResetTokenImplementors = []string{"PasswordResetToken", "Node", "PasswordResetTokenPayload", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAPasswordResetWithPasskeyPayload"}
+var sessionImplementors = []string{"Session", "Node"}
 
-func (ec *executionContext) _PasswordResetToken(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordResetToken) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, passwordResetTokenImplementors)
+func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet, obj *model.Session) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PasswordResetToken")
+			out.Values[i] = graphql.MarshalString("Session")
 		case "id":
-			out.Values[i] = ec._PasswordResetToken_id(ctx, field, obj)
+			out.Values[i] = ec._Session_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "email":
-			out.Values[i] = ec._PasswordResetToken_email(ctx, field, obj)
+		case "userAgent":
+			out.Values[i] = ec._Session_userAgent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "authProviders":
-			out.Values[i] = ec._PasswordResetToken_authProviders(ctx, field, obj)
+		case "ipAddress":
+			out.Values[i] = ec._Session_ipAddress(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "twoFactorProviders":
-			out.Values[i] = ec._PasswordResetToken_twoFactorProviders(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._Session_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "needs2fa":
-			out.Values[i] = ec._PasswordResetToken_needs2fa(ctx, field, obj)
+		case "lastActiveAt":
+			out.Values[i] = ec._Session_lastActiveAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "isCurrent":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Session_isCurrent(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "device":
+			out.Values[i] = ec._Session_device(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "location":
+			out.Values[i] = ec._Session_location(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -3999,27 +7279,29 @@ func (ec *executionContext) _PasswordResetToken(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var passwordResetTokenCooldownErrorImplementors = []string{"PasswordResetTokenCooldownError", "Error", "RequestPasswordResetPayload"}
+var sessionConnectionImplementors = []string{"SessionConnection"}
 
-func (ec *executionContext) _PasswordResetTokenCooldownError(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordResetTokenCooldownError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, passwordResetTokenCooldownErrorImplementors)
+func (ec *executionContext) _SessionConnection(ctx context.Context, sel ast.SelectionSet, obj *model.SessionConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PasswordResetTokenCooldownError")
-		case "message":
-			out.Values[i] = ec._PasswordResetTokenCooldownError_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SessionConnection")
+		case "pageInfo":
+			out.Values[i] = ec._SessionConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "remainingSeconds":
-			out.Values[i] = ec._PasswordResetTokenCooldownError_remainingSeconds(ctx, field, obj)
+		case "edges":
+			out.Values[i] = ec._SessionConnection_edges(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "totalCount":
+			out.Values[i] = ec._SessionConnection_totalCount(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4043,19 +7325,23 @@ func (ec *executionContext) _PasswordResetTokenCooldownError(ctx context.Context
 	return out
 }
 
-var passwordResetTokenNotFoundErrorImplementors = []string{"PasswordResetTokenNotFoundError", "Error", "PasswordResetTokenPayload"}
+var sessionDeviceImplementors = []string{"SessionDevice"}
 
-func (ec *executionContext) _PasswordResetTokenNotFoundError(ctx context.Context, sel ast.SelectionSet, obj *model.PasswordResetTokenNotFoundError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, passwordResetTokenNotFoundErrorImplementors)
+func (ec *executionContext) _SessionDevice(ctx context.Context, sel ast.SelectionSet, obj *model.SessionDevice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionDeviceImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PasswordResetTokenNotFoundError")
-		case "message":
-			out.Values[i] = ec._PasswordResetTokenNotFoundError_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SessionDevice")
+		case "browser":
+			out.Values[i] = ec._SessionDevice_browser(ctx, field, obj)
+		case "os":
+			out.Values[i] = ec._SessionDevice_os(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec._SessionDevice_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4082,24 +7368,24 @@ func (ec *executionContext) _PasswordResetTokenNotFoundError(ctx context.Context
 	return out
 }
 
-var requestEmailVerificationSuccessImplementors = []string{"RequestEmailVerificationSuccess", "RequestEmailVerificationTokenPayload"}
+var sessionEdgeImplementors = []string{"SessionEdge"}
 
-func (ec *executionContext) _RequestEmailVerificationSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.RequestEmailVerificationSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, requestEmailVerificationSuccessImplementors)
+func (ec *executionContext) _SessionEdge(ctx context.Context, sel ast.SelectionSet, obj *model.SessionEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionEdgeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RequestEmailVerificationSuccess")
-		case "message":
-			out.Values[i] = ec._RequestEmailVerificationSuccess_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SessionEdge")
+		case "cursor":
+			out.Values[i] = ec._SessionEdge_cursor(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "remainingSeconds":
-			out.Values[i] = ec._RequestEmailVerificationSuccess_remainingSeconds(ctx, field, obj)
+		case "node":
+			out.Values[i] = ec._SessionEdge_node(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4126,19 +7412,26 @@ func (ec *executionContext) _RequestEmailVerificationSuccess(ctx context.Context
 	return out
 }
 
-var requestPasswordResetSuccessImplementors = []string{"RequestPasswordResetSuccess", "RequestPasswordResetPayload"}
+var sessionLocationImplementors = []string{"SessionLocation"}
 
-func (ec *executionContext) _RequestPasswordResetSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.RequestPasswordResetSuccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, requestPasswordResetSuccessImplementors)
+func (ec *executionContext) _SessionLocation(ctx context.Context, sel ast.SelectionSet, obj *model.SessionLocation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionLocationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RequestPasswordResetSuccess")
-		case "message":
-			out.Values[i] = ec._RequestPasswordResetSuccess_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SessionLocation")
+		case "city":
+			out.Values[i] = ec._SessionLocation_city(ctx, field, obj)
+		case "country":
+			out.Values[i] = ec._SessionLocation_country(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "countryCode":
+			out.Values[i] = ec._SessionLocation_countryCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4165,34 +7458,19 @@ func (ec *executionContext) _RequestPasswordResetSuccess(ctx context.Context, se
 	return out
 }
 
-var sessionImplementors = []string{"Session", "Node"}
+var sessionNotFoundErrorImplementors = []string{"SessionNotFoundError", "DeleteSessionPayload", "Error"}
 
-func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet, obj *model.Session) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sessionImplementors)
+func (ec *executionContext) _SessionNotFoundError(ctx context.Context, sel ast.SelectionSet, obj *model.SessionNotFoundError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionNotFoundErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Session")
-		case "id":
-			out.Values[i] = ec._Session_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "userAgent":
-			out.Values[i] = ec._Session_userAgent(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "ipAddress":
-			out.Values[i] = ec._Session_ipAddress(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createdAt":
-			out.Values[i] = ec._Session_createdAt(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SessionNotFoundError")
+		case "message":
+			out.Values[i] = ec._SessionNotFoundError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4219,29 +7497,27 @@ func (ec *executionContext) _Session(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var sessionConnectionImplementors = []string{"SessionConnection"}
+var tooManyAttemptsErrorImplementors = []string{"TooManyAttemptsError", "LoginWithEmailCodePayload", "LoginWithSmsCodePayload", "LoginWithPasswordPayload", "Error", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "RequestSudoModeWithAuthenticatorPayload", "RequestSudoModeWithPasswordPayload"}
 
-func (ec *executionContext) _SessionConnection(ctx context.Context, sel ast.SelectionSet, obj *model.SessionConnection) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sessionConnectionImplementors)
+func (ec *executionContext) _TooManyAttemptsError(ctx context.Context, sel ast.SelectionSet, obj *model.TooManyAttemptsError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tooManyAttemptsErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SessionConnection")
-		case "pageInfo":
-			out.Values[i] = ec._SessionConnection_pageInfo(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TooManyAttemptsError")
+		case "message":
+			out.Values[i] = ec._TooManyAttemptsError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "edges":
-			out.Values[i] = ec._SessionConnection_edges(ctx, field, obj)
+		case "retryAfterSeconds":
+			out.Values[i] = ec._TooManyAttemptsError_retryAfterSeconds(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalCount":
-			out.Values[i] = ec._SessionConnection_totalCount(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4265,24 +7541,19 @@ func (ec *executionContext) _SessionConnection(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var sessionEdgeImplementors = []string{"SessionEdge"}
+var twoFactorAuthenticationChallengeNotFoundErrorImplementors = []string{"TwoFactorAuthenticationChallengeNotFoundError", "SetAccount2FAPayload", "Error", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "ResetPasswordPayload"}
 
-func (ec *executionContext) _SessionEdge(ctx context.Context, sel ast.SelectionSet, obj *model.SessionEdge) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sessionEdgeImplementors)
+func (ec *executionContext) _TwoFactorAuthenticationChallengeNotFoundError(ctx context.Context, sel ast.SelectionSet, obj *model.TwoFactorAuthenticationChallengeNotFoundError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, twoFactorAuthenticationChallengeNotFoundErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SessionEdge")
-		case "cursor":
-			out.Values[i] = ec._SessionEdge_cursor(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "node":
-			out.Values[i] = ec._SessionEdge_node(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TwoFactorAuthenticationChallengeNotFoundError")
+		case "message":
+			out.Values[i] = ec._TwoFactorAuthenticationChallengeNotFoundError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4309,19 +7580,19 @@ func (ec *executionContext) _SessionEdge(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var sessionNotFoundErrorImplementors = []string{"SessionNotFoundError", "DeleteSessionPayload", "Error"}
+var twoFactorAuthenticationNotEnabledErrorImplementors = []string{"TwoFactorAuthenticationNotEnabledError", "Generate2FARecoveryCodesPayload", "Error", "Verify2FAPasswordResetWithPasskeyPayload", "Verify2FAWithRecoveryCodePayload"}
 
-func (ec *executionContext) _SessionNotFoundError(ctx context.Context, sel ast.SelectionSet, obj *model.SessionNotFoundError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sessionNotFoundErrorImplementors)
+func (ec *executionContext) _TwoFactorAuthenticationNotEnabledError(ctx context.Context, sel ast.SelectionSet, obj *model.TwoFactorAuthenticationNotEnabledError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, twoFactorAuthenticationNotEnabledErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SessionNotFoundError")
+			out.Values[i] = graphql.MarshalString("TwoFactorAuthenticationNotEnabledError")
 		case "message":
-			out.Values[i] = ec._SessionNotFoundError_message(ctx, field, obj)
+			out.Values[i] = ec._TwoFactorAuthenticationNotEnabledError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4348,19 +7619,19 @@ func (ec *executionContext) _SessionNotFoundError(ctx context.Context, sel ast.S
 	return out
 }
 
-var twoFactorAuthenticationChallengeNotFoundErrorImplementors = []string{"TwoFactorAuthenticationChallengeNotFoundError", "SetAccount2FAPayload", "Error", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "ResetPasswordPayload"}
+var twoFactorAuthenticationRequiredErrorImplementors = []string{"TwoFactorAuthenticationRequiredError", "LoginWithEmailCodePayload", "LoginWithSmsCodePayload", "LoginWithPasswordPayload", "Error", "VerifyGoogleTokenPayload", "RequestSudoModeWithPasswordPayload"}
 
-func (ec *executionContext) _TwoFactorAuthenticationChallengeNotFoundError(ctx context.Context, sel ast.SelectionSet, obj *model.TwoFactorAuthenticationChallengeNotFoundError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, twoFactorAuthenticationChallengeNotFoundErrorImplementors)
+func (ec *executionContext) _TwoFactorAuthenticationRequiredError(ctx context.Context, sel ast.SelectionSet, obj *model.TwoFactorAuthenticationRequiredError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, twoFactorAuthenticationRequiredErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TwoFactorAuthenticationChallengeNotFoundError")
+			out.Values[i] = graphql.MarshalString("TwoFactorAuthenticationRequiredError")
 		case "message":
-			out.Values[i] = ec._TwoFactorAuthenticationChallengeNotFoundError_message(ctx, field, obj)
+			out.Values[i] = ec._TwoFactorAuthenticationRequiredError_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4387,19 +7658,19 @@ func (ec *executionContext) _TwoFactorAuthenticationChallengeNotFoundError(ctx c
 	return out
 }
 
-var twoFactorAuthenticationNotEnabledErrorImplementors = []string{"TwoFactorAuthenticationNotEnabledError", "Generate2FARecoveryCodesPayload", "Error", "Verify2FAPasswordResetWithPasskeyPayload", "Verify2FAWithRecoveryCodePayload"}
+var unlinkOAuthIdentitySuccessImplementors = []string{"UnlinkOAuthIdentitySuccess", "UnlinkOAuthIdentityPayload"}
 
-func (ec *executionContext) _TwoFactorAuthenticationNotEnabledError(ctx context.Context, sel ast.SelectionSet, obj *model.TwoFactorAuthenticationNotEnabledError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, twoFactorAuthenticationNotEnabledErrorImplementors)
+func (ec *executionContext) _UnlinkOAuthIdentitySuccess(ctx context.Context, sel ast.SelectionSet, obj *model.UnlinkOAuthIdentitySuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, unlinkOAuthIdentitySuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TwoFactorAuthenticationNotEnabledError")
-		case "message":
-			out.Values[i] = ec._TwoFactorAuthenticationNotEnabledError_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("UnlinkOAuthIdentitySuccess")
+		case "oauthIdentityEdge":
+			out.Values[i] = ec._UnlinkOAuthIdentitySuccess_oauthIdentityEdge(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4426,19 +7697,19 @@ func (ec *executionContext) _TwoFactorAuthenticationNotEnabledError(ctx context.
 	return out
 }
 
-var twoFactorAuthenticationRequiredErrorImplementors = []string{"TwoFactorAuthenticationRequiredError", "LoginWithPasswordPayload", "Error", "VerifyGoogleTokenPayload", "RequestSudoModeWithPasswordPayload"}
+var unlockAccountSuccessImplementors = []string{"UnlockAccountSuccess", "UnlockAccountPayload"}
 
-func (ec *executionContext) _TwoFactorAuthenticationRequiredError(ctx context.Context, sel ast.SelectionSet, obj *model.TwoFactorAuthenticationRequiredError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, twoFactorAuthenticationRequiredErrorImplementors)
+func (ec *executionContext) _UnlockAccountSuccess(ctx context.Context, sel ast.SelectionSet, obj *model.UnlockAccountSuccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, unlockAccountSuccessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TwoFactorAuthenticationRequiredError")
+			out.Values[i] = graphql.MarshalString("UnlockAccountSuccess")
 		case "message":
-			out.Values[i] = ec._TwoFactorAuthenticationRequiredError_message(ctx, field, obj)
+			out.Values[i] = ec._UnlockAccountSuccess_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4786,17 +8057,41 @@ func (ec *executionContext) marshalNAuthProvider2ᚕserverᚋgraphᚋmodelᚐAut
 		} else {
 			go f(i)
 		}
-
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNBearerTokens2serverᚋgraphᚋmodelᚐBearerTokens(ctx context.Context, sel ast.SelectionSet, v model.BearerTokens) graphql.Marshaler {
+	return ec._BearerTokens(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNBearerTokens2ᚖserverᚋgraphᚋmodelᚐBearerTokens(ctx context.Context, sel ast.SelectionSet, v *model.BearerTokens) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-	wg.Wait()
+	return ec._BearerTokens(ctx, sel, v)
+}
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+func (ec *executionContext) marshalNCreatePersonalAccessTokenPayload2serverᚋgraphᚋmodelᚐCreatePersonalAccessTokenPayload(ctx context.Context, sel ast.SelectionSet, v model.CreatePersonalAccessTokenPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-
-	return ret
+	return ec._CreatePersonalAccessTokenPayload(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNCreateWebAuthnCredentialPayload2serverᚋgraphᚋmodelᚐCreateWebAuthnCredentialPayload(ctx context.Context, sel ast.SelectionSet, v model.CreateWebAuthnCredentialPayload) graphql.Marshaler {
@@ -4853,6 +8148,16 @@ func (ec *executionContext) marshalNDeleteWebAuthnCredentialPayload2serverᚋgra
 	return ec._DeleteWebAuthnCredentialPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNDeviceType2serverᚋgraphᚋmodelᚐDeviceType(ctx context.Context, v any) (model.DeviceType, error) {
+	var res model.DeviceType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDeviceType2serverᚋgraphᚋmodelᚐDeviceType(ctx context.Context, sel ast.SelectionSet, v model.DeviceType) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNDisableAccount2FAWithAuthenticatorPayload2serverᚋgraphᚋmodelᚐDisableAccount2FAWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, v model.DisableAccount2FAWithAuthenticatorPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -4913,6 +8218,26 @@ func (ec *executionContext) marshalNGeneratePasskeyRegistrationOptionsPayload2se
 	return ec._GeneratePasskeyRegistrationOptionsPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNLinkOAuthIdentityPayload2serverᚋgraphᚋmodelᚐLinkOAuthIdentityPayload(ctx context.Context, sel ast.SelectionSet, v model.LinkOAuthIdentityPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LinkOAuthIdentityPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNLoginWithEmailCodePayload2serverᚋgraphᚋmodelᚐLoginWithEmailCodePayload(ctx context.Context, sel ast.SelectionSet, v model.LoginWithEmailCodePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LoginWithEmailCodePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNLoginWithPasskeyPayload2serverᚋgraphᚋmodelᚐLoginWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, v model.LoginWithPasskeyPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -4933,6 +8258,16 @@ func (ec *executionContext) marshalNLoginWithPasswordPayload2serverᚋgraphᚋmo
 	return ec._LoginWithPasswordPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNLoginWithSmsCodePayload2serverᚋgraphᚋmodelᚐLoginWithSmsCodePayload(ctx context.Context, sel ast.SelectionSet, v model.LoginWithSmsCodePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LoginWithSmsCodePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNLogoutPayload2serverᚋgraphᚋmodelᚐLogoutPayload(ctx context.Context, sel ast.SelectionSet, v model.LogoutPayload) graphql.Marshaler {
 	return ec._LogoutPayload(ctx, sel, &v)
 }
@@ -4947,6 +8282,84 @@ func (ec *executionContext) marshalNLogoutPayload2ᚖserverᚋgraphᚋmodelᚐLo
 	return ec._LogoutPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNOAuthIdentity2ᚖserverᚋgraphᚋmodelᚐOAuthIdentity(ctx context.Context, sel ast.SelectionSet, v *model.OAuthIdentity) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OAuthIdentity(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNOAuthIdentityConnection2serverᚋgraphᚋmodelᚐOAuthIdentityConnection(ctx context.Context, sel ast.SelectionSet, v model.OAuthIdentityConnection) graphql.Marshaler {
+	return ec._OAuthIdentityConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNOAuthIdentityConnection2ᚖserverᚋgraphᚋmodelᚐOAuthIdentityConnection(ctx context.Context, sel ast.SelectionSet, v *model.OAuthIdentityConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OAuthIdentityConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNOAuthIdentityEdge2ᚕᚖserverᚋgraphᚋmodelᚐOAuthIdentityEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OAuthIdentityEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNOAuthIdentityEdge2ᚖserverᚋgraphᚋmodelᚐOAuthIdentityEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNOAuthIdentityEdge2ᚖserverᚋgraphᚋmodelᚐOAuthIdentityEdge(ctx context.Context, sel ast.SelectionSet, v *model.OAuthIdentityEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OAuthIdentityEdge(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNPasswordResetTokenPayload2serverᚋgraphᚋmodelᚐPasswordResetTokenPayload(ctx context.Context, sel ast.SelectionSet, v model.PasswordResetTokenPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -4957,6 +8370,94 @@ func (ec *executionContext) marshalNPasswordResetTokenPayload2serverᚋgraphᚋm
 	return ec._PasswordResetTokenPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNPersonalAccessToken2ᚖserverᚋgraphᚋmodelᚐPersonalAccessToken(ctx context.Context, sel ast.SelectionSet, v *model.PersonalAccessToken) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PersonalAccessToken(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPersonalAccessTokenConnection2serverᚋgraphᚋmodelᚐPersonalAccessTokenConnection(ctx context.Context, sel ast.SelectionSet, v model.PersonalAccessTokenConnection) graphql.Marshaler {
+	return ec._PersonalAccessTokenConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPersonalAccessTokenConnection2ᚖserverᚋgraphᚋmodelᚐPersonalAccessTokenConnection(ctx context.Context, sel ast.SelectionSet, v *model.PersonalAccessTokenConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PersonalAccessTokenConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPersonalAccessTokenEdge2ᚕᚖserverᚋgraphᚋmodelᚐPersonalAccessTokenEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PersonalAccessTokenEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPersonalAccessTokenEdge2ᚖserverᚋgraphᚋmodelᚐPersonalAccessTokenEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPersonalAccessTokenEdge2ᚖserverᚋgraphᚋmodelᚐPersonalAccessTokenEdge(ctx context.Context, sel ast.SelectionSet, v *model.PersonalAccessTokenEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PersonalAccessTokenEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRefreshBearerTokensPayload2serverᚋgraphᚋmodelᚐRefreshBearerTokensPayload(ctx context.Context, sel ast.SelectionSet, v model.RefreshBearerTokensPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RefreshBearerTokensPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNRegisterWithPasskeyPayload2serverᚋgraphᚋmodelᚐRegisterWithPasskeyPayload(ctx context.Context, sel ast.SelectionSet, v model.RegisterWithPasskeyPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -4977,6 +8478,16 @@ func (ec *executionContext) marshalNRegisterWithPasswordPayload2serverᚋgraph
 	return ec._RegisterWithPasswordPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRequestEmailLoginCodePayload2serverᚋgraphᚋmodelᚐRequestEmailLoginCodePayload(ctx context.Context, sel ast.SelectionSet, v model.RequestEmailLoginCodePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestEmailLoginCodePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNRequestEmailVerificationTokenPayload2serverᚋgraphᚋmodelᚐRequestEmailVerificationTokenPayload(ctx context.Context, sel ast.SelectionSet, v model.RequestEmailVerificationTokenPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -4997,6 +8508,16 @@ func (ec *executionContext) marshalNRequestPasswordResetPayload2serverᚋgraph
 	return ec._RequestPasswordResetPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRequestSmsLoginCodePayload2serverᚋgraphᚋmodelᚐRequestSmsLoginCodePayload(ctx context.Context, sel ast.SelectionSet, v model.RequestSmsLoginCodePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RequestSmsLoginCodePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNRequestSudoModeWithAuthenticatorPayload2serverᚋgraphᚋmodelᚐRequestSudoModeWithAuthenticatorPayload(ctx context.Context, sel ast.SelectionSet, v model.RequestSudoModeWithAuthenticatorPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -5037,6 +8558,30 @@ func (ec *executionContext) marshalNResetPasswordPayload2serverᚋgraphᚋmodel
 	return ec._ResetPasswordPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRevokePersonalAccessTokenPayload2serverᚋgraphᚋmodelᚐRevokePersonalAccessTokenPayload(ctx context.Context, sel ast.SelectionSet, v model.RevokePersonalAccessTokenPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RevokePersonalAccessTokenPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRevokeSessionsPayload2serverᚋgraphᚋmodelᚐRevokeSessionsPayload(ctx context.Context, sel ast.SelectionSet, v model.RevokeSessionsPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RevokeSessionsPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSession2serverᚋgraphᚋmodelᚐSession(ctx context.Context, sel ast.SelectionSet, v model.Session) graphql.Marshaler {
+	return ec._Session(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNSession2ᚖserverᚋgraphᚋmodelᚐSession(ctx context.Context, sel ast.SelectionSet, v *model.Session) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -5047,6 +8592,10 @@ func (ec *executionContext) marshalNSession2ᚖserverᚋgraphᚋmodelᚐSession(
 	return ec._Session(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSessionConnection2serverᚋgraphᚋmodelᚐSessionConnection(ctx context.Context, sel ast.SelectionSet, v model.SessionConnection) graphql.Marshaler {
+	return ec._SessionConnection(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNSessionConnection2ᚖserverᚋgraphᚋmodelᚐSessionConnection(ctx context.Context, sel ast.SelectionSet, v *model.SessionConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -5057,6 +8606,16 @@ func (ec *executionContext) marshalNSessionConnection2ᚖserverᚋgraphᚋmodel
 	return ec._SessionConnection(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSessionDevice2ᚖserverᚋgraphᚋmodelᚐSessionDevice(ctx context.Context, sel ast.SelectionSet, v *model.SessionDevice) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SessionDevice(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNSessionEdge2ᚕᚖserverᚋgraphᚋmodelᚐSessionEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SessionEdge) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -5190,6 +8749,26 @@ func (ec *executionContext) marshalNTwoFactorProvider2ᚕserverᚋgraphᚋmodel
 	return ret
 }
 
+func (ec *executionContext) marshalNUnlinkOAuthIdentityPayload2serverᚋgraphᚋmodelᚐUnlinkOAuthIdentityPayload(ctx context.Context, sel ast.SelectionSet, v model.UnlinkOAuthIdentityPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._UnlinkOAuthIdentityPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNUnlockAccountPayload2serverᚋgraphᚋmodelᚐUnlockAccountPayload(ctx context.Context, sel ast.SelectionSet, v model.UnlockAccountPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._UnlockAccountPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNUpdateAccountPayload2serverᚋgraphᚋmodelᚐUpdateAccountPayload(ctx context.Context, sel ast.SelectionSet, v model.UpdateAccountPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -5310,6 +8889,10 @@ func (ec *executionContext) marshalNWebAuthnCredential2ᚖserverᚋgraphᚋmodel
 	return ec._WebAuthnCredential(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNWebAuthnCredentialConnection2serverᚋgraphᚋmodelᚐWebAuthnCredentialConnection(ctx context.Context, sel ast.SelectionSet, v model.WebAuthnCredentialConnection) graphql.Marshaler {
+	return ec._WebAuthnCredentialConnection(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNWebAuthnCredentialConnection2ᚖserverᚋgraphᚋmodelᚐWebAuthnCredentialConnection(ctx context.Context, sel ast.SelectionSet, v *model.WebAuthnCredentialConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -5374,4 +8957,11 @@ func (ec *executionContext) marshalNWebAuthnCredentialEdge2ᚖserverᚋgraphᚋm
 	return ec._WebAuthnCredentialEdge(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOSessionLocation2ᚖserverᚋgraphᚋmodelᚐSessionLocation(ctx context.Context, sel ast.SelectionSet, v *model.SessionLocation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SessionLocation(ctx, sel, v)
+}
+
 // endregion ***************************** type.gotpl *****************************
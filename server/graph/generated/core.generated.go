@@ -31,19 +31,31 @@ type MutationResolver interface {
 	RegisterWithPasskey(ctx context.Context, email string, emailVerificationToken string, passkeyRegistrationResponse string, passkeyNickname string, fullName string, captchaToken string) (model.RegisterWithPasskeyPayload, error)
 	GenerateAuthenticationOptions(ctx context.Context, captchaToken string) (model.GenerateAuthenticationOptionsPayload, error)
 	GenerateReauthenticationOptions(ctx context.Context, captchaToken string) (model.GenerateAuthenticationOptionsPayload, error)
-	LoginWithPasskey(ctx context.Context, authenticationResponse string, captchaToken string) (model.LoginWithPasskeyPayload, error)
-	LoginWithPassword(ctx context.Context, login string, password string, captchaToken string) (model.LoginWithPasswordPayload, error)
+	LoginWithPasskey(ctx context.Context, authenticationResponse string, captchaToken string, rememberMe bool) (model.LoginWithPasskeyPayload, error)
+	LoginWithPassword(ctx context.Context, login string, password string, captchaToken string, rememberMe bool) (model.LoginWithPasswordPayload, error)
+	RequestEmailLoginCode(ctx context.Context, email string, captchaToken string) (model.RequestEmailLoginCodePayload, error)
+	LoginWithEmailCode(ctx context.Context, email string, code string, captchaToken string, rememberMe bool) (model.LoginWithEmailCodePayload, error)
+	RequestSmsLoginCode(ctx context.Context, phoneNumber string, captchaToken string) (model.RequestSmsLoginCodePayload, error)
+	LoginWithSmsCode(ctx context.Context, phoneNumber string, code string, captchaToken string, rememberMe bool) (model.LoginWithSmsCodePayload, error)
 	Logout(ctx context.Context) (*model.LogoutPayload, error)
 	RequestPasswordReset(ctx context.Context, email string, captchaToken string) (model.RequestPasswordResetPayload, error)
 	Verify2faPasswordResetWithAuthenticator(ctx context.Context, email string, passwordResetToken string, twoFactorToken string, captchaToken string) (model.Verify2FAPasswordResetWithAuthenticatorPayload, error)
 	Verify2faPasswordResetWithPasskey(ctx context.Context, email string, passwordResetToken string, authenticationResponse string, captchaToken string) (model.Verify2FAPasswordResetWithPasskeyPayload, error)
 	ResetPassword(ctx context.Context, email string, passwordResetToken string, newPassword string) (model.ResetPasswordPayload, error)
+	UnlockAccount(ctx context.Context, email string, unlockToken string) (model.UnlockAccountPayload, error)
+	RevokeSessions(ctx context.Context, revocationToken string) (model.RevokeSessionsPayload, error)
+	CreateBearerTokens(ctx context.Context) (*model.BearerTokens, error)
+	RefreshBearerTokens(ctx context.Context, refreshToken string) (model.RefreshBearerTokensPayload, error)
+	CreatePersonalAccessToken(ctx context.Context, name string, scopes []model.PersonalAccessTokenScope, expiresInDays *int32) (model.CreatePersonalAccessTokenPayload, error)
+	RevokePersonalAccessToken(ctx context.Context, personalAccessTokenID string) (model.RevokePersonalAccessTokenPayload, error)
 	UpdatePassword(ctx context.Context, newPassword string) (model.UpdatePasswordPayload, error)
 	DeletePassword(ctx context.Context) (model.DeletePasswordPayload, error)
 	DeleteOtherSessions(ctx context.Context) (*model.DeleteOtherSessionsPayload, error)
 	DeleteSession(ctx context.Context, sessionID string) (model.DeleteSessionPayload, error)
 	DeleteWebAuthnCredential(ctx context.Context, webAuthnCredentialID string) (model.DeleteWebAuthnCredentialPayload, error)
 	UpdateWebAuthnCredential(ctx context.Context, webAuthnCredentialID string, nickname string) (model.UpdateWebAuthnCredentialPayload, error)
+	LinkOAuthIdentity(ctx context.Context, provider string) (model.LinkOAuthIdentityPayload, error)
+	UnlinkOAuthIdentity(ctx context.Context, oauthIdentityID string) (model.UnlinkOAuthIdentityPayload, error)
 	GenerateWebAuthnCredentialCreationOptions(ctx context.Context) (model.GeneratePasskeyCreationOptionsPayload, error)
 	CreateWebAuthnCredential(ctx context.Context, passkeyRegistrationResponse string, nickname string) (model.CreateWebAuthnCredentialPayload, error)
 	RequestSudoModeWithPasskey(ctx context.Context, authenticationResponse string, captchaToken string) (model.RequestSudoModeWithPasskeyPayload, error)
@@ -55,7 +67,7 @@ type MutationResolver interface {
 	Verify2faWithAuthenticator(ctx context.Context, token string, captchaToken string) (model.Verify2FAWithAuthenticatorPayload, error)
 	Verify2faWithRecoveryCode(ctx context.Context, token string, captchaToken string) (model.Verify2FAWithRecoveryCodePayload, error)
 	Generate2faRecoveryCodes(ctx context.Context) (model.Generate2FARecoveryCodesPayload, error)
-	VerifyGoogleToken(ctx context.Context, token string) (model.VerifyGoogleTokenPayload, error)
+	VerifyGoogleToken(ctx context.Context, token string, rememberMe bool) (model.VerifyGoogleTokenPayload, error)
 }
 type QueryResolver interface {
 	Node(ctx context.Context, id string) (model.Node, error)
@@ -67,6 +79,27 @@ type QueryResolver interface {
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Mutation_createPersonalAccessToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "scopes", ec.unmarshalNPersonalAccessTokenScope2ᚕserverᚋgraphᚋmodelᚐPersonalAccessTokenScopeᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["scopes"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "expiresInDays", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["expiresInDays"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createWebAuthnCredential_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -159,6 +192,43 @@ func (ec *executionContext) field_Mutation_generateReauthenticationOptions_args(
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_linkOAuthIdentity_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "provider", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["provider"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_loginWithEmailCode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "email", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["email"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "code", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["code"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "captchaToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["captchaToken"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "rememberMe", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["rememberMe"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_loginWithPasskey_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -172,6 +242,11 @@ func (ec *executionContext) field_Mutation_loginWithPasskey_args(ctx context.Con
 		return nil, err
 	}
 	args["captchaToken"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "rememberMe", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["rememberMe"] = arg2
 	return args, nil
 }
 
@@ -193,6 +268,48 @@ func (ec *executionContext) field_Mutation_loginWithPassword_args(ctx context.Co
 		return nil, err
 	}
 	args["captchaToken"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "rememberMe", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["rememberMe"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_loginWithSmsCode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "phoneNumber", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["phoneNumber"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "code", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["code"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "captchaToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["captchaToken"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "rememberMe", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["rememberMe"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_refreshBearerTokens_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "refreshToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["refreshToken"] = arg0
 	return args, nil
 }
 
@@ -263,6 +380,22 @@ func (ec *executionContext) field_Mutation_registerWithPassword_args(ctx context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_requestEmailLoginCode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "email", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["email"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "captchaToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["captchaToken"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_requestEmailVerificationToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -306,6 +439,22 @@ func (ec *executionContext) field_Mutation_requestPhoneNumberVerificationToken_a
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_requestSmsLoginCode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "phoneNumber", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["phoneNumber"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "captchaToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["captchaToken"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_requestSudoModeWithAuthenticator_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -375,6 +524,55 @@ func (ec *executionContext) field_Mutation_resetPassword_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_revokePersonalAccessToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "personalAccessTokenId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["personalAccessTokenId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_revokeSessions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "revocationToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["revocationToken"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unlinkOAuthIdentity_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "oauthIdentityId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["oauthIdentityId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unlockAccount_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "email", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["email"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "unlockToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["unlockToken"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_updateAccountAnalyticsPreference_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -558,6 +756,11 @@ func (ec *executionContext) field_Mutation_verifyGoogleToken_args(ctx context.Co
 		return nil, err
 	}
 	args["token"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "rememberMe", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["rememberMe"] = arg1
 	return args, nil
 }
 
@@ -714,8 +917,20 @@ func (ec *executionContext) _Mutation_updateAccount(ctx context.Context, field g
 				}
 				return ec.directives.IsAuthenticated(ctx, nil, directive0)
 			}
+			directive2 := func(ctx context.Context) (any, error) {
+				scope, err := ec.unmarshalNPersonalAccessTokenScope2serverᚋgraphᚋmodelᚐPersonalAccessTokenScope(ctx, "WRITE_ACCOUNT")
+				if err != nil {
+					var zeroVal model.UpdateAccountPayload
+					return zeroVal, err
+				}
+				if ec.directives.RequiresScope == nil {
+					var zeroVal model.UpdateAccountPayload
+					return zeroVal, errors.New("directive requiresScope is not implemented")
+				}
+				return ec.directives.RequiresScope(ctx, nil, directive1, scope)
+			}
 
-			next = directive1
+			next = directive2
 			return next
 		},
 		ec.marshalNUpdateAccountPayload2serverᚋgraphᚋmodelᚐUpdateAccountPayload,
@@ -768,8 +983,57 @@ func (ec *executionContext) _Mutation_requestPhoneNumberVerificationToken(ctx co
 				}
 				return ec.directives.IsAuthenticated(ctx, nil, directive0)
 			}
+			directive2 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 5)
+				if err != nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "1h")
+				if err != nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "ACCOUNT")
+				if err != nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive1, limit, window, key, nil)
+			}
+			directive3 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 3)
+				if err != nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "15m")
+				if err != nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "ARG")
+				if err != nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, err
+				}
+				arg, err := ec.unmarshalOString2ᚖstring(ctx, "phoneNumber")
+				if err != nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestPhoneNumberVerificationTokenPayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive2, limit, window, key, arg)
+			}
 
-			next = directive1
+			next = directive3
 			return next
 		},
 		ec.marshalNRequestPhoneNumberVerificationTokenPayload2serverᚋgraphᚋmodelᚐRequestPhoneNumberVerificationTokenPayload,
@@ -922,7 +1186,25 @@ func (ec *executionContext) _Mutation_updateAccountAnalyticsPreference(ctx conte
 			fc := graphql.GetFieldContext(ctx)
 			return ec.resolvers.Mutation().UpdateAccountAnalyticsPreference(ctx, fc.Args["analyticsPreference"].(model.AnalyticsPreferenceInputType))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				scope, err := ec.unmarshalNPersonalAccessTokenScope2serverᚋgraphᚋmodelᚐPersonalAccessTokenScope(ctx, "WRITE_ACCOUNT")
+				if err != nil {
+					var zeroVal *model.Account
+					return zeroVal, err
+				}
+				if ec.directives.RequiresScope == nil {
+					var zeroVal *model.Account
+					return zeroVal, errors.New("directive requiresScope is not implemented")
+				}
+				return ec.directives.RequiresScope(ctx, nil, directive0, scope)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalOAccount2ᚖserverᚋgraphᚋmodelᚐAccount,
 		true,
 		false,
@@ -967,6 +1249,10 @@ func (ec *executionContext) fieldContext_Mutation_updateAccountAnalyticsPreferen
 				return ec.fieldContext_Account_sessions(ctx, field)
 			case "webAuthnCredentials":
 				return ec.fieldContext_Account_webAuthnCredentials(ctx, field)
+			case "personalAccessTokens":
+				return ec.fieldContext_Account_personalAccessTokens(ctx, field)
+			case "oauthIdentities":
+				return ec.fieldContext_Account_oauthIdentities(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Account", field.Name)
 		},
@@ -1004,8 +1290,20 @@ func (ec *executionContext) _Mutation_removeAccountAvatar(ctx context.Context, f
 				}
 				return ec.directives.IsAuthenticated(ctx, nil, directive0)
 			}
+			directive2 := func(ctx context.Context) (any, error) {
+				scope, err := ec.unmarshalNPersonalAccessTokenScope2serverᚋgraphᚋmodelᚐPersonalAccessTokenScope(ctx, "WRITE_ACCOUNT")
+				if err != nil {
+					var zeroVal *model.Account
+					return zeroVal, err
+				}
+				if ec.directives.RequiresScope == nil {
+					var zeroVal *model.Account
+					return zeroVal, errors.New("directive requiresScope is not implemented")
+				}
+				return ec.directives.RequiresScope(ctx, nil, directive1, scope)
+			}
 
-			next = directive1
+			next = directive2
 			return next
 		},
 		ec.marshalNAccount2ᚖserverᚋgraphᚋmodelᚐAccount,
@@ -1052,6 +1350,10 @@ func (ec *executionContext) fieldContext_Mutation_removeAccountAvatar(_ context.
 				return ec.fieldContext_Account_sessions(ctx, field)
 			case "webAuthnCredentials":
 				return ec.fieldContext_Account_webAuthnCredentials(ctx, field)
+			case "personalAccessTokens":
+				return ec.fieldContext_Account_personalAccessTokens(ctx, field)
+			case "oauthIdentities":
+				return ec.fieldContext_Account_oauthIdentities(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Account", field.Name)
 		},
@@ -1069,7 +1371,62 @@ func (ec *executionContext) _Mutation_requestEmailVerificationToken(ctx context.
 			fc := graphql.GetFieldContext(ctx)
 			return ec.resolvers.Mutation().RequestEmailVerificationToken(ctx, fc.Args["email"].(string), fc.Args["captchaToken"].(string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 20)
+				if err != nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "1h")
+				if err != nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "IP")
+				if err != nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive0, limit, window, key, nil)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 3)
+				if err != nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "15m")
+				if err != nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "ARG")
+				if err != nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, err
+				}
+				arg, err := ec.unmarshalOString2ᚖstring(ctx, "email")
+				if err != nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestEmailVerificationTokenPayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive1, limit, window, key, arg)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalNRequestEmailVerificationTokenPayload2serverᚋgraphᚋmodelᚐRequestEmailVerificationTokenPayload,
 		true,
 		true,
@@ -1354,7 +1711,7 @@ func (ec *executionContext) _Mutation_loginWithPasskey(ctx context.Context, fiel
 		ec.fieldContext_Mutation_loginWithPasskey,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().LoginWithPasskey(ctx, fc.Args["authenticationResponse"].(string), fc.Args["captchaToken"].(string))
+			return ec.resolvers.Mutation().LoginWithPasskey(ctx, fc.Args["authenticationResponse"].(string), fc.Args["captchaToken"].(string), fc.Args["rememberMe"].(bool))
 		},
 		nil,
 		ec.marshalNLoginWithPasskeyPayload2serverᚋgraphᚋmodelᚐLoginWithPasskeyPayload,
@@ -1395,7 +1752,7 @@ func (ec *executionContext) _Mutation_loginWithPassword(ctx context.Context, fie
 		ec.fieldContext_Mutation_loginWithPassword,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().LoginWithPassword(ctx, fc.Args["login"].(string), fc.Args["password"].(string), fc.Args["captchaToken"].(string))
+			return ec.resolvers.Mutation().LoginWithPassword(ctx, fc.Args["login"].(string), fc.Args["password"].(string), fc.Args["captchaToken"].(string), fc.Args["rememberMe"].(bool))
 		},
 		nil,
 		ec.marshalNLoginWithPasswordPayload2serverᚋgraphᚋmodelᚐLoginWithPasswordPayload,
@@ -1428,55 +1785,329 @@ func (ec *executionContext) fieldContext_Mutation_loginWithPassword(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_requestEmailLoginCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_logout,
+		ec.fieldContext_Mutation_requestEmailLoginCode,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Mutation().Logout(ctx)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RequestEmailLoginCode(ctx, fc.Args["email"].(string), fc.Args["captchaToken"].(string))
 		},
 		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
 			directive0 := next
 
 			directive1 := func(ctx context.Context) (any, error) {
-				if ec.directives.IsAuthenticated == nil {
-					var zeroVal *model.LogoutPayload
-					return zeroVal, errors.New("directive isAuthenticated is not implemented")
+				limit, err := ec.unmarshalNInt2int32(ctx, 20)
+				if err != nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, err
 				}
-				return ec.directives.IsAuthenticated(ctx, nil, directive0)
+				window, err := ec.unmarshalNString2string(ctx, "1h")
+				if err != nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "IP")
+				if err != nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive0, limit, window, key, nil)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 3)
+				if err != nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "15m")
+				if err != nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "ARG")
+				if err != nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, err
+				}
+				arg, err := ec.unmarshalOString2ᚖstring(ctx, "email")
+				if err != nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestEmailLoginCodePayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive1, limit, window, key, arg)
 			}
 
-			next = directive1
+			next = directive2
 			return next
 		},
-		ec.marshalNLogoutPayload2ᚖserverᚋgraphᚋmodelᚐLogoutPayload,
+		ec.marshalNRequestEmailLoginCodePayload2serverᚋgraphᚋmodelᚐRequestEmailLoginCodePayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_logout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_requestEmailLoginCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "message":
-				return ec.fieldContext_LogoutPayload_message(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LogoutPayload", field.Name)
+			return nil, errors.New("field of type RequestEmailLoginCodePayload does not have child fields")
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Mutation_requestPasswordReset(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_requestEmailLoginCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_loginWithEmailCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_loginWithEmailCode,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().LoginWithEmailCode(ctx, fc.Args["email"].(string), fc.Args["code"].(string), fc.Args["captchaToken"].(string), fc.Args["rememberMe"].(bool))
+		},
+		nil,
+		ec.marshalNLoginWithEmailCodePayload2serverᚋgraphᚋmodelᚐLoginWithEmailCodePayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_loginWithEmailCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type LoginWithEmailCodePayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loginWithEmailCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_requestSmsLoginCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_requestSmsLoginCode,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RequestSmsLoginCode(ctx, fc.Args["phoneNumber"].(string), fc.Args["captchaToken"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 10)
+				if err != nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "1h")
+				if err != nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "IP")
+				if err != nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive0, limit, window, key, nil)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 3)
+				if err != nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "15m")
+				if err != nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "ARG")
+				if err != nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, err
+				}
+				arg, err := ec.unmarshalOString2ᚖstring(ctx, "phoneNumber")
+				if err != nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestSmsLoginCodePayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive1, limit, window, key, arg)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNRequestSmsLoginCodePayload2serverᚋgraphᚋmodelᚐRequestSmsLoginCodePayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_requestSmsLoginCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RequestSmsLoginCodePayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_requestSmsLoginCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_loginWithSmsCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_loginWithSmsCode,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().LoginWithSmsCode(ctx, fc.Args["phoneNumber"].(string), fc.Args["code"].(string), fc.Args["captchaToken"].(string), fc.Args["rememberMe"].(bool))
+		},
+		nil,
+		ec.marshalNLoginWithSmsCodePayload2serverᚋgraphᚋmodelᚐLoginWithSmsCodePayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_loginWithSmsCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type LoginWithSmsCodePayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loginWithSmsCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_logout,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Mutation().Logout(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.IsAuthenticated == nil {
+					var zeroVal *model.LogoutPayload
+					return zeroVal, errors.New("directive isAuthenticated is not implemented")
+				}
+				return ec.directives.IsAuthenticated(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNLogoutPayload2ᚖserverᚋgraphᚋmodelᚐLogoutPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_logout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "message":
+				return ec.fieldContext_LogoutPayload_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogoutPayload", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_requestPasswordReset(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
 		ec.OperationContext,
 		field,
 		ec.fieldContext_Mutation_requestPasswordReset,
@@ -1484,7 +2115,62 @@ func (ec *executionContext) _Mutation_requestPasswordReset(ctx context.Context,
 			fc := graphql.GetFieldContext(ctx)
 			return ec.resolvers.Mutation().RequestPasswordReset(ctx, fc.Args["email"].(string), fc.Args["captchaToken"].(string))
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 20)
+				if err != nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "1h")
+				if err != nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "IP")
+				if err != nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive0, limit, window, key, nil)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 3)
+				if err != nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "15m")
+				if err != nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "ARG")
+				if err != nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, err
+				}
+				arg, err := ec.unmarshalOString2ᚖstring(ctx, "email")
+				if err != nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RequestPasswordResetPayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive1, limit, window, key, arg)
+			}
+
+			next = directive2
+			return next
+		},
 		ec.marshalNRequestPasswordResetPayload2serverᚋgraphᚋmodelᚐRequestPasswordResetPayload,
 		true,
 		true,
@@ -1556,31 +2242,336 @@ func (ec *executionContext) fieldContext_Mutation_verify2faPasswordResetWithAuth
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_verify2faPasswordResetWithPasskey(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_verify2faPasswordResetWithPasskey,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().Verify2faPasswordResetWithPasskey(ctx, fc.Args["email"].(string), fc.Args["passwordResetToken"].(string), fc.Args["authenticationResponse"].(string), fc.Args["captchaToken"].(string))
+func (ec *executionContext) _Mutation_verify2faPasswordResetWithPasskey(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_verify2faPasswordResetWithPasskey,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Verify2faPasswordResetWithPasskey(ctx, fc.Args["email"].(string), fc.Args["passwordResetToken"].(string), fc.Args["authenticationResponse"].(string), fc.Args["captchaToken"].(string))
+		},
+		nil,
+		ec.marshalNVerify2FAPasswordResetWithPasskeyPayload2serverᚋgraphᚋmodelᚐVerify2FAPasswordResetWithPasskeyPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_verify2faPasswordResetWithPasskey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Verify2FAPasswordResetWithPasskeyPayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_verify2faPasswordResetWithPasskey_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_resetPassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_resetPassword,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ResetPassword(ctx, fc.Args["email"].(string), fc.Args["passwordResetToken"].(string), fc.Args["newPassword"].(string))
+		},
+		nil,
+		ec.marshalNResetPasswordPayload2serverᚋgraphᚋmodelᚐResetPasswordPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_resetPassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ResetPasswordPayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_resetPassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_unlockAccount(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_unlockAccount,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UnlockAccount(ctx, fc.Args["email"].(string), fc.Args["unlockToken"].(string))
+		},
+		nil,
+		ec.marshalNUnlockAccountPayload2serverᚋgraphᚋmodelᚐUnlockAccountPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_unlockAccount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UnlockAccountPayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_unlockAccount_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_revokeSessions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_revokeSessions,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RevokeSessions(ctx, fc.Args["revocationToken"].(string))
+		},
+		nil,
+		ec.marshalNRevokeSessionsPayload2serverᚋgraphᚋmodelᚐRevokeSessionsPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_revokeSessions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RevokeSessionsPayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_revokeSessions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createBearerTokens(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createBearerTokens,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Mutation().CreateBearerTokens(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.IsAuthenticated == nil {
+					var zeroVal *model.BearerTokens
+					return zeroVal, errors.New("directive isAuthenticated is not implemented")
+				}
+				return ec.directives.IsAuthenticated(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNBearerTokens2ᚖserverᚋgraphᚋmodelᚐBearerTokens,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createBearerTokens(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accessToken":
+				return ec.fieldContext_BearerTokens_accessToken(ctx, field)
+			case "accessTokenExpiresAt":
+				return ec.fieldContext_BearerTokens_accessTokenExpiresAt(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_BearerTokens_refreshToken(ctx, field)
+			case "refreshTokenExpiresAt":
+				return ec.fieldContext_BearerTokens_refreshTokenExpiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BearerTokens", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_refreshBearerTokens(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_refreshBearerTokens,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RefreshBearerTokens(ctx, fc.Args["refreshToken"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				limit, err := ec.unmarshalNInt2int32(ctx, 60)
+				if err != nil {
+					var zeroVal model.RefreshBearerTokensPayload
+					return zeroVal, err
+				}
+				window, err := ec.unmarshalNString2string(ctx, "1h")
+				if err != nil {
+					var zeroVal model.RefreshBearerTokensPayload
+					return zeroVal, err
+				}
+				key, err := ec.unmarshalNRateLimitKey2serverᚋgraphᚋmodelᚐRateLimitKey(ctx, "IP")
+				if err != nil {
+					var zeroVal model.RefreshBearerTokensPayload
+					return zeroVal, err
+				}
+				if ec.directives.RateLimit == nil {
+					var zeroVal model.RefreshBearerTokensPayload
+					return zeroVal, errors.New("directive rateLimit is not implemented")
+				}
+				return ec.directives.RateLimit(ctx, nil, directive0, limit, window, key, nil)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNRefreshBearerTokensPayload2serverᚋgraphᚋmodelᚐRefreshBearerTokensPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_refreshBearerTokens(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RefreshBearerTokensPayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_refreshBearerTokens_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createPersonalAccessToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createPersonalAccessToken,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreatePersonalAccessToken(ctx, fc.Args["name"].(string), fc.Args["scopes"].([]model.PersonalAccessTokenScope), fc.Args["expiresInDays"].(*int32))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.IsAuthenticated == nil {
+					var zeroVal model.CreatePersonalAccessTokenPayload
+					return zeroVal, errors.New("directive isAuthenticated is not implemented")
+				}
+				return ec.directives.IsAuthenticated(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				if ec.directives.RequiresSudoMode == nil {
+					var zeroVal model.CreatePersonalAccessTokenPayload
+					return zeroVal, errors.New("directive requiresSudoMode is not implemented")
+				}
+				return ec.directives.RequiresSudoMode(ctx, nil, directive1)
+			}
+
+			next = directive2
+			return next
 		},
-		nil,
-		ec.marshalNVerify2FAPasswordResetWithPasskeyPayload2serverᚋgraphᚋmodelᚐVerify2FAPasswordResetWithPasskeyPayload,
+		ec.marshalNCreatePersonalAccessTokenPayload2serverᚋgraphᚋmodelᚐCreatePersonalAccessTokenPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_verify2faPasswordResetWithPasskey(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createPersonalAccessToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Verify2FAPasswordResetWithPasskeyPayload does not have child fields")
+			return nil, errors.New("field of type CreatePersonalAccessTokenPayload does not have child fields")
 		},
 	}
 	defer func() {
@@ -1590,38 +2581,51 @@ func (ec *executionContext) fieldContext_Mutation_verify2faPasswordResetWithPass
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_verify2faPasswordResetWithPasskey_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_createPersonalAccessToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_resetPassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_revokePersonalAccessToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_resetPassword,
+		ec.fieldContext_Mutation_revokePersonalAccessToken,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ResetPassword(ctx, fc.Args["email"].(string), fc.Args["passwordResetToken"].(string), fc.Args["newPassword"].(string))
+			return ec.resolvers.Mutation().RevokePersonalAccessToken(ctx, fc.Args["personalAccessTokenId"].(string))
 		},
-		nil,
-		ec.marshalNResetPasswordPayload2serverᚋgraphᚋmodelᚐResetPasswordPayload,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.IsAuthenticated == nil {
+					var zeroVal model.RevokePersonalAccessTokenPayload
+					return zeroVal, errors.New("directive isAuthenticated is not implemented")
+				}
+				return ec.directives.IsAuthenticated(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNRevokePersonalAccessTokenPayload2serverᚋgraphᚋmodelᚐRevokePersonalAccessTokenPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_resetPassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_revokePersonalAccessToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ResetPasswordPayload does not have child fields")
+			return nil, errors.New("field of type RevokePersonalAccessTokenPayload does not have child fields")
 		},
 	}
 	defer func() {
@@ -1631,7 +2635,7 @@ func (ec *executionContext) fieldContext_Mutation_resetPassword(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_resetPassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_revokePersonalAccessToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -1977,6 +2981,128 @@ func (ec *executionContext) fieldContext_Mutation_updateWebAuthnCredential(ctx c
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_linkOAuthIdentity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_linkOAuthIdentity,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().LinkOAuthIdentity(ctx, fc.Args["provider"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.IsAuthenticated == nil {
+					var zeroVal model.LinkOAuthIdentityPayload
+					return zeroVal, errors.New("directive isAuthenticated is not implemented")
+				}
+				return ec.directives.IsAuthenticated(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				if ec.directives.RequiresSudoMode == nil {
+					var zeroVal model.LinkOAuthIdentityPayload
+					return zeroVal, errors.New("directive requiresSudoMode is not implemented")
+				}
+				return ec.directives.RequiresSudoMode(ctx, nil, directive1)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNLinkOAuthIdentityPayload2serverᚋgraphᚋmodelᚐLinkOAuthIdentityPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_linkOAuthIdentity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type LinkOAuthIdentityPayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_linkOAuthIdentity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_unlinkOAuthIdentity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_unlinkOAuthIdentity,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UnlinkOAuthIdentity(ctx, fc.Args["oauthIdentityId"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.directives.IsAuthenticated == nil {
+					var zeroVal model.UnlinkOAuthIdentityPayload
+					return zeroVal, errors.New("directive isAuthenticated is not implemented")
+				}
+				return ec.directives.IsAuthenticated(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				if ec.directives.RequiresSudoMode == nil {
+					var zeroVal model.UnlinkOAuthIdentityPayload
+					return zeroVal, errors.New("directive requiresSudoMode is not implemented")
+				}
+				return ec.directives.RequiresSudoMode(ctx, nil, directive1)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNUnlinkOAuthIdentityPayload2serverᚋgraphᚋmodelᚐUnlinkOAuthIdentityPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_unlinkOAuthIdentity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UnlinkOAuthIdentityPayload does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_unlinkOAuthIdentity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_generateWebAuthnCredentialCreationOptions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -2547,7 +3673,7 @@ func (ec *executionContext) _Mutation_verifyGoogleToken(ctx context.Context, fie
 		ec.fieldContext_Mutation_verifyGoogleToken,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().VerifyGoogleToken(ctx, fc.Args["token"].(string))
+			return ec.resolvers.Mutation().VerifyGoogleToken(ctx, fc.Args["token"].(string), fc.Args["rememberMe"].(bool))
 		},
 		nil,
 		ec.marshalNVerifyGoogleTokenPayload2serverᚋgraphᚋmodelᚐVerifyGoogleTokenPayload,
@@ -2775,7 +3901,25 @@ func (ec *executionContext) _Query_viewer(ctx context.Context, field graphql.Col
 		func(ctx context.Context) (any, error) {
 			return ec.resolvers.Query().Viewer(ctx)
 		},
-		nil,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				scope, err := ec.unmarshalNPersonalAccessTokenScope2serverᚋgraphᚋmodelᚐPersonalAccessTokenScope(ctx, "READ_ACCOUNT")
+				if err != nil {
+					var zeroVal model.ViewerPayload
+					return zeroVal, err
+				}
+				if ec.directives.RequiresScope == nil {
+					var zeroVal model.ViewerPayload
+					return zeroVal, errors.New("directive requiresScope is not implemented")
+				}
+				return ec.directives.RequiresScope(ctx, nil, directive0, scope)
+			}
+
+			next = directive1
+			return next
+		},
 		ec.marshalNViewerPayload2serverᚋgraphᚋmodelᚐViewerPayload,
 		true,
 		true,
@@ -2991,6 +4135,13 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._TwoFactorAuthenticationChallengeNotFoundError(ctx, sel, obj)
+	case model.TooManyAttemptsError:
+		return ec._TooManyAttemptsError(ctx, sel, &obj)
+	case *model.TooManyAttemptsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TooManyAttemptsError(ctx, sel, obj)
 	case model.SessionNotFoundError:
 		return ec._SessionNotFoundError(ctx, sel, &obj)
 	case *model.SessionNotFoundError:
@@ -3033,6 +4184,13 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._PhoneNumberAlreadyExistsError(ctx, sel, obj)
+	case model.PersonalAccessTokenNotFoundError:
+		return ec._PersonalAccessTokenNotFoundError(ctx, sel, &obj)
+	case *model.PersonalAccessTokenNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PersonalAccessTokenNotFoundError(ctx, sel, obj)
 	case model.PasswordResetTokenNotFoundError:
 		return ec._PasswordResetTokenNotFoundError(ctx, sel, &obj)
 	case *model.PasswordResetTokenNotFoundError:
@@ -3047,6 +4205,13 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._PasswordResetTokenCooldownError(ctx, sel, obj)
+	case model.PasswordPreviouslyUsedError:
+		return ec._PasswordPreviouslyUsedError(ctx, sel, &obj)
+	case *model.PasswordPreviouslyUsedError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PasswordPreviouslyUsedError(ctx, sel, obj)
 	case model.PasswordNotStrongError:
 		return ec._PasswordNotStrongError(ctx, sel, &obj)
 	case *model.PasswordNotStrongError:
@@ -3054,6 +4219,20 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._PasswordNotStrongError(ctx, sel, obj)
+	case model.OAuthProviderNotSupportedError:
+		return ec._OAuthProviderNotSupportedError(ctx, sel, &obj)
+	case *model.OAuthProviderNotSupportedError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OAuthProviderNotSupportedError(ctx, sel, obj)
+	case model.OAuthIdentityNotFoundError:
+		return ec._OAuthIdentityNotFoundError(ctx, sel, &obj)
+	case *model.OAuthIdentityNotFoundError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OAuthIdentityNotFoundError(ctx, sel, obj)
 	case model.NotAuthenticatedError:
 		return ec._NotAuthenticatedError(ctx, sel, &obj)
 	case *model.NotAuthenticatedError:
@@ -3061,6 +4240,27 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._NotAuthenticatedError(ctx, sel, obj)
+	case model.InvalidSmsLoginCodeError:
+		return ec._InvalidSmsLoginCodeError(ctx, sel, &obj)
+	case *model.InvalidSmsLoginCodeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidSmsLoginCodeError(ctx, sel, obj)
+	case model.InvalidSessionRevocationTokenError:
+		return ec._InvalidSessionRevocationTokenError(ctx, sel, &obj)
+	case *model.InvalidSessionRevocationTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidSessionRevocationTokenError(ctx, sel, obj)
+	case model.InvalidRefreshTokenError:
+		return ec._InvalidRefreshTokenError(ctx, sel, &obj)
+	case *model.InvalidRefreshTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRefreshTokenError(ctx, sel, obj)
 	case model.InvalidPhoneNumberVerificationTokenError:
 		return ec._InvalidPhoneNumberVerificationTokenError(ctx, sel, &obj)
 	case *model.InvalidPhoneNumberVerificationTokenError:
@@ -3075,6 +4275,13 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._InvalidPhoneNumberError(ctx, sel, obj)
+	case model.InvalidPersonalAccessTokenError:
+		return ec._InvalidPersonalAccessTokenError(ctx, sel, &obj)
+	case *model.InvalidPersonalAccessTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidPersonalAccessTokenError(ctx, sel, obj)
 	case model.InvalidPasswordResetTokenError:
 		return ec._InvalidPasswordResetTokenError(ctx, sel, &obj)
 	case *model.InvalidPasswordResetTokenError:
@@ -3103,6 +4310,13 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._InvalidEmailVerificationTokenError(ctx, sel, obj)
+	case model.InvalidEmailLoginCodeError:
+		return ec._InvalidEmailLoginCodeError(ctx, sel, &obj)
+	case *model.InvalidEmailLoginCodeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidEmailLoginCodeError(ctx, sel, obj)
 	case model.InvalidEmailError:
 		return ec._InvalidEmailError(ctx, sel, &obj)
 	case *model.InvalidEmailError:
@@ -3131,6 +4345,13 @@ func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, ob
 			return graphql.Null
 		}
 		return ec._InvalidAuthenticationProviderError(ctx, sel, obj)
+	case model.InvalidAccountUnlockTokenError:
+		return ec._InvalidAccountUnlockTokenError(ctx, sel, &obj)
+	case *model.InvalidAccountUnlockTokenError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidAccountUnlockTokenError(ctx, sel, obj)
 	case model.InsufficientAuthProvidersError:
 		return ec._InsufficientAuthProvidersError(ctx, sel, &obj)
 	case *model.InsufficientAuthProvidersError:
@@ -3182,6 +4403,13 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 			return graphql.Null
 		}
 		return ec._Session(ctx, sel, obj)
+	case model.PersonalAccessToken:
+		return ec._PersonalAccessToken(ctx, sel, &obj)
+	case *model.PersonalAccessToken:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PersonalAccessToken(ctx, sel, obj)
 	case model.PasswordResetToken:
 		return ec._PasswordResetToken(ctx, sel, &obj)
 	case *model.PasswordResetToken:
@@ -3189,6 +4417,13 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 			return graphql.Null
 		}
 		return ec._PasswordResetToken(ctx, sel, obj)
+	case model.OAuthIdentity:
+		return ec._OAuthIdentity(ctx, sel, &obj)
+	case *model.OAuthIdentity:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OAuthIdentity(ctx, sel, obj)
 	case model.Account:
 		return ec._Account(ctx, sel, &obj)
 	case *model.Account:
@@ -3244,7 +4479,7 @@ func (ec *executionContext) _CreatePresignedURLPayloadType(ctx context.Context,
 	return out
 }
 
-var invalidCaptchaTokenErrorImplementors = []string{"InvalidCaptchaTokenError", "GenerateAuthenticationOptionsPayload", "GeneratePasskeyRegistrationOptionsPayload", "LoginWithPasskeyPayload", "LoginWithPasswordPayload", "RequestEmailVerificationTokenPayload", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAPasswordResetWithPasskeyPayload", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "VerifyEmailPayload", "RequestPasswordResetPayload", "RequestSudoModeWithAuthenticatorPayload", "RequestSudoModeWithPasskeyPayload", "RequestSudoModeWithPasswordPayload", "RegisterWithPasskeyPayload", "RegisterWithPasswordPayload", "Error"}
+var invalidCaptchaTokenErrorImplementors = []string{"InvalidCaptchaTokenError", "GenerateAuthenticationOptionsPayload", "GeneratePasskeyRegistrationOptionsPayload", "LoginWithPasskeyPayload", "LoginWithEmailCodePayload", "LoginWithSmsCodePayload", "LoginWithPasswordPayload", "RequestEmailVerificationTokenPayload", "Verify2FAPasswordResetWithAuthenticatorPayload", "Verify2FAPasswordResetWithPasskeyPayload", "Verify2FAWithAuthenticatorPayload", "Verify2FAWithRecoveryCodePayload", "VerifyEmailPayload", "RequestEmailLoginCodePayload", "RequestSmsLoginCodePayload", "RequestPasswordResetPayload", "RequestSudoModeWithAuthenticatorPayload", "RequestSudoModeWithPasskeyPayload", "RequestSudoModeWithPasswordPayload", "RegisterWithPasskeyPayload", "RegisterWithPasswordPayload", "Error"}
 
 func (ec *executionContext) _InvalidCaptchaTokenError(ctx context.Context, sel ast.SelectionSet, obj *model.InvalidCaptchaTokenError) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, invalidCaptchaTokenErrorImplementors)
@@ -3408,6 +4643,34 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "requestEmailLoginCode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_requestEmailLoginCode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "loginWithEmailCode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_loginWithEmailCode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestSmsLoginCode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_requestSmsLoginCode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "loginWithSmsCode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_loginWithSmsCode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "logout":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_logout(ctx, field)
@@ -3443,6 +4706,48 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "unlockAccount":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_unlockAccount(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "revokeSessions":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_revokeSessions(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createBearerTokens":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createBearerTokens(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refreshBearerTokens":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_refreshBearerTokens(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createPersonalAccessToken":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createPersonalAccessToken(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "revokePersonalAccessToken":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_revokePersonalAccessToken(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "updatePassword":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_updatePassword(ctx, field)
@@ -3485,6 +4790,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "linkOAuthIdentity":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_linkOAuthIdentity(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unlinkOAuthIdentity":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_unlinkOAuthIdentity(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "generateWebAuthnCredentialCreationOptions":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_generateWebAuthnCredentialCreationOptions(ctx, field)
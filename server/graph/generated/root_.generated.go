@@ -33,32 +33,38 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Account() AccountResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Session() SessionResolver
 }
 
 type DirectiveRoot struct {
 	IsAuthenticated  func(ctx context.Context, obj any, next graphql.Resolver) (res any, err error)
+	RateLimit        func(ctx context.Context, obj any, next graphql.Resolver, limit int32, window string, key model.RateLimitKey, arg *string) (res any, err error)
+	RequiresScope    func(ctx context.Context, obj any, next graphql.Resolver, scope model.PersonalAccessTokenScope) (res any, err error)
 	RequiresSudoMode func(ctx context.Context, obj any, next graphql.Resolver) (res any, err error)
 }
 
 type ComplexityRoot struct {
 	Account struct {
-		AnalyticsPreference func(childComplexity int) int
-		AuthProviders       func(childComplexity int) int
-		AvatarURL           func(childComplexity int) int
-		CurrentSession      func(childComplexity int) int
-		Email               func(childComplexity int) int
-		FullName            func(childComplexity int) int
-		Has2faEnabled       func(childComplexity int) int
-		ID                  func(childComplexity int) int
-		PhoneNumber         func(childComplexity int) int
-		Sessions            func(childComplexity int, before *string, after *string, first *int32, last *int32) int
-		SudoModeExpiresAt   func(childComplexity int) int
-		TermsAndPolicy      func(childComplexity int) int
-		TwoFactorProviders  func(childComplexity int) int
-		UpdatedAt           func(childComplexity int) int
-		WebAuthnCredentials func(childComplexity int, before *string, after *string, first *int32, last *int32) int
+		AnalyticsPreference  func(childComplexity int) int
+		AuthProviders        func(childComplexity int) int
+		AvatarURL            func(childComplexity int) int
+		CurrentSession       func(childComplexity int) int
+		Email                func(childComplexity int) int
+		FullName             func(childComplexity int) int
+		Has2faEnabled        func(childComplexity int) int
+		ID                   func(childComplexity int) int
+		OauthIdentities      func(childComplexity int, before *string, after *string, first *int32, last *int32) int
+		PersonalAccessTokens func(childComplexity int, before *string, after *string, first *int32, last *int32) int
+		PhoneNumber          func(childComplexity int) int
+		Sessions             func(childComplexity int, before *string, after *string, first *int32, last *int32) int
+		SudoModeExpiresAt    func(childComplexity int) int
+		TermsAndPolicy       func(childComplexity int) int
+		TwoFactorProviders   func(childComplexity int) int
+		UpdatedAt            func(childComplexity int) int
+		WebAuthnCredentials  func(childComplexity int, before *string, after *string, first *int32, last *int32) int
 	}
 
 	AnalyticsPreference struct {
@@ -70,6 +76,18 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	BearerTokens struct {
+		AccessToken           func(childComplexity int) int
+		AccessTokenExpiresAt  func(childComplexity int) int
+		RefreshToken          func(childComplexity int) int
+		RefreshTokenExpiresAt func(childComplexity int) int
+	}
+
+	CreatePersonalAccessTokenSuccess struct {
+		PersonalAccessTokenEdge func(childComplexity int) int
+		Token                   func(childComplexity int) int
+	}
+
 	CreatePresignedURLPayloadType struct {
 		PresignedURL func(childComplexity int) int
 	}
@@ -129,6 +147,10 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	InvalidAccountUnlockTokenError struct {
+		Message func(childComplexity int) int
+	}
+
 	InvalidAuthenticationProviderError struct {
 		AvailableProviders func(childComplexity int) int
 		Message            func(childComplexity int) int
@@ -146,6 +168,10 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	InvalidEmailLoginCodeError struct {
+		Message func(childComplexity int) int
+	}
+
 	InvalidEmailVerificationTokenError struct {
 		Message func(childComplexity int) int
 	}
@@ -162,6 +188,10 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	InvalidPersonalAccessTokenError struct {
+		Message func(childComplexity int) int
+	}
+
 	InvalidPhoneNumberError struct {
 		Message func(childComplexity int) int
 	}
@@ -170,11 +200,29 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	InvalidRefreshTokenError struct {
+		Message func(childComplexity int) int
+	}
+
+	InvalidSessionRevocationTokenError struct {
+		Message func(childComplexity int) int
+	}
+
+	InvalidSmsLoginCodeError struct {
+		Message func(childComplexity int) int
+	}
+
+	LinkOAuthIdentitySuccess struct {
+		AuthorizationURL func(childComplexity int) int
+	}
+
 	LogoutPayload struct {
 		Message func(childComplexity int) int
 	}
 
 	Mutation struct {
+		CreateBearerTokens                        func(childComplexity int) int
+		CreatePersonalAccessToken                 func(childComplexity int, name string, scopes []model.PersonalAccessTokenScope, expiresInDays *int32) int
 		CreateWebAuthnCredential                  func(childComplexity int, passkeyRegistrationResponse string, nickname string) int
 		DeleteOtherSessions                       func(childComplexity int) int
 		DeletePassword                            func(childComplexity int) int
@@ -189,20 +237,30 @@ type ComplexityRoot struct {
 		GeneratePasskeyRegistrationOptions        func(childComplexity int, email string, fullName string, captchaToken string) int
 		GenerateReauthenticationOptions           func(childComplexity int, captchaToken string) int
 		GenerateWebAuthnCredentialCreationOptions func(childComplexity int) int
-		LoginWithPasskey                          func(childComplexity int, authenticationResponse string, captchaToken string) int
-		LoginWithPassword                         func(childComplexity int, login string, password string, captchaToken string) int
+		LinkOAuthIdentity                         func(childComplexity int, provider string) int
+		LoginWithEmailCode                        func(childComplexity int, email string, code string, captchaToken string, rememberMe bool) int
+		LoginWithPasskey                          func(childComplexity int, authenticationResponse string, captchaToken string, rememberMe bool) int
+		LoginWithPassword                         func(childComplexity int, login string, password string, captchaToken string, rememberMe bool) int
+		LoginWithSmsCode                          func(childComplexity int, phoneNumber string, code string, captchaToken string, rememberMe bool) int
 		Logout                                    func(childComplexity int) int
+		RefreshBearerTokens                       func(childComplexity int, refreshToken string) int
 		RegisterWithPasskey                       func(childComplexity int, email string, emailVerificationToken string, passkeyRegistrationResponse string, passkeyNickname string, fullName string, captchaToken string) int
 		RegisterWithPassword                      func(childComplexity int, email string, emailVerificationToken string, password string, fullName string, captchaToken string) int
 		RemoveAccountAvatar                       func(childComplexity int) int
 		RemoveAccountPhoneNumber                  func(childComplexity int) int
+		RequestEmailLoginCode                     func(childComplexity int, email string, captchaToken string) int
 		RequestEmailVerificationToken             func(childComplexity int, email string, captchaToken string) int
 		RequestPasswordReset                      func(childComplexity int, email string, captchaToken string) int
 		RequestPhoneNumberVerificationToken       func(childComplexity int, phoneNumber string) int
+		RequestSmsLoginCode                       func(childComplexity int, phoneNumber string, captchaToken string) int
 		RequestSudoModeWithAuthenticator          func(childComplexity int, twoFactorToken string, captchaToken string) int
 		RequestSudoModeWithPasskey                func(childComplexity int, authenticationResponse string, captchaToken string) int
 		RequestSudoModeWithPassword               func(childComplexity int, password string, captchaToken string) int
 		ResetPassword                             func(childComplexity int, email string, passwordResetToken string, newPassword string) int
+		RevokePersonalAccessToken                 func(childComplexity int, personalAccessTokenID string) int
+		RevokeSessions                            func(childComplexity int, revocationToken string) int
+		UnlinkOAuthIdentity                       func(childComplexity int, oauthIdentityID string) int
+		UnlockAccount                             func(childComplexity int, email string, unlockToken string) int
 		UpdateAccount                             func(childComplexity int, fullName string, avatarURL *string) int
 		UpdateAccountAnalyticsPreference          func(childComplexity int, analyticsPreference model.AnalyticsPreferenceInputType) int
 		UpdateAccountPhoneNumber                  func(childComplexity int, phoneNumber string, phoneNumberVerificationToken string) int
@@ -213,13 +271,38 @@ type ComplexityRoot struct {
 		Verify2faWithAuthenticator                func(childComplexity int, token string, captchaToken string) int
 		Verify2faWithRecoveryCode                 func(childComplexity int, token string, captchaToken string) int
 		VerifyEmail                               func(childComplexity int, email string, emailVerificationToken string, captchaToken string) int
-		VerifyGoogleToken                         func(childComplexity int, token string) int
+		VerifyGoogleToken                         func(childComplexity int, token string, rememberMe bool) int
 	}
 
 	NotAuthenticatedError struct {
 		Message func(childComplexity int) int
 	}
 
+	OAuthIdentity struct {
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Provider  func(childComplexity int) int
+	}
+
+	OAuthIdentityConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	OAuthIdentityEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	OAuthIdentityNotFoundError struct {
+		Message func(childComplexity int) int
+	}
+
+	OAuthProviderNotSupportedError struct {
+		Message func(childComplexity int) int
+	}
+
 	PageInfo struct {
 		EndCursor       func(childComplexity int) int
 		HasNextPage     func(childComplexity int) int
@@ -231,6 +314,10 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	PasswordPreviouslyUsedError struct {
+		Message func(childComplexity int) int
+	}
+
 	PasswordResetToken struct {
 		AuthProviders      func(childComplexity int) int
 		Email              func(childComplexity int) int
@@ -248,6 +335,30 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	PersonalAccessToken struct {
+		CreatedAt  func(childComplexity int) int
+		ExpiresAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		LastUsedAt func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Scopes     func(childComplexity int) int
+	}
+
+	PersonalAccessTokenConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PersonalAccessTokenEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	PersonalAccessTokenNotFoundError struct {
+		Message func(childComplexity int) int
+	}
+
 	PhoneNumberAlreadyExistsError struct {
 		Message func(childComplexity int) int
 	}
@@ -271,6 +382,10 @@ type ComplexityRoot struct {
 		Viewer             func(childComplexity int) int
 	}
 
+	RequestEmailLoginCodeSuccess struct {
+		Message func(childComplexity int) int
+	}
+
 	RequestEmailVerificationSuccess struct {
 		Message          func(childComplexity int) int
 		RemainingSeconds func(childComplexity int) int
@@ -285,11 +400,27 @@ type ComplexityRoot struct {
 		Message                  func(childComplexity int) int
 	}
 
+	RequestSmsLoginCodeSuccess struct {
+		Message func(childComplexity int) int
+	}
+
+	RevokePersonalAccessTokenSuccess struct {
+		PersonalAccessTokenEdge func(childComplexity int) int
+	}
+
+	RevokeSessionsSuccess struct {
+		Message func(childComplexity int) int
+	}
+
 	Session struct {
-		CreatedAt func(childComplexity int) int
-		ID        func(childComplexity int) int
-		IPAddress func(childComplexity int) int
-		UserAgent func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		Device       func(childComplexity int) int
+		ID           func(childComplexity int) int
+		IPAddress    func(childComplexity int) int
+		IsCurrent    func(childComplexity int) int
+		LastActiveAt func(childComplexity int) int
+		Location     func(childComplexity int) int
+		UserAgent    func(childComplexity int) int
 	}
 
 	SessionConnection struct {
@@ -298,11 +429,23 @@ type ComplexityRoot struct {
 		TotalCount func(childComplexity int) int
 	}
 
+	SessionDevice struct {
+		Browser func(childComplexity int) int
+		Os      func(childComplexity int) int
+		Type    func(childComplexity int) int
+	}
+
 	SessionEdge struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
 
+	SessionLocation struct {
+		City        func(childComplexity int) int
+		Country     func(childComplexity int) int
+		CountryCode func(childComplexity int) int
+	}
+
 	SessionNotFoundError struct {
 		Message func(childComplexity int) int
 	}
@@ -313,6 +456,11 @@ type ComplexityRoot struct {
 		UpdatedAt func(childComplexity int) int
 	}
 
+	TooManyAttemptsError struct {
+		Message           func(childComplexity int) int
+		RetryAfterSeconds func(childComplexity int) int
+	}
+
 	TwoFactorAuthenticationChallengeNotFoundError struct {
 		Message func(childComplexity int) int
 	}
@@ -325,6 +473,14 @@ type ComplexityRoot struct {
 		Message func(childComplexity int) int
 	}
 
+	UnlinkOAuthIdentitySuccess struct {
+		OauthIdentityEdge func(childComplexity int) int
+	}
+
+	UnlockAccountSuccess struct {
+		Message func(childComplexity int) int
+	}
+
 	VerifyEmailSuccess struct {
 		Message func(childComplexity int) int
 	}
@@ -431,6 +587,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Account.ID(childComplexity), true
 
+	case "Account.oauthIdentities":
+		if e.complexity.Account.OauthIdentities == nil {
+			break
+		}
+
+		args, err := ec.field_Account_oauthIdentities_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Account.OauthIdentities(childComplexity, args["before"].(*string), args["after"].(*string), args["first"].(*int32), args["last"].(*int32)), true
+
+	case "Account.personalAccessTokens":
+		if e.complexity.Account.PersonalAccessTokens == nil {
+			break
+		}
+
+		args, err := ec.field_Account_personalAccessTokens_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Account.PersonalAccessTokens(childComplexity, args["before"].(*string), args["after"].(*string), args["first"].(*int32), args["last"].(*int32)), true
+
 	case "Account.phoneNumber":
 		if e.complexity.Account.PhoneNumber == nil {
 			break
@@ -511,6 +691,48 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.AuthenticatorNotEnabledError.Message(childComplexity), true
 
+	case "BearerTokens.accessToken":
+		if e.complexity.BearerTokens.AccessToken == nil {
+			break
+		}
+
+		return e.complexity.BearerTokens.AccessToken(childComplexity), true
+
+	case "BearerTokens.accessTokenExpiresAt":
+		if e.complexity.BearerTokens.AccessTokenExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.BearerTokens.AccessTokenExpiresAt(childComplexity), true
+
+	case "BearerTokens.refreshToken":
+		if e.complexity.BearerTokens.RefreshToken == nil {
+			break
+		}
+
+		return e.complexity.BearerTokens.RefreshToken(childComplexity), true
+
+	case "BearerTokens.refreshTokenExpiresAt":
+		if e.complexity.BearerTokens.RefreshTokenExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.BearerTokens.RefreshTokenExpiresAt(childComplexity), true
+
+	case "CreatePersonalAccessTokenSuccess.personalAccessTokenEdge":
+		if e.complexity.CreatePersonalAccessTokenSuccess.PersonalAccessTokenEdge == nil {
+			break
+		}
+
+		return e.complexity.CreatePersonalAccessTokenSuccess.PersonalAccessTokenEdge(childComplexity), true
+
+	case "CreatePersonalAccessTokenSuccess.token":
+		if e.complexity.CreatePersonalAccessTokenSuccess.Token == nil {
+			break
+		}
+
+		return e.complexity.CreatePersonalAccessTokenSuccess.Token(childComplexity), true
+
 	case "CreatePresignedURLPayloadType.presignedUrl":
 		if e.complexity.CreatePresignedURLPayloadType.PresignedURL == nil {
 			break
@@ -630,6 +852,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.InsufficientAuthProvidersError.Message(childComplexity), true
 
+	case "InvalidAccountUnlockTokenError.message":
+		if e.complexity.InvalidAccountUnlockTokenError.Message == nil {
+			break
+		}
+
+		return e.complexity.InvalidAccountUnlockTokenError.Message(childComplexity), true
+
 	case "InvalidAuthenticationProviderError.availableProviders":
 		if e.complexity.InvalidAuthenticationProviderError.AvailableProviders == nil {
 			break
@@ -665,6 +894,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.InvalidEmailError.Message(childComplexity), true
 
+	case "InvalidEmailLoginCodeError.message":
+		if e.complexity.InvalidEmailLoginCodeError.Message == nil {
+			break
+		}
+
+		return e.complexity.InvalidEmailLoginCodeError.Message(childComplexity), true
+
 	case "InvalidEmailVerificationTokenError.message":
 		if e.complexity.InvalidEmailVerificationTokenError.Message == nil {
 			break
@@ -693,6 +929,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.InvalidPasswordResetTokenError.Message(childComplexity), true
 
+	case "InvalidPersonalAccessTokenError.message":
+		if e.complexity.InvalidPersonalAccessTokenError.Message == nil {
+			break
+		}
+
+		return e.complexity.InvalidPersonalAccessTokenError.Message(childComplexity), true
+
 	case "InvalidPhoneNumberError.message":
 		if e.complexity.InvalidPhoneNumberError.Message == nil {
 			break
@@ -707,6 +950,34 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.InvalidPhoneNumberVerificationTokenError.Message(childComplexity), true
 
+	case "InvalidRefreshTokenError.message":
+		if e.complexity.InvalidRefreshTokenError.Message == nil {
+			break
+		}
+
+		return e.complexity.InvalidRefreshTokenError.Message(childComplexity), true
+
+	case "InvalidSessionRevocationTokenError.message":
+		if e.complexity.InvalidSessionRevocationTokenError.Message == nil {
+			break
+		}
+
+		return e.complexity.InvalidSessionRevocationTokenError.Message(childComplexity), true
+
+	case "InvalidSmsLoginCodeError.message":
+		if e.complexity.InvalidSmsLoginCodeError.Message == nil {
+			break
+		}
+
+		return e.complexity.InvalidSmsLoginCodeError.Message(childComplexity), true
+
+	case "LinkOAuthIdentitySuccess.authorizationUrl":
+		if e.complexity.LinkOAuthIdentitySuccess.AuthorizationURL == nil {
+			break
+		}
+
+		return e.complexity.LinkOAuthIdentitySuccess.AuthorizationURL(childComplexity), true
+
 	case "LogoutPayload.message":
 		if e.complexity.LogoutPayload.Message == nil {
 			break
@@ -714,6 +985,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.LogoutPayload.Message(childComplexity), true
 
+	case "Mutation.createBearerTokens":
+		if e.complexity.Mutation.CreateBearerTokens == nil {
+			break
+		}
+
+		return e.complexity.Mutation.CreateBearerTokens(childComplexity), true
+
+	case "Mutation.createPersonalAccessToken":
+		if e.complexity.Mutation.CreatePersonalAccessToken == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createPersonalAccessToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreatePersonalAccessToken(childComplexity, args["name"].(string), args["scopes"].([]model.PersonalAccessTokenScope), args["expiresInDays"].(*int32)), true
+
 	case "Mutation.createWebAuthnCredential":
 		if e.complexity.Mutation.CreateWebAuthnCredential == nil {
 			break
@@ -847,6 +1137,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.GenerateWebAuthnCredentialCreationOptions(childComplexity), true
 
+	case "Mutation.linkOAuthIdentity":
+		if e.complexity.Mutation.LinkOAuthIdentity == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_linkOAuthIdentity_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LinkOAuthIdentity(childComplexity, args["provider"].(string)), true
+
+	case "Mutation.loginWithEmailCode":
+		if e.complexity.Mutation.LoginWithEmailCode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loginWithEmailCode_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginWithEmailCode(childComplexity, args["email"].(string), args["code"].(string), args["captchaToken"].(string), args["rememberMe"].(bool)), true
+
 	case "Mutation.loginWithPasskey":
 		if e.complexity.Mutation.LoginWithPasskey == nil {
 			break
@@ -857,7 +1171,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoginWithPasskey(childComplexity, args["authenticationResponse"].(string), args["captchaToken"].(string)), true
+		return e.complexity.Mutation.LoginWithPasskey(childComplexity, args["authenticationResponse"].(string), args["captchaToken"].(string), args["rememberMe"].(bool)), true
 
 	case "Mutation.loginWithPassword":
 		if e.complexity.Mutation.LoginWithPassword == nil {
@@ -869,7 +1183,19 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.LoginWithPassword(childComplexity, args["login"].(string), args["password"].(string), args["captchaToken"].(string)), true
+		return e.complexity.Mutation.LoginWithPassword(childComplexity, args["login"].(string), args["password"].(string), args["captchaToken"].(string), args["rememberMe"].(bool)), true
+
+	case "Mutation.loginWithSmsCode":
+		if e.complexity.Mutation.LoginWithSmsCode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loginWithSmsCode_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginWithSmsCode(childComplexity, args["phoneNumber"].(string), args["code"].(string), args["captchaToken"].(string), args["rememberMe"].(bool)), true
 
 	case "Mutation.logout":
 		if e.complexity.Mutation.Logout == nil {
@@ -878,6 +1204,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.Logout(childComplexity), true
 
+	case "Mutation.refreshBearerTokens":
+		if e.complexity.Mutation.RefreshBearerTokens == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_refreshBearerTokens_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RefreshBearerTokens(childComplexity, args["refreshToken"].(string)), true
+
 	case "Mutation.registerWithPasskey":
 		if e.complexity.Mutation.RegisterWithPasskey == nil {
 			break
@@ -916,6 +1254,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.RemoveAccountPhoneNumber(childComplexity), true
 
+	case "Mutation.requestEmailLoginCode":
+		if e.complexity.Mutation.RequestEmailLoginCode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestEmailLoginCode_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestEmailLoginCode(childComplexity, args["email"].(string), args["captchaToken"].(string)), true
+
 	case "Mutation.requestEmailVerificationToken":
 		if e.complexity.Mutation.RequestEmailVerificationToken == nil {
 			break
@@ -952,6 +1302,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.RequestPhoneNumberVerificationToken(childComplexity, args["phoneNumber"].(string)), true
 
+	case "Mutation.requestSmsLoginCode":
+		if e.complexity.Mutation.RequestSmsLoginCode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestSmsLoginCode_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestSmsLoginCode(childComplexity, args["phoneNumber"].(string), args["captchaToken"].(string)), true
+
 	case "Mutation.requestSudoModeWithAuthenticator":
 		if e.complexity.Mutation.RequestSudoModeWithAuthenticator == nil {
 			break
@@ -1000,6 +1362,54 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Mutation.ResetPassword(childComplexity, args["email"].(string), args["passwordResetToken"].(string), args["newPassword"].(string)), true
 
+	case "Mutation.revokePersonalAccessToken":
+		if e.complexity.Mutation.RevokePersonalAccessToken == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokePersonalAccessToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokePersonalAccessToken(childComplexity, args["personalAccessTokenId"].(string)), true
+
+	case "Mutation.revokeSessions":
+		if e.complexity.Mutation.RevokeSessions == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeSessions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeSessions(childComplexity, args["revocationToken"].(string)), true
+
+	case "Mutation.unlinkOAuthIdentity":
+		if e.complexity.Mutation.UnlinkOAuthIdentity == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unlinkOAuthIdentity_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnlinkOAuthIdentity(childComplexity, args["oauthIdentityId"].(string)), true
+
+	case "Mutation.unlockAccount":
+		if e.complexity.Mutation.UnlockAccount == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unlockAccount_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnlockAccount(childComplexity, args["email"].(string), args["unlockToken"].(string)), true
+
 	case "Mutation.updateAccount":
 		if e.complexity.Mutation.UpdateAccount == nil {
 			break
@@ -1130,7 +1540,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.Mutation.VerifyGoogleToken(childComplexity, args["token"].(string)), true
+		return e.complexity.Mutation.VerifyGoogleToken(childComplexity, args["token"].(string), args["rememberMe"].(bool)), true
 
 	case "NotAuthenticatedError.message":
 		if e.complexity.NotAuthenticatedError.Message == nil {
@@ -1139,26 +1549,96 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.NotAuthenticatedError.Message(childComplexity), true
 
-	case "PageInfo.endCursor":
-		if e.complexity.PageInfo.EndCursor == nil {
+	case "OAuthIdentity.createdAt":
+		if e.complexity.OAuthIdentity.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.EndCursor(childComplexity), true
+		return e.complexity.OAuthIdentity.CreatedAt(childComplexity), true
 
-	case "PageInfo.hasNextPage":
-		if e.complexity.PageInfo.HasNextPage == nil {
+	case "OAuthIdentity.id":
+		if e.complexity.OAuthIdentity.ID == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+		return e.complexity.OAuthIdentity.ID(childComplexity), true
 
-	case "PageInfo.hasPreviousPage":
-		if e.complexity.PageInfo.HasPreviousPage == nil {
+	case "OAuthIdentity.provider":
+		if e.complexity.OAuthIdentity.Provider == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+		return e.complexity.OAuthIdentity.Provider(childComplexity), true
+
+	case "OAuthIdentityConnection.edges":
+		if e.complexity.OAuthIdentityConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.OAuthIdentityConnection.Edges(childComplexity), true
+
+	case "OAuthIdentityConnection.pageInfo":
+		if e.complexity.OAuthIdentityConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.OAuthIdentityConnection.PageInfo(childComplexity), true
+
+	case "OAuthIdentityConnection.totalCount":
+		if e.complexity.OAuthIdentityConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.OAuthIdentityConnection.TotalCount(childComplexity), true
+
+	case "OAuthIdentityEdge.cursor":
+		if e.complexity.OAuthIdentityEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.OAuthIdentityEdge.Cursor(childComplexity), true
+
+	case "OAuthIdentityEdge.node":
+		if e.complexity.OAuthIdentityEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.OAuthIdentityEdge.Node(childComplexity), true
+
+	case "OAuthIdentityNotFoundError.message":
+		if e.complexity.OAuthIdentityNotFoundError.Message == nil {
+			break
+		}
+
+		return e.complexity.OAuthIdentityNotFoundError.Message(childComplexity), true
+
+	case "OAuthProviderNotSupportedError.message":
+		if e.complexity.OAuthProviderNotSupportedError.Message == nil {
+			break
+		}
+
+		return e.complexity.OAuthProviderNotSupportedError.Message(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
 
 	case "PageInfo.startCursor":
 		if e.complexity.PageInfo.StartCursor == nil {
@@ -1174,6 +1654,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PasswordNotStrongError.Message(childComplexity), true
 
+	case "PasswordPreviouslyUsedError.message":
+		if e.complexity.PasswordPreviouslyUsedError.Message == nil {
+			break
+		}
+
+		return e.complexity.PasswordPreviouslyUsedError.Message(childComplexity), true
+
 	case "PasswordResetToken.authProviders":
 		if e.complexity.PasswordResetToken.AuthProviders == nil {
 			break
@@ -1230,6 +1717,90 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PasswordResetTokenNotFoundError.Message(childComplexity), true
 
+	case "PersonalAccessToken.createdAt":
+		if e.complexity.PersonalAccessToken.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessToken.CreatedAt(childComplexity), true
+
+	case "PersonalAccessToken.expiresAt":
+		if e.complexity.PersonalAccessToken.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessToken.ExpiresAt(childComplexity), true
+
+	case "PersonalAccessToken.id":
+		if e.complexity.PersonalAccessToken.ID == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessToken.ID(childComplexity), true
+
+	case "PersonalAccessToken.lastUsedAt":
+		if e.complexity.PersonalAccessToken.LastUsedAt == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessToken.LastUsedAt(childComplexity), true
+
+	case "PersonalAccessToken.name":
+		if e.complexity.PersonalAccessToken.Name == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessToken.Name(childComplexity), true
+
+	case "PersonalAccessToken.scopes":
+		if e.complexity.PersonalAccessToken.Scopes == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessToken.Scopes(childComplexity), true
+
+	case "PersonalAccessTokenConnection.edges":
+		if e.complexity.PersonalAccessTokenConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessTokenConnection.Edges(childComplexity), true
+
+	case "PersonalAccessTokenConnection.pageInfo":
+		if e.complexity.PersonalAccessTokenConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessTokenConnection.PageInfo(childComplexity), true
+
+	case "PersonalAccessTokenConnection.totalCount":
+		if e.complexity.PersonalAccessTokenConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessTokenConnection.TotalCount(childComplexity), true
+
+	case "PersonalAccessTokenEdge.cursor":
+		if e.complexity.PersonalAccessTokenEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessTokenEdge.Cursor(childComplexity), true
+
+	case "PersonalAccessTokenEdge.node":
+		if e.complexity.PersonalAccessTokenEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessTokenEdge.Node(childComplexity), true
+
+	case "PersonalAccessTokenNotFoundError.message":
+		if e.complexity.PersonalAccessTokenNotFoundError.Message == nil {
+			break
+		}
+
+		return e.complexity.PersonalAccessTokenNotFoundError.Message(childComplexity), true
+
 	case "PhoneNumberAlreadyExistsError.message":
 		if e.complexity.PhoneNumberAlreadyExistsError.Message == nil {
 			break
@@ -1296,6 +1867,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Viewer(childComplexity), true
 
+	case "RequestEmailLoginCodeSuccess.message":
+		if e.complexity.RequestEmailLoginCodeSuccess.Message == nil {
+			break
+		}
+
+		return e.complexity.RequestEmailLoginCodeSuccess.Message(childComplexity), true
+
 	case "RequestEmailVerificationSuccess.message":
 		if e.complexity.RequestEmailVerificationSuccess.Message == nil {
 			break
@@ -1331,6 +1909,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.RequestPhoneNumberVerificationTokenSuccess.Message(childComplexity), true
 
+	case "RequestSmsLoginCodeSuccess.message":
+		if e.complexity.RequestSmsLoginCodeSuccess.Message == nil {
+			break
+		}
+
+		return e.complexity.RequestSmsLoginCodeSuccess.Message(childComplexity), true
+
+	case "RevokePersonalAccessTokenSuccess.personalAccessTokenEdge":
+		if e.complexity.RevokePersonalAccessTokenSuccess.PersonalAccessTokenEdge == nil {
+			break
+		}
+
+		return e.complexity.RevokePersonalAccessTokenSuccess.PersonalAccessTokenEdge(childComplexity), true
+
+	case "RevokeSessionsSuccess.message":
+		if e.complexity.RevokeSessionsSuccess.Message == nil {
+			break
+		}
+
+		return e.complexity.RevokeSessionsSuccess.Message(childComplexity), true
+
 	case "Session.createdAt":
 		if e.complexity.Session.CreatedAt == nil {
 			break
@@ -1338,6 +1937,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Session.CreatedAt(childComplexity), true
 
+	case "Session.device":
+		if e.complexity.Session.Device == nil {
+			break
+		}
+
+		return e.complexity.Session.Device(childComplexity), true
+
 	case "Session.id":
 		if e.complexity.Session.ID == nil {
 			break
@@ -1352,6 +1958,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Session.IPAddress(childComplexity), true
 
+	case "Session.isCurrent":
+		if e.complexity.Session.IsCurrent == nil {
+			break
+		}
+
+		return e.complexity.Session.IsCurrent(childComplexity), true
+
+	case "Session.lastActiveAt":
+		if e.complexity.Session.LastActiveAt == nil {
+			break
+		}
+
+		return e.complexity.Session.LastActiveAt(childComplexity), true
+
+	case "Session.location":
+		if e.complexity.Session.Location == nil {
+			break
+		}
+
+		return e.complexity.Session.Location(childComplexity), true
+
 	case "Session.userAgent":
 		if e.complexity.Session.UserAgent == nil {
 			break
@@ -1380,6 +2007,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SessionConnection.TotalCount(childComplexity), true
 
+	case "SessionDevice.browser":
+		if e.complexity.SessionDevice.Browser == nil {
+			break
+		}
+
+		return e.complexity.SessionDevice.Browser(childComplexity), true
+
+	case "SessionDevice.os":
+		if e.complexity.SessionDevice.Os == nil {
+			break
+		}
+
+		return e.complexity.SessionDevice.Os(childComplexity), true
+
+	case "SessionDevice.type":
+		if e.complexity.SessionDevice.Type == nil {
+			break
+		}
+
+		return e.complexity.SessionDevice.Type(childComplexity), true
+
 	case "SessionEdge.cursor":
 		if e.complexity.SessionEdge.Cursor == nil {
 			break
@@ -1394,6 +2042,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SessionEdge.Node(childComplexity), true
 
+	case "SessionLocation.city":
+		if e.complexity.SessionLocation.City == nil {
+			break
+		}
+
+		return e.complexity.SessionLocation.City(childComplexity), true
+
+	case "SessionLocation.country":
+		if e.complexity.SessionLocation.Country == nil {
+			break
+		}
+
+		return e.complexity.SessionLocation.Country(childComplexity), true
+
+	case "SessionLocation.countryCode":
+		if e.complexity.SessionLocation.CountryCode == nil {
+			break
+		}
+
+		return e.complexity.SessionLocation.CountryCode(childComplexity), true
+
 	case "SessionNotFoundError.message":
 		if e.complexity.SessionNotFoundError.Message == nil {
 			break
@@ -1422,6 +2091,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TermsAndPolicy.UpdatedAt(childComplexity), true
 
+	case "TooManyAttemptsError.message":
+		if e.complexity.TooManyAttemptsError.Message == nil {
+			break
+		}
+
+		return e.complexity.TooManyAttemptsError.Message(childComplexity), true
+
+	case "TooManyAttemptsError.retryAfterSeconds":
+		if e.complexity.TooManyAttemptsError.RetryAfterSeconds == nil {
+			break
+		}
+
+		return e.complexity.TooManyAttemptsError.RetryAfterSeconds(childComplexity), true
+
 	case "TwoFactorAuthenticationChallengeNotFoundError.message":
 		if e.complexity.TwoFactorAuthenticationChallengeNotFoundError.Message == nil {
 			break
@@ -1443,6 +2126,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TwoFactorAuthenticationRequiredError.Message(childComplexity), true
 
+	case "UnlinkOAuthIdentitySuccess.oauthIdentityEdge":
+		if e.complexity.UnlinkOAuthIdentitySuccess.OauthIdentityEdge == nil {
+			break
+		}
+
+		return e.complexity.UnlinkOAuthIdentitySuccess.OauthIdentityEdge(childComplexity), true
+
+	case "UnlockAccountSuccess.message":
+		if e.complexity.UnlockAccountSuccess.Message == nil {
+			break
+		}
+
+		return e.complexity.UnlockAccountSuccess.Message(childComplexity), true
+
 	case "VerifyEmailSuccess.message":
 		if e.complexity.VerifyEmailSuccess.Message == nil {
 			break
@@ -1792,6 +2489,56 @@ type Account implements Node {
 		"""
 		last: Int = null
 	): WebAuthnCredentialConnection!
+
+	"""
+	The personal access tokens for the account.
+	"""
+	personalAccessTokens(
+		"""
+		Returns items before the given cursor.
+		"""
+		before: ID = null
+
+		"""
+		Returns items after the given cursor.
+		"""
+		after: ID = null
+
+		"""
+		How many items to return after the cursor?
+		"""
+		first: Int = null
+
+		"""
+		How many items to return before the cursor?
+		"""
+		last: Int = null
+	): PersonalAccessTokenConnection!
+
+	"""
+	The social login identities linked to the account.
+	"""
+	oauthIdentities(
+		"""
+		Returns items before the given cursor.
+		"""
+		before: ID = null
+
+		"""
+		Returns items after the given cursor.
+		"""
+		after: ID = null
+
+		"""
+		How many items to return after the cursor?
+		"""
+		first: Int = null
+
+		"""
+		How many items to return before the cursor?
+		"""
+		last: Int = null
+	): OAuthIdentityConnection!
 }
 
 
@@ -1887,7 +2634,7 @@ extend type Mutation {
 		The URL of the profile picture.
 		"""
 		avatarUrl: String
-	): UpdateAccountPayload! @isAuthenticated
+	): UpdateAccountPayload! @isAuthenticated @requiresScope(scope: WRITE_ACCOUNT)
 
 	"""
 	Create a phone number verification token.
@@ -1897,7 +2644,7 @@ extend type Mutation {
 		The phone of the user account.
 		"""
 		phoneNumber: String!
-	): RequestPhoneNumberVerificationTokenPayload! @isAuthenticated
+	): RequestPhoneNumberVerificationTokenPayload! @isAuthenticated @rateLimit(limit: 5, window: "1h", key: ACCOUNT) @rateLimit(limit: 3, window: "15m", key: ARG, arg: "phoneNumber")
 
 	"""
 	Update the current user's phone number.
@@ -1927,12 +2674,12 @@ extend type Mutation {
 		The analytics preference of the user account.
 		"""
 		analyticsPreference: AnalyticsPreferenceInputType!
-	): Account
+	): Account @requiresScope(scope: WRITE_ACCOUNT)
 
 	"""
 	Remove the avatar from the current user account.
 	"""
-	removeAccountAvatar: Account! @isAuthenticated
+	removeAccountAvatar: Account! @isAuthenticated @requiresScope(scope: WRITE_ACCOUNT)
 }
 `, BuiltIn: false},
 	{Name: "../schema/auth.graphqls", Input: `"""
@@ -1942,6 +2689,9 @@ enum AuthProvider {
 	PASSWORD
 	WEBAUTHN_CREDENTIAL
 	OAUTH_GOOGLE
+	OAUTH_GITHUB
+	OAUTH_MICROSOFT
+	OAUTH_GITLAB
 }
 
 """
@@ -2198,6 +2948,26 @@ type InvalidEmailError implements Error {
 	message: String!
 }
 
+"""
+Used when an invalid or expired email login code is provided.
+"""
+type InvalidEmailLoginCodeError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
+
+"""
+Used when an invalid or expired SMS login code is provided.
+"""
+type InvalidSmsLoginCodeError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
+
 """
 Used when an invalid email verification token is provided.
 """
@@ -2231,28 +3001,93 @@ type InvalidPasskeyRegistrationCredentialError implements Error {
 }
 
 """
-Used when an invalid password reset token is provided.
+Used when an invalid or expired account unlock token is provided.
 """
-type InvalidPasswordResetTokenError implements Error {
+type InvalidAccountUnlockTokenError implements Error {
 	"""
 	Human readable error message.
 	"""
 	message: String!
 }
 
-
+"""
+Used when an invalid or expired session revocation token is provided.
+"""
+type InvalidSessionRevocationTokenError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
 
 """
-The login with passkey payload.
+Used when an invalid or expired refresh token is provided, or a used one was presented again.
 """
-union LoginWithPasskeyPayload =
-	| Account
-	| InvalidPasskeyAuthenticationCredentialError
-	| InvalidCaptchaTokenError
-	| WebAuthnChallengeNotFoundError
+type InvalidRefreshTokenError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
 
 """
-The login with password payload.
+Used when an invalid password reset token is provided.
+"""
+type InvalidPasswordResetTokenError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
+
+"""
+The link OAuth identity payload.
+"""
+union LinkOAuthIdentityPayload = LinkOAuthIdentitySuccess | OAuthProviderNotSupportedError
+
+"""
+Link OAuth identity success.
+"""
+type LinkOAuthIdentitySuccess {
+	"""
+	The provider's sign in URL to redirect the user to.
+	The identity is linked once the user is redirected back from the provider.
+	"""
+	authorizationUrl: String!
+}
+
+
+"""
+The login with passkey payload.
+"""
+union LoginWithPasskeyPayload =
+	| Account
+	| InvalidPasskeyAuthenticationCredentialError
+	| InvalidCaptchaTokenError
+	| WebAuthnChallengeNotFoundError
+
+"""
+The login with email code payload.
+"""
+union LoginWithEmailCodePayload =
+	| Account
+	| InvalidEmailLoginCodeError
+	| InvalidCaptchaTokenError
+	| TwoFactorAuthenticationRequiredError
+	| TooManyAttemptsError
+
+"""
+The login with SMS code payload.
+"""
+union LoginWithSmsCodePayload =
+	| Account
+	| InvalidSmsLoginCodeError
+	| InvalidCaptchaTokenError
+	| TwoFactorAuthenticationRequiredError
+	| TooManyAttemptsError
+
+"""
+The login with password payload.
 """
 union LoginWithPasswordPayload =
 	| Account
@@ -2260,6 +3095,7 @@ union LoginWithPasswordPayload =
 	| InvalidCaptchaTokenError
 	| InvalidAuthenticationProviderError
 	| TwoFactorAuthenticationRequiredError
+	| TooManyAttemptsError
 
 """
 The logout payload.
@@ -2271,6 +3107,75 @@ type LogoutPayload {
 	message: String!
 }
 
+"""
+A social login identity linked to an account.
+"""
+type OAuthIdentity implements Node {
+	"""
+	The Globally Unique ID of this object
+	"""
+	id: ID!
+
+	"""
+	The name of the social login provider.
+	"""
+	provider: String!
+
+	"""
+	When the identity was linked.
+	"""
+	createdAt: DateTime!
+}
+
+type OAuthIdentityConnection {
+	"""
+	Information to aid in pagination.
+	"""
+	pageInfo: PageInfo!
+
+	"""
+	A list of edges.
+	"""
+	edges: [OAuthIdentityEdge!]!
+
+	"""
+	The total number of items in the connection.
+	"""
+	totalCount: Int
+}
+
+type OAuthIdentityEdge {
+	"""
+	A cursor for use in pagination
+	"""
+	cursor: String!
+
+	"""
+	The item at the end of the edge
+	"""
+	node: OAuthIdentity!
+}
+
+"""
+Used when the OAuth identity is not found.
+"""
+type OAuthIdentityNotFoundError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
+
+"""
+Used when the social login provider is not configured.
+"""
+type OAuthProviderNotSupportedError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
+
 
 """
 Used when the password is not strong enough.
@@ -2282,6 +3187,16 @@ type PasswordNotStrongError implements Error {
 	message: String!
 }
 
+"""
+Used when the new password is the current password or one of the recently used passwords.
+"""
+type PasswordPreviouslyUsedError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
+
 """
 A password reset token.
 """
@@ -2388,6 +3303,77 @@ type Session implements Node {
 	When the session was created.
 	"""
 	createdAt: DateTime!
+
+	"""
+	When the session was last used. Recorded at most once every few minutes.
+	"""
+	lastActiveAt: DateTime!
+
+	"""
+	Whether this is the session making the request.
+	"""
+	isCurrent: Boolean!
+
+	"""
+	The device the session was created from, parsed from its user agent.
+	"""
+	device: SessionDevice!
+
+	"""
+	The approximate location the session was created from, resolved from its IP address. Null if unknown.
+	"""
+	location: SessionLocation
+}
+
+"""
+The type of device a session was created from.
+"""
+enum DeviceType {
+	DESKTOP
+	MOBILE
+	TABLET
+	BOT
+	UNKNOWN
+}
+
+"""
+The device a session was created from.
+"""
+type SessionDevice {
+	"""
+	Browser name and major version, such as "Chrome 120". Null if unknown.
+	"""
+	browser: String
+
+	"""
+	Operating system name, with its version when known, such as "Android 14". Null if unknown.
+	"""
+	os: String
+
+	"""
+	The type of device.
+	"""
+	type: DeviceType!
+}
+
+"""
+The approximate location a session was created from.
+"""
+type SessionLocation {
+	"""
+	City name. Null if only the country is known.
+	"""
+	city: String
+
+	"""
+	Country name.
+	"""
+	country: String!
+
+	"""
+	ISO 3166-1 alpha-2 country code.
+	"""
+	countryCode: String!
 }
 
 type SessionConnection {
@@ -2459,6 +3445,21 @@ type TwoFactorAuthenticationNotEnabledError implements Error {
 	message: String!
 }
 
+"""
+Used when too many failed attempts were made against the account or from the IP address.
+"""
+type TooManyAttemptsError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+
+	"""
+	The number of seconds until another attempt is allowed.
+	"""
+	retryAfterSeconds: Int!
+}
+
 """
 Used when 2FA is required.
 """
@@ -2480,6 +3481,7 @@ union Verify2FAPasswordResetWithAuthenticatorPayload =
 	| AuthenticatorNotEnabledError
 	| InvalidPasswordResetTokenError
 	| InvalidCaptchaTokenError
+	| TooManyAttemptsError
 
 """
 The verify 2FA password reset with passkey payload.
@@ -2501,6 +3503,7 @@ union Verify2FAWithAuthenticatorPayload =
 	| AuthenticatorNotEnabledError
 	| TwoFactorAuthenticationChallengeNotFoundError
 	| InvalidCaptchaTokenError
+	| TooManyAttemptsError
 
 """
 The verify 2FA with recovery code payload.
@@ -2511,6 +3514,7 @@ union Verify2FAWithRecoveryCodePayload =
 	| TwoFactorAuthenticationNotEnabledError
 	| TwoFactorAuthenticationChallengeNotFoundError
 	| InvalidCaptchaTokenError
+	| TooManyAttemptsError
 
 """
 The verify email payload.
@@ -2530,7 +3534,7 @@ type VerifyEmailSuccess {
 """
 The verify Google (one-tap) token payload.
 """
-union VerifyGoogleTokenPayload = Account | InvalidCredentialsError | InvalidEmailError | TwoFactorAuthenticationRequiredError
+union VerifyGoogleTokenPayload = Account | InvalidCredentialsError | InvalidEmailError | EmailInUseError | TwoFactorAuthenticationRequiredError
 
 """
 The viewer payload.
@@ -2622,6 +3626,36 @@ The update account phone number payload.
 """
 union UpdateAccountPhoneNumberPayload = Account | InvalidPhoneNumberVerificationTokenError | InvalidPhoneNumberError
 
+"""
+The request email login code payload.
+"""
+union RequestEmailLoginCodePayload = RequestEmailLoginCodeSuccess | InvalidCaptchaTokenError
+
+"""
+Request email login code success.
+"""
+type RequestEmailLoginCodeSuccess {
+	"""
+	Human readable success message.
+	"""
+	message: String!
+}
+
+"""
+The request SMS login code payload.
+"""
+union RequestSmsLoginCodePayload = RequestSmsLoginCodeSuccess | InvalidCaptchaTokenError | InvalidPhoneNumberError
+
+"""
+Request SMS login code success.
+"""
+type RequestSmsLoginCodeSuccess {
+	"""
+	Human readable success message.
+	"""
+	message: String!
+}
+
 """
 The request password reset payload.
 """
@@ -2640,7 +3674,12 @@ type RequestPasswordResetSuccess {
 """
 The request sudo mode with authenticator app payload.
 """
-union RequestSudoModeWithAuthenticatorPayload = Account | InvalidCredentialsError | InvalidCaptchaTokenError | AuthenticatorNotEnabledError
+union RequestSudoModeWithAuthenticatorPayload =
+	| Account
+	| InvalidCredentialsError
+	| InvalidCaptchaTokenError
+	| AuthenticatorNotEnabledError
+	| TooManyAttemptsError
 
 """
 The request sudo mode with passkey payload.
@@ -2660,6 +3699,7 @@ union RequestSudoModeWithPasswordPayload =
 	| InvalidCaptchaTokenError
 	| InvalidAuthenticationProviderError
 	| TwoFactorAuthenticationRequiredError
+	| TooManyAttemptsError
 
 """
 The reset password payload.
@@ -2668,6 +3708,7 @@ union ResetPasswordPayload =
 	| Account
 	| InvalidPasswordResetTokenError
 	| PasswordNotStrongError
+	| PasswordPreviouslyUsedError
 	| TwoFactorAuthenticationChallengeNotFoundError
 
 """
@@ -2693,7 +3734,7 @@ union RegisterWithPasswordPayload =
 """
 The update password payload.
 """
-union UpdatePasswordPayload = Account | PasswordNotStrongError
+union UpdatePasswordPayload = Account | PasswordNotStrongError | PasswordPreviouslyUsedError
 
 
 """
@@ -2701,6 +3742,200 @@ The update webauthn credential payload.
 """
 union UpdateWebAuthnCredentialPayload = WebAuthnCredential | WebAuthnCredentialNotFoundError
 
+"""
+The unlink OAuth identity payload.
+"""
+union UnlinkOAuthIdentityPayload = UnlinkOAuthIdentitySuccess | OAuthIdentityNotFoundError | InsufficientAuthProvidersError
+
+"""
+Unlink OAuth identity success.
+"""
+type UnlinkOAuthIdentitySuccess {
+	"""
+	The unlinked OAuth identity edge.
+	"""
+	oauthIdentityEdge: OAuthIdentityEdge!
+}
+
+"""
+The revoke sessions payload.
+"""
+union RevokeSessionsPayload = RevokeSessionsSuccess | InvalidSessionRevocationTokenError
+
+"""
+Revoke sessions success.
+"""
+type RevokeSessionsSuccess {
+	"""
+	Human readable success message.
+	"""
+	message: String!
+}
+
+"""
+The access and refresh tokens of a native client's session.
+"""
+type BearerTokens {
+	"""
+	The access token, sent in an ` + "`" + `Authorization: Bearer` + "`" + ` header.
+	"""
+	accessToken: String!
+
+	"""
+	When the access token expires.
+	"""
+	accessTokenExpiresAt: DateTime!
+
+	"""
+	The refresh token, exchanged once for new tokens with refreshBearerTokens.
+	"""
+	refreshToken: String!
+
+	"""
+	When the refresh token expires, at the latest along with the session.
+	"""
+	refreshTokenExpiresAt: DateTime!
+}
+
+"""
+The refresh bearer tokens payload.
+"""
+union RefreshBearerTokensPayload = BearerTokens | InvalidRefreshTokenError
+
+"""
+A personal access token, letting scripts and CI jobs call the API as the account.
+"""
+type PersonalAccessToken implements Node {
+	"""
+	The Globally Unique ID of this object
+	"""
+	id: ID!
+
+	"""
+	The name telling what the token is used for.
+	"""
+	name: String!
+
+	"""
+	What the token is allowed to do.
+	"""
+	scopes: [PersonalAccessTokenScope!]!
+
+	"""
+	When the token expires. Null if it never expires.
+	"""
+	expiresAt: DateTime
+
+	"""
+	When the token was last used. Recorded at most once every few minutes, null if never used.
+	"""
+	lastUsedAt: DateTime
+
+	"""
+	When the token was created.
+	"""
+	createdAt: DateTime!
+}
+
+type PersonalAccessTokenConnection {
+	"""
+	Information to aid in pagination.
+	"""
+	pageInfo: PageInfo!
+
+	"""
+	A list of edges.
+	"""
+	edges: [PersonalAccessTokenEdge!]!
+
+	"""
+	The total number of items in the connection.
+	"""
+	totalCount: Int
+}
+
+type PersonalAccessTokenEdge {
+	"""
+	A cursor for use in pagination
+	"""
+	cursor: String!
+
+	"""
+	The item at the end of the edge
+	"""
+	node: PersonalAccessToken!
+}
+
+"""
+Used when the personal access token is not found.
+"""
+type PersonalAccessTokenNotFoundError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
+
+"""
+Used when the name, scopes or expiry of a new personal access token are invalid.
+"""
+type InvalidPersonalAccessTokenError implements Error {
+	"""
+	Human readable error message.
+	"""
+	message: String!
+}
+
+"""
+The create personal access token payload.
+"""
+union CreatePersonalAccessTokenPayload = CreatePersonalAccessTokenSuccess | InvalidPersonalAccessTokenError
+
+"""
+Create personal access token success.
+"""
+type CreatePersonalAccessTokenSuccess {
+	"""
+	The token, sent in an ` + "`" + `Authorization: Bearer` + "`" + ` header. It is shown only once.
+	"""
+	token: String!
+
+	"""
+	The created personal access token edge.
+	"""
+	personalAccessTokenEdge: PersonalAccessTokenEdge!
+}
+
+"""
+The revoke personal access token payload.
+"""
+union RevokePersonalAccessTokenPayload = RevokePersonalAccessTokenSuccess | PersonalAccessTokenNotFoundError
+
+"""
+Revoke personal access token success.
+"""
+type RevokePersonalAccessTokenSuccess {
+	"""
+	The revoked personal access token edge.
+	"""
+	personalAccessTokenEdge: PersonalAccessTokenEdge!
+}
+
+"""
+The unlock account payload.
+"""
+union UnlockAccountPayload = UnlockAccountSuccess | InvalidAccountUnlockTokenError
+
+"""
+Unlock account success.
+"""
+type UnlockAccountSuccess {
+	"""
+	Human readable success message.
+	"""
+	message: String!
+}
+
 
 extend type Mutation {
 	"""
@@ -2716,7 +3951,7 @@ extend type Mutation {
 		The captcha token to verify the user request.
 		"""
 		captchaToken: String!
-	): RequestEmailVerificationTokenPayload!
+	): RequestEmailVerificationTokenPayload! @rateLimit(limit: 20, window: "1h") @rateLimit(limit: 3, window: "15m", key: ARG, arg: "email")
 
 	"""
 	Verify an email.
@@ -2856,6 +4091,11 @@ extend type Mutation {
 		The captcha token to verify the user request.
 		"""
 		captchaToken: String!
+
+		"""
+		Whether to keep the user logged in for longer, following the "remember me" session policy.
+		"""
+		rememberMe: Boolean! = false
 	): LoginWithPasskeyPayload!
 
 	"""
@@ -2876,8 +4116,93 @@ extend type Mutation {
 		The captcha token to verify the user request.
 		"""
 		captchaToken: String!
+
+		"""
+		Whether to keep the user logged in for longer, following the "remember me" session policy.
+		"""
+		rememberMe: Boolean! = false
 	): LoginWithPasswordPayload!
 
+	"""
+	Request a one-time login code by email.
+	"""
+	requestEmailLoginCode(
+		"""
+		The email of the existing user.
+		"""
+		email: String!
+
+		"""
+		The captcha token to verify the user request.
+		"""
+		captchaToken: String!
+	): RequestEmailLoginCodePayload! @rateLimit(limit: 20, window: "1h") @rateLimit(limit: 3, window: "15m", key: ARG, arg: "email")
+
+	"""
+	Log in a user with a one-time code, or the token of a login link, sent by email.
+	"""
+	loginWithEmailCode(
+		"""
+		The email of the user.
+		"""
+		email: String!
+
+		"""
+		The numeric code or the login link token.
+		"""
+		code: String!
+
+		"""
+		The captcha token to verify the user request.
+		"""
+		captchaToken: String!
+
+		"""
+		Whether to keep the user logged in for longer, following the "remember me" session policy.
+		"""
+		rememberMe: Boolean! = false
+	): LoginWithEmailCodePayload!
+
+	"""
+	Request a one-time login code by SMS, sent to the verified phone number of the user.
+	"""
+	requestSmsLoginCode(
+		"""
+		The phone number of the existing user.
+		"""
+		phoneNumber: String!
+
+		"""
+		The captcha token to verify the user request.
+		"""
+		captchaToken: String!
+	): RequestSmsLoginCodePayload! @rateLimit(limit: 10, window: "1h") @rateLimit(limit: 3, window: "15m", key: ARG, arg: "phoneNumber")
+
+	"""
+	Log in a user with a one-time code sent by SMS.
+	"""
+	loginWithSmsCode(
+		"""
+		The phone number of the user.
+		"""
+		phoneNumber: String!
+
+		"""
+		The code sent by SMS.
+		"""
+		code: String!
+
+		"""
+		The captcha token to verify the user request.
+		"""
+		captchaToken: String!
+
+		"""
+		Whether to keep the user logged in for longer, following the "remember me" session policy.
+		"""
+		rememberMe: Boolean! = false
+	): LoginWithSmsCodePayload!
+
 	"""
 	Log out the current user.
 	"""
@@ -2896,7 +4221,7 @@ extend type Mutation {
 		The captcha token to verify the user request.
 		"""
 		captchaToken: String!
-	): RequestPasswordResetPayload!
+	): RequestPasswordResetPayload! @rateLimit(limit: 20, window: "1h") @rateLimit(limit: 3, window: "15m", key: ARG, arg: "email")
 
 	"""
 	Verify a 2FA challenge for password reset using an authenticator app.
@@ -2968,6 +4293,79 @@ extend type Mutation {
 		newPassword: String!
 	): ResetPasswordPayload!
 
+	"""
+	Unlock an account locked after too many failed attempts, using the link mailed to its owner.
+	"""
+	unlockAccount(
+		"""
+		The email of the locked account.
+		"""
+		email: String!
+
+		"""
+		The account unlock token.
+		"""
+		unlockToken: String!
+	): UnlockAccountPayload!
+
+	"""
+	Sign an account out of all of its sessions, using the "this wasn't me" link of a security notification email.
+	The password of the account is cleared too, the owner sets a new one with a password reset.
+	"""
+	revokeSessions(
+		"""
+		The session revocation token.
+		"""
+		revocationToken: String!
+	): RevokeSessionsPayload!
+
+	"""
+	Exchange the session cookie for bearer tokens, for native clients.
+	The session is no longer held by the cookie afterwards.
+	"""
+	createBearerTokens: BearerTokens! @isAuthenticated
+
+	"""
+	Exchange a refresh token for new access and refresh tokens.
+	Refresh tokens are used once, presenting a used one again signs its session out.
+	"""
+	refreshBearerTokens(
+		"""
+		The refresh token.
+		"""
+		refreshToken: String!
+	): RefreshBearerTokensPayload! @rateLimit(limit: 60, window: "1h")
+
+	"""
+	Create a personal access token for the current user.
+	"""
+	createPersonalAccessToken(
+		"""
+		The name telling what the token is used for.
+		"""
+		name: String!
+
+		"""
+		What the token is allowed to do.
+		"""
+		scopes: [PersonalAccessTokenScope!]!
+
+		"""
+		How many days the token is valid for. The token never expires if null.
+		"""
+		expiresInDays: Int = null
+	): CreatePersonalAccessTokenPayload! @isAuthenticated @requiresSudoMode
+
+	"""
+	Revoke a personal access token by ID.
+	"""
+	revokePersonalAccessToken(
+		"""
+		The ID of the personal access token to revoke.
+		"""
+		personalAccessTokenId: ID!
+	): RevokePersonalAccessTokenPayload! @isAuthenticated
+
 	"""
 	Update the current user's password.
 	"""
@@ -3023,6 +4421,26 @@ extend type Mutation {
 		nickname: String!
 	): UpdateWebAuthnCredentialPayload! @isAuthenticated
 
+	"""
+	Start linking a social login identity to the current user.
+	"""
+	linkOAuthIdentity(
+		"""
+		The name of the social login provider.
+		"""
+		provider: String!
+	): LinkOAuthIdentityPayload! @isAuthenticated @requiresSudoMode
+
+	"""
+	Unlink a social login identity by ID.
+	"""
+	unlinkOAuthIdentity(
+		"""
+		The ID of the OAuth identity to unlink.
+		"""
+		oauthIdentityId: ID!
+	): UnlinkOAuthIdentityPayload! @isAuthenticated @requiresSudoMode
+
 	"""
 	Generate registration options for adding a webauthn credential.
 	"""
@@ -3151,6 +4569,11 @@ extend type Mutation {
 		The Google (one-tap) credential token.
 		"""
 		token: String!
+
+		"""
+		Whether to keep the user logged in for longer, following the "remember me" session policy.
+		"""
+		rememberMe: Boolean! = false
 	): VerifyGoogleTokenPayload!
 }
 
@@ -3159,7 +4582,7 @@ extend type Query {
 	"""
 	Get the current user.
 	"""
-	viewer: ViewerPayload!
+	viewer: ViewerPayload! @requiresScope(scope: READ_ACCOUNT)
 
 	"""
 	Get a password reset token.
@@ -3265,7 +4688,60 @@ type Mutation {
 `, BuiltIn: false},
 	{Name: "../schema/directives.graphqls", Input: `directive @isAuthenticated on FIELD_DEFINITION
 
-directive @requiresSudoMode on FIELD_DEFINITION`, BuiltIn: false},
+directive @requiresSudoMode on FIELD_DEFINITION
+
+"""
+What a personal access token is allowed to do.
+"""
+enum PersonalAccessTokenScope {
+	"""
+	Read the account.
+	"""
+	READ_ACCOUNT
+
+	"""
+	Update the account's profile.
+	"""
+	WRITE_ACCOUNT
+}
+
+"""
+Requires personal access tokens to be granted ` + "`" + `scope` + "`" + `. Personal access tokens are denied the
+@isAuthenticated fields without it. Session viewers are not restricted by scopes.
+"""
+directive @requiresScope(scope: PersonalAccessTokenScope!) on FIELD_DEFINITION
+
+"""
+What requests are counted together by @rateLimit.
+"""
+enum RateLimitKey {
+	"""
+	The client's IP address.
+	"""
+	IP
+
+	"""
+	The authenticated account, falling back to the IP address of anonymous requests.
+	"""
+	ACCOUNT
+
+	"""
+	The value of the field argument named by ` + "`" + `arg` + "`" + `.
+	"""
+	ARG
+}
+
+"""
+Allows at most ` + "`" + `limit` + "`" + ` requests to the field per key within a sliding ` + "`" + `window` + "`" + `,
+given as a duration such as "30s", "15m" or "1h".
+"""
+directive @rateLimit(
+	limit: Int!
+	window: String!
+	key: RateLimitKey! = IP
+	arg: String
+) repeatable on FIELD_DEFINITION
+`, BuiltIn: false},
 	{Name: "../schema/scalars.graphqls", Input: `"""
 Date (isoformat)
 """
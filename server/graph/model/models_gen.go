@@ -9,6 +9,11 @@ import (
 	"strconv"
 )
 
+// The create personal access token payload.
+type CreatePersonalAccessTokenPayload interface {
+	IsCreatePersonalAccessTokenPayload()
+}
+
 // The create webauthn credential payload.
 type CreateWebAuthnCredentialPayload interface {
 	IsCreateWebAuthnCredentialPayload()
@@ -66,6 +71,16 @@ type GeneratePasskeyRegistrationOptionsPayload interface {
 	IsGeneratePasskeyRegistrationOptionsPayload()
 }
 
+// The link OAuth identity payload.
+type LinkOAuthIdentityPayload interface {
+	IsLinkOAuthIdentityPayload()
+}
+
+// The login with email code payload.
+type LoginWithEmailCodePayload interface {
+	IsLoginWithEmailCodePayload()
+}
+
 // The login with passkey payload.
 type LoginWithPasskeyPayload interface {
 	IsLoginWithPasskeyPayload()
@@ -76,6 +91,11 @@ type LoginWithPasswordPayload interface {
 	IsLoginWithPasswordPayload()
 }
 
+// The login with SMS code payload.
+type LoginWithSmsCodePayload interface {
+	IsLoginWithSmsCodePayload()
+}
+
 // An object with a Globally Unique ID
 type Node interface {
 	IsNode()
@@ -88,6 +108,11 @@ type PasswordResetTokenPayload interface {
 	IsPasswordResetTokenPayload()
 }
 
+// The refresh bearer tokens payload.
+type RefreshBearerTokensPayload interface {
+	IsRefreshBearerTokensPayload()
+}
+
 // The register with passkey payload.
 type RegisterWithPasskeyPayload interface {
 	IsRegisterWithPasskeyPayload()
@@ -103,6 +128,11 @@ type RemoveAccountPhoneNumberPayload interface {
 	IsRemoveAccountPhoneNumberPayload()
 }
 
+// The request email login code payload.
+type RequestEmailLoginCodePayload interface {
+	IsRequestEmailLoginCodePayload()
+}
+
 // The request email verification token payload.
 type RequestEmailVerificationTokenPayload interface {
 	IsRequestEmailVerificationTokenPayload()
@@ -118,6 +148,11 @@ type RequestPhoneNumberVerificationTokenPayload interface {
 	IsRequestPhoneNumberVerificationTokenPayload()
 }
 
+// The request SMS login code payload.
+type RequestSmsLoginCodePayload interface {
+	IsRequestSmsLoginCodePayload()
+}
+
 // The request sudo mode with authenticator app payload.
 type RequestSudoModeWithAuthenticatorPayload interface {
 	IsRequestSudoModeWithAuthenticatorPayload()
@@ -138,11 +173,31 @@ type ResetPasswordPayload interface {
 	IsResetPasswordPayload()
 }
 
+// The revoke personal access token payload.
+type RevokePersonalAccessTokenPayload interface {
+	IsRevokePersonalAccessTokenPayload()
+}
+
+// The revoke sessions payload.
+type RevokeSessionsPayload interface {
+	IsRevokeSessionsPayload()
+}
+
 // The enable account 2FA with authenticator payload.
 type SetAccount2FAPayload interface {
 	IsSetAccount2FAPayload()
 }
 
+// The unlink OAuth identity payload.
+type UnlinkOAuthIdentityPayload interface {
+	IsUnlinkOAuthIdentityPayload()
+}
+
+// The unlock account payload.
+type UnlockAccountPayload interface {
+	IsUnlockAccountPayload()
+}
+
 // The update account payload.
 type UpdateAccountPayload interface {
 	IsUpdateAccountPayload()
@@ -230,6 +285,10 @@ type Account struct {
 	Sessions *SessionConnection `json:"sessions"`
 	// The webauthn credentials for the account.
 	WebAuthnCredentials *WebAuthnCredentialConnection `json:"webAuthnCredentials"`
+	// The personal access tokens for the account.
+	PersonalAccessTokens *PersonalAccessTokenConnection `json:"personalAccessTokens"`
+	// The social login identities linked to the account.
+	OauthIdentities *OAuthIdentityConnection `json:"oauthIdentities"`
 }
 
 func (Account) IsNode() {}
@@ -245,6 +304,10 @@ func (Account) IsDisableAccount2FAWithAuthenticatorPayload() {}
 
 func (Account) IsLoginWithPasskeyPayload() {}
 
+func (Account) IsLoginWithEmailCodePayload() {}
+
+func (Account) IsLoginWithSmsCodePayload() {}
+
 func (Account) IsLoginWithPasswordPayload() {}
 
 func (Account) IsVerify2FAWithAuthenticatorPayload() {}
@@ -298,6 +361,30 @@ func (AuthenticatorNotEnabledError) IsVerify2FAWithAuthenticatorPayload() {}
 
 func (AuthenticatorNotEnabledError) IsRequestSudoModeWithAuthenticatorPayload() {}
 
+// The access and refresh tokens of a native client's session.
+type BearerTokens struct {
+	// The access token, sent in an `Authorization: Bearer` header.
+	AccessToken string `json:"accessToken"`
+	// When the access token expires.
+	AccessTokenExpiresAt string `json:"accessTokenExpiresAt"`
+	// The refresh token, exchanged once for new tokens with refreshBearerTokens.
+	RefreshToken string `json:"refreshToken"`
+	// When the refresh token expires, at the latest along with the session.
+	RefreshTokenExpiresAt string `json:"refreshTokenExpiresAt"`
+}
+
+func (BearerTokens) IsRefreshBearerTokensPayload() {}
+
+// Create personal access token success.
+type CreatePersonalAccessTokenSuccess struct {
+	// The token, sent in an `Authorization: Bearer` header. It is shown only once.
+	Token string `json:"token"`
+	// The created personal access token edge.
+	PersonalAccessTokenEdge *PersonalAccessTokenEdge `json:"personalAccessTokenEdge"`
+}
+
+func (CreatePersonalAccessTokenSuccess) IsCreatePersonalAccessTokenPayload() {}
+
 // The payload for creating a presigned URL.
 type CreatePresignedURLPayloadType struct {
 	// The presigned URL.
@@ -351,6 +438,8 @@ func (EmailInUseError) IsRequestEmailVerificationTokenPayload() {}
 
 func (EmailInUseError) IsVerifyEmailPayload() {}
 
+func (EmailInUseError) IsVerifyGoogleTokenPayload() {}
+
 func (EmailInUseError) IsRegisterWithPasskeyPayload() {}
 
 func (EmailInUseError) IsRegisterWithPasswordPayload() {}
@@ -436,6 +525,21 @@ func (InsufficientAuthProvidersError) IsError() {}
 // Human readable error message.
 func (this InsufficientAuthProvidersError) GetMessage() string { return this.Message }
 
+func (InsufficientAuthProvidersError) IsUnlinkOAuthIdentityPayload() {}
+
+// Used when an invalid or expired account unlock token is provided.
+type InvalidAccountUnlockTokenError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (InvalidAccountUnlockTokenError) IsError() {}
+
+// Human readable error message.
+func (this InvalidAccountUnlockTokenError) GetMessage() string { return this.Message }
+
+func (InvalidAccountUnlockTokenError) IsUnlockAccountPayload() {}
+
 // Used when an invalid authentication provider is used.
 type InvalidAuthenticationProviderError struct {
 	// Human readable error message.
@@ -465,6 +569,10 @@ func (InvalidCaptchaTokenError) IsGeneratePasskeyRegistrationOptionsPayload() {}
 
 func (InvalidCaptchaTokenError) IsLoginWithPasskeyPayload() {}
 
+func (InvalidCaptchaTokenError) IsLoginWithEmailCodePayload() {}
+
+func (InvalidCaptchaTokenError) IsLoginWithSmsCodePayload() {}
+
 func (InvalidCaptchaTokenError) IsLoginWithPasswordPayload() {}
 
 func (InvalidCaptchaTokenError) IsRequestEmailVerificationTokenPayload() {}
@@ -479,6 +587,10 @@ func (InvalidCaptchaTokenError) IsVerify2FAWithRecoveryCodePayload() {}
 
 func (InvalidCaptchaTokenError) IsVerifyEmailPayload() {}
 
+func (InvalidCaptchaTokenError) IsRequestEmailLoginCodePayload() {}
+
+func (InvalidCaptchaTokenError) IsRequestSmsLoginCodePayload() {}
+
 func (InvalidCaptchaTokenError) IsRequestPasswordResetPayload() {}
 
 func (InvalidCaptchaTokenError) IsRequestSudoModeWithAuthenticatorPayload() {}
@@ -538,6 +650,19 @@ func (InvalidEmailError) IsRequestEmailVerificationTokenPayload() {}
 
 func (InvalidEmailError) IsVerifyGoogleTokenPayload() {}
 
+// Used when an invalid or expired email login code is provided.
+type InvalidEmailLoginCodeError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (InvalidEmailLoginCodeError) IsError() {}
+
+// Human readable error message.
+func (this InvalidEmailLoginCodeError) GetMessage() string { return this.Message }
+
+func (InvalidEmailLoginCodeError) IsLoginWithEmailCodePayload() {}
+
 // Used when an invalid email verification token is provided.
 type InvalidEmailVerificationTokenError struct {
 	// Human readable error message.
@@ -604,6 +729,19 @@ func (InvalidPasswordResetTokenError) IsVerify2FAPasswordResetWithPasskeyPayload
 
 func (InvalidPasswordResetTokenError) IsResetPasswordPayload() {}
 
+// Used when the name, scopes or expiry of a new personal access token are invalid.
+type InvalidPersonalAccessTokenError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (InvalidPersonalAccessTokenError) IsError() {}
+
+// Human readable error message.
+func (this InvalidPersonalAccessTokenError) GetMessage() string { return this.Message }
+
+func (InvalidPersonalAccessTokenError) IsCreatePersonalAccessTokenPayload() {}
+
 type InvalidPhoneNumberError struct {
 	// Human readable error message.
 	Message string `json:"message"`
@@ -618,6 +756,8 @@ func (InvalidPhoneNumberError) IsRequestPhoneNumberVerificationTokenPayload() {}
 
 func (InvalidPhoneNumberError) IsUpdateAccountPhoneNumberPayload() {}
 
+func (InvalidPhoneNumberError) IsRequestSmsLoginCodePayload() {}
+
 type InvalidPhoneNumberVerificationTokenError struct {
 	// Human readable error message.
 	Message string `json:"message"`
@@ -630,6 +770,54 @@ func (this InvalidPhoneNumberVerificationTokenError) GetMessage() string { retur
 
 func (InvalidPhoneNumberVerificationTokenError) IsUpdateAccountPhoneNumberPayload() {}
 
+// Used when an invalid or expired refresh token is provided, or a used one was presented again.
+type InvalidRefreshTokenError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (InvalidRefreshTokenError) IsError() {}
+
+// Human readable error message.
+func (this InvalidRefreshTokenError) GetMessage() string { return this.Message }
+
+func (InvalidRefreshTokenError) IsRefreshBearerTokensPayload() {}
+
+// Used when an invalid or expired session revocation token is provided.
+type InvalidSessionRevocationTokenError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (InvalidSessionRevocationTokenError) IsError() {}
+
+// Human readable error message.
+func (this InvalidSessionRevocationTokenError) GetMessage() string { return this.Message }
+
+func (InvalidSessionRevocationTokenError) IsRevokeSessionsPayload() {}
+
+// Used when an invalid or expired SMS login code is provided.
+type InvalidSmsLoginCodeError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (InvalidSmsLoginCodeError) IsError() {}
+
+// Human readable error message.
+func (this InvalidSmsLoginCodeError) GetMessage() string { return this.Message }
+
+func (InvalidSmsLoginCodeError) IsLoginWithSmsCodePayload() {}
+
+// Link OAuth identity success.
+type LinkOAuthIdentitySuccess struct {
+	// The provider's sign in URL to redirect the user to.
+	// The identity is linked once the user is redirected back from the provider.
+	AuthorizationURL string `json:"authorizationUrl"`
+}
+
+func (LinkOAuthIdentitySuccess) IsLinkOAuthIdentityPayload() {}
+
 // The logout payload.
 type LogoutPayload struct {
 	// Human readable success message.
@@ -651,6 +839,63 @@ func (NotAuthenticatedError) IsError() {}
 // Human readable error message.
 func (this NotAuthenticatedError) GetMessage() string { return this.Message }
 
+// A social login identity linked to an account.
+type OAuthIdentity struct {
+	// The Globally Unique ID of this object
+	ID string `json:"id"`
+	// The name of the social login provider.
+	Provider string `json:"provider"`
+	// When the identity was linked.
+	CreatedAt string `json:"createdAt"`
+}
+
+func (OAuthIdentity) IsNode() {}
+
+// The Globally Unique ID of this object
+func (this OAuthIdentity) GetID() string { return this.ID }
+
+type OAuthIdentityConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// A list of edges.
+	Edges []*OAuthIdentityEdge `json:"edges"`
+	// The total number of items in the connection.
+	TotalCount *int32 `json:"totalCount,omitempty"`
+}
+
+type OAuthIdentityEdge struct {
+	// A cursor for use in pagination
+	Cursor string `json:"cursor"`
+	// The item at the end of the edge
+	Node *OAuthIdentity `json:"node"`
+}
+
+// Used when the OAuth identity is not found.
+type OAuthIdentityNotFoundError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (OAuthIdentityNotFoundError) IsError() {}
+
+// Human readable error message.
+func (this OAuthIdentityNotFoundError) GetMessage() string { return this.Message }
+
+func (OAuthIdentityNotFoundError) IsUnlinkOAuthIdentityPayload() {}
+
+// Used when the social login provider is not configured.
+type OAuthProviderNotSupportedError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (OAuthProviderNotSupportedError) IsLinkOAuthIdentityPayload() {}
+
+func (OAuthProviderNotSupportedError) IsError() {}
+
+// Human readable error message.
+func (this OAuthProviderNotSupportedError) GetMessage() string { return this.Message }
+
 // Information to aid in pagination.
 type PageInfo struct {
 	// When paginating forwards, are there more items?
@@ -680,6 +925,21 @@ func (PasswordNotStrongError) IsRegisterWithPasswordPayload() {}
 
 func (PasswordNotStrongError) IsUpdatePasswordPayload() {}
 
+// Used when the new password is the current password or one of the recently used passwords.
+type PasswordPreviouslyUsedError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (PasswordPreviouslyUsedError) IsError() {}
+
+// Human readable error message.
+func (this PasswordPreviouslyUsedError) GetMessage() string { return this.Message }
+
+func (PasswordPreviouslyUsedError) IsResetPasswordPayload() {}
+
+func (PasswordPreviouslyUsedError) IsUpdatePasswordPayload() {}
+
 // A password reset token.
 type PasswordResetToken struct {
 	// The Globally Unique ID of this object
@@ -732,6 +992,56 @@ func (this PasswordResetTokenNotFoundError) GetMessage() string { return this.Me
 
 func (PasswordResetTokenNotFoundError) IsPasswordResetTokenPayload() {}
 
+// A personal access token, letting scripts and CI jobs call the API as the account.
+type PersonalAccessToken struct {
+	// The Globally Unique ID of this object
+	ID string `json:"id"`
+	// The name telling what the token is used for.
+	Name string `json:"name"`
+	// What the token is allowed to do.
+	Scopes []PersonalAccessTokenScope `json:"scopes"`
+	// When the token expires. Null if it never expires.
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	// When the token was last used. Recorded at most once every few minutes, null if never used.
+	LastUsedAt *string `json:"lastUsedAt,omitempty"`
+	// When the token was created.
+	CreatedAt string `json:"createdAt"`
+}
+
+func (PersonalAccessToken) IsNode() {}
+
+// The Globally Unique ID of this object
+func (this PersonalAccessToken) GetID() string { return this.ID }
+
+type PersonalAccessTokenConnection struct {
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// A list of edges.
+	Edges []*PersonalAccessTokenEdge `json:"edges"`
+	// The total number of items in the connection.
+	TotalCount *int32 `json:"totalCount,omitempty"`
+}
+
+type PersonalAccessTokenEdge struct {
+	// A cursor for use in pagination
+	Cursor string `json:"cursor"`
+	// The item at the end of the edge
+	Node *PersonalAccessToken `json:"node"`
+}
+
+// Used when the personal access token is not found.
+type PersonalAccessTokenNotFoundError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+}
+
+func (PersonalAccessTokenNotFoundError) IsError() {}
+
+// Human readable error message.
+func (this PersonalAccessTokenNotFoundError) GetMessage() string { return this.Message }
+
+func (PersonalAccessTokenNotFoundError) IsRevokePersonalAccessTokenPayload() {}
+
 type PhoneNumberAlreadyExistsError struct {
 	// Human readable error message.
 	Message string `json:"message"`
@@ -783,6 +1093,14 @@ func (PhoneNumberVerificationTokenCooldownError) IsRequestPhoneNumberVerificatio
 type Query struct {
 }
 
+// Request email login code success.
+type RequestEmailLoginCodeSuccess struct {
+	// Human readable success message.
+	Message string `json:"message"`
+}
+
+func (RequestEmailLoginCodeSuccess) IsRequestEmailLoginCodePayload() {}
+
 // Request email verification success.
 type RequestEmailVerificationSuccess struct {
 	// Human readable error message.
@@ -815,6 +1133,30 @@ func (RequestPhoneNumberVerificationTokenSuccess) IsError() {}
 // Human readable error message.
 func (this RequestPhoneNumberVerificationTokenSuccess) GetMessage() string { return this.Message }
 
+// Request SMS login code success.
+type RequestSmsLoginCodeSuccess struct {
+	// Human readable success message.
+	Message string `json:"message"`
+}
+
+func (RequestSmsLoginCodeSuccess) IsRequestSmsLoginCodePayload() {}
+
+// Revoke personal access token success.
+type RevokePersonalAccessTokenSuccess struct {
+	// The revoked personal access token edge.
+	PersonalAccessTokenEdge *PersonalAccessTokenEdge `json:"personalAccessTokenEdge"`
+}
+
+func (RevokePersonalAccessTokenSuccess) IsRevokePersonalAccessTokenPayload() {}
+
+// Revoke sessions success.
+type RevokeSessionsSuccess struct {
+	// Human readable success message.
+	Message string `json:"message"`
+}
+
+func (RevokeSessionsSuccess) IsRevokeSessionsPayload() {}
+
 // An account's session.
 type Session struct {
 	// The Globally Unique ID of this object
@@ -825,6 +1167,14 @@ type Session struct {
 	IPAddress string `json:"ipAddress"`
 	// When the session was created.
 	CreatedAt string `json:"createdAt"`
+	// When the session was last used. Recorded at most once every few minutes.
+	LastActiveAt string `json:"lastActiveAt"`
+	// Whether this is the session making the request.
+	IsCurrent bool `json:"isCurrent"`
+	// The device the session was created from, parsed from its user agent.
+	Device *SessionDevice `json:"device"`
+	// The approximate location the session was created from, resolved from its IP address. Null if unknown.
+	Location *SessionLocation `json:"location,omitempty"`
 }
 
 func (Session) IsNode() {}
@@ -841,6 +1191,16 @@ type SessionConnection struct {
 	TotalCount *int32 `json:"totalCount,omitempty"`
 }
 
+// The device a session was created from.
+type SessionDevice struct {
+	// Browser name and major version, such as "Chrome 120". Null if unknown.
+	Browser *string `json:"browser,omitempty"`
+	// Operating system name, with its version when known, such as "Android 14". Null if unknown.
+	Os *string `json:"os,omitempty"`
+	// The type of device.
+	Type DeviceType `json:"type"`
+}
+
 type SessionEdge struct {
 	// A cursor for use in pagination
 	Cursor string `json:"cursor"`
@@ -848,6 +1208,16 @@ type SessionEdge struct {
 	Node *Session `json:"node"`
 }
 
+// The approximate location a session was created from.
+type SessionLocation struct {
+	// City name. Null if only the country is known.
+	City *string `json:"city,omitempty"`
+	// Country name.
+	Country string `json:"country"`
+	// ISO 3166-1 alpha-2 country code.
+	CountryCode string `json:"countryCode"`
+}
+
 // Used when the session is not found.
 type SessionNotFoundError struct {
 	// Human readable error message.
@@ -868,6 +1238,35 @@ type TermsAndPolicy struct {
 	IsLatest  bool               `json:"isLatest"`
 }
 
+// Used when too many failed attempts were made against the account or from the IP address.
+type TooManyAttemptsError struct {
+	// Human readable error message.
+	Message string `json:"message"`
+	// The number of seconds until another attempt is allowed.
+	RetryAfterSeconds int32 `json:"retryAfterSeconds"`
+}
+
+func (TooManyAttemptsError) IsLoginWithEmailCodePayload() {}
+
+func (TooManyAttemptsError) IsLoginWithSmsCodePayload() {}
+
+func (TooManyAttemptsError) IsLoginWithPasswordPayload() {}
+
+func (TooManyAttemptsError) IsError() {}
+
+// Human readable error message.
+func (this TooManyAttemptsError) GetMessage() string { return this.Message }
+
+func (TooManyAttemptsError) IsVerify2FAPasswordResetWithAuthenticatorPayload() {}
+
+func (TooManyAttemptsError) IsVerify2FAWithAuthenticatorPayload() {}
+
+func (TooManyAttemptsError) IsVerify2FAWithRecoveryCodePayload() {}
+
+func (TooManyAttemptsError) IsRequestSudoModeWithAuthenticatorPayload() {}
+
+func (TooManyAttemptsError) IsRequestSudoModeWithPasswordPayload() {}
+
 // Used when the 2FA challenge is not found.
 type TwoFactorAuthenticationChallengeNotFoundError struct {
 	// Human readable error message.
@@ -910,6 +1309,10 @@ type TwoFactorAuthenticationRequiredError struct {
 	Message string `json:"message"`
 }
 
+func (TwoFactorAuthenticationRequiredError) IsLoginWithEmailCodePayload() {}
+
+func (TwoFactorAuthenticationRequiredError) IsLoginWithSmsCodePayload() {}
+
 func (TwoFactorAuthenticationRequiredError) IsLoginWithPasswordPayload() {}
 
 func (TwoFactorAuthenticationRequiredError) IsError() {}
@@ -921,6 +1324,22 @@ func (TwoFactorAuthenticationRequiredError) IsVerifyGoogleTokenPayload() {}
 
 func (TwoFactorAuthenticationRequiredError) IsRequestSudoModeWithPasswordPayload() {}
 
+// Unlink OAuth identity success.
+type UnlinkOAuthIdentitySuccess struct {
+	// The unlinked OAuth identity edge.
+	OauthIdentityEdge *OAuthIdentityEdge `json:"oauthIdentityEdge"`
+}
+
+func (UnlinkOAuthIdentitySuccess) IsUnlinkOAuthIdentityPayload() {}
+
+// Unlock account success.
+type UnlockAccountSuccess struct {
+	// Human readable success message.
+	Message string `json:"message"`
+}
+
+func (UnlockAccountSuccess) IsUnlockAccountPayload() {}
+
 // Verify email success.
 type VerifyEmailSuccess struct {
 	// Human readable success message.
@@ -1117,17 +1536,23 @@ const (
 	AuthProviderPassword           AuthProvider = "PASSWORD"
 	AuthProviderWebauthnCredential AuthProvider = "WEBAUTHN_CREDENTIAL"
 	AuthProviderOauthGoogle        AuthProvider = "OAUTH_GOOGLE"
+	AuthProviderOauthGithub        AuthProvider = "OAUTH_GITHUB"
+	AuthProviderOauthMicrosoft     AuthProvider = "OAUTH_MICROSOFT"
+	AuthProviderOauthGitlab        AuthProvider = "OAUTH_GITLAB"
 )
 
 var AllAuthProvider = []AuthProvider{
 	AuthProviderPassword,
 	AuthProviderWebauthnCredential,
 	AuthProviderOauthGoogle,
+	AuthProviderOauthGithub,
+	AuthProviderOauthMicrosoft,
+	AuthProviderOauthGitlab,
 }
 
 func (e AuthProvider) IsValid() bool {
 	switch e {
-	case AuthProviderPassword, AuthProviderWebauthnCredential, AuthProviderOauthGoogle:
+	case AuthProviderPassword, AuthProviderWebauthnCredential, AuthProviderOauthGoogle, AuthProviderOauthGithub, AuthProviderOauthMicrosoft, AuthProviderOauthGitlab:
 		return true
 	}
 	return false
@@ -1168,6 +1593,187 @@ func (e AuthProvider) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// The type of device a session was created from.
+type DeviceType string
+
+const (
+	DeviceTypeDesktop DeviceType = "DESKTOP"
+	DeviceTypeMobile  DeviceType = "MOBILE"
+	DeviceTypeTablet  DeviceType = "TABLET"
+	DeviceTypeBot     DeviceType = "BOT"
+	DeviceTypeUnknown DeviceType = "UNKNOWN"
+)
+
+var AllDeviceType = []DeviceType{
+	DeviceTypeDesktop,
+	DeviceTypeMobile,
+	DeviceTypeTablet,
+	DeviceTypeBot,
+	DeviceTypeUnknown,
+}
+
+func (e DeviceType) IsValid() bool {
+	switch e {
+	case DeviceTypeDesktop, DeviceTypeMobile, DeviceTypeTablet, DeviceTypeBot, DeviceTypeUnknown:
+		return true
+	}
+	return false
+}
+
+func (e DeviceType) String() string {
+	return string(e)
+}
+
+func (e *DeviceType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeviceType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeviceType", str)
+	}
+	return nil
+}
+
+func (e DeviceType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DeviceType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DeviceType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// What a personal access token is allowed to do.
+type PersonalAccessTokenScope string
+
+const (
+	// Read the account.
+	PersonalAccessTokenScopeReadAccount PersonalAccessTokenScope = "READ_ACCOUNT"
+	// Update the account's profile.
+	PersonalAccessTokenScopeWriteAccount PersonalAccessTokenScope = "WRITE_ACCOUNT"
+)
+
+var AllPersonalAccessTokenScope = []PersonalAccessTokenScope{
+	PersonalAccessTokenScopeReadAccount,
+	PersonalAccessTokenScopeWriteAccount,
+}
+
+func (e PersonalAccessTokenScope) IsValid() bool {
+	switch e {
+	case PersonalAccessTokenScopeReadAccount, PersonalAccessTokenScopeWriteAccount:
+		return true
+	}
+	return false
+}
+
+func (e PersonalAccessTokenScope) String() string {
+	return string(e)
+}
+
+func (e *PersonalAccessTokenScope) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PersonalAccessTokenScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PersonalAccessTokenScope", str)
+	}
+	return nil
+}
+
+func (e PersonalAccessTokenScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PersonalAccessTokenScope) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PersonalAccessTokenScope) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// What requests are counted together by @rateLimit.
+type RateLimitKey string
+
+const (
+	// The client's IP address.
+	RateLimitKeyIP RateLimitKey = "IP"
+	// The authenticated account, falling back to the IP address of anonymous requests.
+	RateLimitKeyAccount RateLimitKey = "ACCOUNT"
+	// The value of the field argument named by `arg`.
+	RateLimitKeyArg RateLimitKey = "ARG"
+)
+
+var AllRateLimitKey = []RateLimitKey{
+	RateLimitKeyIP,
+	RateLimitKeyAccount,
+	RateLimitKeyArg,
+}
+
+func (e RateLimitKey) IsValid() bool {
+	switch e {
+	case RateLimitKeyIP, RateLimitKeyAccount, RateLimitKeyArg:
+		return true
+	}
+	return false
+}
+
+func (e RateLimitKey) String() string {
+	return string(e)
+}
+
+func (e *RateLimitKey) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RateLimitKey(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RateLimitKey", str)
+	}
+	return nil
+}
+
+func (e RateLimitKey) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RateLimitKey) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RateLimitKey) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // The terms and policy type.
 type TermsAndPolicyType string
 
@@ -7,36 +7,249 @@ package resolver
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"server/graph/generated"
 	"server/graph/model"
+	"server/internal/domain/account"
+	httpmiddleware "server/internal/http/middleware"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// SudoModeExpiresAt is the resolver for the sudoModeExpiresAt field.
+func (r *accountResolver) SudoModeExpiresAt(ctx context.Context, obj *model.Account) (*string, error) {
+	session, ok := httpmiddleware.GetViewerSession(ctx)
+	if !ok || !session.HasSudoMode() {
+		return nil, nil
+	}
+
+	accountID, err := fromGlobalID("Account", obj.ID)
+	if err != nil || accountID != session.AccountId {
+		return nil, nil
+	}
+
+	sudoModeExpiresAt := formatTime(*session.SudoModeExpiresAt)
+	return &sudoModeExpiresAt, nil
+}
+
+// CurrentSession is the resolver for the currentSession field.
+func (r *accountResolver) CurrentSession(ctx context.Context, obj *model.Account) (*model.Session, error) {
+	session, err := viewerSessionForAccount(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionToModel(session), nil
+}
+
+// Sessions is the resolver for the sessions field.
+func (r *accountResolver) Sessions(ctx context.Context, obj *model.Account, before *string, after *string, first *int32, last *int32) (*model.SessionConnection, error) {
+	session, err := viewerSessionForAccount(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.authService.GetSessions(ctx, session.AccountId, intFromInt32(first), intFromInt32(last), before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionConnectionToModel(result), nil
+}
+
+// WebAuthnCredentials is the resolver for the webAuthnCredentials field.
+func (r *accountResolver) WebAuthnCredentials(ctx context.Context, obj *model.Account, before *string, after *string, first *int32, last *int32) (*model.WebAuthnCredentialConnection, error) {
+	session, err := viewerSessionForAccount(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.authService.GetWebAuthnCredentials(ctx, session.AccountId, intFromInt32(first), intFromInt32(last), before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return webAuthnCredentialConnectionToModel(result), nil
+}
+
+// PersonalAccessTokens is the resolver for the personalAccessTokens field.
+func (r *accountResolver) PersonalAccessTokens(ctx context.Context, obj *model.Account, before *string, after *string, first *int32, last *int32) (*model.PersonalAccessTokenConnection, error) {
+	session, err := viewerSessionForAccount(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.authService.GetPersonalAccessTokens(ctx, session.AccountId, intFromInt32(first), intFromInt32(last), before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return personalAccessTokenConnectionToModel(result), nil
+}
+
+// OauthIdentities is the resolver for the oauthIdentities field.
+func (r *accountResolver) OauthIdentities(ctx context.Context, obj *model.Account, before *string, after *string, first *int32, last *int32) (*model.OAuthIdentityConnection, error) {
+	session, err := viewerSessionForAccount(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.authService.GetOAuthIdentities(ctx, session.AccountId, intFromInt32(first), intFromInt32(last), before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return oauthIdentityConnectionToModel(result), nil
+}
+
 // UpdateAccount is the resolver for the updateAccount field.
 func (r *mutationResolver) UpdateAccount(ctx context.Context, fullName string, avatarURL *string) (model.UpdateAccountPayload, error) {
-	panic(fmt.Errorf("not implemented: UpdateAccount - updateAccount"))
+	viewer, err := viewerAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAccount, err := r.accountService.UpdateAccountFullName(ctx, viewer.ID, fullName)
+	if err != nil {
+		if errors.Is(err, account.ErrInvalidFullName) {
+			return nil, gqlerror.Errorf("%s", account.MsgFullNameRequired)
+		}
+		return nil, err
+	}
+
+	if avatarURL != nil {
+		updatedAccount, err = r.accountService.SetAccountAvatarURL(ctx, viewer.ID, *avatarURL)
+		if err != nil {
+			if errors.Is(err, account.ErrInvalidInput) {
+				return nil, gqlerror.Errorf("%s", err.Error())
+			}
+			return nil, err
+		}
+	}
+
+	return accountToModel(updatedAccount), nil
 }
 
 // RequestPhoneNumberVerificationToken is the resolver for the requestPhoneNumberVerificationToken field.
 func (r *mutationResolver) RequestPhoneNumberVerificationToken(ctx context.Context, phoneNumber string) (model.RequestPhoneNumberVerificationTokenPayload, error) {
-	panic(fmt.Errorf("not implemented: RequestPhoneNumberVerificationToken - requestPhoneNumberVerificationToken"))
+	_, err := r.accountService.GetAccountByPhoneNumber(ctx, phoneNumber)
+	switch {
+	case err == nil:
+		return &model.PhoneNumberAlreadyExistsError{Message: account.MsgPhoneNumberAlreadyExists}, nil
+	case errors.Is(err, account.ErrInvalidPhoneNumber):
+		return &model.InvalidPhoneNumberError{Message: account.MsgInvalidPhoneNumberFormat}, nil
+	case !errors.Is(err, account.ErrAccountNotFound):
+		return nil, err
+	}
+
+	if err := r.accountService.CreatePhoneVerificationToken(ctx, phoneNumber); err != nil {
+		var cooldownErr *account.CooldownError
+		switch {
+		case errors.Is(err, account.ErrSMSCountryNotAllowed), errors.Is(err, account.ErrSMSNumberTypeNotAllowed):
+			return &model.InvalidPhoneNumberError{Message: account.MsgPhoneNumberNotSupported}, nil
+		case errors.Is(err, account.ErrInvalidPhoneNumber):
+			return &model.InvalidPhoneNumberError{Message: account.MsgInvalidPhoneNumberFormat}, nil
+		case errors.As(err, &cooldownErr):
+			return &model.PhoneNumberVerificationTokenCooldownError{
+				Message:          account.MsgPhoneVerificationCooldown,
+				RemainingSeconds: int32(cooldownErr.RemainingSeconds),
+			}, nil
+		case errors.Is(err, account.ErrSMSBudgetExceeded):
+			return nil, gqlerror.Errorf("%s", account.MsgSMSBudgetExceeded)
+		}
+		return nil, err
+	}
+
+	return &model.RequestPhoneNumberVerificationTokenSuccess{
+		Message:                  "Phone number verification token sent successfully",
+		CooldownRemainingSeconds: int32(account.SMSTokenCooldown.Seconds()),
+	}, nil
 }
 
 // UpdateAccountPhoneNumber is the resolver for the updateAccountPhoneNumber field.
 func (r *mutationResolver) UpdateAccountPhoneNumber(ctx context.Context, phoneNumber string, phoneNumberVerificationToken string) (model.UpdateAccountPhoneNumberPayload, error) {
-	panic(fmt.Errorf("not implemented: UpdateAccountPhoneNumber - updateAccountPhoneNumber"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.accountService.VerifyPhoneNumber(ctx, phoneNumber, phoneNumberVerificationToken); err != nil {
+		switch {
+		case errors.Is(err, account.ErrInvalidPhoneNumber):
+			return &model.InvalidPhoneNumberError{Message: account.MsgInvalidPhoneNumberFormat}, nil
+		case errors.Is(err, account.ErrTokenExpired):
+			return &model.InvalidPhoneNumberVerificationTokenError{Message: account.MsgVerificationTokenExpired}, nil
+		case errors.Is(err, account.ErrInvalidVerificationToken):
+			return &model.InvalidPhoneNumberVerificationTokenError{Message: account.MsgVerificationTokenInvalid}, nil
+		}
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	updatedAccount, err := r.accountService.UpdateAccountPhoneNumber(ctx, session.AccountId, phoneNumber, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		switch {
+		case errors.Is(err, account.ErrInvalidPhoneNumber):
+			return &model.InvalidPhoneNumberError{Message: account.MsgInvalidPhoneNumberFormat}, nil
+		case errors.Is(err, account.ErrPhoneAlreadyExists):
+			return &model.InvalidPhoneNumberError{Message: account.MsgPhoneNumberAlreadyExists}, nil
+		}
+		return nil, err
+	}
+
+	return accountToModel(updatedAccount), nil
 }
 
 // RemoveAccountPhoneNumber is the resolver for the removeAccountPhoneNumber field.
 func (r *mutationResolver) RemoveAccountPhoneNumber(ctx context.Context) (model.RemoveAccountPhoneNumberPayload, error) {
-	panic(fmt.Errorf("not implemented: RemoveAccountPhoneNumber - removeAccountPhoneNumber"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	updatedAccount, err := r.accountService.RemoveAccountPhoneNumber(ctx, session.AccountId, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		if errors.Is(err, account.ErrPhoneNumberMissing) {
+			return &model.PhoneNumberDoesNotExistError{Message: account.MsgPhoneNumberMissing}, nil
+		}
+		return nil, err
+	}
+
+	return accountToModel(updatedAccount), nil
 }
 
 // UpdateAccountAnalyticsPreference is the resolver for the updateAccountAnalyticsPreference field.
 func (r *mutationResolver) UpdateAccountAnalyticsPreference(ctx context.Context, analyticsPreference model.AnalyticsPreferenceInputType) (*model.Account, error) {
-	panic(fmt.Errorf("not implemented: UpdateAccountAnalyticsPreference - updateAccountAnalyticsPreference"))
+	viewer, err := viewerAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAccount, err := r.accountService.UpdateAccountAnalyticsPreference(ctx, viewer.ID, analyticsPreferenceFromModel(analyticsPreference))
+	if err != nil {
+		return nil, err
+	}
+
+	return accountToModel(updatedAccount), nil
 }
 
 // RemoveAccountAvatar is the resolver for the removeAccountAvatar field.
 func (r *mutationResolver) RemoveAccountAvatar(ctx context.Context) (*model.Account, error) {
-	panic(fmt.Errorf("not implemented: RemoveAccountAvatar - removeAccountAvatar"))
+	viewer, err := viewerAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAccount, err := r.accountService.RemoveAccountAvatar(ctx, viewer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountToModel(updatedAccount), nil
 }
+
+// Account returns generated.AccountResolver implementation.
+func (r *Resolver) Account() generated.AccountResolver { return &accountResolver{r} }
+
+type accountResolver struct{ *Resolver }
@@ -7,31 +7,54 @@ package resolver
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"server/graph/generated"
 	"server/graph/model"
+	"server/internal/domain/account"
+	"server/internal/domain/auth"
+	httpmiddleware "server/internal/http/middleware"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
-// verifyCaptchaToken verifies a captcha token and returns a message if verification fails
-func (r *mutationResolver) verifyCaptchaToken(ctx context.Context, captchaToken string) (bool, string) {
-	if captchaToken == "" {
-		return false, "Captcha token is required"
+// RequestEmailVerificationToken is the resolver for the requestEmailVerificationToken field.
+func (r *mutationResolver) RequestEmailVerificationToken(ctx context.Context, email string, captchaToken string) (model.RequestEmailVerificationTokenPayload, error) {
+	// Verify captcha token first
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
 	}
 
-	valid, err := r.captchaVerifier.VerifyToken(ctx, captchaToken)
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	remainingSeconds, err := r.authService.RequestEmailVerificationToken(ctx, email, requestInfo.UserAgent)
 	if err != nil {
-		return false, "Captcha verification failed"
-	}
-
-	if !valid {
-		return false, "Invalid captcha token"
+		var cooldownErr *auth.CooldownError
+		switch {
+		case errors.Is(err, auth.ErrInvalidEmail):
+			return &model.InvalidEmailError{Message: auth.MsgInvalidEmail}, nil
+		case errors.Is(err, auth.ErrEmailAlreadyExists):
+			return &model.EmailInUseError{Message: auth.MsgEmailAlreadyExists}, nil
+		case errors.As(err, &cooldownErr):
+			return &model.EmailVerificationTokenCooldownError{
+				Message:          auth.MsgEmailCooldown,
+				RemainingSeconds: int32(cooldownErr.RemainingSeconds),
+			}, nil
+		}
+		return nil, err
 	}
 
-	return true, ""
+	return &model.RequestEmailVerificationSuccess{
+		Message:          "Email verification token sent successfully",
+		RemainingSeconds: int32(remainingSeconds),
+	}, nil
 }
 
-// RequestEmailVerificationToken is the resolver for the requestEmailVerificationToken field.
-func (r *mutationResolver) RequestEmailVerificationToken(ctx context.Context, email string, captchaToken string) (model.RequestEmailVerificationTokenPayload, error) {
-	// Verify captcha token first
+// VerifyEmail is the resolver for the verifyEmail field.
+func (r *mutationResolver) VerifyEmail(ctx context.Context, email string, emailVerificationToken string, captchaToken string) (model.VerifyEmailPayload, error) {
 	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
 	if !valid {
 		return &model.InvalidCaptchaTokenError{
@@ -39,47 +62,182 @@ func (r *mutationResolver) RequestEmailVerificationToken(ctx context.Context, em
 		}, nil
 	}
 
-	// TODO: Implement the actual email verification token request logic
-	panic(fmt.Errorf("not implemented: RequestEmailVerificationToken - requestEmailVerificationToken"))
-}
+	if err := r.authService.VerifyEmail(ctx, email, emailVerificationToken); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrEmailAlreadyExists):
+			return &model.EmailInUseError{Message: auth.MsgEmailAlreadyExists}, nil
+		case errors.Is(err, auth.ErrInvalidEmail), errors.Is(err, auth.ErrInvalidOrExpiredToken):
+			return &model.InvalidEmailVerificationTokenError{Message: auth.MsgInvalidToken}, nil
+		}
+		return nil, err
+	}
 
-// VerifyEmail is the resolver for the verifyEmail field.
-func (r *mutationResolver) VerifyEmail(ctx context.Context, email string, emailVerificationToken string, captchaToken string) (model.VerifyEmailPayload, error) {
-	panic(fmt.Errorf("not implemented: VerifyEmail - verifyEmail"))
+	return &model.VerifyEmailSuccess{
+		Message: "Email verified successfully",
+	}, nil
 }
 
 // RegisterWithPassword is the resolver for the registerWithPassword field.
 func (r *mutationResolver) RegisterWithPassword(ctx context.Context, email string, emailVerificationToken string, password string, fullName string, captchaToken string) (model.RegisterWithPasswordPayload, error) {
-	panic(fmt.Errorf("not implemented: RegisterWithPassword - registerWithPassword"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	createdAccount, sessionToken, err := r.authService.RegisterWithPassword(ctx, email, emailVerificationToken, password, fullName, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		var validationErr *auth.ValidationError
+		var policyErr *auth.PasswordPolicyError
+		switch {
+		case errors.Is(err, auth.ErrEmailAlreadyExists):
+			return &model.EmailInUseError{Message: auth.MsgEmailAlreadyExists}, nil
+		case errors.Is(err, auth.ErrInvalidEmail), errors.Is(err, auth.ErrInvalidOrExpiredToken):
+			return &model.InvalidEmailVerificationTokenError{Message: auth.MsgInvalidToken}, nil
+		case errors.As(err, &policyErr):
+			return &model.PasswordNotStrongError{Message: policyErr.Reason}, nil
+		case errors.As(err, &validationErr):
+			return nil, gqlerror.Errorf("%s", validationErr.Message)
+		}
+		return nil, err
+	}
+
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(createdAccount), nil
 }
 
 // GeneratePasskeyRegistrationOptions is the resolver for the generatePasskeyRegistrationOptions field.
 func (r *mutationResolver) GeneratePasskeyRegistrationOptions(ctx context.Context, email string, fullName string, captchaToken string) (model.GeneratePasskeyRegistrationOptionsPayload, error) {
-	panic(fmt.Errorf("not implemented: GeneratePasskeyRegistrationOptions - generatePasskeyRegistrationOptions"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	registrationOptions, err := r.authService.GeneratePasskeyRegistrationOptions(ctx, email, fullName)
+	if err != nil {
+		if errors.Is(err, auth.ErrEmailAlreadyExists) {
+			return &model.EmailInUseError{Message: auth.MsgEmailAlreadyExists}, nil
+		}
+		if errors.Is(err, auth.ErrInvalidEmail) {
+			return nil, gqlerror.Errorf("%s", auth.MsgInvalidEmail)
+		}
+		return nil, err
+	}
+
+	return &model.GeneratePasskeyRegistrationOptionsSuccess{
+		RegistrationOptions: registrationOptions,
+	}, nil
 }
 
 // RegisterWithPasskey is the resolver for the registerWithPasskey field.
 func (r *mutationResolver) RegisterWithPasskey(ctx context.Context, email string, emailVerificationToken string, passkeyRegistrationResponse string, passkeyNickname string, fullName string, captchaToken string) (model.RegisterWithPasskeyPayload, error) {
-	panic(fmt.Errorf("not implemented: RegisterWithPasskey - registerWithPasskey"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	createdAccount, sessionToken, err := r.authService.RegisterWithPasskey(ctx, email, emailVerificationToken, passkeyRegistrationResponse, passkeyNickname, fullName, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		var validationErr *auth.ValidationError
+		switch {
+		case errors.Is(err, auth.ErrEmailAlreadyExists):
+			return &model.EmailInUseError{Message: auth.MsgEmailAlreadyExists}, nil
+		case errors.Is(err, auth.ErrInvalidEmail), errors.Is(err, auth.ErrInvalidOrExpiredToken):
+			return &model.InvalidEmailVerificationTokenError{Message: auth.MsgInvalidToken}, nil
+		case errors.Is(err, auth.ErrChallengeNotFound), errors.Is(err, auth.ErrInvalidWebAuthnResponse):
+			return &model.InvalidPasskeyRegistrationCredentialError{Message: auth.MsgInvalidWebAuthnResponse}, nil
+		case errors.As(err, &validationErr):
+			return nil, gqlerror.Errorf("%s", validationErr.Message)
+		}
+		return nil, err
+	}
+
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(createdAccount), nil
 }
 
 // GenerateAuthenticationOptions is the resolver for the generateAuthenticationOptions field.
 func (r *mutationResolver) GenerateAuthenticationOptions(ctx context.Context, captchaToken string) (model.GenerateAuthenticationOptionsPayload, error) {
-	panic(fmt.Errorf("not implemented: GenerateAuthenticationOptions - generateAuthenticationOptions"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	authenticationOptions, err := r.authService.GenerateAuthenticationOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenerateAuthenticationOptionsSuccess{
+		AuthenticationOptions: authenticationOptions,
+	}, nil
 }
 
 // GenerateReauthenticationOptions is the resolver for the generateReauthenticationOptions field.
 func (r *mutationResolver) GenerateReauthenticationOptions(ctx context.Context, captchaToken string) (model.GenerateAuthenticationOptionsPayload, error) {
-	panic(fmt.Errorf("not implemented: GenerateReauthenticationOptions - generateReauthenticationOptions"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticationOptions, err := r.authService.GenerateReauthenticationOptions(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenerateAuthenticationOptionsSuccess{
+		AuthenticationOptions: authenticationOptions,
+	}, nil
 }
 
 // LoginWithPasskey is the resolver for the loginWithPasskey field.
-func (r *mutationResolver) LoginWithPasskey(ctx context.Context, authenticationResponse string, captchaToken string) (model.LoginWithPasskeyPayload, error) {
-	panic(fmt.Errorf("not implemented: LoginWithPasskey - loginWithPasskey"))
+func (r *mutationResolver) LoginWithPasskey(ctx context.Context, authenticationResponse string, captchaToken string, rememberMe bool) (model.LoginWithPasskeyPayload, error) {
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, sessionToken, err := r.authService.LoginWithPasskey(ctx, authenticationResponse, requestInfo.UserAgent, requestInfo.IPAddress, rememberMe)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrChallengeNotFound):
+			return &model.WebAuthnChallengeNotFoundError{Message: auth.MsgChallengeNotFound}, nil
+		case errors.Is(err, auth.ErrWebAuthnCredentialCloned):
+			return &model.InvalidPasskeyAuthenticationCredentialError{Message: auth.MsgWebAuthnCredentialCloned}, nil
+		case errors.Is(err, auth.ErrInvalidWebAuthnResponse):
+			return &model.InvalidPasskeyAuthenticationCredentialError{Message: auth.MsgInvalidWebAuthnResponse}, nil
+		}
+		return nil, err
+	}
+
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(acc), nil
 }
 
 // LoginWithPassword is the resolver for the loginWithPassword field.
-func (r *mutationResolver) LoginWithPassword(ctx context.Context, login string, password string, captchaToken string) (model.LoginWithPasswordPayload, error) {
+func (r *mutationResolver) LoginWithPassword(ctx context.Context, login string, password string, captchaToken string, rememberMe bool) (model.LoginWithPasswordPayload, error) {
 	// Verify captcha token first
 	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
 	if !valid {
@@ -88,8 +246,131 @@ func (r *mutationResolver) LoginWithPassword(ctx context.Context, login string,
 		}, nil
 	}
 
-	// TODO: Implement the actual login logic
-	panic(fmt.Errorf("not implemented: LoginWithPassword - loginWithPassword"))
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, sessionToken, err := r.authService.LoginWithPassword(ctx, login, password, requestInfo.UserAgent, requestInfo.IPAddress, rememberMe)
+	if err != nil {
+		var twoFactorErr *auth.TwoFactorRequiredError
+		var tooManyErr *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyErr):
+			return tooManyAttemptsToModel(tooManyErr), nil
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return &model.InvalidCredentialsError{Message: auth.MsgInvalidCredentials}, nil
+		case errors.As(err, &twoFactorErr):
+			setSessionValue(ctx, twoFactorChallengeKey, twoFactorErr.Challenge)
+			return &model.TwoFactorAuthenticationRequiredError{Message: auth.MsgTwoFactorRequired}, nil
+		}
+		return nil, err
+	}
+
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(acc), nil
+}
+
+// RequestEmailLoginCode is the resolver for the requestEmailLoginCode field.
+func (r *mutationResolver) RequestEmailLoginCode(ctx context.Context, email string, captchaToken string) (model.RequestEmailLoginCodePayload, error) {
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	if err := r.authService.RequestEmailLoginCode(ctx, email, requestInfo.UserAgent); err != nil {
+		return nil, err
+	}
+
+	return &model.RequestEmailLoginCodeSuccess{
+		Message: auth.MsgEmailLoginCodeRequested,
+	}, nil
+}
+
+// LoginWithEmailCode is the resolver for the loginWithEmailCode field.
+func (r *mutationResolver) LoginWithEmailCode(ctx context.Context, email string, code string, captchaToken string, rememberMe bool) (model.LoginWithEmailCodePayload, error) {
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, sessionToken, err := r.authService.LoginWithEmailCode(ctx, email, code, requestInfo.UserAgent, requestInfo.IPAddress, rememberMe)
+	if err != nil {
+		var twoFactorErr *auth.TwoFactorRequiredError
+		var tooManyErr *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyErr):
+			return tooManyAttemptsToModel(tooManyErr), nil
+		case errors.Is(err, auth.ErrInvalidEmailLoginCode):
+			return &model.InvalidEmailLoginCodeError{Message: auth.MsgInvalidEmailLoginCode}, nil
+		case errors.As(err, &twoFactorErr):
+			setSessionValue(ctx, twoFactorChallengeKey, twoFactorErr.Challenge)
+			return &model.TwoFactorAuthenticationRequiredError{Message: auth.MsgTwoFactorRequired}, nil
+		}
+		return nil, err
+	}
+
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(acc), nil
+}
+
+// RequestSmsLoginCode is the resolver for the requestSmsLoginCode field.
+func (r *mutationResolver) RequestSmsLoginCode(ctx context.Context, phoneNumber string, captchaToken string) (model.RequestSmsLoginCodePayload, error) {
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	if err := r.authService.RequestSmsLoginCode(ctx, phoneNumber); err != nil {
+		switch {
+		case errors.Is(err, account.ErrSMSCountryNotAllowed), errors.Is(err, account.ErrSMSNumberTypeNotAllowed):
+			return &model.InvalidPhoneNumberError{Message: account.MsgPhoneNumberNotSupported}, nil
+		case errors.Is(err, account.ErrInvalidPhoneNumber):
+			return &model.InvalidPhoneNumberError{Message: account.MsgInvalidPhoneNumberFormat}, nil
+		}
+		return nil, err
+	}
+
+	return &model.RequestSmsLoginCodeSuccess{
+		Message: auth.MsgSmsLoginCodeRequested,
+	}, nil
+}
+
+// LoginWithSmsCode is the resolver for the loginWithSmsCode field.
+func (r *mutationResolver) LoginWithSmsCode(ctx context.Context, phoneNumber string, code string, captchaToken string, rememberMe bool) (model.LoginWithSmsCodePayload, error) {
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, sessionToken, err := r.authService.LoginWithSmsCode(ctx, phoneNumber, code, requestInfo.UserAgent, requestInfo.IPAddress, rememberMe)
+	if err != nil {
+		var twoFactorErr *auth.TwoFactorRequiredError
+		var tooManyErr *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyErr):
+			return tooManyAttemptsToModel(tooManyErr), nil
+		case errors.Is(err, auth.ErrInvalidSmsLoginCode):
+			return &model.InvalidSmsLoginCodeError{Message: auth.MsgInvalidSmsLoginCode}, nil
+		case errors.As(err, &twoFactorErr):
+			setSessionValue(ctx, twoFactorChallengeKey, twoFactorErr.Challenge)
+			return &model.TwoFactorAuthenticationRequiredError{Message: auth.MsgTwoFactorRequired}, nil
+		}
+		return nil, err
+	}
+
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(acc), nil
 }
 
 // Logout is the resolver for the logout field.
@@ -99,27 +380,256 @@ func (r *mutationResolver) Logout(ctx context.Context) (*model.LogoutPayload, er
 
 // RequestPasswordReset is the resolver for the requestPasswordReset field.
 func (r *mutationResolver) RequestPasswordReset(ctx context.Context, email string, captchaToken string) (model.RequestPasswordResetPayload, error) {
-	panic(fmt.Errorf("not implemented: RequestPasswordReset - requestPasswordReset"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	if err := r.authService.RequestPasswordReset(ctx, email, requestInfo.UserAgent); err != nil {
+		return nil, err
+	}
+
+	return &model.RequestPasswordResetSuccess{
+		Message: auth.MsgPasswordResetRequested,
+	}, nil
 }
 
 // Verify2faPasswordResetWithAuthenticator is the resolver for the verify2faPasswordResetWithAuthenticator field.
 func (r *mutationResolver) Verify2faPasswordResetWithAuthenticator(ctx context.Context, email string, passwordResetToken string, twoFactorToken string, captchaToken string) (model.Verify2FAPasswordResetWithAuthenticatorPayload, error) {
-	panic(fmt.Errorf("not implemented: Verify2faPasswordResetWithAuthenticator - verify2faPasswordResetWithAuthenticator"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	challenge, resetToken, err := r.authService.Verify2FAPasswordResetWithAuthenticator(ctx, email, passwordResetToken, twoFactorToken, requestInfo.IPAddress)
+	if err != nil {
+		var tooManyErr *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyErr):
+			return tooManyAttemptsToModel(tooManyErr), nil
+		case errors.Is(err, auth.ErrInvalidOrExpiredToken):
+			return &model.InvalidPasswordResetTokenError{Message: auth.MsgInvalidPasswordResetToken}, nil
+		case errors.Is(err, auth.ErrTwoFactorNotEnabled):
+			return &model.AuthenticatorNotEnabledError{Message: auth.MsgTwoFactorNotEnabled}, nil
+		case errors.Is(err, auth.ErrInvalidTwoFactorCode):
+			return &model.InvalidCredentialsError{Message: auth.MsgInvalidTwoFactorCode}, nil
+		}
+		return nil, err
+	}
+
+	setSessionValue(ctx, passwordResetChallengeKey, challenge)
+
+	return passwordResetTokenToModel(resetToken, false), nil
 }
 
 // Verify2faPasswordResetWithPasskey is the resolver for the verify2faPasswordResetWithPasskey field.
 func (r *mutationResolver) Verify2faPasswordResetWithPasskey(ctx context.Context, email string, passwordResetToken string, authenticationResponse string, captchaToken string) (model.Verify2FAPasswordResetWithPasskeyPayload, error) {
-	panic(fmt.Errorf("not implemented: Verify2faPasswordResetWithPasskey - verify2faPasswordResetWithPasskey"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	challenge, resetToken, err := r.authService.Verify2FAPasswordResetWithPasskey(ctx, email, passwordResetToken, authenticationResponse)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidOrExpiredToken):
+			return &model.InvalidPasswordResetTokenError{Message: auth.MsgInvalidPasswordResetToken}, nil
+		case errors.Is(err, auth.ErrTwoFactorNotEnabled):
+			return &model.TwoFactorAuthenticationNotEnabledError{Message: auth.MsgTwoFactorNotEnabled}, nil
+		case errors.Is(err, auth.ErrChallengeNotFound):
+			return &model.WebAuthnChallengeNotFoundError{Message: auth.MsgChallengeNotFound}, nil
+		case errors.Is(err, auth.ErrWebAuthnCredentialCloned):
+			return &model.InvalidPasskeyAuthenticationCredentialError{Message: auth.MsgWebAuthnCredentialCloned}, nil
+		case errors.Is(err, auth.ErrInvalidWebAuthnResponse):
+			return &model.InvalidPasskeyAuthenticationCredentialError{Message: auth.MsgInvalidWebAuthnResponse}, nil
+		}
+		return nil, err
+	}
+
+	setSessionValue(ctx, passwordResetChallengeKey, challenge)
+
+	return passwordResetTokenToModel(resetToken, false), nil
 }
 
 // ResetPassword is the resolver for the resetPassword field.
 func (r *mutationResolver) ResetPassword(ctx context.Context, email string, passwordResetToken string, newPassword string) (model.ResetPasswordPayload, error) {
-	panic(fmt.Errorf("not implemented: ResetPassword - resetPassword"))
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	updatedAccount, err := r.authService.ResetPassword(ctx, email, passwordResetToken, newPassword, getSessionString(ctx, passwordResetChallengeKey), requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		var policyErr *auth.PasswordPolicyError
+		switch {
+		case errors.Is(err, auth.ErrInvalidOrExpiredToken):
+			return &model.InvalidPasswordResetTokenError{Message: auth.MsgInvalidPasswordResetToken}, nil
+		case errors.Is(err, auth.ErrTemporaryTwoFactorNotFound):
+			return &model.TwoFactorAuthenticationChallengeNotFoundError{Message: auth.MsgTwoFactorChallengeNotFound}, nil
+		case errors.As(err, &policyErr):
+			return &model.PasswordNotStrongError{Message: policyErr.Reason}, nil
+		case errors.Is(err, auth.ErrPasswordPreviouslyUsed):
+			return &model.PasswordPreviouslyUsedError{Message: auth.MsgPasswordPreviouslyUsed}, nil
+		}
+		return nil, err
+	}
+
+	// All sessions were invalidated, including the one in this cookie
+	httpmiddleware.ClearSession(ctx)
+
+	return accountToModel(updatedAccount), nil
+}
+
+// UnlockAccount is the resolver for the unlockAccount field.
+func (r *mutationResolver) UnlockAccount(ctx context.Context, email string, unlockToken string) (model.UnlockAccountPayload, error) {
+	if err := r.authService.UnlockAccount(ctx, email, unlockToken); err != nil {
+		if errors.Is(err, auth.ErrInvalidUnlockToken) {
+			return &model.InvalidAccountUnlockTokenError{Message: auth.MsgInvalidUnlockToken}, nil
+		}
+		return nil, err
+	}
+
+	return &model.UnlockAccountSuccess{
+		Message: auth.MsgAccountUnlocked,
+	}, nil
+}
+
+// RevokeSessions is the resolver for the revokeSessions field.
+func (r *mutationResolver) RevokeSessions(ctx context.Context, revocationToken string) (model.RevokeSessionsPayload, error) {
+	if err := r.authService.RevokeSessions(ctx, revocationToken); err != nil {
+		if errors.Is(err, auth.ErrInvalidOrExpiredToken) {
+			return &model.InvalidSessionRevocationTokenError{Message: auth.MsgInvalidSessionRevocationToken}, nil
+		}
+		return nil, err
+	}
+
+	return &model.RevokeSessionsSuccess{
+		Message: auth.MsgSessionsRevoked,
+	}, nil
+}
+
+// CreateBearerTokens is the resolver for the createBearerTokens field.
+func (r *mutationResolver) CreateBearerTokens(ctx context.Context) (*model.BearerTokens, error) {
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bearer viewers keep rotating their refresh token instead
+	if _, ok := httpmiddleware.GetSessionToken(ctx); !ok {
+		return nil, gqlerror.Errorf("%s", auth.MsgSessionCookieRequired)
+	}
+
+	tokens, err := r.authService.CreateBearerTokens(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	// The session is held by the tokens from now on, not by the cookie
+	deleteSessionValue(ctx, httpmiddleware.SessionTokenKey)
+
+	return bearerTokensToModel(tokens), nil
+}
+
+// RefreshBearerTokens is the resolver for the refreshBearerTokens field.
+func (r *mutationResolver) RefreshBearerTokens(ctx context.Context, refreshToken string) (model.RefreshBearerTokensPayload, error) {
+	tokens, err := r.authService.RefreshBearerTokens(ctx, refreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidRefreshToken):
+			return &model.InvalidRefreshTokenError{Message: auth.MsgInvalidRefreshToken}, nil
+		case errors.Is(err, auth.ErrRefreshTokenReused):
+			return &model.InvalidRefreshTokenError{Message: auth.MsgRefreshTokenReused}, nil
+		}
+		return nil, err
+	}
+
+	return bearerTokensToModel(tokens), nil
+}
+
+// CreatePersonalAccessToken is the resolver for the createPersonalAccessToken field.
+func (r *mutationResolver) CreatePersonalAccessToken(ctx context.Context, name string, scopes []model.PersonalAccessTokenScope, expiresInDays *int32) (model.CreatePersonalAccessTokenPayload, error) {
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if expiresInDays != nil {
+		expiry := time.Now().Add(time.Duration(*expiresInDays) * 24 * time.Hour)
+		expiresAt = &expiry
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	personalAccessToken, token, err := r.authService.CreatePersonalAccessToken(ctx, session.Account, name, personalAccessTokenScopesFromModel(scopes), expiresAt, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidPersonalAccessTokenName):
+			return &model.InvalidPersonalAccessTokenError{Message: fmt.Sprintf(auth.MsgInvalidPersonalAccessTokenName, auth.PersonalAccessTokenNameMaxLength)}, nil
+		case errors.Is(err, auth.ErrInvalidPersonalAccessTokenScopes):
+			return &model.InvalidPersonalAccessTokenError{Message: auth.MsgInvalidPersonalAccessTokenScopes}, nil
+		case errors.Is(err, auth.ErrInvalidPersonalAccessTokenExpiry):
+			return &model.InvalidPersonalAccessTokenError{Message: auth.MsgInvalidPersonalAccessTokenExpiry}, nil
+		}
+		return nil, err
+	}
+
+	return &model.CreatePersonalAccessTokenSuccess{
+		Token:                   token,
+		PersonalAccessTokenEdge: personalAccessTokenEdgeToModel(personalAccessToken),
+	}, nil
+}
+
+// RevokePersonalAccessToken is the resolver for the revokePersonalAccessToken field.
+func (r *mutationResolver) RevokePersonalAccessToken(ctx context.Context, personalAccessTokenID string) (model.RevokePersonalAccessTokenPayload, error) {
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := fromGlobalID("PersonalAccessToken", personalAccessTokenID)
+	if err != nil {
+		return &model.PersonalAccessTokenNotFoundError{Message: auth.MsgPersonalAccessTokenNotFound}, nil
+	}
+
+	personalAccessToken, err := r.authService.RevokePersonalAccessToken(ctx, session.AccountId, id)
+	if err != nil {
+		if errors.Is(err, auth.ErrPersonalAccessTokenNotFound) {
+			return &model.PersonalAccessTokenNotFoundError{Message: auth.MsgPersonalAccessTokenNotFound}, nil
+		}
+		return nil, err
+	}
+
+	return &model.RevokePersonalAccessTokenSuccess{
+		PersonalAccessTokenEdge: personalAccessTokenEdgeToModel(personalAccessToken),
+	}, nil
 }
 
 // UpdatePassword is the resolver for the updatePassword field.
 func (r *mutationResolver) UpdatePassword(ctx context.Context, newPassword string) (model.UpdatePasswordPayload, error) {
-	panic(fmt.Errorf("not implemented: UpdatePassword - updatePassword"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	updatedAccount, err := r.authService.UpdatePassword(ctx, session, newPassword, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		var policyErr *auth.PasswordPolicyError
+		switch {
+		case errors.As(err, &policyErr):
+			return &model.PasswordNotStrongError{Message: policyErr.Reason}, nil
+		case errors.Is(err, auth.ErrPasswordPreviouslyUsed):
+			return &model.PasswordPreviouslyUsedError{Message: auth.MsgPasswordPreviouslyUsed}, nil
+		}
+		return nil, err
+	}
+
+	return accountToModel(updatedAccount), nil
 }
 
 // DeletePassword is the resolver for the deletePassword field.
@@ -129,90 +639,496 @@ func (r *mutationResolver) DeletePassword(ctx context.Context) (model.DeletePass
 
 // DeleteOtherSessions is the resolver for the deleteOtherSessions field.
 func (r *mutationResolver) DeleteOtherSessions(ctx context.Context) (*model.DeleteOtherSessionsPayload, error) {
-	panic(fmt.Errorf("not implemented: DeleteOtherSessions - deleteOtherSessions"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedSessionIDs, err := r.authService.DeleteOtherSessions(ctx, session.AccountId, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedSessionGlobalIDs := make([]string, 0, len(deletedSessionIDs))
+	for _, sessionID := range deletedSessionIDs {
+		deletedSessionGlobalIDs = append(deletedSessionGlobalIDs, toGlobalID("Session", sessionID))
+	}
+
+	return &model.DeleteOtherSessionsPayload{
+		DeletedSessionIds: deletedSessionGlobalIDs,
+	}, nil
 }
 
 // DeleteSession is the resolver for the deleteSession field.
 func (r *mutationResolver) DeleteSession(ctx context.Context, sessionID string) (model.DeleteSessionPayload, error) {
-	panic(fmt.Errorf("not implemented: DeleteSession - deleteSession"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := fromGlobalID("Session", sessionID)
+	if err != nil {
+		return &model.SessionNotFoundError{Message: auth.MsgSessionNotFound}, nil
+	}
+
+	deletedSession, err := r.authService.DeleteSession(ctx, session.AccountId, id, session.ID)
+	if err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			return &model.SessionNotFoundError{Message: auth.MsgSessionNotFound}, nil
+		}
+		return nil, err
+	}
+
+	return &model.DeleteSessionSuccess{
+		SessionEdge: sessionEdgeToModel(deletedSession),
+	}, nil
 }
 
 // DeleteWebAuthnCredential is the resolver for the deleteWebAuthnCredential field.
 func (r *mutationResolver) DeleteWebAuthnCredential(ctx context.Context, webAuthnCredentialID string) (model.DeleteWebAuthnCredentialPayload, error) {
-	panic(fmt.Errorf("not implemented: DeleteWebAuthnCredential - deleteWebAuthnCredential"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := fromGlobalID("WebAuthnCredential", webAuthnCredentialID)
+	if err != nil {
+		return &model.WebAuthnCredentialNotFoundError{Message: auth.MsgWebAuthnCredentialNotFound}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	credential, err := r.authService.DeleteWebAuthnCredential(ctx, session.Account, id, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrWebAuthnCredentialNotFound):
+			return &model.WebAuthnCredentialNotFoundError{Message: auth.MsgWebAuthnCredentialNotFound}, nil
+		case errors.Is(err, auth.ErrInsufficientAuthProviders):
+			return &model.InsufficientAuthProvidersError{Message: auth.MsgInsufficientAuthProviders}, nil
+		}
+		return nil, err
+	}
+
+	return &model.DeleteWebAuthnCredentialSuccess{
+		WebAuthnCredentialEdge: webAuthnCredentialEdgeToModel(credential),
+	}, nil
 }
 
 // UpdateWebAuthnCredential is the resolver for the updateWebAuthnCredential field.
 func (r *mutationResolver) UpdateWebAuthnCredential(ctx context.Context, webAuthnCredentialID string, nickname string) (model.UpdateWebAuthnCredentialPayload, error) {
-	panic(fmt.Errorf("not implemented: UpdateWebAuthnCredential - updateWebAuthnCredential"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := fromGlobalID("WebAuthnCredential", webAuthnCredentialID)
+	if err != nil {
+		return &model.WebAuthnCredentialNotFoundError{Message: auth.MsgWebAuthnCredentialNotFound}, nil
+	}
+
+	credential, err := r.authService.UpdateWebAuthnCredential(ctx, session.AccountId, id, nickname)
+	if err != nil {
+		if errors.Is(err, auth.ErrWebAuthnCredentialNotFound) {
+			return &model.WebAuthnCredentialNotFoundError{Message: auth.MsgWebAuthnCredentialNotFound}, nil
+		}
+		return nil, err
+	}
+
+	return webAuthnCredentialToModel(credential), nil
+}
+
+// LinkOAuthIdentity is the resolver for the linkOAuthIdentity field.
+func (r *mutationResolver) LinkOAuthIdentity(ctx context.Context, provider string) (model.LinkOAuthIdentityPayload, error) {
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, state, err := r.authService.StartOAuthLink(ctx, session.Account, provider)
+	if err != nil {
+		if errors.Is(err, auth.ErrOAuthProviderUnsupported) {
+			return &model.OAuthProviderNotSupportedError{Message: auth.MsgOAuthProviderUnsupported}, nil
+		}
+		return nil, err
+	}
+
+	setSessionValue(ctx, oauthLinkStateKey, state)
+
+	return &model.LinkOAuthIdentitySuccess{
+		AuthorizationURL: authURL,
+	}, nil
+}
+
+// UnlinkOAuthIdentity is the resolver for the unlinkOAuthIdentity field.
+func (r *mutationResolver) UnlinkOAuthIdentity(ctx context.Context, oauthIdentityID string) (model.UnlinkOAuthIdentityPayload, error) {
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := fromGlobalID("OAuthIdentity", oauthIdentityID)
+	if err != nil {
+		return &model.OAuthIdentityNotFoundError{Message: auth.MsgOAuthIdentityNotFound}, nil
+	}
+
+	credential, err := r.authService.UnlinkOAuthIdentity(ctx, session.Account, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrOAuthCredentialNotFound):
+			return &model.OAuthIdentityNotFoundError{Message: auth.MsgOAuthIdentityNotFound}, nil
+		case errors.Is(err, auth.ErrInsufficientAuthProviders):
+			return &model.InsufficientAuthProvidersError{Message: auth.MsgInsufficientAuthProviders}, nil
+		}
+		return nil, err
+	}
+
+	return &model.UnlinkOAuthIdentitySuccess{
+		OauthIdentityEdge: oauthIdentityEdgeToModel(credential),
+	}, nil
 }
 
 // GenerateWebAuthnCredentialCreationOptions is the resolver for the generateWebAuthnCredentialCreationOptions field.
 func (r *mutationResolver) GenerateWebAuthnCredentialCreationOptions(ctx context.Context) (model.GeneratePasskeyCreationOptionsPayload, error) {
-	panic(fmt.Errorf("not implemented: GenerateWebAuthnCredentialCreationOptions - generateWebAuthnCredentialCreationOptions"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	registrationOptions, err := r.authService.GenerateWebAuthnCredentialCreationOptions(ctx, session.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GeneratePasskeyCreationOptionsSuccess{
+		RegistrationOptions: registrationOptions,
+	}, nil
 }
 
 // CreateWebAuthnCredential is the resolver for the createWebAuthnCredential field.
 func (r *mutationResolver) CreateWebAuthnCredential(ctx context.Context, passkeyRegistrationResponse string, nickname string) (model.CreateWebAuthnCredentialPayload, error) {
-	panic(fmt.Errorf("not implemented: CreateWebAuthnCredential - createWebAuthnCredential"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	credential, err := r.authService.CreateWebAuthnCredential(ctx, session.Account, passkeyRegistrationResponse, nickname, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		if errors.Is(err, auth.ErrChallengeNotFound) || errors.Is(err, auth.ErrInvalidWebAuthnResponse) {
+			return &model.InvalidPasskeyRegistrationCredentialError{Message: auth.MsgInvalidWebAuthnResponse}, nil
+		}
+		return nil, err
+	}
+
+	return &model.CreateWebAuthnCredentialSuccess{
+		WebAuthnCredentialEdge: webAuthnCredentialEdgeToModel(credential),
+	}, nil
 }
 
 // RequestSudoModeWithPasskey is the resolver for the requestSudoModeWithPasskey field.
 func (r *mutationResolver) RequestSudoModeWithPasskey(ctx context.Context, authenticationResponse string, captchaToken string) (model.RequestSudoModeWithPasskeyPayload, error) {
-	panic(fmt.Errorf("not implemented: RequestSudoModeWithPasskey - requestSudoModeWithPasskey"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.authService.RequestSudoModeWithPasskey(ctx, session, authenticationResponse); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrChallengeNotFound):
+			return &model.WebAuthnChallengeNotFoundError{Message: auth.MsgChallengeNotFound}, nil
+		case errors.Is(err, auth.ErrWebAuthnCredentialCloned):
+			return &model.InvalidPasskeyAuthenticationCredentialError{Message: auth.MsgWebAuthnCredentialCloned}, nil
+		case errors.Is(err, auth.ErrInvalidWebAuthnResponse):
+			return &model.InvalidPasskeyAuthenticationCredentialError{Message: auth.MsgInvalidWebAuthnResponse}, nil
+		}
+		return nil, err
+	}
+
+	return accountToModel(session.Account), nil
 }
 
 // RequestSudoModeWithPassword is the resolver for the requestSudoModeWithPassword field.
 func (r *mutationResolver) RequestSudoModeWithPassword(ctx context.Context, password string, captchaToken string) (model.RequestSudoModeWithPasswordPayload, error) {
-	panic(fmt.Errorf("not implemented: RequestSudoModeWithPassword - requestSudoModeWithPassword"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	if err := r.authService.RequestSudoModeWithPassword(ctx, session, password, requestInfo.IPAddress); err != nil {
+		var tooManyErr *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyErr):
+			return tooManyAttemptsToModel(tooManyErr), nil
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return &model.InvalidCredentialsError{Message: auth.MsgPasswordIncorrect}, nil
+		case errors.Is(err, auth.ErrInvalidAuthProvider):
+			return &model.InvalidAuthenticationProviderError{Message: auth.MsgInvalidAuthProvider}, nil
+		case errors.Is(err, auth.ErrTwoFactorRequired):
+			return &model.TwoFactorAuthenticationRequiredError{Message: auth.MsgTwoFactorRequired}, nil
+		}
+		return nil, err
+	}
+
+	return accountToModel(session.Account), nil
 }
 
 // RequestSudoModeWithAuthenticator is the resolver for the requestSudoModeWithAuthenticator field.
 func (r *mutationResolver) RequestSudoModeWithAuthenticator(ctx context.Context, twoFactorToken string, captchaToken string) (model.RequestSudoModeWithAuthenticatorPayload, error) {
-	panic(fmt.Errorf("not implemented: RequestSudoModeWithAuthenticator - requestSudoModeWithAuthenticator"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	if err := r.authService.RequestSudoModeWithAuthenticator(ctx, session, twoFactorToken, requestInfo.IPAddress); err != nil {
+		var tooManyErr *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyErr):
+			return tooManyAttemptsToModel(tooManyErr), nil
+		case errors.Is(err, auth.ErrTwoFactorNotEnabled):
+			return &model.AuthenticatorNotEnabledError{Message: auth.MsgTwoFactorNotEnabled}, nil
+		case errors.Is(err, auth.ErrInvalidTwoFactorCode):
+			return &model.InvalidCredentialsError{Message: auth.MsgInvalidTwoFactorCode}, nil
+		}
+		return nil, err
+	}
+
+	return accountToModel(session.Account), nil
 }
 
 // EnableAccount2faWithAuthenticator is the resolver for the enableAccount2faWithAuthenticator field.
 func (r *mutationResolver) EnableAccount2faWithAuthenticator(ctx context.Context, token string) (model.SetAccount2FAPayload, error) {
-	panic(fmt.Errorf("not implemented: EnableAccount2faWithAuthenticator - enableAccount2faWithAuthenticator"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, recoveryCodes, err := r.authService.EnableTwoFactorWithAuthenticator(ctx, session.Account, getSessionString(ctx, authenticatorEnrollmentChallengeKey), token, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrTwoFactorAuthenticationNotFound):
+			return &model.TwoFactorAuthenticationChallengeNotFoundError{Message: auth.MsgTwoFactorChallengeNotFound}, nil
+		case errors.Is(err, auth.ErrInvalidTwoFactorCode):
+			return &model.InvalidCredentialsError{Message: auth.MsgInvalidTwoFactorCode}, nil
+		}
+		return nil, err
+	}
+
+	deleteSessionValue(ctx, authenticatorEnrollmentChallengeKey)
+
+	return &model.EnableAccount2FAWithAuthenticatorSuccess{
+		Account:       accountToModel(acc),
+		RecoveryCodes: recoveryCodes,
+	}, nil
 }
 
 // DisableAccount2faWithAuthenticator is the resolver for the disableAccount2faWithAuthenticator field.
 func (r *mutationResolver) DisableAccount2faWithAuthenticator(ctx context.Context) (model.DisableAccount2FAWithAuthenticatorPayload, error) {
-	panic(fmt.Errorf("not implemented: DisableAccount2faWithAuthenticator - disableAccount2faWithAuthenticator"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, err := r.authService.DisableTwoFactorWithAuthenticator(ctx, session.Account, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		if errors.Is(err, auth.ErrTwoFactorNotEnabled) {
+			return &model.AuthenticatorNotEnabledError{Message: auth.MsgTwoFactorNotEnabled}, nil
+		}
+		return nil, err
+	}
+
+	return accountToModel(acc), nil
 }
 
 // GenerateAuthenticator2faChallenge is the resolver for the generateAuthenticator2faChallenge field.
 func (r *mutationResolver) GenerateAuthenticator2faChallenge(ctx context.Context) (model.GenerateAuthenticator2FAChallengePayload, error) {
-	panic(fmt.Errorf("not implemented: GenerateAuthenticator2faChallenge - generateAuthenticator2faChallenge"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, otpURI, secret, err := r.authService.GenerateAuthenticatorChallenge(ctx, session.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	setSessionValue(ctx, authenticatorEnrollmentChallengeKey, challenge)
+
+	return &model.GenerateAuthenticator2FAChallengeSuccess{
+		OtpURI: otpURI,
+		Secret: secret,
+	}, nil
 }
 
 // Verify2faWithAuthenticator is the resolver for the verify2faWithAuthenticator field.
 func (r *mutationResolver) Verify2faWithAuthenticator(ctx context.Context, token string, captchaToken string) (model.Verify2FAWithAuthenticatorPayload, error) {
-	panic(fmt.Errorf("not implemented: Verify2faWithAuthenticator - verify2faWithAuthenticator"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, sessionToken, err := r.authService.VerifyTwoFactorWithAuthenticator(ctx, getSessionString(ctx, twoFactorChallengeKey), token, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		var tooManyErr *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyErr):
+			return tooManyAttemptsToModel(tooManyErr), nil
+		case errors.Is(err, auth.ErrTwoFactorAuthenticationNotFound):
+			return &model.TwoFactorAuthenticationChallengeNotFoundError{Message: auth.MsgTwoFactorChallengeNotFound}, nil
+		case errors.Is(err, auth.ErrTwoFactorNotEnabled):
+			return &model.AuthenticatorNotEnabledError{Message: auth.MsgTwoFactorNotEnabled}, nil
+		case errors.Is(err, auth.ErrInvalidTwoFactorCode):
+			return &model.InvalidCredentialsError{Message: auth.MsgInvalidTwoFactorCode}, nil
+		}
+		return nil, err
+	}
+
+	deleteSessionValue(ctx, twoFactorChallengeKey)
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(acc), nil
 }
 
 // Verify2faWithRecoveryCode is the resolver for the verify2faWithRecoveryCode field.
 func (r *mutationResolver) Verify2faWithRecoveryCode(ctx context.Context, token string, captchaToken string) (model.Verify2FAWithRecoveryCodePayload, error) {
-	panic(fmt.Errorf("not implemented: Verify2faWithRecoveryCode - verify2faWithRecoveryCode"))
+	valid, message := r.verifyCaptchaToken(ctx, captchaToken)
+	if !valid {
+		return &model.InvalidCaptchaTokenError{
+			Message: message,
+		}, nil
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, sessionToken, err := r.authService.VerifyTwoFactorWithRecoveryCode(ctx, getSessionString(ctx, twoFactorChallengeKey), token, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		var tooManyErr *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyErr):
+			return tooManyAttemptsToModel(tooManyErr), nil
+		case errors.Is(err, auth.ErrTwoFactorAuthenticationNotFound):
+			return &model.TwoFactorAuthenticationChallengeNotFoundError{Message: auth.MsgTwoFactorChallengeNotFound}, nil
+		case errors.Is(err, auth.ErrTwoFactorNotEnabled):
+			return &model.TwoFactorAuthenticationNotEnabledError{Message: auth.MsgTwoFactorNotEnabled}, nil
+		case errors.Is(err, auth.ErrRecoveryCodeInvalid):
+			return &model.InvalidCredentialsError{Message: auth.MsgRecoveryCodeInvalid}, nil
+		}
+		return nil, err
+	}
+
+	deleteSessionValue(ctx, twoFactorChallengeKey)
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(acc), nil
 }
 
 // Generate2faRecoveryCodes is the resolver for the generate2faRecoveryCodes field.
 func (r *mutationResolver) Generate2faRecoveryCodes(ctx context.Context) (model.Generate2FARecoveryCodesPayload, error) {
-	panic(fmt.Errorf("not implemented: Generate2faRecoveryCodes - generate2faRecoveryCodes"))
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	recoveryCodes, err := r.authService.GenerateRecoveryCodes(ctx, session.Account, requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		if errors.Is(err, auth.ErrTwoFactorNotEnabled) {
+			return &model.TwoFactorAuthenticationNotEnabledError{Message: auth.MsgTwoFactorNotEnabled}, nil
+		}
+		return nil, err
+	}
+
+	return &model.Generate2FARecoveryCodesSuccess{
+		RecoveryCodes: recoveryCodes,
+	}, nil
 }
 
 // VerifyGoogleToken is the resolver for the verifyGoogleToken field.
-func (r *mutationResolver) VerifyGoogleToken(ctx context.Context, token string) (model.VerifyGoogleTokenPayload, error) {
-	panic(fmt.Errorf("not implemented: VerifyGoogleToken - verifyGoogleToken"))
+func (r *mutationResolver) VerifyGoogleToken(ctx context.Context, token string, rememberMe bool) (model.VerifyGoogleTokenPayload, error) {
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	acc, sessionToken, err := r.authService.LoginWithGoogle(ctx, token, requestInfo.UserAgent, requestInfo.IPAddress, rememberMe)
+	if err != nil {
+		var twoFactorErr *auth.TwoFactorRequiredError
+		switch {
+		case errors.Is(err, auth.ErrOAuthTokenInvalid):
+			return &model.InvalidCredentialsError{Message: auth.MsgOAuthTokenInvalid}, nil
+		case errors.Is(err, auth.ErrEmailNotVerified):
+			return &model.InvalidEmailError{Message: auth.MsgEmailNotVerified}, nil
+		case errors.Is(err, auth.ErrInvalidEmail):
+			return &model.InvalidEmailError{Message: auth.MsgInvalidEmail}, nil
+		case errors.Is(err, auth.ErrOAuthAccountExists):
+			return &model.EmailInUseError{Message: auth.MsgOAuthAccountExists}, nil
+		case errors.As(err, &twoFactorErr):
+			setSessionValue(ctx, twoFactorChallengeKey, twoFactorErr.Challenge)
+			return &model.TwoFactorAuthenticationRequiredError{Message: auth.MsgTwoFactorRequired}, nil
+		}
+		return nil, err
+	}
+
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	return accountToModel(acc), nil
 }
 
 // Viewer is the resolver for the viewer field.
 func (r *queryResolver) Viewer(ctx context.Context) (model.ViewerPayload, error) {
-	panic(fmt.Errorf("not implemented: Viewer - viewer"))
+	acc, err := viewerAccount(ctx)
+	if err != nil {
+		return &model.NotAuthenticatedError{Message: err.Error()}, nil
+	}
+
+	return accountToModel(acc), nil
 }
 
 // PasswordResetToken is the resolver for the passwordResetToken field.
 func (r *queryResolver) PasswordResetToken(ctx context.Context, resetToken string, email string) (model.PasswordResetTokenPayload, error) {
-	panic(fmt.Errorf("not implemented: PasswordResetToken - passwordResetToken"))
+	passwordResetToken, needs2FA, err := r.authService.GetPasswordResetToken(ctx, email, resetToken, getSessionString(ctx, passwordResetChallengeKey))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidOrExpiredToken) {
+			return &model.PasswordResetTokenNotFoundError{Message: auth.MsgInvalidPasswordResetToken}, nil
+		}
+		return nil, err
+	}
+
+	return passwordResetTokenToModel(passwordResetToken, needs2FA), nil
 }
+
+// IsCurrent is the resolver for the isCurrent field.
+func (r *sessionResolver) IsCurrent(ctx context.Context, obj *model.Session) (bool, error) {
+	session, ok := httpmiddleware.GetViewerSession(ctx)
+	if !ok {
+		return false, nil
+	}
+
+	return obj.ID == toGlobalID("Session", session.ID), nil
+}
+
+// Session returns generated.SessionResolver implementation.
+func (r *Resolver) Session() generated.SessionResolver { return &sessionResolver{r} }
+
+type sessionResolver struct{ *Resolver }
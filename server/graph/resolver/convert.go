@@ -0,0 +1,382 @@
+package resolver
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"server/graph/model"
+	"server/internal/domain/account"
+	"server/internal/domain/auth"
+	"server/internal/infrastructure/db"
+)
+
+// toGlobalID encodes a database ID into a Relay global ID
+func toGlobalID(typeName string, id int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", typeName, id)))
+}
+
+// fromGlobalID decodes a Relay global ID, checking that it belongs to the expected type
+func fromGlobalID(typeName string, globalID string) (int64, error) {
+	decoded, err := base64.StdEncoding.DecodeString(globalID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid global id: %w", err)
+	}
+
+	prefix, rawID, found := strings.Cut(string(decoded), ":")
+	if !found || prefix != typeName {
+		return 0, fmt.Errorf("invalid global id for type %s", typeName)
+	}
+
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid global id: %w", err)
+	}
+	return id, nil
+}
+
+// formatTime formats a time as a DateTime scalar
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// accountToModel converts a domain account into its GraphQL representation
+func accountToModel(acc *account.Account) *model.Account {
+	updatedAt := formatTime(acc.UpdatedAt)
+
+	return &model.Account{
+		ID:                 toGlobalID("Account", acc.ID),
+		FullName:           acc.FullName,
+		Email:              acc.Email,
+		AvatarURL:          acc.AvatarURL(),
+		PhoneNumber:        acc.PhoneNumber,
+		UpdatedAt:          &updatedAt,
+		AuthProviders:      authProvidersToModel(acc),
+		TwoFactorProviders: twoFactorProvidersToModel(acc),
+		Has2faEnabled:      acc.Has2FAEnabled(),
+		TermsAndPolicy: &model.TermsAndPolicy{
+			Type:      termsAndPolicyTypeToModel(acc.TermsAndPolicy.Type),
+			UpdatedAt: formatTime(acc.TermsAndPolicy.UpdatedAt),
+			IsLatest:  acc.TermsAndPolicy.Version == account.LatestTermsAndPolicyVersion,
+		},
+		AnalyticsPreference: &model.AnalyticsPreference{
+			Type:      analyticsPreferenceTypeToModel(acc.AnalyticsPref.Type),
+			UpdatedAt: formatTime(acc.AnalyticsPref.UpdatedAt),
+		},
+	}
+}
+
+// sessionToModel converts a session into its GraphQL representation
+func sessionToModel(session *auth.Session) *model.Session {
+	return &model.Session{
+		ID:           toGlobalID("Session", session.ID),
+		UserAgent:    session.UserAgent,
+		IPAddress:    session.IPAddress,
+		CreatedAt:    formatTime(session.CreatedAt),
+		LastActiveAt: formatTime(session.LastActiveAt),
+		Device:       sessionDeviceToModel(session),
+		Location:     sessionLocationToModel(session),
+	}
+}
+
+// bearerTokensToModel converts the bearer tokens of a native client's session
+func bearerTokensToModel(tokens *auth.BearerTokens) *model.BearerTokens {
+	return &model.BearerTokens{
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  formatTime(tokens.AccessTokenExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: formatTime(tokens.RefreshTokenExpiresAt),
+	}
+}
+
+// sessionDeviceToModel converts the device a session was created from
+//
+// Sessions created before devices were recorded have an unknown device.
+func sessionDeviceToModel(session *auth.Session) *model.SessionDevice {
+	deviceType := model.DeviceType(strings.ToUpper(session.DeviceType))
+	if !deviceType.IsValid() {
+		deviceType = model.DeviceTypeUnknown
+	}
+
+	return &model.SessionDevice{
+		Browser: optionalString(session.Browser),
+		Os:      optionalString(session.OS),
+		Type:    deviceType,
+	}
+}
+
+// sessionLocationToModel converts the location a session was created from, nil if unknown
+func sessionLocationToModel(session *auth.Session) *model.SessionLocation {
+	if session.Country == "" {
+		return nil
+	}
+
+	return &model.SessionLocation{
+		City:        optionalString(session.City),
+		Country:     session.Country,
+		CountryCode: session.CountryCode,
+	}
+}
+
+// sessionEdgeToModel wraps a session into a connection edge
+func sessionEdgeToModel(session *auth.Session) *model.SessionEdge {
+	return &model.SessionEdge{
+		Cursor: formatCursor(session.ID),
+		Node:   sessionToModel(session),
+	}
+}
+
+// sessionConnectionToModel converts a page of sessions into a connection
+func sessionConnectionToModel(result *db.PaginatedResult[*auth.Session, int64]) *model.SessionConnection {
+	edges := make([]*model.SessionEdge, 0, len(result.Data))
+	for _, session := range result.Data {
+		edges = append(edges, sessionEdgeToModel(session))
+	}
+
+	return &model.SessionConnection{
+		PageInfo: pageInfoToModel(result),
+		Edges:    edges,
+	}
+}
+
+// webAuthnCredentialToModel converts a passkey into its GraphQL representation
+//
+// The credential is updated whenever it is used to sign in, so its update time
+// doubles as the last use time.
+func webAuthnCredentialToModel(credential *auth.WebAuthnCredential) *model.WebAuthnCredential {
+	return &model.WebAuthnCredential{
+		ID:         toGlobalID("WebAuthnCredential", credential.ID),
+		Nickname:   credential.Nickname,
+		CreatedAt:  formatTime(credential.CreatedAt),
+		LastUsedAt: formatTime(credential.UpdatedAt),
+	}
+}
+
+// webAuthnCredentialEdgeToModel wraps a passkey into a connection edge
+func webAuthnCredentialEdgeToModel(credential *auth.WebAuthnCredential) *model.WebAuthnCredentialEdge {
+	return &model.WebAuthnCredentialEdge{
+		Cursor: formatCursor(credential.ID),
+		Node:   webAuthnCredentialToModel(credential),
+	}
+}
+
+// webAuthnCredentialConnectionToModel converts a page of passkeys into a connection
+func webAuthnCredentialConnectionToModel(result *db.PaginatedResult[*auth.WebAuthnCredential, int64]) *model.WebAuthnCredentialConnection {
+	edges := make([]*model.WebAuthnCredentialEdge, 0, len(result.Data))
+	for _, credential := range result.Data {
+		edges = append(edges, webAuthnCredentialEdgeToModel(credential))
+	}
+
+	return &model.WebAuthnCredentialConnection{
+		PageInfo: pageInfoToModel(result),
+		Edges:    edges,
+	}
+}
+
+// personalAccessTokenToModel converts a personal access token into its GraphQL representation
+func personalAccessTokenToModel(token *auth.PersonalAccessToken) *model.PersonalAccessToken {
+	scopes := make([]model.PersonalAccessTokenScope, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, model.PersonalAccessTokenScope(strings.ToUpper(scope)))
+	}
+
+	var expiresAt, lastUsedAt *string
+	if token.ExpiresAt != nil {
+		formatted := formatTime(*token.ExpiresAt)
+		expiresAt = &formatted
+	}
+	if token.LastUsedAt != nil {
+		formatted := formatTime(*token.LastUsedAt)
+		lastUsedAt = &formatted
+	}
+
+	return &model.PersonalAccessToken{
+		ID:         toGlobalID("PersonalAccessToken", token.ID),
+		Name:       token.Name,
+		Scopes:     scopes,
+		ExpiresAt:  expiresAt,
+		LastUsedAt: lastUsedAt,
+		CreatedAt:  formatTime(token.CreatedAt),
+	}
+}
+
+// personalAccessTokenScopesFromModel converts GraphQL personal access token scopes into domain scopes
+func personalAccessTokenScopesFromModel(scopes []model.PersonalAccessTokenScope) []string {
+	tokenScopes := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		tokenScopes = append(tokenScopes, strings.ToLower(string(scope)))
+	}
+	return tokenScopes
+}
+
+// personalAccessTokenEdgeToModel wraps a personal access token into a connection edge
+func personalAccessTokenEdgeToModel(token *auth.PersonalAccessToken) *model.PersonalAccessTokenEdge {
+	return &model.PersonalAccessTokenEdge{
+		Cursor: formatCursor(token.ID),
+		Node:   personalAccessTokenToModel(token),
+	}
+}
+
+// personalAccessTokenConnectionToModel converts a page of personal access tokens into a connection
+func personalAccessTokenConnectionToModel(result *db.PaginatedResult[*auth.PersonalAccessToken, int64]) *model.PersonalAccessTokenConnection {
+	edges := make([]*model.PersonalAccessTokenEdge, 0, len(result.Data))
+	for _, token := range result.Data {
+		edges = append(edges, personalAccessTokenEdgeToModel(token))
+	}
+
+	return &model.PersonalAccessTokenConnection{
+		PageInfo: pageInfoToModel(result),
+		Edges:    edges,
+	}
+}
+
+// oauthIdentityToModel converts a linked social identity into its GraphQL representation
+func oauthIdentityToModel(credential *auth.OAuthCredential) *model.OAuthIdentity {
+	return &model.OAuthIdentity{
+		ID:        toGlobalID("OAuthIdentity", credential.ID),
+		Provider:  auth.OAuthProviderName(credential.Provider),
+		CreatedAt: formatTime(credential.CreatedAt),
+	}
+}
+
+// oauthIdentityEdgeToModel wraps a linked social identity into a connection edge
+func oauthIdentityEdgeToModel(credential *auth.OAuthCredential) *model.OAuthIdentityEdge {
+	return &model.OAuthIdentityEdge{
+		Cursor: formatCursor(credential.ID),
+		Node:   oauthIdentityToModel(credential),
+	}
+}
+
+// oauthIdentityConnectionToModel converts a page of linked social identities into a connection
+func oauthIdentityConnectionToModel(result *db.PaginatedResult[*auth.OAuthCredential, int64]) *model.OAuthIdentityConnection {
+	edges := make([]*model.OAuthIdentityEdge, 0, len(result.Data))
+	for _, credential := range result.Data {
+		edges = append(edges, oauthIdentityEdgeToModel(credential))
+	}
+
+	return &model.OAuthIdentityConnection{
+		PageInfo: pageInfoToModel(result),
+		Edges:    edges,
+	}
+}
+
+// pageInfoToModel converts the pagination metadata of a result page
+func pageInfoToModel[T any](result *db.PaginatedResult[T, int64]) *model.PageInfo {
+	pageInfo := &model.PageInfo{
+		HasNextPage:     result.HasNextPage,
+		HasPreviousPage: result.HasPreviousPage,
+	}
+	if result.StartCursor != nil {
+		startCursor := formatCursor(*result.StartCursor)
+		pageInfo.StartCursor = &startCursor
+	}
+	if result.EndCursor != nil {
+		endCursor := formatCursor(*result.EndCursor)
+		pageInfo.EndCursor = &endCursor
+	}
+	return pageInfo
+}
+
+// formatCursor encodes a database ID as a pagination cursor
+func formatCursor(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// optionalString returns nil for an empty string, for nullable fields
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// intFromInt32 converts an optional GraphQL Int argument
+func intFromInt32(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+// passwordResetTokenToModel converts a password reset token into its GraphQL representation
+func passwordResetTokenToModel(resetToken *auth.PasswordResetToken, needs2FA bool) *model.PasswordResetToken {
+	return &model.PasswordResetToken{
+		ID:                 toGlobalID("PasswordResetToken", resetToken.ID),
+		Email:              resetToken.Account.Email,
+		AuthProviders:      authProvidersToModel(resetToken.Account),
+		TwoFactorProviders: twoFactorProvidersToModel(resetToken.Account),
+		Needs2fa:           needs2FA,
+	}
+}
+
+// tooManyAttemptsToModel converts a throttled attempt, telling a locked account apart from a backoff
+func tooManyAttemptsToModel(tooManyErr *auth.TooManyAttemptsError) *model.TooManyAttemptsError {
+	message := auth.MsgTooManyAttempts
+	if errors.Is(tooManyErr, auth.ErrAccountLocked) {
+		message = auth.MsgAccountLocked
+	}
+
+	return &model.TooManyAttemptsError{
+		Message:           message,
+		RetryAfterSeconds: int32(tooManyErr.RetryAfterSeconds),
+	}
+}
+
+// authProvidersToModel maps an account's stored auth providers to their GraphQL enums
+//
+// Social login providers added through configuration only have no enum value and are skipped.
+func authProvidersToModel(acc *account.Account) []model.AuthProvider {
+	authProviders := make([]model.AuthProvider, 0, len(acc.AuthProviders))
+	for _, provider := range acc.AuthProviders {
+		authProvider := model.AuthProvider(strings.ToUpper(provider))
+		if authProvider.IsValid() {
+			authProviders = append(authProviders, authProvider)
+		}
+	}
+	return authProviders
+}
+
+// twoFactorProvidersToModel maps an account's enabled 2FA providers to their GraphQL enums
+func twoFactorProvidersToModel(acc *account.Account) []model.TwoFactorProvider {
+	twoFactorProviders := []model.TwoFactorProvider{}
+	for _, provider := range acc.TwoFactorProviders() {
+		twoFactorProviders = append(twoFactorProviders, model.TwoFactorProvider(strings.ToUpper(string(provider))))
+	}
+	return twoFactorProviders
+}
+
+// analyticsPreferenceFromModel maps an analytics preference input to its stored value
+func analyticsPreferenceFromModel(preference model.AnalyticsPreferenceInputType) string {
+	if preference == model.AnalyticsPreferenceInputTypeAcceptance {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// termsAndPolicyTypeToModel maps a stored terms and policy type to its GraphQL enum
+func termsAndPolicyTypeToModel(termsType string) model.TermsAndPolicyType {
+	switch termsType {
+	case "acceptance", "accepted":
+		return model.TermsAndPolicyTypeAcceptance
+	case "rejection", "rejected":
+		return model.TermsAndPolicyTypeRejection
+	default:
+		return model.TermsAndPolicyTypeUndecided
+	}
+}
+
+// analyticsPreferenceTypeToModel maps a stored analytics preference to its GraphQL enum
+func analyticsPreferenceTypeToModel(preference string) model.AnalyticsPreferenceType {
+	switch preference {
+	case "acceptance", "enabled":
+		return model.AnalyticsPreferenceTypeAcceptance
+	case "rejection", "disabled":
+		return model.AnalyticsPreferenceTypeRejection
+	default:
+		return model.AnalyticsPreferenceTypeUndecided
+	}
+}
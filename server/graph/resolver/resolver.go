@@ -1,18 +1,117 @@
 package resolver
 
 import (
+	"context"
+
+	"server/graph"
+	"server/graph/model"
+	"server/internal/domain/account"
+	"server/internal/domain/auth"
+	httpmiddleware "server/internal/http/middleware"
 	"server/internal/infrastructure/captcha"
 )
 
+// Session data keys holding short-lived authentication state
+const (
+	// passwordResetChallengeKey holds the temporary 2FA challenge of a password reset
+	passwordResetChallengeKey = "password_reset_2fa_challenge"
+	// twoFactorChallengeKey holds the challenge of a login pending 2FA verification
+	twoFactorChallengeKey = httpmiddleware.TwoFactorChallengeKey
+	// authenticatorEnrollmentChallengeKey holds the challenge of an authenticator enrollment
+	authenticatorEnrollmentChallengeKey = "authenticator_enrollment_challenge"
+	// oauthLinkStateKey holds the state of a pending social identity link
+	oauthLinkStateKey = httpmiddleware.OAuthLinkStateKey
+)
+
 type Resolver struct {
-	// add services here
-	// UserService *services.UserService
 	captchaVerifier captcha.BaseCaptchaVerifier
+	authService     *auth.AuthService
+	accountService  *account.AccountService
 }
 
 // constructor for Fx
-func NewResolver(captchaVerifier captcha.BaseCaptchaVerifier) *Resolver {
+func NewResolver(captchaVerifier captcha.BaseCaptchaVerifier, authService *auth.AuthService, accountService *account.AccountService) *Resolver {
 	return &Resolver{
 		captchaVerifier: captchaVerifier,
+		authService:     authService,
+		accountService:  accountService,
+	}
+}
+
+// verifyCaptchaToken verifies a captcha token and returns a message if verification fails
+func (r *Resolver) verifyCaptchaToken(ctx context.Context, captchaToken string) (bool, string) {
+	if captchaToken == "" {
+		return false, "Captcha token is required"
+	}
+
+	valid, err := r.captchaVerifier.VerifyToken(ctx, captchaToken)
+	if err != nil {
+		return false, "Captcha verification failed"
+	}
+
+	if !valid {
+		return false, "Invalid captcha token"
+	}
+
+	return true, ""
+}
+
+// viewerSession returns the authenticated viewer's session, with its account loaded
+func viewerSession(ctx context.Context) (*auth.Session, error) {
+	session, ok := httpmiddleware.GetViewerSession(ctx)
+	if !ok {
+		return nil, graph.ErrNotAuthenticated
+	}
+	return session, nil
+}
+
+// viewerAccount returns the authenticated viewer's account, whether signed in with a session
+// or a personal access token
+func viewerAccount(ctx context.Context) (*account.Account, error) {
+	if session, ok := httpmiddleware.GetViewerSession(ctx); ok {
+		return session.Account, nil
+	}
+	if personalAccessToken, ok := httpmiddleware.GetViewerPersonalAccessToken(ctx); ok {
+		return personalAccessToken.Account, nil
+	}
+	return nil, graph.ErrNotAuthenticated
+}
+
+// viewerSessionForAccount returns the viewer's session, checking that the account belongs to the viewer
+func viewerSessionForAccount(ctx context.Context, obj *model.Account) (*auth.Session, error) {
+	session, err := viewerSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := fromGlobalID("Account", obj.ID)
+	if err != nil || accountID != session.AccountId {
+		return nil, graph.ErrNotAuthenticated
+	}
+
+	return session, nil
+}
+
+// getSessionString returns a string value stored in the session data, if any
+func getSessionString(ctx context.Context, key string) string {
+	sessionData, ok := httpmiddleware.GetSessionData(ctx)
+	if !ok {
+		return ""
+	}
+	value, _ := sessionData[key].(string)
+	return value
+}
+
+// setSessionValue stores a value in the session data
+func setSessionValue(ctx context.Context, key string, value string) {
+	if sessionData, ok := httpmiddleware.GetSessionData(ctx); ok {
+		sessionData[key] = value
+	}
+}
+
+// deleteSessionValue removes a value from the session data
+func deleteSessionValue(ctx context.Context, key string) {
+	if sessionData, ok := httpmiddleware.GetSessionData(ctx); ok {
+		delete(sessionData, key)
 	}
 }
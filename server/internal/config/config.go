@@ -4,22 +4,108 @@ import (
 	"log"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
+// DevelopmentTokenHashPepper is the default TOKEN_HASH_PEPPER, it is rejected outside development
+const DevelopmentTokenHashPepper = "development-pepper-change-in-production"
+
+// OAuthProviderConfig holds the client configuration of a social login provider
+type OAuthProviderConfig struct {
+	// Type is "oidc" for OpenID Connect providers configured through discovery, or "github"
+	Type         string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+
+	// Issuer is the OpenID Connect issuer, its discovery document is served below it
+	Issuer string
+
+	// Endpoint overrides for providers without discovery
+	AuthURL  string
+	TokenURL string
+	APIURL   string
+
+	// TrustEmail links a new identity to the existing account using the same email address.
+	// Only enable it for providers that own the email addresses they assert as verified.
+	TrustEmail bool
+}
+
 type Config struct {
 	ServerPort  string `mapstructure:"SERVER_PORT"`
 	Environment string `mapstructure:"ENVIRONMENT"`
 
 	DBUrl string `mapstructure:"DB_URL"`
 
+	// Base URL of the accounts frontend, used to build links sent by email
+	AccountsBaseURL string `mapstructure:"ACCOUNTS_BASE_URL"`
+
+	// Reverse proxies, as IP addresses or CIDR ranges, whose X-Forwarded-For and X-Real-IP headers are honoured
+	TrustedProxies []string `mapstructure:"TRUSTED_PROXIES"`
+
 	// JWT Configuration
 	JWTSecret string `mapstructure:"JWT_SECRET"`
 
 	// JWE Configuration
 	JWESecret string `mapstructure:"JWE_SECRET"`
 
+	// Token hashing Configuration
+	// The pepper keys the HMAC of stored tokens, changing it invalidates every session and pending token.
+	TokenHashPepper string `mapstructure:"TOKEN_HASH_PEPPER"`
+	// Accept the MD5 token hashes of earlier releases, rows are rewritten when used.
+	// On for the migration release, turn it off in a later release once no rows written by earlier releases remain.
+	TokenHashAcceptLegacyMD5 bool `mapstructure:"TOKEN_HASH_ACCEPT_LEGACY_MD5"`
+
+	// Password hashing Configuration (Argon2id)
+	// Hashes using other parameters are upgraded when the password is next verified.
+	PasswordHashMemory      uint32 `mapstructure:"PASSWORD_HASH_MEMORY"`
+	PasswordHashIterations  uint32 `mapstructure:"PASSWORD_HASH_ITERATIONS"`
+	PasswordHashParallelism uint8  `mapstructure:"PASSWORD_HASH_PARALLELISM"`
+	PasswordHashSaltLength  uint32 `mapstructure:"PASSWORD_HASH_SALT_LENGTH"`
+	PasswordHashKeyLength   uint32 `mapstructure:"PASSWORD_HASH_KEY_LENGTH"`
+
+	// Password policy Configuration
+	// Scores range from 0 (too guessable) to 4 (very unguessable), as in zxcvbn.
+	PasswordMinLength int `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PasswordMinScore  int `mapstructure:"PASSWORD_MIN_SCORE"`
+
+	// Password history Configuration
+	// The current password and the last PasswordHistorySize passwords can't be reused, entries older than
+	// the retention are pruned. A retention of 0 keeps entries until newer passwords push them out.
+	PasswordHistorySize      int           `mapstructure:"PASSWORD_HISTORY_SIZE"`
+	PasswordHistoryRetention time.Duration `mapstructure:"PASSWORD_HISTORY_RETENTION"`
+
+	// Brute-force lockout Configuration
+	// Each failed password, 2FA or sudo attempt past the free attempts doubles the wait before the next one,
+	// starting at the backoff base. At the threshold the account is locked and an unlock link is mailed.
+	// Failures are forgotten once no attempt failed for the window.
+	LockoutAccountFreeAttempts int           `mapstructure:"LOCKOUT_ACCOUNT_FREE_ATTEMPTS"`
+	LockoutIPFreeAttempts      int           `mapstructure:"LOCKOUT_IP_FREE_ATTEMPTS"`
+	LockoutBackoffBase         time.Duration `mapstructure:"LOCKOUT_BACKOFF_BASE"`
+	LockoutBackoffMax          time.Duration `mapstructure:"LOCKOUT_BACKOFF_MAX"`
+	LockoutThreshold           int           `mapstructure:"LOCKOUT_THRESHOLD"`
+	LockoutDuration            time.Duration `mapstructure:"LOCKOUT_DURATION"`
+	LockoutWindow              time.Duration `mapstructure:"LOCKOUT_WINDOW"`
+
+	// GraphQL rate limit Configuration
+	// Store is "memory" for a single instance or "postgres" to share the @rateLimit counts between replicas.
+	RateLimitStore string `mapstructure:"RATE_LIMIT_STORE"`
+
+	// Breached password check Configuration
+	// Mode is "api" for the Have I Been Pwned range API, "file" for a local sorted SHA-1 hash file, or "disabled".
+	PwnedPasswordsMode   string `mapstructure:"PWNED_PASSWORDS_MODE"`
+	PwnedPasswordsAPIURL string `mapstructure:"PWNED_PASSWORDS_API_URL"`
+	PwnedPasswordsFile   string `mapstructure:"PWNED_PASSWORDS_FILE"`
+
+	// Firebase scrypt project parameters of imported password hashes (Authentication > Users > Password hash parameters)
+	FirebaseScryptSignerKey     string `mapstructure:"FIREBASE_SCRYPT_SIGNER_KEY"`
+	FirebaseScryptSaltSeparator string `mapstructure:"FIREBASE_SCRYPT_SALT_SEPARATOR"`
+	FirebaseScryptRounds        int    `mapstructure:"FIREBASE_SCRYPT_ROUNDS"`
+	FirebaseScryptMemCost       int    `mapstructure:"FIREBASE_SCRYPT_MEM_COST"`
+
 	// S3 Configuration
 	S3Bucket    string `mapstructure:"S3_BUCKET"`
 	S3Region    string `mapstructure:"S3_REGION"`
@@ -32,6 +118,17 @@ type Config struct {
 	SMSTwilioToken string `mapstructure:"SMS_TWILIO_TOKEN"`
 	SMSFromNumber string `mapstructure:"SMS_FROM_NUMBER"`
 
+	// SMS fraud protection Configuration
+	// Countries are ISO 3166-1 alpha-2 codes, an empty allowlist allows every country that isn't denied.
+	// Budgets cap the SMS sent per hour to numbers sharing their first SMSBudgetPrefixLength digits, and in total.
+	// "0" disables a budget.
+	SMSAllowedCountries   []string `mapstructure:"SMS_ALLOWED_COUNTRIES"`
+	SMSDeniedCountries    []string `mapstructure:"SMS_DENIED_COUNTRIES"`
+	SMSBudgetPrefixLength int      `mapstructure:"SMS_BUDGET_PREFIX_LENGTH"`
+	SMSPrefixHourlyBudget int      `mapstructure:"SMS_PREFIX_HOURLY_BUDGET"`
+	SMSGlobalHourlyBudget int      `mapstructure:"SMS_GLOBAL_HOURLY_BUDGET"`
+	SMSBudgetAlertPercent int      `mapstructure:"SMS_BUDGET_ALERT_PERCENT"`
+
 	// Email Configuration
 	EmailProvider     string `mapstructure:"EMAIL_PROVIDER"`
 	SMTPHost          string `mapstructure:"SMTP_HOST"`
@@ -58,6 +155,47 @@ type Config struct {
 	HCaptchaSiteKey       string `mapstructure:"HCAPTCHA_SITEKEY"`
 	ReCaptchaSecretKey    string `mapstructure:"RECAPTCHA_SECRET_KEY"`
 	ReCaptchaSiteKey      string `mapstructure:"RECAPTCHA_SITEKEY"`
+
+	// Google Sign-In Configuration
+	GoogleClientID   string `mapstructure:"GOOGLE_CLIENT_ID"`
+	GoogleJWKSURL    string `mapstructure:"GOOGLE_JWKS_URL"`
+	GoogleTrustEmail bool   `mapstructure:"GOOGLE_TRUST_EMAIL"`
+
+	// Social login Configuration
+	OAuthRedirectBaseURL string                         `mapstructure:"OAUTH_REDIRECT_BASE_URL"`
+	OAuthProviderNames   []string                       `mapstructure:"OAUTH_PROVIDERS"`
+	OAuthProviders       map[string]OAuthProviderConfig `mapstructure:"-"`
+
+	// Session Configuration
+	// Sessions expire their lifetime after the login at the latest, and earlier once unused for their idle timeout.
+	// Logins with "remember me" use the longer REMEMBER_ME policy. Activity is recorded at most once per activity interval.
+	SessionLifetime               time.Duration `mapstructure:"SESSION_LIFETIME"`
+	SessionIdleTimeout            time.Duration `mapstructure:"SESSION_IDLE_TIMEOUT"`
+	SessionRememberMeLifetime     time.Duration `mapstructure:"SESSION_REMEMBER_ME_LIFETIME"`
+	SessionRememberMeIdleTimeout  time.Duration `mapstructure:"SESSION_REMEMBER_ME_IDLE_TIMEOUT"`
+	SessionActivityUpdateInterval time.Duration `mapstructure:"SESSION_ACTIVITY_UPDATE_INTERVAL"`
+
+	// Bearer token Configuration
+	// Native clients authenticate with short lived access tokens, renewed with a refresh token living as long as the session.
+	AccessTokenLifetime time.Duration `mapstructure:"ACCESS_TOKEN_LIFETIME"`
+
+	// GeoIP Configuration
+	// Path of a MaxMind DB format City or Country database, such as GeoLite2-City.mmdb, to locate sessions by IP address.
+	// Session locations are left unknown while empty.
+	GeoIPDatabasePath string `mapstructure:"GEOIP_DATABASE_PATH"`
+
+	// Sudo mode Configuration
+	SudoModeLifetime time.Duration `mapstructure:"SUDO_MODE_LIFETIME"`
+
+	// TOTP Configuration
+	TOTPIssuer string `mapstructure:"TOTP_ISSUER"`
+
+	// WebAuthn Configuration
+	WebAuthnRPID                string        `mapstructure:"WEBAUTHN_RP_ID"`
+	WebAuthnRPName              string        `mapstructure:"WEBAUTHN_RP_NAME"`
+	WebAuthnRPOrigins           []string      `mapstructure:"WEBAUTHN_RP_ORIGINS"`
+	WebAuthnRegistrationTimeout time.Duration `mapstructure:"WEBAUTHN_REGISTRATION_TIMEOUT"`
+	WebAuthnLoginTimeout        time.Duration `mapstructure:"WEBAUTHN_LOGIN_TIMEOUT"`
 }
 
 func SetupConfig() *Config {
@@ -77,12 +215,64 @@ func SetupConfig() *Config {
 	viper.SetDefault("S3_REGION", "us-east-1")
 	viper.SetDefault("SMS_PROVIDER", "dummy")
 
+	// Set defaults for SMS fraud protection
+	viper.SetDefault("SMS_BUDGET_PREFIX_LENGTH", 6)
+	viper.SetDefault("SMS_PREFIX_HOURLY_BUDGET", 20)
+	viper.SetDefault("SMS_GLOBAL_HOURLY_BUDGET", 500)
+	viper.SetDefault("SMS_BUDGET_ALERT_PERCENT", 80)
+
+	// Set default for the accounts frontend URL
+	viper.SetDefault("ACCOUNTS_BASE_URL", "http://localhost:3000")
+
+	// No proxies are trusted by default, the client address is the peer of the connection
+	viper.SetDefault("TRUSTED_PROXIES", "")
+
 	// Set default for JWT secret in development
 	viper.SetDefault("JWT_SECRET", "development-secret-change-in-production")
 
 	// Set default for JWE secret in development (exactly 16 bytes for AES-128)
 	viper.SetDefault("JWE_SECRET", "dev-jwe-secret-16b!")
 
+	// Set defaults for token hashing configuration
+	viper.SetDefault("TOKEN_HASH_PEPPER", DevelopmentTokenHashPepper)
+	viper.SetDefault("TOKEN_HASH_ACCEPT_LEGACY_MD5", true)
+
+	// Set defaults for password hashing configuration (memory in KiB)
+	viper.SetDefault("PASSWORD_HASH_MEMORY", 102400)
+	viper.SetDefault("PASSWORD_HASH_ITERATIONS", 1)
+	viper.SetDefault("PASSWORD_HASH_PARALLELISM", 8)
+	viper.SetDefault("PASSWORD_HASH_SALT_LENGTH", 16)
+	viper.SetDefault("PASSWORD_HASH_KEY_LENGTH", 32)
+
+	// Set defaults for the password policy
+	viper.SetDefault("PASSWORD_MIN_LENGTH", 8)
+	viper.SetDefault("PASSWORD_MIN_SCORE", 3)
+
+	// Set defaults for the password history
+	viper.SetDefault("PASSWORD_HISTORY_SIZE", 5)
+	viper.SetDefault("PASSWORD_HISTORY_RETENTION", "8760h")
+
+	// Set defaults for the brute-force lockout
+	viper.SetDefault("LOCKOUT_ACCOUNT_FREE_ATTEMPTS", 3)
+	viper.SetDefault("LOCKOUT_IP_FREE_ATTEMPTS", 20)
+	viper.SetDefault("LOCKOUT_BACKOFF_BASE", "1s")
+	viper.SetDefault("LOCKOUT_BACKOFF_MAX", "15m")
+	viper.SetDefault("LOCKOUT_THRESHOLD", 10)
+	viper.SetDefault("LOCKOUT_DURATION", "1h")
+	viper.SetDefault("LOCKOUT_WINDOW", "24h")
+
+	// Set default for the GraphQL rate limit store
+	viper.SetDefault("RATE_LIMIT_STORE", "memory")
+
+	// Set defaults for the breached password check
+	viper.SetDefault("PWNED_PASSWORDS_MODE", "api")
+	viper.SetDefault("PWNED_PASSWORDS_API_URL", "https://api.pwnedpasswords.com")
+
+	// Set defaults for imported Firebase scrypt hashes (Firebase's own defaults)
+	viper.SetDefault("FIREBASE_SCRYPT_SALT_SEPARATOR", "Bw==")
+	viper.SetDefault("FIREBASE_SCRYPT_ROUNDS", 8)
+	viper.SetDefault("FIREBASE_SCRYPT_MEM_COST", 14)
+
 	// Set defaults for email configuration
 	viper.SetDefault("EMAIL_PROVIDER", "dummy")
 	viper.SetDefault("EMAIL_TEMPLATE_PATH", "./templates/emails")
@@ -91,10 +281,68 @@ func SetupConfig() *Config {
 	// Set defaults for captcha configuration
 	viper.SetDefault("CAPTCHA_PROVIDER", "dummy")
 
+	// Set default for the Google signing keys endpoint
+	viper.SetDefault("GOOGLE_JWKS_URL", "https://www.googleapis.com/oauth2/v3/certs")
+	viper.SetDefault("GOOGLE_TRUST_EMAIL", false)
+
+	// Set default for the base URL of the social login callbacks
+	viper.SetDefault("OAUTH_REDIRECT_BASE_URL", "http://localhost:3000")
+
+	// Set defaults for session configuration
+	viper.SetDefault("SESSION_LIFETIME", "24h")
+	viper.SetDefault("SESSION_IDLE_TIMEOUT", "2h")
+	viper.SetDefault("SESSION_REMEMBER_ME_LIFETIME", "720h")
+	viper.SetDefault("SESSION_REMEMBER_ME_IDLE_TIMEOUT", "336h")
+	viper.SetDefault("SESSION_ACTIVITY_UPDATE_INTERVAL", "5m")
+
+	// Set default for bearer token configuration
+	viper.SetDefault("ACCESS_TOKEN_LIFETIME", "15m")
+
+	// Set defaults for sudo mode configuration
+	viper.SetDefault("SUDO_MODE_LIFETIME", "15m")
+
+	// Set defaults for TOTP configuration
+	viper.SetDefault("TOTP_ISSUER", "HospitalJobs")
+
+	// Set defaults for WebAuthn configuration
+	viper.SetDefault("WEBAUTHN_RP_ID", "localhost")
+	viper.SetDefault("WEBAUTHN_RP_NAME", "HospitalJobs")
+	viper.SetDefault("WEBAUTHN_RP_ORIGINS", "http://localhost:3000")
+	viper.SetDefault("WEBAUTHN_REGISTRATION_TIMEOUT", "5m")
+	viper.SetDefault("WEBAUTHN_LOGIN_TIMEOUT", "5m")
+
 	err = viper.Unmarshal(&config)
 	if err != nil {
 		log.Fatal("environment can't be loaded: ", err)
 	}
 
+	config.OAuthProviders = loadOAuthProviders(config.OAuthProviderNames)
+
 	return &config
+}
+
+// loadOAuthProviders reads the OAUTH_<NAME>_* settings of each configured social login provider
+func loadOAuthProviders(names []string) map[string]OAuthProviderConfig {
+	providers := make(map[string]OAuthProviderConfig, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OAUTH_" + strings.ToUpper(name) + "_"
+		viper.SetDefault(prefix+"TYPE", "oidc")
+		providers[name] = OAuthProviderConfig{
+			Type:         viper.GetString(prefix + "TYPE"),
+			ClientID:     viper.GetString(prefix + "CLIENT_ID"),
+			ClientSecret: viper.GetString(prefix + "CLIENT_SECRET"),
+			Scopes:       strings.Fields(viper.GetString(prefix + "SCOPES")),
+			Issuer:       viper.GetString(prefix + "ISSUER"),
+			AuthURL:      viper.GetString(prefix + "AUTH_URL"),
+			TokenURL:     viper.GetString(prefix + "TOKEN_URL"),
+			APIURL:       viper.GetString(prefix + "API_URL"),
+			TrustEmail:   viper.GetBool(prefix + "TRUST_EMAIL"),
+		}
+	}
+	return providers
 }
\ No newline at end of file
@@ -13,13 +13,10 @@ type MockEmailVerificationTokenRepo struct {
 
 func (m *MockEmailVerificationTokenRepo) Create(ctx context.Context, email string) (string, *EmailVerificationToken, error) {
 	args := m.Called(ctx, email)
-	if args.Get(0) == nil {
-		return "", nil, args.Error(1)
-	}
 	if args.Get(1) == nil {
-		return args.String(0), nil, args.Error(1)
+		return args.String(0), nil, args.Error(2)
 	}
-	return args.String(0), args.Get(1).(*EmailVerificationToken), args.Error(1)
+	return args.String(0), args.Get(1).(*EmailVerificationToken), args.Error(2)
 }
 
 func (m *MockEmailVerificationTokenRepo) Get(ctx context.Context, verificationToken string) (*EmailVerificationToken, error) {
@@ -51,4 +48,8 @@ func (m *MockEmailVerificationTokenRepo) GenerateVerificationToken(length int) (
 func (m *MockEmailVerificationTokenRepo) HashVerificationToken(token string) string {
 	args := m.Called(token)
 	return args.String(0)
-}
\ No newline at end of file
+}
+func (m *MockEmailVerificationTokenRepo) VerifyVerificationToken(token string, hash string) bool {
+	args := m.Called(token, hash)
+	return args.Bool(0)
+}
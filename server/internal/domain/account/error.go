@@ -17,6 +17,7 @@ var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrTokenExpired       = errors.New("token has expired")
 	ErrTokenNotFound      = errors.New("token not found")
+	ErrInvalidVerificationToken = errors.New("invalid verification token")
 
 	// Validation errors
 	ErrInvalidInput        = errors.New("invalid input")
@@ -28,7 +29,6 @@ var (
 	ErrUnsupportedFileType = errors.New("unsupported file type")
 
 	// Business logic errors
-	ErrAccountDisabled    = errors.New("account is disabled")
 	ErrVerificationFailed = errors.New("verification failed")
 	ErrSMSSendingFailed   = errors.New("SMS sending failed")
 	ErrEmailSendingFailed = errors.New("email sending failed")
@@ -36,6 +36,22 @@ var (
 	// Configuration errors
 	ErrS3NotConfigured = errors.New("S3 client not configured")
 	ErrSMSServiceDown  = errors.New("SMS service unavailable")
+
+	// Rate limiting errors
+	ErrPhoneVerificationCooldown = errors.New("phone verification request too frequent")
+
+	// Phone number errors
+	ErrPhoneNumberMissing = errors.New("account has no phone number")
+
+	// SMS fraud protection errors
+	ErrSMSCountryNotAllowed    = errors.New("SMS to this country is not allowed")
+	ErrSMSNumberTypeNotAllowed = errors.New("SMS to this number type is not allowed")
+	ErrSMSBudgetExceeded       = errors.New("SMS budget exceeded")
+
+	// Password hashing errors
+	ErrInvalidPasswordHash       = errors.New("invalid password hash format")
+	ErrInvalidPasswordHashParams = errors.New("invalid password hash parameters")
+	ErrUnsupportedPasswordHash   = errors.New("unsupported password hash format")
 )
 
 // Detailed error types with additional context
@@ -85,6 +101,20 @@ func (e *RepositoryError) Unwrap() error {
 	return e.Err
 }
 
+// CooldownError is returned when a request is repeated before its cooldown has elapsed
+type CooldownError struct {
+	RemainingSeconds int
+	Err              error
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("%s: retry in %d seconds", e.Err, e.RemainingSeconds)
+}
+
+func (e *CooldownError) Unwrap() error {
+	return e.Err
+}
+
 // Helper functions to create detailed errors
 func NewValidationError(field, message string, err error) *ValidationError {
 	return &ValidationError{
@@ -102,6 +132,13 @@ func NewServiceError(operation, message string, err error) *ServiceError {
 	}
 }
 
+func NewCooldownError(remainingSeconds int, err error) *CooldownError {
+	return &CooldownError{
+		RemainingSeconds: remainingSeconds,
+		Err:              err,
+	}
+}
+
 func NewRepositoryError(operation, entity, message string, err error) *RepositoryError {
 	return &RepositoryError{
 		Operation: operation,
@@ -132,4 +169,9 @@ const (
 	MsgMessageRequired           = "message is required"
 	MsgMessageUnsafe             = "message contains potentially unsafe content"
 	MsgS3ClientRequired          = "S3 client is required for this operation"
+	MsgPhoneNumberAlreadyExists  = "phone number is already registered"
+	MsgPhoneNumberMissing        = "account does not have a phone number"
+	MsgPhoneVerificationCooldown = "please wait before requesting another verification code"
+	MsgPhoneNumberNotSupported   = "text messages can't be sent to this phone number"
+	MsgSMSBudgetExceeded         = "text messages are temporarily unavailable, please try again later"
 )
\ No newline at end of file
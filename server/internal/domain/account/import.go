@@ -0,0 +1,153 @@
+package account
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// Supported account export formats
+const (
+	ImportFormatFirebase = "firebase"
+	ImportFormatAuth0    = "auth0"
+)
+
+// Firebase provider id of users who signed in with a phone number
+const firebasePhoneProviderID = "phone"
+
+// ImportedAccount is an account read from the export of another identity provider
+//
+// Accounts whose email wasn't verified by the provider have no password hash, so only the owner
+// of the email can set a password through a password reset. The phone number is only kept when the
+// provider verified it, since SMS login trusts it.
+type ImportedAccount struct {
+	Email         string
+	EmailVerified bool
+	FullName      string
+	PhoneNumber   *string
+	PasswordHash  *string
+}
+
+// firebaseExport is the output of `firebase auth:export --format=json`
+type firebaseExport struct {
+	Users []struct {
+		Email            string `json:"email"`
+		EmailVerified    bool   `json:"emailVerified"`
+		DisplayName      string `json:"displayName"`
+		PhoneNumber      string `json:"phoneNumber"`
+		PasswordHash     string `json:"passwordHash"`
+		Salt             string `json:"salt"`
+		ProviderUserInfo []struct {
+			ProviderID string `json:"providerId"`
+		} `json:"providerUserInfo"`
+	} `json:"users"`
+}
+
+// auth0User is a user of an Auth0 export, the password hash export is newline delimited JSON
+type auth0User struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	PhoneNumber   string `json:"phone_number"`
+	PhoneVerified bool   `json:"phone_verified"`
+	PasswordHash  string `json:"passwordHash"`
+}
+
+// ParseAccountExport reads the accounts of an export in the given format
+func ParseAccountExport(format string, r io.Reader) ([]ImportedAccount, error) {
+	switch format {
+	case ImportFormatFirebase:
+		return ParseFirebaseExport(r)
+	case ImportFormatAuth0:
+		return ParseAuth0Export(r)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// ParseFirebaseExport reads the accounts of a Firebase Authentication JSON export
+//
+// Password hashes are encoded as $firebase-scrypt$ hashes, the project parameters must be configured
+// to verify them. Firebase has no phone verification flag, phone numbers are verified when the user
+// signed in with the phone provider.
+func ParseFirebaseExport(r io.Reader) ([]ImportedAccount, error) {
+	var export firebaseExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to decode firebase export: %w", err)
+	}
+
+	accounts := make([]ImportedAccount, 0, len(export.Users))
+	for _, user := range export.Users {
+		phoneVerified := false
+		for _, provider := range user.ProviderUserInfo {
+			if provider.ProviderID == firebasePhoneProviderID {
+				phoneVerified = true
+			}
+		}
+
+		account := newImportedAccount(user.Email, user.EmailVerified, user.DisplayName, user.PhoneNumber, phoneVerified)
+		if user.PasswordHash != "" && account.EmailVerified {
+			passwordHash := FormatFirebaseScryptHash(user.Salt, user.PasswordHash)
+			account.PasswordHash = &passwordHash
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// ParseAuth0Export reads the accounts of an Auth0 user export
+//
+// Users may be newline delimited or concatenated JSON objects, Auth0 exports bcrypt password hashes.
+func ParseAuth0Export(r io.Reader) ([]ImportedAccount, error) {
+	decoder := json.NewDecoder(r)
+
+	accounts := make([]ImportedAccount, 0)
+	for {
+		var user auth0User
+		if err := decoder.Decode(&user); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode auth0 export: %w", err)
+		}
+
+		account := newImportedAccount(user.Email, user.EmailVerified, user.Name, user.PhoneNumber, user.PhoneVerified)
+		if user.PasswordHash != "" && account.EmailVerified {
+			passwordHash := user.PasswordHash
+			account.PasswordHash = &passwordHash
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// newImportedAccount normalizes the common fields of an exported user
+//
+// The full name falls back to the local part of the email since exports often don't have one.
+func newImportedAccount(email string, emailVerified bool, fullName, phoneNumber string, phoneVerified bool) ImportedAccount {
+	account := ImportedAccount{
+		Email:         strings.ToLower(strings.TrimSpace(email)),
+		EmailVerified: emailVerified,
+		FullName:      strings.TrimSpace(fullName),
+	}
+	if account.FullName == "" {
+		account.FullName, _, _ = strings.Cut(account.Email, "@")
+	}
+	if phoneNumber = strings.TrimSpace(phoneNumber); phoneVerified && isE164PhoneNumber(phoneNumber) {
+		account.PhoneNumber = &phoneNumber
+	}
+	return account
+}
+
+// isE164PhoneNumber reports whether the phone number is a valid number written in E.164 format
+func isE164PhoneNumber(phoneNumber string) bool {
+	number, err := phonenumbers.Parse(phoneNumber, "")
+	if err != nil || !phonenumbers.IsValidNumber(number) {
+		return false
+	}
+	return phonenumbers.Format(number, phonenumbers.E164) == phoneNumber
+}
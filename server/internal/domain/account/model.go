@@ -16,6 +16,16 @@ const (
 	TwoFactorProviderAuthenticator TwoFactorProvider = "authenticator"
 )
 
+// LatestTermsAndPolicyVersion is the version of the terms and policy new accounts accept
+const LatestTermsAndPolicyVersion = "1.0"
+
+// Auth providers stored in Account.AuthProviders
+const (
+	AuthProviderPassword           = "password"
+	AuthProviderWebAuthnCredential = "webauthn_credential"
+	AuthProviderOAuthGoogle        = "oauth_google"
+)
+
 type TermsAndPolicy struct {
 	Type      string    `bun:"type,notnull"` // e.g., "accepted", "updated"
 	UpdatedAt time.Time `bun:"updated_at,nullzero"`
@@ -39,6 +49,9 @@ type Account struct {
 	AuthProviders     []string `bun:"auth_providers,array"`
 	PhoneNumber       *string  `bun:"phone_number,unique"` // nullable, unique constraint
 
+	// Time step of the last accepted TOTP code, codes of this or earlier steps are replays
+	TwoFactorLastTimeStep int64 `bun:"two_factor_last_time_step,notnull,default:0"`
+
 	TermsAndPolicy TermsAndPolicy      `bun:"embed:terms_and_policy_"`
 	AnalyticsPref  AnalyticsPreference `bun:"embed:analytics_pref_"`
 }
@@ -91,3 +104,13 @@ type PhoneNumberVerificationToken struct {
 func (pvt *PhoneNumberVerificationToken) IsExpired() bool {
 	return time.Now().After(pvt.ExpiresAt)
 }
+
+// PasswordHistoryEntry is a previous password hash of an account, kept to prevent password reuse
+type PasswordHistoryEntry struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:password_history,alias:ph"`
+
+	PasswordHash string   `bun:"password_hash,notnull"`
+	AccountId    int64    `bun:"account_id,notnull"`
+	Account      *Account `bun:"rel:belongs-to,join:account_id=id"`
+}
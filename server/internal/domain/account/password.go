@@ -0,0 +1,157 @@
+package account
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// passwordHashPrefix identifies PHC formatted Argon2id password hashes
+const passwordHashPrefix = "$argon2id$"
+
+// PasswordHashParams are the Argon2id parameters used for new password hashes
+type PasswordHashParams struct {
+	// Memory is the memory cost in KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// legacyPasswordHashParams are the parameters of the legacy base64(salt||hash) password hashes
+// (Python passlib defaults with a 32 byte key)
+var legacyPasswordHashParams = PasswordHashParams{
+	Memory:      102400,
+	Iterations:  1,
+	Parallelism: 8,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// DefaultPasswordHashParams keeps the cost of the legacy password hashes
+var DefaultPasswordHashParams = legacyPasswordHashParams
+
+// PasswordHasher hashes passwords with Argon2id in the PHC string format
+//
+// Hashes are encoded as $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<hash>
+// so that the parameters can be changed without breaking existing hashes. The legacy
+// base64(salt||hash) format is still verified.
+type PasswordHasher struct {
+	params PasswordHashParams
+}
+
+// NewPasswordHasher creates a password hasher hashing new passwords with the given parameters
+func NewPasswordHasher(params PasswordHashParams) (*PasswordHasher, error) {
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 || params.SaltLength < 8 || params.KeyLength < 16 {
+		return nil, ErrInvalidPasswordHashParams
+	}
+	return &PasswordHasher{params: params}, nil
+}
+
+// Hash hashes the password with the current parameters
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	hash := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		passwordHashPrefix,
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash),
+	), nil
+}
+
+// Verify reports whether the password matches a PHC or legacy hash
+func (h *PasswordHasher) Verify(password, encodedHash string) (bool, error) {
+	params, salt, storedHash, err := decodePasswordHash(encodedHash)
+	if err != nil {
+		return false, err
+	}
+
+	computedHash := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	// Constant-time comparison to prevent timing attacks
+	return subtle.ConstantTimeCompare(storedHash, computedHash) == 1, nil
+}
+
+// NeedsRehash reports whether the hash was not created with the current parameters
+//
+// Legacy hashes always need a rehash since they don't record their parameters.
+func (h *PasswordHasher) NeedsRehash(encodedHash string) bool {
+	if !strings.HasPrefix(encodedHash, passwordHashPrefix) {
+		return true
+	}
+
+	params, _, _, err := decodePasswordHash(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return params != h.params
+}
+
+// decodePasswordHash returns the parameters, salt and hash of a PHC or legacy password hash
+func decodePasswordHash(encodedHash string) (PasswordHashParams, []byte, []byte, error) {
+	if !strings.HasPrefix(encodedHash, passwordHashPrefix) {
+		return decodeLegacyPasswordHash(encodedHash)
+	}
+
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, hash
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 {
+		return PasswordHashParams{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return PasswordHashParams{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	var params PasswordHashParams
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return PasswordHashParams{}, nil, nil, ErrInvalidPasswordHash
+	}
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 {
+		return PasswordHashParams{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return PasswordHashParams{}, nil, nil, ErrInvalidPasswordHash
+	}
+	hash, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(hash) == 0 {
+		return PasswordHashParams{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(hash))
+	return params, salt, hash, nil
+}
+
+// decodeLegacyPasswordHash decodes the base64(salt||hash) hashes written before the PHC format
+func decodeLegacyPasswordHash(encodedHash string) (PasswordHashParams, []byte, []byte, error) {
+	combined, err := base64.StdEncoding.DecodeString(encodedHash)
+	if err != nil {
+		return PasswordHashParams{}, nil, nil, fmt.Errorf("failed to decode password hash: %w", err)
+	}
+
+	saltLength := legacyPasswordHashParams.SaltLength
+	if len(combined) <= int(saltLength) {
+		return PasswordHashParams{}, nil, nil, ErrInvalidPasswordHash
+	}
+
+	return legacyPasswordHashParams, combined[:saltLength], combined[saltLength:], nil
+}
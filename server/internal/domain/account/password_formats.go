@@ -0,0 +1,196 @@
+package account
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Prefixes of the password hash formats imported from other identity providers
+const (
+	PasswordHashPrefixBcrypt2a       = "$2a$"
+	PasswordHashPrefixBcrypt2b       = "$2b$"
+	PasswordHashPrefixBcrypt2y       = "$2y$"
+	PasswordHashPrefixPBKDF2SHA256   = "$pbkdf2-sha256$"
+	PasswordHashPrefixScrypt         = "$scrypt$"
+	PasswordHashPrefixFirebaseScrypt = "$firebase-scrypt$"
+)
+
+// BcryptPasswordVerifier verifies bcrypt hashes, as exported by Auth0
+type BcryptPasswordVerifier struct{}
+
+// Verify reports whether the password matches a $2a$, $2b$ or $2y$ bcrypt hash
+func (BcryptPasswordVerifier) Verify(password, encodedHash string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrInvalidPasswordHash, err)
+	}
+	return true, nil
+}
+
+// PBKDF2PasswordVerifier verifies PBKDF2-SHA256 hashes in the passlib format
+//
+// Hashes are encoded as $pbkdf2-sha256$<rounds>$<salt>$<hash> using passlib's adapted base64,
+// which replaces "+" with "." and drops the padding.
+type PBKDF2PasswordVerifier struct{}
+
+// Verify reports whether the password matches a $pbkdf2-sha256$ hash
+func (PBKDF2PasswordVerifier) Verify(password, encodedHash string) (bool, error) {
+	// "", "pbkdf2-sha256", rounds, salt, hash
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 5 {
+		return false, ErrInvalidPasswordHash
+	}
+
+	rounds, err := strconv.Atoi(parts[2])
+	if err != nil || rounds <= 0 {
+		return false, ErrInvalidPasswordHash
+	}
+	salt, err := decodeAdaptedBase64(parts[3])
+	if err != nil {
+		return false, ErrInvalidPasswordHash
+	}
+	storedHash, err := decodeAdaptedBase64(parts[4])
+	if err != nil || len(storedHash) == 0 {
+		return false, ErrInvalidPasswordHash
+	}
+
+	computedHash, err := pbkdf2.Key(sha256.New, password, salt, rounds, len(storedHash))
+	if err != nil {
+		return false, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	return subtle.ConstantTimeCompare(storedHash, computedHash) == 1, nil
+}
+
+// ScryptPasswordVerifier verifies standard scrypt hashes in the passlib format
+//
+// Hashes are encoded as $scrypt$ln=<log2 N>,r=<block size>,p=<parallelism>$<salt>$<hash>
+// with unpadded base64.
+type ScryptPasswordVerifier struct{}
+
+// Verify reports whether the password matches a $scrypt$ hash
+func (ScryptPasswordVerifier) Verify(password, encodedHash string) (bool, error) {
+	// "", "scrypt", "ln=..,r=..,p=..", salt, hash
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 5 {
+		return false, ErrInvalidPasswordHash
+	}
+
+	var logN, r, p int
+	if _, err := fmt.Sscanf(parts[2], "ln=%d,r=%d,p=%d", &logN, &r, &p); err != nil {
+		return false, ErrInvalidPasswordHash
+	}
+	if logN <= 0 || logN >= 32 || r <= 0 || p <= 0 {
+		return false, ErrInvalidPasswordHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(parts[3], "="))
+	if err != nil {
+		return false, ErrInvalidPasswordHash
+	}
+	storedHash, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(parts[4], "="))
+	if err != nil || len(storedHash) == 0 {
+		return false, ErrInvalidPasswordHash
+	}
+
+	computedHash, err := scrypt.Key([]byte(password), salt, 1<<logN, r, p, len(storedHash))
+	if err != nil {
+		return false, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	return subtle.ConstantTimeCompare(storedHash, computedHash) == 1, nil
+}
+
+// FirebaseScryptPasswordVerifier verifies the modified scrypt hashes of Firebase Authentication
+//
+// Firebase derives a key with scrypt from the password and the user's salt followed by the
+// project's salt separator, then encrypts the project's signer key with AES-256-CTR using it.
+// Hashes are encoded as $firebase-scrypt$<salt>$<hash> with the base64 values of the export,
+// the project parameters are shown in the Firebase console.
+type FirebaseScryptPasswordVerifier struct {
+	signerKey     []byte
+	saltSeparator []byte
+	rounds        int
+	memCost       int
+}
+
+// NewFirebaseScryptPasswordVerifier creates a verifier from the base64 encoded project hash parameters
+func NewFirebaseScryptPasswordVerifier(signerKey, saltSeparator string, rounds, memCost int) (*FirebaseScryptPasswordVerifier, error) {
+	decodedSignerKey, err := base64.StdEncoding.DecodeString(signerKey)
+	if err != nil || len(decodedSignerKey) == 0 {
+		return nil, fmt.Errorf("%w: invalid firebase signer key", ErrInvalidPasswordHashParams)
+	}
+	decodedSaltSeparator, err := base64.StdEncoding.DecodeString(saltSeparator)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid firebase salt separator", ErrInvalidPasswordHashParams)
+	}
+	if rounds <= 0 || memCost <= 0 || memCost >= 32 {
+		return nil, fmt.Errorf("%w: invalid firebase rounds or memory cost", ErrInvalidPasswordHashParams)
+	}
+
+	return &FirebaseScryptPasswordVerifier{
+		signerKey:     decodedSignerKey,
+		saltSeparator: decodedSaltSeparator,
+		rounds:        rounds,
+		memCost:       memCost,
+	}, nil
+}
+
+// FormatFirebaseScryptHash encodes the base64 salt and hash of a Firebase export as a $firebase-scrypt$ hash
+func FormatFirebaseScryptHash(salt, hash string) string {
+	return PasswordHashPrefixFirebaseScrypt + salt + "$" + hash
+}
+
+// Verify reports whether the password matches a $firebase-scrypt$ hash
+func (v *FirebaseScryptPasswordVerifier) Verify(password, encodedHash string) (bool, error) {
+	// "", "firebase-scrypt", salt, hash
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 4 {
+		return false, ErrInvalidPasswordHash
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false, ErrInvalidPasswordHash
+	}
+	storedHash, err := base64.StdEncoding.DecodeString(parts[3])
+	if err != nil || len(storedHash) == 0 {
+		return false, ErrInvalidPasswordHash
+	}
+
+	saltWithSeparator := make([]byte, 0, len(salt)+len(v.saltSeparator))
+	saltWithSeparator = append(saltWithSeparator, salt...)
+	saltWithSeparator = append(saltWithSeparator, v.saltSeparator...)
+
+	derivedKey, err := scrypt.Key([]byte(password), saltWithSeparator, 1<<v.memCost, v.rounds, 1, 32)
+	if err != nil {
+		return false, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return false, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	computedHash := make([]byte, len(v.signerKey))
+	cipher.NewCTR(block, make([]byte, aes.BlockSize)).XORKeyStream(computedHash, v.signerKey)
+
+	return subtle.ConstantTimeCompare(storedHash, computedHash) == 1, nil
+}
+
+// decodeAdaptedBase64 decodes passlib's adapted base64 which uses "." instead of "+" and no padding
+func decodeAdaptedBase64(value string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(strings.ReplaceAll(strings.TrimRight(value, "="), ".", "+"))
+}
@@ -0,0 +1,181 @@
+package account
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Sample user of the firebase/scrypt reference implementation
+const (
+	testFirebaseSignerKey     = "jxspr8Ki0RYycVU8zykbdLGjFQ3McFUH0uiiTvC8pVMXAn210wjLNmdZJzxUECKbm0QsEmYUSDzZvpjeJ9WmXA=="
+	testFirebaseSaltSeparator = "Bw=="
+	testFirebaseSalt          = "42xEC+ixf3L2lw=="
+	testFirebaseHash          = "lSrfV15cpx95/sZS2W9c9Kp6i/LVgQNDNC/qzrCnh1SAyZvqmZqAjTdn3aoItz+VHjoZilo78198JAdRuid5lQ=="
+	testFirebasePassword      = "user1password"
+)
+
+func newTestPasswordHasherRegistry(t *testing.T) *PasswordHasherRegistry {
+	hasher, err := NewPasswordHasher(PasswordHashParams{Memory: 8192, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+	require.NoError(t, err)
+	firebaseVerifier, err := NewFirebaseScryptPasswordVerifier(testFirebaseSignerKey, testFirebaseSaltSeparator, 8, 14)
+	require.NoError(t, err)
+
+	registry := NewPasswordHasherRegistry(hasher)
+	registry.Register(PasswordHashPrefixBcrypt2a, BcryptPasswordVerifier{})
+	registry.Register(PasswordHashPrefixBcrypt2b, BcryptPasswordVerifier{})
+	registry.Register(PasswordHashPrefixPBKDF2SHA256, PBKDF2PasswordVerifier{})
+	registry.Register(PasswordHashPrefixScrypt, ScryptPasswordVerifier{})
+	registry.Register(PasswordHashPrefixFirebaseScrypt, firebaseVerifier)
+	return registry
+}
+
+func TestPasswordHasherRegistry(t *testing.T) {
+	registry := newTestPasswordHasherRegistry(t)
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("Str0ng!Password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	salt := []byte("0123456789abcdef")
+	pbkdf2Key, err := pbkdf2.Key(sha256.New, "Str0ng!Password", salt, 1000, 32)
+	require.NoError(t, err)
+	adaptedBase64 := func(b []byte) string {
+		return strings.ReplaceAll(base64.RawStdEncoding.EncodeToString(b), "+", ".")
+	}
+	pbkdf2Hash := fmt.Sprintf("$pbkdf2-sha256$1000$%s$%s", adaptedBase64(salt), adaptedBase64(pbkdf2Key))
+
+	scryptKey, err := scrypt.Key([]byte("Str0ng!Password"), salt, 1<<10, 8, 1, 32)
+	require.NoError(t, err)
+	scryptHash := fmt.Sprintf("$scrypt$ln=10,r=8,p=1$%s$%s",
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(scryptKey))
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+	}{
+		{name: "bcrypt", hash: string(bcryptHash), password: "Str0ng!Password"},
+		{name: "bcrypt 2b", hash: "$2b$" + strings.TrimPrefix(string(bcryptHash), "$2a$"), password: "Str0ng!Password"},
+		{name: "pbkdf2-sha256", hash: pbkdf2Hash, password: "Str0ng!Password"},
+		{name: "scrypt", hash: scryptHash, password: "Str0ng!Password"},
+		{name: "firebase scrypt", hash: FormatFirebaseScryptHash(testFirebaseSalt, testFirebaseHash), password: testFirebasePassword},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, registry.Supports(tt.hash))
+			assert.True(t, registry.NeedsRehash(tt.hash))
+
+			valid, err := registry.Verify(tt.password, tt.hash)
+			require.NoError(t, err)
+			assert.True(t, valid)
+
+			valid, err = registry.Verify("wrong", tt.hash)
+			require.NoError(t, err)
+			assert.False(t, valid)
+		})
+	}
+
+	t.Run("argon2id hashes are verified by the hasher", func(t *testing.T) {
+		hash, err := registry.Hash("Str0ng!Password")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(hash, "$argon2id$"))
+		assert.True(t, registry.Supports(hash))
+		assert.False(t, registry.NeedsRehash(hash))
+
+		valid, err := registry.Verify("Str0ng!Password", hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("unknown formats are not supported", func(t *testing.T) {
+		assert.False(t, registry.Supports("$md5-crypt$abc"))
+		assert.False(t, registry.Supports("$pbkdf2-sha512$1000$c2FsdA$aGFzaA"))
+	})
+
+	t.Run("malformed hashes are rejected", func(t *testing.T) {
+		for _, hash := range []string{
+			"$pbkdf2-sha256$abc$c2FsdA$aGFzaA",
+			"$scrypt$ln=0,r=8,p=1$c2FsdA$aGFzaA",
+			"$firebase-scrypt$not base64$aGFzaA==",
+			"$2a$10$short",
+		} {
+			_, err := registry.Verify("Str0ng!Password", hash)
+			assert.ErrorIs(t, err, ErrInvalidPasswordHash, hash)
+		}
+	})
+}
+
+func TestNewFirebaseScryptPasswordVerifier(t *testing.T) {
+	_, err := NewFirebaseScryptPasswordVerifier("", testFirebaseSaltSeparator, 8, 14)
+	assert.ErrorIs(t, err, ErrInvalidPasswordHashParams)
+
+	_, err = NewFirebaseScryptPasswordVerifier(testFirebaseSignerKey, testFirebaseSaltSeparator, 0, 14)
+	assert.ErrorIs(t, err, ErrInvalidPasswordHashParams)
+}
+
+func TestParseAccountExport(t *testing.T) {
+	t.Run("firebase", func(t *testing.T) {
+		export := `{"users": [
+			{"localId": "1", "email": "User1@Example.com", "emailVerified": true, "displayName": "User One", "passwordHash": "` + testFirebaseHash + `", "salt": "` + testFirebaseSalt + `"},
+			{"localId": "2", "email": "user2@example.com", "emailVerified": true, "phoneNumber": "+14155552671", "providerUserInfo": [{"providerId": "phone"}]},
+			{"localId": "3", "email": "user3@example.com", "phoneNumber": "+14155552672", "passwordHash": "` + testFirebaseHash + `", "salt": "` + testFirebaseSalt + `"}
+		]}`
+
+		accounts, err := ParseAccountExport(ImportFormatFirebase, strings.NewReader(export))
+		require.NoError(t, err)
+		require.Len(t, accounts, 3)
+
+		assert.Equal(t, "user1@example.com", accounts[0].Email)
+		assert.Equal(t, "User One", accounts[0].FullName)
+		require.NotNil(t, accounts[0].PasswordHash)
+		assert.Equal(t, FormatFirebaseScryptHash(testFirebaseSalt, testFirebaseHash), *accounts[0].PasswordHash)
+
+		assert.Equal(t, "user2", accounts[1].FullName)
+		assert.Nil(t, accounts[1].PasswordHash)
+		require.NotNil(t, accounts[1].PhoneNumber)
+		assert.Equal(t, "+14155552671", *accounts[1].PhoneNumber)
+
+		// Unverified emails lose their password and unverified phone numbers are dropped
+		assert.False(t, accounts[2].EmailVerified)
+		assert.Nil(t, accounts[2].PasswordHash)
+		assert.Nil(t, accounts[2].PhoneNumber)
+	})
+
+	t.Run("auth0", func(t *testing.T) {
+		export := `{"_id":{"$oid":"1"},"email":"user1@example.com","email_verified":true,"passwordHash":"$2b$10$abcdefghijklmnopqrstuu5Yb7TB0mSMoGgjMQ6hm8qGMx.8GLYSy"}
+{"_id":{"$oid":"2"},"email":"user2@example.com","name":"User Two","phone_number":"+447911123456","phone_verified":true}
+{"_id":{"$oid":"3"},"email":"user3@example.com","email_verified":false,"passwordHash":"$2b$10$abcdefghijklmnopqrstuu5Yb7TB0mSMoGgjMQ6hm8qGMx.8GLYSy","phone_number":"+14155552671"}
+{"_id":{"$oid":"4"},"email":"user4@example.com","phone_number":"4155552671","phone_verified":true}
+`
+
+		accounts, err := ParseAccountExport(ImportFormatAuth0, strings.NewReader(export))
+		require.NoError(t, err)
+		require.Len(t, accounts, 4)
+
+		require.NotNil(t, accounts[0].PasswordHash)
+		assert.True(t, strings.HasPrefix(*accounts[0].PasswordHash, PasswordHashPrefixBcrypt2b))
+		assert.Equal(t, "User Two", accounts[1].FullName)
+		assert.Nil(t, accounts[1].PasswordHash)
+		require.NotNil(t, accounts[1].PhoneNumber)
+		assert.Equal(t, "+447911123456", *accounts[1].PhoneNumber)
+
+		// Unverified emails lose their password, unverified or non E.164 phone numbers are dropped
+		assert.Nil(t, accounts[2].PasswordHash)
+		assert.Nil(t, accounts[2].PhoneNumber)
+		assert.Nil(t, accounts[3].PhoneNumber)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := ParseAccountExport("okta", strings.NewReader("{}"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,82 @@
+package account
+
+import (
+	"sort"
+	"strings"
+)
+
+// PasswordVerifier verifies passwords against the hashes of a single format
+type PasswordVerifier interface {
+	Verify(password, encodedHash string) (bool, error)
+}
+
+// PasswordHasherRegistry hashes new passwords with Argon2id and verifies the hashes of every registered format
+//
+// Verifiers are keyed by the prefix of the hashes they handle. Hashes without a registered prefix
+// are verified by the Argon2id hasher, which also handles the legacy base64(salt||hash) format.
+// Hashes of any other format need a rehash so that they are upgraded to Argon2id on the next login.
+type PasswordHasherRegistry struct {
+	hasher    *PasswordHasher
+	verifiers map[string]PasswordVerifier
+	// prefixes are sorted longest first so that the most specific prefix wins
+	prefixes []string
+}
+
+// NewPasswordHasherRegistry creates a registry hashing new passwords with the given Argon2id hasher
+func NewPasswordHasherRegistry(hasher *PasswordHasher) *PasswordHasherRegistry {
+	return &PasswordHasherRegistry{
+		hasher:    hasher,
+		verifiers: make(map[string]PasswordVerifier),
+	}
+}
+
+// Register verifies hashes starting with the prefix with the verifier
+func (r *PasswordHasherRegistry) Register(prefix string, verifier PasswordVerifier) {
+	if _, ok := r.verifiers[prefix]; !ok {
+		r.prefixes = append(r.prefixes, prefix)
+		sort.Slice(r.prefixes, func(i, j int) bool {
+			return len(r.prefixes[i]) > len(r.prefixes[j])
+		})
+	}
+	r.verifiers[prefix] = verifier
+}
+
+// Hash hashes the password with Argon2id
+func (r *PasswordHasherRegistry) Hash(password string) (string, error) {
+	return r.hasher.Hash(password)
+}
+
+// Verify reports whether the password matches the hash using the verifier registered for its prefix
+func (r *PasswordHasherRegistry) Verify(password, encodedHash string) (bool, error) {
+	if verifier := r.verifier(encodedHash); verifier != nil {
+		return verifier.Verify(password, encodedHash)
+	}
+	return r.hasher.Verify(password, encodedHash)
+}
+
+// NeedsRehash reports whether the hash isn't an Argon2id hash with the current parameters
+func (r *PasswordHasherRegistry) NeedsRehash(encodedHash string) bool {
+	if r.verifier(encodedHash) != nil {
+		return true
+	}
+	return r.hasher.NeedsRehash(encodedHash)
+}
+
+// Supports reports whether the hash has a registered format or is an Argon2id hash
+func (r *PasswordHasherRegistry) Supports(encodedHash string) bool {
+	if r.verifier(encodedHash) != nil {
+		return true
+	}
+	_, _, _, err := decodePasswordHash(encodedHash)
+	return err == nil
+}
+
+// verifier returns the verifier registered for the longest matching prefix of the hash
+func (r *PasswordHasherRegistry) verifier(encodedHash string) PasswordVerifier {
+	for _, prefix := range r.prefixes {
+		if strings.HasPrefix(encodedHash, prefix) {
+			return r.verifiers[prefix]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package account
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+)
+
+// legacyPasswordHash hashes a password in the base64(salt||hash) format used before PHC hashes
+func legacyPasswordHash(t *testing.T, password string) string {
+	salt := make([]byte, 16)
+	_, err := rand.Read(salt)
+	require.NoError(t, err)
+
+	hash := argon2.IDKey([]byte(password), salt, 1, 102400, 8, 32)
+	return base64.StdEncoding.EncodeToString(append(salt, hash...))
+}
+
+func TestNewPasswordHasher(t *testing.T) {
+	_, err := NewPasswordHasher(PasswordHashParams{Memory: 0, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+	assert.ErrorIs(t, err, ErrInvalidPasswordHashParams)
+
+	_, err = NewPasswordHasher(PasswordHashParams{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 4, KeyLength: 32})
+	assert.ErrorIs(t, err, ErrInvalidPasswordHashParams)
+}
+
+func TestPasswordHasher(t *testing.T) {
+	params := PasswordHashParams{Memory: 8192, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	hasher, err := NewPasswordHasher(params)
+	require.NoError(t, err)
+
+	t.Run("Hash encodes the parameters", func(t *testing.T) {
+		hash, err := hasher.Hash("Str0ng!Password")
+		require.NoError(t, err)
+		assert.Regexp(t, `^\$argon2id\$v=19\$m=8192,t=2,p=1\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`, hash)
+
+		valid, err := hasher.Verify("Str0ng!Password", hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = hasher.Verify("wrong", hash)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("Verify uses the parameters stored in the hash", func(t *testing.T) {
+		otherHasher, err := NewPasswordHasher(PasswordHashParams{Memory: 4096, Iterations: 1, Parallelism: 2, SaltLength: 8, KeyLength: 16})
+		require.NoError(t, err)
+		hash, err := otherHasher.Hash("Str0ng!Password")
+		require.NoError(t, err)
+
+		valid, err := hasher.Verify("Str0ng!Password", hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("Verify accepts legacy hashes", func(t *testing.T) {
+		hash := legacyPasswordHash(t, "Str0ng!Password")
+
+		valid, err := hasher.Verify("Str0ng!Password", hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		valid, err = hasher.Verify("wrong", hash)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("Verify rejects malformed hashes", func(t *testing.T) {
+		for _, hash := range []string{
+			"$argon2id$v=19$m=8192,t=2,p=1$c2FsdA",
+			"$argon2id$v=16$m=8192,t=2,p=1$c2FsdHNhbHRzYWx0$aGFzaA",
+			"$argon2id$v=19$m=0,t=2,p=1$c2FsdHNhbHRzYWx0$aGFzaA",
+			"$argon2id$v=19$m=8192,t=2,p=1$$aGFzaA",
+			"",
+		} {
+			_, err := hasher.Verify("Str0ng!Password", hash)
+			assert.Error(t, err, hash)
+		}
+	})
+
+	t.Run("NeedsRehash detects other parameters and legacy hashes", func(t *testing.T) {
+		hash, err := hasher.Hash("Str0ng!Password")
+		require.NoError(t, err)
+		assert.False(t, hasher.NeedsRehash(hash))
+
+		otherHasher, err := NewPasswordHasher(PasswordHashParams{Memory: 8192, Iterations: 3, Parallelism: 1, SaltLength: 16, KeyLength: 32})
+		require.NoError(t, err)
+		assert.True(t, otherHasher.NeedsRehash(hash))
+
+		assert.True(t, hasher.NeedsRehash(legacyPasswordHash(t, "Str0ng!Password")))
+	})
+}
@@ -3,6 +3,9 @@ package account
 import (
 	"log"
 
+	"server/internal/config"
+	"server/internal/infrastructure/ratelimit"
+
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
@@ -10,14 +13,59 @@ import (
 // AccountDomainModule contains all account domain repositories and services for dependency injection
 var AccountDomainModule = fx.Options(
 	fx.Provide(
+		NewPasswordHasherProvider,
+		NewPasswordHasherRegistryProvider,
 		NewAccountRepo,
 		NewEmailVerificationTokenRepo,
 		NewPhoneNumberVerificationTokenRepo,
+		NewPasswordHistoryRepo,
 		NewAccountService,
+		NewSecurityEventBus,
 		NewDummyMessageSenderForFX,
+		NewSMSAlertHookProvider,
 	),
+	// Every SMS goes through the fraud protection guard
+	fx.Decorate(NewSMSGuardProvider),
 )
 
+// NewPasswordHasherProvider creates the password hasher from the PASSWORD_HASH_* configuration
+func NewPasswordHasherProvider(cfg *config.Config) (*PasswordHasher, error) {
+	return NewPasswordHasher(PasswordHashParams{
+		Memory:      cfg.PasswordHashMemory,
+		Iterations:  cfg.PasswordHashIterations,
+		Parallelism: cfg.PasswordHashParallelism,
+		SaltLength:  cfg.PasswordHashSaltLength,
+		KeyLength:   cfg.PasswordHashKeyLength,
+	})
+}
+
+// NewPasswordHasherRegistryProvider registers the password hash formats imported from other identity providers
+//
+// Firebase scrypt hashes are only accepted when the project's signer key is configured.
+func NewPasswordHasherRegistryProvider(cfg *config.Config, hasher *PasswordHasher) (*PasswordHasherRegistry, error) {
+	registry := NewPasswordHasherRegistry(hasher)
+	registry.Register(PasswordHashPrefixBcrypt2a, BcryptPasswordVerifier{})
+	registry.Register(PasswordHashPrefixBcrypt2b, BcryptPasswordVerifier{})
+	registry.Register(PasswordHashPrefixBcrypt2y, BcryptPasswordVerifier{})
+	registry.Register(PasswordHashPrefixPBKDF2SHA256, PBKDF2PasswordVerifier{})
+	registry.Register(PasswordHashPrefixScrypt, ScryptPasswordVerifier{})
+
+	if cfg.FirebaseScryptSignerKey != "" {
+		verifier, err := NewFirebaseScryptPasswordVerifier(
+			cfg.FirebaseScryptSignerKey,
+			cfg.FirebaseScryptSaltSeparator,
+			cfg.FirebaseScryptRounds,
+			cfg.FirebaseScryptMemCost,
+		)
+		if err != nil {
+			return nil, err
+		}
+		registry.Register(PasswordHashPrefixFirebaseScrypt, verifier)
+	}
+
+	return registry, nil
+}
+
 // NewDummyMessageSenderForFX creates a new dummy message sender for FX dependency injection
 func NewDummyMessageSenderForFX(logger *zap.Logger) MessageSender {
 	// Convert zap logger to standard logger for compatibility with existing code
@@ -32,3 +80,13 @@ func NewDummyMessageSenderForFX(logger *zap.Logger) MessageSender {
 
 	return NewDummyMessageSender(config, stdLogger)
 }
+
+// NewSMSAlertHookProvider creates the SMS budget alert hook, logging the alerts
+func NewSMSAlertHookProvider(logger *zap.Logger) SMSAlertHook {
+	return NewLoggingSMSAlertHook(logger)
+}
+
+// NewSMSGuardProvider puts the SMS fraud protection guard in front of the message sender
+func NewSMSGuardProvider(sender MessageSender, store ratelimit.Store, alertHook SMSAlertHook, cfg *config.Config) MessageSender {
+	return NewSMSGuard(sender, store, alertHook, cfg)
+}
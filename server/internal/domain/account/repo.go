@@ -2,10 +2,8 @@ package account
 
 import (
 	"context"
-	"crypto/md5"
 	"crypto/rand"
 	"database/sql"
-	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -13,60 +11,12 @@ import (
 	"strings"
 	"time"
 
+	"server/internal/infrastructure/tokenhash"
+
 	"github.com/uptrace/bun"
-	"golang.org/x/crypto/argon2"
 )
 
-// Security utility functions for password hashing
-func HashPassword(password string) (string, error) {
-	// Use argon2 parameters that match Python passlib defaults
-	// Python passlib.argon2 defaults: time=1, memory=102400, parallelism=8, hashlen=16, saltlen=16
-	salt := make([]byte, 16)
-	if _, err := rand.Read(salt); err != nil {
-		return "", fmt.Errorf("failed to generate salt: %w", err)
-	}
-
-	hash := argon2.IDKey([]byte(password), salt, 1, 102400, 8, 32)
-
-	// Combine salt and hash for storage
-	combined := append(salt, hash...)
-	return base64.StdEncoding.EncodeToString(combined), nil
-}
-
-func VerifyPassword(password, hash string) (bool, error) {
-	combined, err := base64.StdEncoding.DecodeString(hash)
-	if err != nil {
-		return false, fmt.Errorf("failed to decode password hash: %w", err)
-	}
-
-	if len(combined) < 16 {
-		return false, errors.New("invalid password hash format")
-	}
-
-	salt := combined[:16]
-	storedHash := combined[16:]
-
-	computedHash := argon2.IDKey([]byte(password), salt, 1, 102400, 8, 32)
-
-	// Constant-time comparison to prevent timing attacks
-	if len(storedHash) != len(computedHash) {
-		return false, nil
-	}
-
-	var result byte
-	for i := range storedHash {
-		result |= storedHash[i] ^ computedHash[i]
-	}
-
-	return result == 0, nil
-}
-
-// Token generation and hashing utilities
-func HashVerificationToken(token string) string {
-	hash := md5.Sum([]byte(token))
-	return hex.EncodeToString(hash[:])
-}
-
+// Token generation utilities
 func GenerateVerificationToken(length int) (string, error) {
 	if length <= 0 {
 		length = 32 // default length
@@ -121,33 +71,46 @@ type AccountRepo interface {
 	Update(ctx context.Context, account *Account, fullName *string, avatarURL *string, phoneNumber *string, termsAndPolicy *TermsAndPolicy, analyticsPreference *AnalyticsPreference) (*Account, error)
 	UpdateAuthProviders(ctx context.Context, account *Account, authProviders []string) (*Account, error)
 	DeleteAvatar(ctx context.Context, account *Account) (*Account, error)
+	DeletePhoneNumber(ctx context.Context, account *Account) (*Account, error)
 	SetTwoFactorSecret(ctx context.Context, account *Account, totpSecret string) (*Account, error)
 	DeleteTwoFactorSecret(ctx context.Context, account *Account) (*Account, error)
+	UseTwoFactorTimeStep(ctx context.Context, account *Account, timeStep int64) (bool, error)
 	UpdatePassword(ctx context.Context, account *Account, password string) (*Account, error)
 	DeletePassword(ctx context.Context, account *Account) (*Account, error)
 	Delete(ctx context.Context, account *Account) error
 
+	// Import creates an account with a password hash exported from another identity provider
+	Import(ctx context.Context, email string, fullName string, passwordHash *string, phoneNumber *string) (*Account, error)
+	RehashPassword(ctx context.Context, account *Account, password string) (*Account, error)
+
 	// Static methods for password operations
 	HashPassword(password string) (string, error)
 	VerifyPassword(password, hash string) (bool, error)
+	PasswordNeedsRehash(hash string) bool
 }
 
 // Repository implementations
 type accountRepo struct {
-	db *bun.DB
+	db              *bun.DB
+	passwordHashers *PasswordHasherRegistry
 }
 
-func NewAccountRepo(db *bun.DB) AccountRepo {
-	return &accountRepo{db: db}
+func NewAccountRepo(db *bun.DB, passwordHashers *PasswordHasherRegistry) AccountRepo {
+	return &accountRepo{db: db, passwordHashers: passwordHashers}
 }
 
 // Implement static methods for AccountRepo
 func (r *accountRepo) HashPassword(password string) (string, error) {
-	return HashPassword(password)
+	return r.passwordHashers.Hash(password)
 }
 
 func (r *accountRepo) VerifyPassword(password, hash string) (bool, error) {
-	return VerifyPassword(password, hash)
+	return r.passwordHashers.Verify(password, hash)
+}
+
+// PasswordNeedsRehash reports whether the hash isn't an Argon2id hash with the current parameters
+func (r *accountRepo) PasswordNeedsRehash(hash string) bool {
+	return r.passwordHashers.NeedsRehash(hash)
 }
 
 // Create creates a new account
@@ -174,7 +137,7 @@ func (r *accountRepo) Create(ctx context.Context, email string, fullName string,
 	account.TermsAndPolicy = TermsAndPolicy{
 		Type:      "acceptance",
 		UpdatedAt: time.Now(),
-		Version:   "1.0",
+		Version:   LatestTermsAndPolicyVersion,
 	}
 
 	if analyticsPreference == "" {
@@ -185,7 +148,43 @@ func (r *accountRepo) Create(ctx context.Context, email string, fullName string,
 		UpdatedAt: time.Now(),
 	}
 
-	// Insert into database
+	return r.insert(ctx, account)
+}
+
+// Import creates an account with a password hash exported from another identity provider
+//
+// The hash must use a registered format, it is upgraded to Argon2id on the first successful login.
+func (r *accountRepo) Import(ctx context.Context, email string, fullName string, passwordHash *string, phoneNumber *string) (*Account, error) {
+	account := &Account{
+		FullName:      fullName,
+		Email:         email,
+		AuthProviders: []string{},
+		PhoneNumber:   phoneNumber,
+	}
+
+	if passwordHash != nil {
+		if !r.passwordHashers.Supports(*passwordHash) {
+			return nil, ErrUnsupportedPasswordHash
+		}
+		account.PasswordHash = passwordHash
+		account.AuthProviders = append(account.AuthProviders, AuthProviderPassword)
+	}
+
+	// Imported users haven't accepted our terms yet, leaving the version empty prompts them to
+	account.TermsAndPolicy = TermsAndPolicy{
+		Type:      "imported",
+		UpdatedAt: time.Now(),
+	}
+	account.AnalyticsPref = AnalyticsPreference{
+		Type:      "undecided",
+		UpdatedAt: time.Now(),
+	}
+
+	return r.insert(ctx, account)
+}
+
+// insert inserts a new account, mapping unique constraint violations to domain errors
+func (r *accountRepo) insert(ctx context.Context, account *Account) (*Account, error) {
 	_, err := r.db.NewInsert().
 		Model(account).
 		Returning("*").
@@ -332,6 +331,24 @@ func (r *accountRepo) DeleteAvatar(ctx context.Context, account *Account) (*Acco
 	return account, nil
 }
 
+// DeletePhoneNumber removes the phone number from an account
+func (r *accountRepo) DeletePhoneNumber(ctx context.Context, account *Account) (*Account, error) {
+	account.PhoneNumber = nil
+
+	_, err := r.db.NewUpdate().
+		Model(account).
+		Set("phone_number = NULL").
+		Set("updated_at = ?", time.Now()).
+		Where("id = ?", account.ID).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete phone number: %w", err)
+	}
+
+	return account, nil
+}
+
 // SetTwoFactorSecret sets the 2FA secret for an account
 func (r *accountRepo) SetTwoFactorSecret(ctx context.Context, account *Account, totpSecret string) (*Account, error) {
 	account.TwoFactorSecret = &totpSecret
@@ -368,6 +385,60 @@ func (r *accountRepo) DeleteTwoFactorSecret(ctx context.Context, account *Accoun
 	return account, nil
 }
 
+// UseTwoFactorTimeStep records the time step of an accepted TOTP code
+//
+// The time step is only recorded when it is later than the last accepted one, so that each
+// code is used once. Of concurrent uses of the same code, only one succeeds.
+//
+// Returns:
+//   - bool: Whether the time step was later than the last accepted one
+func (r *accountRepo) UseTwoFactorTimeStep(ctx context.Context, account *Account, timeStep int64) (bool, error) {
+	result, err := r.db.NewUpdate().
+		Model((*Account)(nil)).
+		Set("two_factor_last_time_step = ?", timeStep).
+		Set("updated_at = ?", time.Now()).
+		Where("id = ?", account.ID).
+		Where("two_factor_last_time_step < ?", timeStep).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to use two factor time step: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to use two factor time step: %w", err)
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	account.TwoFactorLastTimeStep = timeStep
+	return true, nil
+}
+
+// RehashPassword stores a new hash of the account's current password
+//
+// Unlike UpdatePassword it doesn't change the password, it is used to upgrade hashes
+// to the current parameters after the password has been verified.
+func (r *accountRepo) RehashPassword(ctx context.Context, account *Account, password string) (*Account, error) {
+	hashedPassword, err := r.HashPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	_, err = r.db.NewUpdate().
+		Model(account).
+		Set("password_hash = ?", hashedPassword).
+		Where("id = ?", account.ID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rehash password: %w", err)
+	}
+
+	account.PasswordHash = &hashedPassword
+	return account, nil
+}
+
 // UpdatePassword updates the account's password
 func (r *accountRepo) UpdatePassword(ctx context.Context, account *Account, password string) (*Account, error) {
 	hashedPassword, err := r.HashPassword(password)
@@ -378,8 +449,8 @@ func (r *accountRepo) UpdatePassword(ctx context.Context, account *Account, pass
 	account.PasswordHash = &hashedPassword
 
 	// Add "password" to auth providers if not already present
-	if !slices.Contains(account.AuthProviders, "password") {
-		account.AuthProviders = addStringToSlice(account.AuthProviders, "password")
+	if !slices.Contains(account.AuthProviders, AuthProviderPassword) {
+		account.AuthProviders = addStringToSlice(account.AuthProviders, AuthProviderPassword)
 	}
 
 	_, err = r.db.NewUpdate().
@@ -402,7 +473,7 @@ func (r *accountRepo) DeletePassword(ctx context.Context, account *Account) (*Ac
 	account.PasswordHash = nil
 
 	// Remove "password" from auth providers
-	account.AuthProviders = removeStringFromSlice(account.AuthProviders, "password")
+	account.AuthProviders = removeStringFromSlice(account.AuthProviders, AuthProviderPassword)
 
 	_, err := r.db.NewUpdate().
 		Model(account).
@@ -464,14 +535,16 @@ type EmailVerificationTokenRepo interface {
 	// Static methods for token operations
 	GenerateVerificationToken(length int) (string, error)
 	HashVerificationToken(token string) string
+	VerifyVerificationToken(token string, hash string) bool
 }
 
 type emailVerificationTokenRepo struct {
-	db *bun.DB
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
 }
 
-func NewEmailVerificationTokenRepo(db *bun.DB) EmailVerificationTokenRepo {
-	return &emailVerificationTokenRepo{db: db}
+func NewEmailVerificationTokenRepo(db *bun.DB, hasher tokenhash.TokenHasher) EmailVerificationTokenRepo {
+	return &emailVerificationTokenRepo{db: db, hasher: hasher}
 }
 
 // Implement static methods for EmailVerificationTokenRepo
@@ -480,7 +553,12 @@ func (r *emailVerificationTokenRepo) GenerateVerificationToken(length int) (stri
 }
 
 func (r *emailVerificationTokenRepo) HashVerificationToken(token string) string {
-	return HashVerificationToken(token)
+	return r.hasher.Hash(token)
+}
+
+// VerifyVerificationToken compares a token with a stored hash in constant time
+func (r *emailVerificationTokenRepo) VerifyVerificationToken(token string, hash string) bool {
+	return r.hasher.Verify(token, hash)
 }
 
 // Create creates a new email verification token
@@ -509,12 +587,10 @@ func (r *emailVerificationTokenRepo) Create(ctx context.Context, email string) (
 
 // Get retrieves an email verification token by the plaintext token
 func (r *emailVerificationTokenRepo) Get(ctx context.Context, verificationToken string) (*EmailVerificationToken, error) {
-	tokenHash := r.HashVerificationToken(verificationToken)
-
 	emailVerification := &EmailVerificationToken{}
 	err := r.db.NewSelect().
 		Model(emailVerification).
-		Where("token_hash = ?", tokenHash).
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(verificationToken))).
 		Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -523,6 +599,10 @@ func (r *emailVerificationTokenRepo) Get(ctx context.Context, verificationToken
 		return nil, fmt.Errorf("failed to get email verification token: %w", err)
 	}
 
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, emailVerification, "token_hash", &emailVerification.TokenHash, verificationToken); err != nil {
+		return nil, err
+	}
+
 	return emailVerification, nil
 }
 
@@ -564,14 +644,16 @@ type PhoneNumberVerificationTokenRepo interface {
 	// Static methods for token operations
 	GenerateVerificationToken(length int) (string, error)
 	HashVerificationToken(token string) string
+	VerifyVerificationToken(token string, hash string) bool
 }
 
 type phoneNumberVerificationTokenRepo struct {
-	db *bun.DB
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
 }
 
-func NewPhoneNumberVerificationTokenRepo(db *bun.DB) PhoneNumberVerificationTokenRepo {
-	return &phoneNumberVerificationTokenRepo{db: db}
+func NewPhoneNumberVerificationTokenRepo(db *bun.DB, hasher tokenhash.TokenHasher) PhoneNumberVerificationTokenRepo {
+	return &phoneNumberVerificationTokenRepo{db: db, hasher: hasher}
 }
 
 // Implement static methods for PhoneNumberVerificationTokenRepo
@@ -580,7 +662,12 @@ func (r *phoneNumberVerificationTokenRepo) GenerateVerificationToken(length int)
 }
 
 func (r *phoneNumberVerificationTokenRepo) HashVerificationToken(token string) string {
-	return HashVerificationToken(token)
+	return r.hasher.Hash(token)
+}
+
+// VerifyVerificationToken compares a token with a stored hash in constant time
+func (r *phoneNumberVerificationTokenRepo) VerifyVerificationToken(token string, hash string) bool {
+	return r.hasher.Verify(token, hash)
 }
 
 // Create creates a new phone number verification token
@@ -609,12 +696,10 @@ func (r *phoneNumberVerificationTokenRepo) Create(ctx context.Context, phoneNumb
 
 // Get retrieves a phone number verification token by the plaintext token
 func (r *phoneNumberVerificationTokenRepo) Get(ctx context.Context, verificationToken string) (*PhoneNumberVerificationToken, error) {
-	tokenHash := r.HashVerificationToken(verificationToken)
-
 	phoneVerification := &PhoneNumberVerificationToken{}
 	err := r.db.NewSelect().
 		Model(phoneVerification).
-		Where("token_hash = ?", tokenHash).
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(verificationToken))).
 		Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -623,6 +708,10 @@ func (r *phoneNumberVerificationTokenRepo) Get(ctx context.Context, verification
 		return nil, fmt.Errorf("failed to get phone number verification token: %w", err)
 	}
 
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, phoneVerification, "token_hash", &phoneVerification.TokenHash, verificationToken); err != nil {
+		return nil, err
+	}
+
 	return phoneVerification, nil
 }
 
@@ -654,3 +743,84 @@ func (r *phoneNumberVerificationTokenRepo) Delete(ctx context.Context, phoneNumb
 	}
 	return nil
 }
+
+type PasswordHistoryRepo interface {
+	Create(ctx context.Context, accountID int64, passwordHash string) (*PasswordHistoryEntry, error)
+	GetRecent(ctx context.Context, accountID int64, limit int, since time.Time) ([]*PasswordHistoryEntry, error)
+	Prune(ctx context.Context, accountID int64, keep int, before time.Time) error
+}
+
+type passwordHistoryRepo struct {
+	db *bun.DB
+}
+
+func NewPasswordHistoryRepo(db *bun.DB) PasswordHistoryRepo {
+	return &passwordHistoryRepo{db: db}
+}
+
+// Create records a previous password hash of the account
+func (r *passwordHistoryRepo) Create(ctx context.Context, accountID int64, passwordHash string) (*PasswordHistoryEntry, error) {
+	entry := &PasswordHistoryEntry{
+		PasswordHash: passwordHash,
+		AccountId:    accountID,
+	}
+
+	_, err := r.db.NewInsert().
+		Model(entry).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create password history entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// GetRecent returns up to limit of the account's previous password hashes created after since, newest first
+func (r *passwordHistoryRepo) GetRecent(ctx context.Context, accountID int64, limit int, since time.Time) ([]*PasswordHistoryEntry, error) {
+	var entries []*PasswordHistoryEntry
+	err := r.db.NewSelect().
+		Model(&entries).
+		Where("account_id = ?", accountID).
+		Where("created_at > ?", since).
+		Order("id DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Prune removes the account's entries beyond the keep newest ones, and the entries of every account created before before
+func (r *passwordHistoryRepo) Prune(ctx context.Context, accountID int64, keep int, before time.Time) error {
+	query := r.db.NewDelete().
+		Model((*PasswordHistoryEntry)(nil)).
+		Where("account_id = ?", accountID)
+	// A zero limit would select every entry, so nothing is kept without the subquery
+	if keep > 0 {
+		keptEntries := r.db.NewSelect().
+			Model((*PasswordHistoryEntry)(nil)).
+			Column("id").
+			Where("account_id = ?", accountID).
+			Order("id DESC").
+			Limit(keep)
+		query = query.Where("id NOT IN (?)", keptEntries)
+	}
+
+	_, err := query.Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prune password history: %w", err)
+	}
+
+	_, err = r.db.NewDelete().
+		Model((*PasswordHistoryEntry)(nil)).
+		Where("created_at <= ?", before).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired password history: %w", err)
+	}
+
+	return nil
+}
@@ -3,9 +3,12 @@ package account
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
+	"server/internal/infrastructure/tokenhash"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/uptrace/bun"
@@ -13,6 +16,24 @@ import (
 	"github.com/uptrace/bun/driver/pgdriver"
 )
 
+// testTokenHasher hashes tokens like the repositories, legacy MD5 hashes are accepted
+var testTokenHasher = func() tokenhash.TokenHasher {
+	hasher, err := tokenhash.NewTokenHasher("test-pepper", true)
+	if err != nil {
+		panic(err)
+	}
+	return hasher
+}()
+
+// testPasswordHasher hashes passwords with the default parameters
+var testPasswordHasher = func() *PasswordHasherRegistry {
+	hasher, err := NewPasswordHasher(DefaultPasswordHashParams)
+	if err != nil {
+		panic(err)
+	}
+	return NewPasswordHasherRegistry(hasher)
+}()
+
 // setupTestDB creates a test database connection
 func setupTestDB(t *testing.T) *bun.DB {
 	// Use in-memory PostgreSQL connection string for testing
@@ -66,7 +87,7 @@ func TestHashPassword(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			hash, err := HashPassword(tt.password)
+			hash, err := testPasswordHasher.Hash(tt.password)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -74,8 +95,9 @@ func TestHashPassword(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotEmpty(t, hash)
-				// Hash should be base64 encoded
+				// Hash should be PHC encoded
 				assert.NotContains(t, hash, "\x00")
+				assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=102400,t=1,p=8$"))
 			}
 		})
 	}
@@ -85,7 +107,7 @@ func TestVerifyPassword(t *testing.T) {
 	password := "testPassword123!"
 
 	// First, hash a password
-	hash, err := HashPassword(password)
+	hash, err := testPasswordHasher.Hash(password)
 	require.NoError(t, err)
 	require.NotEmpty(t, hash)
 
@@ -135,7 +157,7 @@ func TestVerifyPassword(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			valid, err := VerifyPassword(tt.password, tt.hash)
+			valid, err := testPasswordHasher.Verify(tt.password, tt.hash)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -147,18 +169,6 @@ func TestVerifyPassword(t *testing.T) {
 	}
 }
 
-func TestHashVerificationToken(t *testing.T) {
-	token := "test-token-12345"
-
-	hash1 := HashVerificationToken(token)
-	hash2 := HashVerificationToken(token)
-
-	// Same token should produce same hash
-	assert.Equal(t, hash1, hash2)
-	assert.NotEmpty(t, hash1)
-	assert.Len(t, hash1, 32) // MD5 hash length in hex
-}
-
 func TestGenerateVerificationToken(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -239,7 +249,7 @@ func TestGenerateVerificationTokenUniqueness(t *testing.T) {
 
 func TestNewAccountRepo(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 
 	assert.NotNil(t, repo)
 	assert.Implements(t, (*AccountRepo)(nil), repo)
@@ -247,7 +257,7 @@ func TestNewAccountRepo(t *testing.T) {
 
 func TestAccountRepo_Create(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	tests := []struct {
@@ -340,7 +350,7 @@ func TestAccountRepo_Create(t *testing.T) {
 
 func TestAccountRepo_Get(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account first
@@ -389,7 +399,7 @@ func TestAccountRepo_Get(t *testing.T) {
 
 func TestAccountRepo_GetByEmail(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account first
@@ -444,7 +454,7 @@ func TestAccountRepo_GetByEmail(t *testing.T) {
 
 func TestAccountRepo_Update(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account first
@@ -567,7 +577,7 @@ func TestAccountRepo_Update(t *testing.T) {
 
 func TestAccountRepo_UpdateAuthProviders(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account first
@@ -613,7 +623,7 @@ func TestAccountRepo_UpdateAuthProviders(t *testing.T) {
 
 func TestAccountRepo_DeleteAvatar(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account first
@@ -652,7 +662,7 @@ func TestAccountRepo_DeleteAvatar(t *testing.T) {
 
 func TestAccountRepo_UpdatePassword(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account first
@@ -680,7 +690,7 @@ func TestAccountRepo_UpdatePassword(t *testing.T) {
 
 func TestAccountRepo_UpdatePassword_AlreadyHasPassword(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account with password
@@ -714,7 +724,7 @@ func TestAccountRepo_UpdatePassword_AlreadyHasPassword(t *testing.T) {
 
 func TestAccountRepo_DeletePassword(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account with password
@@ -735,7 +745,7 @@ func TestAccountRepo_DeletePassword(t *testing.T) {
 
 func TestAccountRepo_SetTwoFactorSecret(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account first
@@ -760,7 +770,7 @@ func TestAccountRepo_SetTwoFactorSecret(t *testing.T) {
 
 func TestAccountRepo_DeleteTwoFactorSecret(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account and set 2FA secret
@@ -789,7 +799,7 @@ func TestAccountRepo_DeleteTwoFactorSecret(t *testing.T) {
 
 func TestAccountRepo_Delete(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 	ctx := context.Background()
 
 	// Create an account first
@@ -817,7 +827,7 @@ func TestAccountRepo_Delete(t *testing.T) {
 
 func TestAccountRepo_StaticMethods(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewAccountRepo(db)
+	repo := NewAccountRepo(db, testPasswordHasher)
 
 	password := "testPassword123!"
 
@@ -840,7 +850,7 @@ func TestAccountRepo_StaticMethods(t *testing.T) {
 
 func TestNewEmailVerificationTokenRepo(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewEmailVerificationTokenRepo(db)
+	repo := NewEmailVerificationTokenRepo(db, testTokenHasher)
 
 	assert.NotNil(t, repo)
 	assert.Implements(t, (*EmailVerificationTokenRepo)(nil), repo)
@@ -848,7 +858,7 @@ func TestNewEmailVerificationTokenRepo(t *testing.T) {
 
 func TestEmailVerificationTokenRepo_Create(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewEmailVerificationTokenRepo(db)
+	repo := NewEmailVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	email := "verify@example.com"
@@ -867,14 +877,14 @@ func TestEmailVerificationTokenRepo_Create(t *testing.T) {
 	assert.Regexp(t, `^[0-9a-f]+$`, token)
 	assert.Len(t, token, 64) // 32 bytes = 64 hex chars
 
-	// Token hash should match MD5 hash of token
-	expectedHash := HashVerificationToken(token)
+	// Token hash should match the keyed hash of token
+	expectedHash := testTokenHasher.Hash(token)
 	assert.Equal(t, expectedHash, emailToken.TokenHash)
 }
 
 func TestEmailVerificationTokenRepo_Get(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewEmailVerificationTokenRepo(db)
+	repo := NewEmailVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	// Create a token first
@@ -900,7 +910,7 @@ func TestEmailVerificationTokenRepo_Get(t *testing.T) {
 
 func TestEmailVerificationTokenRepo_GetByEmail(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewEmailVerificationTokenRepo(db)
+	repo := NewEmailVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	// Create a token first
@@ -925,7 +935,7 @@ func TestEmailVerificationTokenRepo_GetByEmail(t *testing.T) {
 
 func TestEmailVerificationTokenRepo_Delete(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewEmailVerificationTokenRepo(db)
+	repo := NewEmailVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	// Create a token first
@@ -955,7 +965,7 @@ func TestEmailVerificationTokenRepo_Delete(t *testing.T) {
 
 func TestEmailVerificationTokenRepo_StaticMethods(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewEmailVerificationTokenRepo(db)
+	repo := NewEmailVerificationTokenRepo(db, testTokenHasher)
 
 	// Test static GenerateVerificationToken method
 	token, err := repo.GenerateVerificationToken(16)
@@ -967,17 +977,18 @@ func TestEmailVerificationTokenRepo_StaticMethods(t *testing.T) {
 	// Test static HashVerificationToken method
 	originalToken := "test-token-12345"
 	hash1 := repo.HashVerificationToken(originalToken)
-	hash2 := HashVerificationToken(originalToken) // Compare with global function
+	hash2 := testTokenHasher.Hash(originalToken) // Compare with the shared hasher
 
 	assert.Equal(t, hash1, hash2)
-	assert.Len(t, hash1, 32) // MD5 hash length in hex
+	assert.Len(t, hash1, 64) // HMAC-SHA256 length in hex
+	assert.True(t, repo.VerifyVerificationToken(originalToken, hash1))
 }
 
 // Task Group 6: Phone Number Verification Token Repository Tests
 
 func TestNewPhoneNumberVerificationTokenRepo(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewPhoneNumberVerificationTokenRepo(db)
+	repo := NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 
 	assert.NotNil(t, repo)
 	assert.Implements(t, (*PhoneNumberVerificationTokenRepo)(nil), repo)
@@ -985,7 +996,7 @@ func TestNewPhoneNumberVerificationTokenRepo(t *testing.T) {
 
 func TestPhoneNumberVerificationTokenRepo_Create(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewPhoneNumberVerificationTokenRepo(db)
+	repo := NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	phoneNumber := "+1234567890"
@@ -1004,14 +1015,14 @@ func TestPhoneNumberVerificationTokenRepo_Create(t *testing.T) {
 	assert.Regexp(t, `^[0-9a-f]+$`, token)
 	assert.Len(t, token, 64) // 32 bytes = 64 hex chars
 
-	// Token hash should match MD5 hash of token
-	expectedHash := HashVerificationToken(token)
+	// Token hash should match the keyed hash of token
+	expectedHash := testTokenHasher.Hash(token)
 	assert.Equal(t, expectedHash, phoneToken.TokenHash)
 }
 
 func TestPhoneNumberVerificationTokenRepo_Get(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewPhoneNumberVerificationTokenRepo(db)
+	repo := NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	// Create a token first
@@ -1037,7 +1048,7 @@ func TestPhoneNumberVerificationTokenRepo_Get(t *testing.T) {
 
 func TestPhoneNumberVerificationTokenRepo_GetByPhoneNumber(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewPhoneNumberVerificationTokenRepo(db)
+	repo := NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	// Create a token first
@@ -1062,7 +1073,7 @@ func TestPhoneNumberVerificationTokenRepo_GetByPhoneNumber(t *testing.T) {
 
 func TestPhoneNumberVerificationTokenRepo_Delete(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewPhoneNumberVerificationTokenRepo(db)
+	repo := NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	// Create a token first
@@ -1092,7 +1103,7 @@ func TestPhoneNumberVerificationTokenRepo_Delete(t *testing.T) {
 
 func TestPhoneNumberVerificationTokenRepo_StaticMethods(t *testing.T) {
 	db := setupTestDB(t)
-	repo := NewPhoneNumberVerificationTokenRepo(db)
+	repo := NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 
 	// Test static GenerateVerificationToken method
 	token, err := repo.GenerateVerificationToken(24)
@@ -1104,19 +1115,20 @@ func TestPhoneNumberVerificationTokenRepo_StaticMethods(t *testing.T) {
 	// Test static HashVerificationToken method
 	originalToken := "phone-token-67890"
 	hash1 := repo.HashVerificationToken(originalToken)
-	hash2 := HashVerificationToken(originalToken) // Compare with global function
+	hash2 := testTokenHasher.Hash(originalToken) // Compare with the shared hasher
 
 	assert.Equal(t, hash1, hash2)
-	assert.Len(t, hash1, 32) // MD5 hash length in hex
+	assert.Len(t, hash1, 64) // HMAC-SHA256 length in hex
+	assert.True(t, repo.VerifyVerificationToken(originalToken, hash1))
 }
 
 // Task Group 7: Integration Tests
 
 func TestRepositoryIntegration_CreateAccountAndVerificationToken(t *testing.T) {
 	db := setupTestDB(t)
-	accountRepo := NewAccountRepo(db)
-	emailTokenRepo := NewEmailVerificationTokenRepo(db)
-	phoneTokenRepo := NewPhoneNumberVerificationTokenRepo(db)
+	accountRepo := NewAccountRepo(db, testPasswordHasher)
+	emailTokenRepo := NewEmailVerificationTokenRepo(db, testTokenHasher)
+	phoneTokenRepo := NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 	ctx := context.Background()
 
 	// Create an account
@@ -1165,14 +1177,14 @@ func TestRepositoryInterface_Compliance(t *testing.T) {
 	db := setupTestDB(t)
 
 	// Test that all repositories implement their interfaces correctly
-	var _ AccountRepo = NewAccountRepo(db)
-	var _ EmailVerificationTokenRepo = NewEmailVerificationTokenRepo(db)
-	var _ PhoneNumberVerificationTokenRepo = NewPhoneNumberVerificationTokenRepo(db)
+	var _ AccountRepo = NewAccountRepo(db, testPasswordHasher)
+	var _ EmailVerificationTokenRepo = NewEmailVerificationTokenRepo(db, testTokenHasher)
+	var _ PhoneNumberVerificationTokenRepo = NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 
 	// Test that static methods work
-	accountRepo := NewAccountRepo(db)
-	emailRepo := NewEmailVerificationTokenRepo(db)
-	phoneRepo := NewPhoneNumberVerificationTokenRepo(db)
+	accountRepo := NewAccountRepo(db, testPasswordHasher)
+	emailRepo := NewEmailVerificationTokenRepo(db, testTokenHasher)
+	phoneRepo := NewPhoneNumberVerificationTokenRepo(db, testTokenHasher)
 
 	// Test password methods
 	hash, err := accountRepo.HashPassword("test")
@@ -1195,7 +1207,7 @@ func TestRepositoryInterface_Compliance(t *testing.T) {
 	hash1 := emailRepo.HashVerificationToken("test")
 	hash2 := phoneRepo.HashVerificationToken("test")
 	assert.Equal(t, hash1, hash2)
-	assert.Equal(t, HashVerificationToken("test"), hash1)
+	assert.Equal(t, testTokenHasher.Hash("test"), hash1)
 }
 
 // Helper function
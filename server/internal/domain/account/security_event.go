@@ -0,0 +1,88 @@
+package account
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// SecurityEventType identifies a sign in or a sensitive change the account owner is told about
+type SecurityEventType string
+
+// Security event types
+const (
+	SecurityEventNewDeviceSignIn            SecurityEventType = "new-device-sign-in"
+	SecurityEventPasswordChanged            SecurityEventType = "password-changed"
+	SecurityEventTwoFactorEnabled           SecurityEventType = "two-factor-enabled"
+	SecurityEventTwoFactorDisabled          SecurityEventType = "two-factor-disabled"
+	SecurityEventPasskeyAdded               SecurityEventType = "passkey-added"
+	SecurityEventPasskeyRemoved             SecurityEventType = "passkey-removed"
+	SecurityEventRecoveryCodesRegenerated   SecurityEventType = "recovery-codes-regenerated"
+	SecurityEventPhoneNumberChanged         SecurityEventType = "phone-number-changed"
+	SecurityEventPersonalAccessTokenCreated SecurityEventType = "personal-access-token-created"
+)
+
+// SecurityEvent is a sign in or a sensitive change on an account
+type SecurityEvent struct {
+	Type    SecurityEventType
+	Account *Account
+
+	// UserAgent and IPAddress are the client details of the request causing the event
+	UserAgent string
+	IPAddress string
+
+	// Detail names what changed where it helps the owner, such as a passkey nickname, the
+	// masked new phone number or a personal access token name. It is empty for a removed
+	// phone number.
+	Detail string
+}
+
+// SecurityEventHandler handles a published security event
+//
+// Handlers run within the request causing the event, after the change was made, so they
+// report their own errors rather than failing it.
+type SecurityEventHandler func(ctx context.Context, event SecurityEvent)
+
+// SecurityEventBus delivers security events to the handlers subscribed to them
+type SecurityEventBus struct {
+	mu       sync.RWMutex
+	handlers []SecurityEventHandler
+}
+
+// NewSecurityEventBus creates a bus without subscribers
+func NewSecurityEventBus() *SecurityEventBus {
+	return &SecurityEventBus{}
+}
+
+// Subscribe adds a handler called for every published event
+func (b *SecurityEventBus) Subscribe(handler SecurityEventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// Publish calls the subscribed handlers in order
+//
+// Publishing on a nil bus does nothing, so services can be built without one.
+func (b *SecurityEventBus) Publish(ctx context.Context, event SecurityEvent) {
+	if b == nil {
+		return
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(ctx, event)
+	}
+}
+
+// MaskPhoneNumber hides all but the last two digits of a phone number, for telling the
+// account owner which number was set without revealing it in full
+func MaskPhoneNumber(phoneNumber string) string {
+	if len(phoneNumber) <= 2 {
+		return phoneNumber
+	}
+	return strings.Repeat("•", len(phoneNumber)-2) + phoneNumber[len(phoneNumber)-2:]
+}
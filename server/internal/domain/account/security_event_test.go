@@ -0,0 +1,73 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"server/internal/domain/core"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSecurityEventBus(t *testing.T) {
+	ctx := context.Background()
+	event := SecurityEvent{Type: SecurityEventPasswordChanged, IPAddress: "127.0.0.1"}
+
+	t.Run("calls the handlers in order", func(t *testing.T) {
+		var calls []string
+		bus := NewSecurityEventBus()
+		bus.Subscribe(func(ctx context.Context, received SecurityEvent) {
+			assert.Equal(t, event, received)
+			calls = append(calls, "first")
+		})
+		bus.Subscribe(func(ctx context.Context, received SecurityEvent) {
+			calls = append(calls, "second")
+		})
+
+		bus.Publish(ctx, event)
+
+		assert.Equal(t, []string{"first", "second"}, calls)
+	})
+
+	t.Run("publishing on a nil bus does nothing", func(t *testing.T) {
+		var bus *SecurityEventBus
+		assert.NotPanics(t, func() { bus.Publish(ctx, event) })
+	})
+}
+
+func TestMaskPhoneNumber(t *testing.T) {
+	assert.Equal(t, "••••••••••90", MaskPhoneNumber("+12345678890"))
+	assert.Equal(t, "12", MaskPhoneNumber("12"))
+}
+
+func TestAccountService_PhoneNumberSecurityEvents(t *testing.T) {
+	ctx := context.Background()
+	phoneNumber := "+14155552671"
+	acc := &Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PhoneNumber: &phoneNumber}
+
+	var events []SecurityEvent
+	bus := NewSecurityEventBus()
+	bus.Subscribe(func(ctx context.Context, event SecurityEvent) {
+		events = append(events, event)
+	})
+
+	accountRepo := new(MockAccountRepo)
+	accountRepo.On("Get", mock.Anything, int64(7)).Return(acc, nil)
+	accountRepo.On("Update", mock.Anything, acc, mock.Anything, mock.Anything, &phoneNumber, mock.Anything, mock.Anything).Return(acc, nil)
+	accountRepo.On("DeletePhoneNumber", mock.Anything, acc).Return(acc, nil)
+
+	service := NewAccountService(accountRepo, &MockPhoneNumberVerificationTokenRepo{}, &MockEmailVerificationTokenRepo{}, &MockMessageSender{}, nil, bus, zap.NewNop())
+
+	_, err := service.UpdateAccountPhoneNumber(ctx, 7, phoneNumber, "Mozilla/5.0", "127.0.0.1")
+	require.NoError(t, err)
+	_, err = service.RemoveAccountPhoneNumber(ctx, 7, "Mozilla/5.0", "127.0.0.1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []SecurityEvent{
+		{Type: SecurityEventPhoneNumberChanged, Account: acc, UserAgent: "Mozilla/5.0", IPAddress: "127.0.0.1", Detail: "••••••••••71"},
+		{Type: SecurityEventPhoneNumberChanged, Account: acc, UserAgent: "Mozilla/5.0", IPAddress: "127.0.0.1"},
+	}, events)
+}
@@ -2,8 +2,10 @@ package account
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"strings"
 	"time"
@@ -22,8 +24,12 @@ const (
 	AvatarURLExpiry    = 24 * time.Hour
 	SMSTokenLength     = 6
 	SMSTokenExpiry     = 15 * time.Minute
+	SMSTokenCooldown   = 1 * time.Minute
 )
 
+// avatarBucketURLPrefix is the URL prefix of files uploaded to the avatar bucket
+var avatarBucketURLPrefix = fmt.Sprintf("https://%s.s3.amazonaws.com/", AvatarBucketName)
+
 // AccountService provides business logic for account operations
 type AccountService struct {
 	accountRepo    AccountRepo
@@ -31,6 +37,7 @@ type AccountService struct {
 	emailTokenRepo EmailVerificationTokenRepo
 	messageSender  MessageSender
 	s3Client       *s3.Client
+	securityEvents *SecurityEventBus
 	logger         *zap.Logger
 }
 
@@ -41,6 +48,7 @@ func NewAccountService(
 	emailTokenRepo EmailVerificationTokenRepo,
 	messageSender MessageSender,
 	s3Client *s3.Client, // Optional dependency
+	securityEvents *SecurityEventBus, // Optional dependency
 	logger *zap.Logger,
 ) *AccountService {
 	return &AccountService{
@@ -48,7 +56,8 @@ func NewAccountService(
 		phoneTokenRepo: phoneTokenRepo,
 		emailTokenRepo: emailTokenRepo,
 		messageSender:  messageSender,
-		s3Client:       s3Client, // Can be nil
+		s3Client:       s3Client,       // Can be nil
+		securityEvents: securityEvents, // Can be nil
 		logger:         logger,
 	}
 }
@@ -116,7 +125,7 @@ func (s *AccountService) GetAccountByPhoneNumber(ctx context.Context, phoneNumbe
 func (s *AccountService) UpdateAccountFullName(ctx context.Context, accountID int64, fullName string) (*Account, error) {
 	fullName = strings.TrimSpace(fullName)
 	if fullName == "" {
-		return nil, fmt.Errorf("full name cannot be empty")
+		return nil, fmt.Errorf("%w: full name cannot be empty", ErrInvalidFullName)
 	}
 
 	account, err := s.accountRepo.Get(ctx, accountID)
@@ -142,6 +151,7 @@ func (s *AccountService) UpdateAccountFullName(ctx context.Context, accountID in
 //   - ctx: Context for the request
 //   - accountID: ID of the account to update
 //   - phoneNumber: New phone number in international format (e.g., "+1234567890")
+//   - userAgent, ipAddress: Client details of the request, told to the account owner
 //
 // Returns:
 //   - *Account: The updated account
@@ -149,13 +159,13 @@ func (s *AccountService) UpdateAccountFullName(ctx context.Context, accountID in
 //
 // Example:
 //
-//	account, err := service.UpdateAccountPhoneNumber(ctx, accountID, "+1234567890")
+//	account, err := service.UpdateAccountPhoneNumber(ctx, accountID, "+1234567890", userAgent, ipAddress)
 //	if err != nil {
 //	    log.Printf("Failed to update phone number: %v", err)
 //	    return
 //	}
 //	fmt.Printf("Updated phone number: %s\n", account.PhoneNumber)
-func (s *AccountService) UpdateAccountPhoneNumber(ctx context.Context, accountID int64, phoneNumber string) (*Account, error) {
+func (s *AccountService) UpdateAccountPhoneNumber(ctx context.Context, accountID int64, phoneNumber string, userAgent string, ipAddress string) (*Account, error) {
 	if err := s.validatePhoneNumber(phoneNumber); err != nil {
 		return nil, err
 	}
@@ -171,6 +181,14 @@ func (s *AccountService) UpdateAccountPhoneNumber(ctx context.Context, accountID
 		return nil, fmt.Errorf("failed to update account: %w", err)
 	}
 
+	s.securityEvents.Publish(ctx, SecurityEvent{
+		Type:      SecurityEventPhoneNumberChanged,
+		Account:   updatedAccount,
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+		Detail:    MaskPhoneNumber(phoneNumber),
+	})
+
 	return updatedAccount, nil
 }
 
@@ -432,17 +450,113 @@ func (s *AccountService) validateAvatarFile(file io.Reader, filename string) ([]
 	return fileBytes, contentType, nil
 }
 
+// SetAccountAvatarURL sets an account's avatar to a file already uploaded to the avatar bucket
+//
+// Only URLs pointing into AvatarBucketName are accepted, so that avatars cannot be used to
+// embed arbitrary third-party content.
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - accountID: ID of the account to update
+//   - avatarURL: URL of the uploaded avatar
+//
+// Returns:
+//   - *Account: The updated account
+//   - error: ErrInvalidInput if the URL is not an avatar bucket URL, or other errors for database issues
+func (s *AccountService) SetAccountAvatarURL(ctx context.Context, accountID int64, avatarURL string) (*Account, error) {
+	avatarURL = strings.TrimSpace(avatarURL)
+	if !strings.HasPrefix(avatarURL, avatarBucketURLPrefix) || len(avatarURL) == len(avatarBucketURLPrefix) {
+		return nil, fmt.Errorf("%w: avatar URL must point to the avatar bucket", ErrInvalidInput)
+	}
+
+	account, err := s.accountRepo.Get(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	updatedAccount, err := s.accountRepo.Update(ctx, account, nil, &avatarURL, nil, nil, nil)
+	if err != nil {
+		s.logger.Error("Failed to update account avatar URL", zap.Error(err))
+		return nil, fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return updatedAccount, nil
+}
+
+// RemoveAccountAvatar removes an account's avatar, reverting to the generated default
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - accountID: ID of the account to update
+//
+// Returns:
+//   - *Account: The updated account
+//   - error: Error if account not found or database update fails
+func (s *AccountService) RemoveAccountAvatar(ctx context.Context, accountID int64) (*Account, error) {
+	account, err := s.accountRepo.Get(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	updatedAccount, err := s.accountRepo.DeleteAvatar(ctx, account)
+	if err != nil {
+		s.logger.Error("Failed to remove account avatar", zap.Error(err))
+		return nil, fmt.Errorf("failed to remove avatar: %w", err)
+	}
+
+	return updatedAccount, nil
+}
+
+// RemoveAccountPhoneNumber removes an account's phone number
+//
+// Parameters:
+//   - ctx: Context for the request
+//   - accountID: ID of the account to update
+//   - userAgent, ipAddress: Client details of the request, told to the account owner
+//
+// Returns:
+//   - *Account: The updated account
+//   - error: ErrPhoneNumberMissing if the account has no phone number, or other errors for database issues
+func (s *AccountService) RemoveAccountPhoneNumber(ctx context.Context, accountID int64, userAgent string, ipAddress string) (*Account, error) {
+	account, err := s.accountRepo.Get(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if account.PhoneNumber == nil {
+		return nil, ErrPhoneNumberMissing
+	}
+
+	updatedAccount, err := s.accountRepo.DeletePhoneNumber(ctx, account)
+	if err != nil {
+		s.logger.Error("Failed to remove account phone number", zap.Error(err))
+		return nil, fmt.Errorf("failed to remove phone number: %w", err)
+	}
+
+	s.securityEvents.Publish(ctx, SecurityEvent{
+		Type:      SecurityEventPhoneNumberChanged,
+		Account:   updatedAccount,
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+	})
+
+	return updatedAccount, nil
+}
+
 // CreatePhoneVerificationToken creates and sends a phone verification token
 //
 // This method generates a 6-digit verification token, stores it in the database,
 // and sends it to the user's phone number via SMS. The token expires after 15 minutes.
+// Only one token is kept per phone number, and a new one can only be requested once
+// SMSTokenCooldown has elapsed.
 //
 // Parameters:
 //   - ctx: Context for the request
 //   - phoneNumber: Phone number to send the verification token to (international format)
 //
 // Returns:
-//   - error: Error if phone validation fails, token creation fails, or SMS sending fails
+//   - error: ErrInvalidPhoneNumber, a *CooldownError wrapping ErrPhoneVerificationCooldown,
+//     or an error if token creation or SMS sending fails
 //
 // Example:
 //
@@ -457,6 +571,22 @@ func (s *AccountService) CreatePhoneVerificationToken(ctx context.Context, phone
 		return fmt.Errorf("invalid phone number format: %w", err)
 	}
 
+	existingToken, err := s.phoneTokenRepo.GetByPhoneNumber(ctx, phoneNumber)
+	if err != nil && !errors.Is(err, ErrTokenNotFound) {
+		return fmt.Errorf("failed to get verification token: %w", err)
+	}
+
+	if existingToken != nil {
+		if remaining := time.Until(existingToken.CreatedAt.Add(SMSTokenCooldown)); remaining > 0 {
+			return NewCooldownError(int(math.Ceil(remaining.Seconds())), ErrPhoneVerificationCooldown)
+		}
+
+		// Only one token is kept per phone number
+		if err := s.phoneTokenRepo.Delete(ctx, existingToken); err != nil {
+			return fmt.Errorf("failed to delete previous verification token: %w", err)
+		}
+	}
+
 	// Create verification token
 	token, phoneToken, err := s.phoneTokenRepo.Create(ctx, phoneNumber)
 	if err != nil {
@@ -521,7 +651,7 @@ func (s *AccountService) VerifyPhoneNumber(ctx context.Context, phoneNumber, tok
 	phoneToken, err := s.phoneTokenRepo.GetByPhoneNumber(ctx, phoneNumber)
 	if err != nil {
 		if err == ErrTokenNotFound {
-			return fmt.Errorf("invalid verification token: no verification token found for this phone number")
+			return fmt.Errorf("%w: no verification token found for this phone number", ErrInvalidVerificationToken)
 		}
 		s.logger.Error("Failed to get phone verification token", zap.Error(err))
 		return fmt.Errorf("failed to get verification token: %w", err)
@@ -532,16 +662,15 @@ func (s *AccountService) VerifyPhoneNumber(ctx context.Context, phoneNumber, tok
 		s.logger.Warn("Verification token has expired",
 			zap.String("phone_number", phoneNumber),
 			zap.Time("expired_at", phoneToken.ExpiresAt))
-		return fmt.Errorf("token has expired")
+		return ErrTokenExpired
 	}
 
 	// Verify token hash
-	tokenHash := s.phoneTokenRepo.HashVerificationToken(token)
-	if tokenHash != phoneToken.TokenHash {
+	if !s.phoneTokenRepo.VerifyVerificationToken(token, phoneToken.TokenHash) {
 		s.logger.Warn("Invalid verification token provided",
 			zap.String("phone_number", phoneNumber),
 			zap.Int64("token_id", phoneToken.ID))
-		return fmt.Errorf("invalid verification token")
+		return ErrInvalidVerificationToken
 	}
 
 	// Check if account exists with this phone number
@@ -582,17 +711,17 @@ func (s *AccountService) validatePhoneNumber(phoneNumber string) error {
 	phoneNumber = strings.TrimSpace(phoneNumber)
 
 	if phoneNumber == "" {
-		return fmt.Errorf("phone number cannot be empty")
+		return fmt.Errorf("%w: phone number cannot be empty", ErrInvalidPhoneNumber)
 	}
 
 	// Use libphonenumber for comprehensive validation
 	parsed, err := libphonenumber.Parse(phoneNumber, "")
 	if err != nil {
-		return fmt.Errorf("invalid phone number format: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidPhoneNumber, err)
 	}
 
 	if !libphonenumber.IsValidNumber(parsed) {
-		return fmt.Errorf("invalid phone number format")
+		return ErrInvalidPhoneNumber
 	}
 
 	return nil
@@ -45,7 +45,7 @@ func TestAccountService_UpdateAccountTermsAndPolicy(t *testing.T) {
 					},
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), mock.AnythingOfType("*account.TermsAndPolicy"), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(updatedAccount, nil)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), mock.AnythingOfType("*account.TermsAndPolicy"), (*AnalyticsPreference)(nil)).Return(updatedAccount, nil)
 			},
 			expectError: false,
 		},
@@ -86,7 +86,7 @@ func TestAccountService_UpdateAccountTermsAndPolicy(t *testing.T) {
 					Email:     "john@example.com",
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), mock.AnythingOfType("*account.TermsAndPolicy"), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(nil, databaseError)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), mock.AnythingOfType("*account.TermsAndPolicy"), (*AnalyticsPreference)(nil)).Return(nil, databaseError)
 			},
 			expectError:  true,
 			errorMessage: "failed to update account terms and policy",
@@ -98,7 +98,7 @@ func TestAccountService_UpdateAccountTermsAndPolicy(t *testing.T) {
 			// Create fresh mocks for each test to avoid state conflicts
 			mockRepo := &MockAccountRepo{}
 			mockSMS := &MockMessageSender{}
-			service := NewAccountService(mockRepo, nil, nil, mockSMS, nil, logger)
+			service := NewAccountService(mockRepo, nil, nil, mockSMS, nil, nil, logger)
 
 			tt.setupMocks(mockRepo)
 
@@ -152,7 +152,7 @@ func TestAccountService_UpdateAccountAnalyticsPreference(t *testing.T) {
 					},
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference"), (*bool)(nil)).Return(updatedAccount, nil)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference")).Return(updatedAccount, nil)
 			},
 			expectError: false,
 		},
@@ -175,7 +175,7 @@ func TestAccountService_UpdateAccountAnalyticsPreference(t *testing.T) {
 					},
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference"), (*bool)(nil)).Return(updatedAccount, nil)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference")).Return(updatedAccount, nil)
 			},
 			expectError: false,
 		},
@@ -198,7 +198,7 @@ func TestAccountService_UpdateAccountAnalyticsPreference(t *testing.T) {
 					},
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference"), (*bool)(nil)).Return(updatedAccount, nil)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference")).Return(updatedAccount, nil)
 			},
 			expectError: false,
 		},
@@ -231,7 +231,7 @@ func TestAccountService_UpdateAccountAnalyticsPreference(t *testing.T) {
 					Email:     "john@example.com",
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference"), (*bool)(nil)).Return(nil, databaseError)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference")).Return(nil, databaseError)
 			},
 			expectError:  true,
 			errorMessage: "failed to update account analytics preference",
@@ -243,7 +243,7 @@ func TestAccountService_UpdateAccountAnalyticsPreference(t *testing.T) {
 			// Create fresh mocks for each test to avoid state conflicts
 			mockRepo := &MockAccountRepo{}
 			mockSMS := &MockMessageSender{}
-			service := NewAccountService(mockRepo, nil, nil, mockSMS, nil, logger)
+			service := NewAccountService(mockRepo, nil, nil, mockSMS, nil, nil, logger)
 
 			tt.setupMocks(mockRepo)
 
@@ -293,7 +293,7 @@ func TestAccountService_UpdateAccountWhatsappJobAlerts(t *testing.T) {
 					Email:     "john@example.com",
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), mock.AnythingOfType("*bool")).Return(updatedAccount, nil)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(updatedAccount, nil)
 			},
 			expectError: false,
 		},
@@ -313,7 +313,7 @@ func TestAccountService_UpdateAccountWhatsappJobAlerts(t *testing.T) {
 					Email:     "john@example.com",
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), mock.AnythingOfType("*bool")).Return(updatedAccount, nil)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(updatedAccount, nil)
 			},
 			expectError: false,
 		},
@@ -338,7 +338,7 @@ func TestAccountService_UpdateAccountWhatsappJobAlerts(t *testing.T) {
 					Email:     "john@example.com",
 				}
 				m.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), mock.AnythingOfType("*bool")).Return(nil, databaseError)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(nil, databaseError)
 			},
 			expectError:  true,
 			errorMessage: "failed to update account WhatsApp job alerts",
@@ -350,7 +350,7 @@ func TestAccountService_UpdateAccountWhatsappJobAlerts(t *testing.T) {
 			// Create fresh mocks for each test to avoid state conflicts
 			mockRepo := &MockAccountRepo{}
 			mockSMS := &MockMessageSender{}
-			service := NewAccountService(mockRepo, nil, nil, mockSMS, nil, logger)
+			service := NewAccountService(mockRepo, nil, nil, mockSMS, nil, nil, logger)
 
 			tt.setupMocks(mockRepo)
 
@@ -375,7 +375,7 @@ func TestAccountService_AdvancedMethodsIntegration(t *testing.T) {
 	mockRepo := &MockAccountRepo{}
 	mockSMS := &MockMessageSender{}
 	logger := zap.NewNop()
-	service := NewAccountService(mockRepo, nil, nil, mockSMS, nil, logger)
+	service := NewAccountService(mockRepo, nil, nil, mockSMS, nil, nil, logger)
 
 	testAccount := &Account{
 		CoreModel: core.CoreModel{ID: 1},
@@ -386,7 +386,7 @@ func TestAccountService_AdvancedMethodsIntegration(t *testing.T) {
 	// Test: Update terms and policy with structured data capture
 	var capturedTermsAndPolicy *TermsAndPolicy
 	mockRepo.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-	mockRepo.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), mock.AnythingOfType("*account.TermsAndPolicy"), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(testAccount, nil).Run(func(args mock.Arguments) {
+	mockRepo.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), mock.AnythingOfType("*account.TermsAndPolicy"), (*AnalyticsPreference)(nil)).Return(testAccount, nil).Run(func(args mock.Arguments) {
 		capturedTermsAndPolicy = args.Get(5).(*TermsAndPolicy)
 	})
 
@@ -404,11 +404,11 @@ func TestAccountService_AdvancedMethodsIntegration(t *testing.T) {
 
 	// Test: Update analytics preference with structured data capture
 	mockRepo = &MockAccountRepo{} // Create fresh mock
-	service = NewAccountService(mockRepo, nil, nil, mockSMS, nil, logger)
+	service = NewAccountService(mockRepo, nil, nil, mockSMS, nil, nil, logger)
 
 	var capturedAnalyticsPreference *AnalyticsPreference
 	mockRepo.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-	mockRepo.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference"), (*bool)(nil)).Return(testAccount, nil).Run(func(args mock.Arguments) {
+	mockRepo.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference")).Return(testAccount, nil).Run(func(args mock.Arguments) {
 		capturedAnalyticsPreference = args.Get(6).(*AnalyticsPreference)
 	})
 
@@ -3,6 +3,7 @@ package account
 import (
 	"bytes"
 	"context"
+	"io"
 	"server/internal/infrastructure/s3client"
 	"testing"
 
@@ -48,10 +49,11 @@ func TestAccountService_UpdateAccountAvatarURL(t *testing.T) {
 			logger := zap.NewNop()
 
 			// Create service without S3 client
-			service := NewAccountService(mockRepo, nil, nil, nil, nil, logger)
+			service := NewAccountService(mockRepo, nil, nil, nil, nil, nil, logger)
 
 			ctx := context.Background()
-			var file *bytes.Reader
+			// A nil *bytes.Reader would be a non-nil io.Reader
+			var file io.Reader
 			if tt.fileContent != nil {
 				file = bytes.NewReader(tt.fileContent)
 			}
@@ -76,7 +78,7 @@ func TestAccountService_ValidateAvatarFile(t *testing.T) {
 	mockEmailTokenRepo := &MockEmailVerificationTokenRepo{}
 	mockSMS := &MockMessageSender{}
 	logger := zap.NewNop()
-	service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+	service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 	tests := []struct {
 		name          string
@@ -199,7 +201,7 @@ func TestAccountService_AvatarIntegration(t *testing.T) {
 	logger := zap.NewNop()
 
 	// Create service with nil S3 client (will fail at S3 step)
-	service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+	service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 	ctx := context.Background()
 	fileContent := []byte("\xFF\xD8\xFF") // JPEG header
@@ -233,7 +235,7 @@ func TestAccountService_AvatarWorkflowWithS3(t *testing.T) {
 
 	// Create service with mocked S3-like behavior
 	// In a real test, you would mock the S3 client, but for now we test the failure case
-	service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+	service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 	ctx := context.Background()
 	fileContent := []byte("\xFF\xD8\xFF\xE0\x00\x10JFIF\x00\x01") // More complete JPEG
@@ -253,7 +255,7 @@ func TestAccountService_AvatarEdgeCases(t *testing.T) {
 	mockEmailTokenRepo := &MockEmailVerificationTokenRepo{}
 	mockSMS := &MockMessageSender{}
 	logger := zap.NewNop()
-	service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+	service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 	tests := []struct {
 		name          string
@@ -68,6 +68,11 @@ func (m *MockAccountRepo) DeleteAvatar(ctx context.Context, account *Account) (*
 	return args.Get(0).(*Account), args.Error(1)
 }
 
+func (m *MockAccountRepo) DeletePhoneNumber(ctx context.Context, account *Account) (*Account, error) {
+	args := m.Called(ctx, account)
+	return args.Get(0).(*Account), args.Error(1)
+}
+
 func (m *MockAccountRepo) SetTwoFactorSecret(ctx context.Context, account *Account, totpSecret string) (*Account, error) {
 	args := m.Called(ctx, account, totpSecret)
 	return args.Get(0).(*Account), args.Error(1)
@@ -78,6 +83,11 @@ func (m *MockAccountRepo) DeleteTwoFactorSecret(ctx context.Context, account *Ac
 	return args.Get(0).(*Account), args.Error(1)
 }
 
+func (m *MockAccountRepo) UseTwoFactorTimeStep(ctx context.Context, account *Account, timeStep int64) (bool, error) {
+	args := m.Called(ctx, account, timeStep)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockAccountRepo) UpdatePassword(ctx context.Context, account *Account, password string) (*Account, error) {
 	args := m.Called(ctx, account, password)
 	return args.Get(0).(*Account), args.Error(1)
@@ -103,6 +113,27 @@ func (m *MockAccountRepo) VerifyPassword(password, hash string) (bool, error) {
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockAccountRepo) Import(ctx context.Context, email string, fullName string, passwordHash *string, phoneNumber *string) (*Account, error) {
+	args := m.Called(ctx, email, fullName, passwordHash, phoneNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) PasswordNeedsRehash(hash string) bool {
+	args := m.Called(hash)
+	return args.Bool(0)
+}
+
+func (m *MockAccountRepo) RehashPassword(ctx context.Context, account *Account, password string) (*Account, error) {
+	args := m.Called(ctx, account, password)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Account), args.Error(1)
+}
+
 // MockMessageSender is a mock implementation of MessageSender for testing
 type MockMessageSender struct {
 	mock.Mock
@@ -159,45 +190,9 @@ func (m *MockPhoneNumberVerificationTokenRepo) HashVerificationToken(token strin
 	return args.String(0)
 }
 
-// MockEmailVerificationTokenRepo is a mock implementation for testing
-type MockEmailVerificationTokenRepo struct {
-	mock.Mock
-}
-
-func (m *MockEmailVerificationTokenRepo) Create(ctx context.Context, email string) (string, *EmailVerificationToken, error) {
-	args := m.Called(ctx, email)
-	return args.String(0), args.Get(1).(*EmailVerificationToken), args.Error(2)
-}
-
-func (m *MockEmailVerificationTokenRepo) Get(ctx context.Context, verificationToken string) (*EmailVerificationToken, error) {
-	args := m.Called(ctx, verificationToken)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).(*EmailVerificationToken), args.Error(1)
-}
-
-func (m *MockEmailVerificationTokenRepo) GetByEmail(ctx context.Context, email string) (*EmailVerificationToken, error) {
-	args := m.Called(ctx, email)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).(*EmailVerificationToken), args.Error(1)
-}
-
-func (m *MockEmailVerificationTokenRepo) Delete(ctx context.Context, emailVerification *EmailVerificationToken) error {
-	args := m.Called(ctx, emailVerification)
-	return args.Error(0)
-}
-
-func (m *MockEmailVerificationTokenRepo) GenerateVerificationToken(length int) (string, error) {
-	args := m.Called(length)
-	return args.String(0), args.Error(1)
-}
-
-func (m *MockEmailVerificationTokenRepo) HashVerificationToken(token string) string {
-	args := m.Called(token)
-	return args.String(0)
+// VerifyVerificationToken compares against the mocked HashVerificationToken
+func (m *MockPhoneNumberVerificationTokenRepo) VerifyVerificationToken(token string, hash string) bool {
+	return m.HashVerificationToken(token) == hash
 }
 
 func TestAccountService_GetAccountByPhoneNumber(t *testing.T) {
@@ -258,6 +253,7 @@ func TestAccountService_GetAccountByPhoneNumber(t *testing.T) {
 				mockEmailTokenRepo,
 				mockSMS,
 				nil, // S3 client not needed for this test
+				nil, // No security events
 				zap.NewNop(),
 			)
 
@@ -347,6 +343,7 @@ func TestAccountService_UpdateAccountFullName(t *testing.T) {
 				mockEmailTokenRepo,
 				mockSMS,
 				nil,
+				nil, // No security events
 				zap.NewNop(),
 			)
 
@@ -444,11 +441,12 @@ func TestAccountService_UpdateAccountPhoneNumber(t *testing.T) {
 				mockEmailTokenRepo,
 				mockSMS,
 				nil,
+				nil, // No security events
 				zap.NewNop(),
 			)
 
 			// Execute test
-			result, err := service.UpdateAccountPhoneNumber(context.Background(), tt.accountID, tt.phoneNumber)
+			result, err := service.UpdateAccountPhoneNumber(context.Background(), tt.accountID, tt.phoneNumber, "test-agent", "127.0.0.1")
 
 			// Assertions
 			if tt.expectError {
@@ -508,6 +506,7 @@ func TestAccountService_validatePhoneNumber(t *testing.T) {
 				&MockEmailVerificationTokenRepo{},
 				&MockMessageSender{},
 				nil,
+				nil, // No security events
 				zap.NewNop(),
 			)
 
@@ -26,10 +26,13 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		mockSMS := &MockMessageSender{}
 		logger := zap.NewNop()
 
-		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 		// Step 1: User provides phone number for verification
-		phoneNumber := "+1234567890"
+		phoneNumber := "+14155552671"
+
+		// Mock that no token was sent to the number recently
+		mockPhoneTokenRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(nil, ErrTokenNotFound).Once()
 
 		// Mock successful token creation
 		mockPhoneTokenRepo.On("Create", mock.Anything, phoneNumber).Return("123456", &PhoneNumberVerificationToken{
@@ -77,7 +80,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		}
 
 		mockRepo.On("Get", mock.Anything, int64(1)).Return(testAccount, nil)
-		mockRepo.On("Update", mock.Anything, testAccount, mock.AnythingOfType("*string"), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(&Account{
+		mockRepo.On("Update", mock.Anything, testAccount, mock.AnythingOfType("*string"), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(&Account{
 			CoreModel: core.CoreModel{ID: 1},
 			FullName:  "John Doe",
 			Email:     "user@example.com",
@@ -87,9 +90,8 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "John Doe", updatedAccount.FullName)
 
-		// Step 5: User accepts terms and conditions
-		updatedAccount.FullName = "John Doe" // Update for next call
-		mockRepo.On("Update", mock.Anything, updatedAccount, (*string)(nil), (*string)(nil), (*string)(nil), mock.AnythingOfType("*account.TermsAndPolicy"), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(&Account{
+		// Step 5: User accepts terms and conditions, updating the account as loaded by Get
+		mockRepo.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), mock.AnythingOfType("*account.TermsAndPolicy"), (*AnalyticsPreference)(nil)).Return(&Account{
 			CoreModel: core.CoreModel{ID: 1},
 			FullName:  "John Doe",
 			Email:     "user@example.com",
@@ -105,8 +107,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		assert.Equal(t, "2.1.0", updatedAccount.TermsAndPolicy.Version)
 
 		// Step 6: User sets preferences
-		updatedAccount.TermsAndPolicy = TermsAndPolicy{Type: "accepted", Version: "2.1.0", UpdatedAt: time.Now()}
-		mockRepo.On("Update", mock.Anything, updatedAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference"), (*bool)(nil)).Return(&Account{
+		mockRepo.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), mock.AnythingOfType("*account.AnalyticsPreference")).Return(&Account{
 			CoreModel: core.CoreModel{ID: 1},
 			FullName:  "John Doe",
 			Email:     "user@example.com",
@@ -139,7 +140,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		mockSMS := &MockMessageSender{}
 		logger := zap.NewNop()
 
-		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 		testAccount := &Account{
 			CoreModel: core.CoreModel{ID: 2},
@@ -151,7 +152,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 
 		// Step 1: Update full name
 		mockRepo.On("Get", ctx, int64(2)).Return(testAccount, nil)
-		mockRepo.On("Update", ctx, testAccount, mock.AnythingOfType("*string"), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(&Account{
+		mockRepo.On("Update", ctx, testAccount, mock.AnythingOfType("*string"), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(&Account{
 			CoreModel: core.CoreModel{ID: 2},
 			FullName:  "Jane Doe",
 			Email:     "jane@example.com",
@@ -162,23 +163,20 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		assert.Equal(t, "Jane Doe", updatedAccount.FullName)
 
 		// Step 2: Update phone number (new number needs verification)
-		newPhoneNumber := "+9876543210"
-		updatedAccount.FullName = "Jane Doe"
-
-		mockRepo.On("Update", ctx, updatedAccount, (*string)(nil), (*string)(nil), mock.AnythingOfType("*string"), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(&Account{
+		newPhoneNumber := "+447911123456"
+		mockRepo.On("Update", ctx, testAccount, (*string)(nil), (*string)(nil), mock.AnythingOfType("*string"), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(&Account{
 			CoreModel:   core.CoreModel{ID: 2},
 			FullName:    "Jane Doe",
 			Email:       "jane@example.com",
 			PhoneNumber: &newPhoneNumber,
 		}, nil)
 
-		updatedAccount, err = service.UpdateAccountPhoneNumber(ctx, 2, newPhoneNumber)
+		updatedAccount, err = service.UpdateAccountPhoneNumber(ctx, 2, newPhoneNumber, "test-agent", "127.0.0.1")
 		require.NoError(t, err)
 		assert.Equal(t, newPhoneNumber, *updatedAccount.PhoneNumber)
 
 		// Step 3: Update WhatsApp preferences
-		updatedAccount.PhoneNumber = &newPhoneNumber
-		mockRepo.On("Update", ctx, updatedAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), mock.AnythingOfType("*bool")).Return(&Account{
+		mockRepo.On("Update", ctx, testAccount, (*string)(nil), (*string)(nil), (*string)(nil), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(&Account{
 			CoreModel:   core.CoreModel{ID: 2},
 			FullName:    "Jane Doe",
 			Email:       "jane@example.com",
@@ -200,14 +198,15 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		mockSMS := &MockMessageSender{}
 		logger := zap.NewNop()
 
-		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
-		oldPhoneNumber := "+1234567890"
-		newPhoneNumber := "+9876543210"
+		oldPhoneNumber := "+14155552671"
+		newPhoneNumber := "+447911123456"
 
 		ctx := context.Background()
 
 		// Step 1: Create verification token for new phone number
+		mockPhoneTokenRepo.On("GetByPhoneNumber", ctx, newPhoneNumber).Return(nil, ErrTokenNotFound).Once()
 		mockPhoneTokenRepo.On("Create", ctx, newPhoneNumber).Return("654321", &PhoneNumberVerificationToken{
 			CoreModel:   core.CoreModel{ID: 2},
 			PhoneNumber: newPhoneNumber,
@@ -241,7 +240,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		mockRepo.On("GetByPhoneNumber", ctx, newPhoneNumber).Return(existingAccount, nil)
 
 		// Mock account update with new phone number
-		mockRepo.On("Update", ctx, existingAccount, (*string)(nil), (*string)(nil), &newPhoneNumber, (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(&Account{
+		mockRepo.On("Update", ctx, existingAccount, (*string)(nil), (*string)(nil), &newPhoneNumber, (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(&Account{
 			CoreModel:   core.CoreModel{ID: 3},
 			FullName:    "Bob Johnson",
 			Email:       "bob@example.com",
@@ -267,7 +266,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		mockSMS := &MockMessageSender{}
 		logger := zap.NewNop()
 
-		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 		ctx := context.Background()
 		fileContent := []byte("\xFF\xD8\xFF\xE0\x00\x10JFIF\x00\x01") // JPEG content
@@ -292,7 +291,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		mockSMS := &MockMessageSender{}
 		logger := zap.NewNop()
 
-		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 		ctx := context.Background()
 
@@ -302,7 +301,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid phone number format")
 
 		// Test 2: Empty token verification
-		err = service.VerifyPhoneNumber(ctx, "+1234567890", "")
+		err = service.VerifyPhoneNumber(ctx, "+14155552671", "")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "token cannot be empty")
 
@@ -313,15 +312,7 @@ func TestAccountService_EndToEndWorkflows(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to get account")
 
-		// Test 4: Invalid analytics preference
-		testAccount := &Account{
-			CoreModel: core.CoreModel{ID: 1},
-			FullName:  "John Doe",
-			Email:     "john@example.com",
-		}
-
-		mockRepo.On("Get", ctx, int64(1)).Return(testAccount, nil)
-
+		// Test 4: Invalid analytics preference, rejected before the account is loaded
 		_, err = service.UpdateAccountAnalyticsPreference(ctx, 1, "invalid-preference")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid preference value")
@@ -341,7 +332,7 @@ func TestAccountService_EdgeCases(t *testing.T) {
 		mockSMS := &MockMessageSender{}
 		logger := zap.NewNop()
 
-		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 		// This test ensures the service structure itself doesn't have race conditions
 		// The actual concurrency safety would depend on the underlying repositories
@@ -358,7 +349,7 @@ func TestAccountService_EdgeCases(t *testing.T) {
 		mockSMS := &MockMessageSender{}
 		logger := zap.NewNop()
 
-		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 		// Create a cancelled context
 		ctx, cancel := context.WithCancel(context.Background())
@@ -367,14 +358,13 @@ func TestAccountService_EdgeCases(t *testing.T) {
 		// Test with cancelled context - this should respect the cancellation
 		// Note: Our current implementation doesn't explicitly check context cancellation,
 		// but this test ensures we handle it gracefully when added
-		phoneNumber := "+1234567890"
+		phoneNumber := "+14155552671"
 
 		// Mock the repository to return a cancellation error
-		mockPhoneTokenRepo.On("Create", ctx, phoneNumber).Return("", (*PhoneNumberVerificationToken)(nil), context.Canceled)
+		mockPhoneTokenRepo.On("GetByPhoneNumber", ctx, phoneNumber).Return(nil, context.Canceled)
 
 		err := service.CreatePhoneVerificationToken(ctx, phoneNumber)
-		assert.Error(t, err)
-		assert.Equal(t, context.Canceled, err)
+		assert.ErrorIs(t, err, context.Canceled)
 
 		mockPhoneTokenRepo.AssertExpectations(t)
 	})
@@ -387,7 +377,7 @@ func TestAccountService_EdgeCases(t *testing.T) {
 		mockSMS := &MockMessageSender{}
 		logger := zap.NewNop()
 
-		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, logger)
+		service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
 
 		ctx := context.Background()
 
@@ -401,7 +391,7 @@ func TestAccountService_EdgeCases(t *testing.T) {
 			{"   ", true, "whitespace only phone number"},
 			{"123", true, "too short phone number"},
 			{"+1", true, "minimum valid format but invalid"},
-			{"+123456789012345", false, "maximum valid length"},
+			{"+14155552671", false, "valid phone number"},
 			{"+1234567890123456", true, "too long phone number"},
 		}
 
@@ -434,39 +424,33 @@ func TestAccountService_EdgeCases(t *testing.T) {
 
 		for _, tc := range testTextCases {
 			t.Run(fmt.Sprintf("TextValidation_%s", tc.description), func(t *testing.T) {
-				var err error
+				// Invalid input is rejected before the account is loaded
+				mockRepo := &MockAccountRepo{}
+				service := NewAccountService(mockRepo, mockPhoneTokenRepo, mockEmailTokenRepo, mockSMS, nil, nil, logger)
+				testAccount := &Account{
+					CoreModel: core.CoreModel{ID: 1},
+					FullName:  "Test User",
+					Email:     "test@example.com",
+				}
+				if !tc.expectError {
+					mockRepo.On("Get", ctx, int64(1)).Return(testAccount, nil)
+					mockRepo.On("Update", ctx, testAccount, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testAccount, nil)
+				}
 
+				var err error
 				switch tc.fieldName {
 				case "fullName":
-					testAccount := &Account{
-						CoreModel: core.CoreModel{ID: 1},
-						FullName:  "",
-						Email:     "test@example.com",
-					}
-					mockRepo.On("Get", ctx, int64(1)).Return(testAccount, nil)
 					_, err = service.UpdateAccountFullName(ctx, 1, tc.input)
-					mockRepo.AssertExpectations(t)
-
 				case "termsVersion":
-					testAccount := &Account{
-						CoreModel: core.CoreModel{ID: 1},
-						FullName:  "Test User",
-						Email:     "test@example.com",
-					}
-					mockRepo.On("Get", ctx, int64(1)).Return(testAccount, nil)
 					_, err = service.UpdateAccountTermsAndPolicy(ctx, 1, tc.input)
-					mockRepo.AssertExpectations(t)
 				}
 
 				if tc.expectError {
 					assert.Error(t, err)
 				} else {
-					// Don't check for no error here as the Update call will fail at the repo level
-					// The important thing is that validation passed
-					if tc.fieldName == "fullName" && tc.input != "" {
-						assert.NoError(t, err)
-					}
+					assert.NoError(t, err)
 				}
+				mockRepo.AssertExpectations(t)
 			})
 		}
 	})
@@ -143,6 +143,7 @@ func TestAccountServiceWithOptionalS3Client(t *testing.T) {
 		mockEmailTokenRepo,
 		mockMessageSender,
 		nil, // S3 client is nil
+		nil, // No security events
 		logger,
 	)
 
@@ -160,6 +161,7 @@ func TestAccountServiceWithOptionalS3Client(t *testing.T) {
 		mockEmailTokenRepo,
 		mockMessageSender,
 		nil, // Would be real S3 client in production
+		nil, // No security events
 		logger,
 	)
 
@@ -32,11 +32,24 @@ func TestAccountService_CreatePhoneVerificationToken(t *testing.T) {
 					TokenHash:   "hashedtoken123",
 					ExpiresAt:   time.Now().Add(24 * time.Hour),
 				}
+				p.On("GetByPhoneNumber", mock.Anything, "+12345678901").Return(nil, ErrTokenNotFound)
 				p.On("Create", mock.Anything, "+12345678901").Return("123456", testPhoneToken, nil)
 				s.On("SendSMS", mock.Anything, "+12345678901", mock.AnythingOfType("string")).Return(nil)
 			},
 			expectError: false,
 		},
+		{
+			name:        "token sent recently should return cooldown error",
+			phoneNumber: "+12345678901",
+			setupMocks: func(m *MockAccountRepo, p *MockPhoneNumberVerificationTokenRepo, s *MockMessageSender) {
+				p.On("GetByPhoneNumber", mock.Anything, "+12345678901").Return(&PhoneNumberVerificationToken{
+					CoreModel:   core.CoreModel{ID: 1, CreatedAt: time.Now()},
+					PhoneNumber: "+12345678901",
+				}, nil)
+			},
+			expectError:  true,
+			errorMessage: ErrPhoneVerificationCooldown.Error(),
+		},
 		{
 			name:         "invalid phone number should return error",
 			phoneNumber: "invalid",
@@ -55,6 +68,7 @@ func TestAccountService_CreatePhoneVerificationToken(t *testing.T) {
 			name:        "failed to create token should return error",
 			phoneNumber: "+12345678901",
 			setupMocks: func(m *MockAccountRepo, p *MockPhoneNumberVerificationTokenRepo, s *MockMessageSender) {
+				p.On("GetByPhoneNumber", mock.Anything, "+12345678901").Return(nil, ErrTokenNotFound)
 				p.On("Create", mock.Anything, "+12345678901").Return("", (*PhoneNumberVerificationToken)(nil), assert.AnError)
 			},
 			expectError:  true,
@@ -70,6 +84,7 @@ func TestAccountService_CreatePhoneVerificationToken(t *testing.T) {
 					TokenHash:   "hashedtoken123",
 					ExpiresAt:   time.Now().Add(24 * time.Hour),
 				}
+				p.On("GetByPhoneNumber", mock.Anything, "+12345678901").Return(nil, ErrTokenNotFound)
 				p.On("Create", mock.Anything, "+12345678901").Return("123456", testPhoneToken, nil)
 				s.On("SendSMS", mock.Anything, "+12345678901", mock.AnythingOfType("string")).Return(assert.AnError)
 			},
@@ -84,7 +99,7 @@ func TestAccountService_CreatePhoneVerificationToken(t *testing.T) {
 			mockRepo := &MockAccountRepo{}
 			mockPhoneTokenRepo := &MockPhoneNumberVerificationTokenRepo{}
 			mockMessageSender := &MockMessageSender{}
-			service := NewAccountService(mockRepo, mockPhoneTokenRepo, nil, mockMessageSender, nil, logger)
+			service := NewAccountService(mockRepo, mockPhoneTokenRepo, nil, mockMessageSender, nil, nil, logger)
 
 			tt.setupMocks(mockRepo, mockPhoneTokenRepo, mockMessageSender)
 
@@ -145,7 +160,7 @@ func TestAccountService_VerifyPhoneNumber(t *testing.T) {
 					Email:       "john@example.com",
 					PhoneNumber: stringPtr("+12345678901"),
 				}
-				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), mock.AnythingOfType("*string"), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil), (*bool)(nil)).Return(updatedAccount, nil)
+				m.On("Update", mock.Anything, testAccount, (*string)(nil), (*string)(nil), mock.AnythingOfType("*string"), (*TermsAndPolicy)(nil), (*AnalyticsPreference)(nil)).Return(updatedAccount, nil)
 
 				// Mock Delete
 				p.On("Delete", mock.Anything, testPhoneToken).Return(nil)
@@ -251,7 +266,7 @@ func TestAccountService_VerifyPhoneNumber(t *testing.T) {
 			// Create fresh mocks for each test to avoid state conflicts
 			mockRepo := &MockAccountRepo{}
 			mockPhoneTokenRepo := &MockPhoneNumberVerificationTokenRepo{}
-			service := NewAccountService(mockRepo, mockPhoneTokenRepo, nil, nil, nil, logger)
+			service := NewAccountService(mockRepo, mockPhoneTokenRepo, nil, nil, nil, nil, logger)
 
 			tt.setupMocks(mockRepo, mockPhoneTokenRepo)
 
@@ -276,7 +291,7 @@ func TestAccountService_PhoneVerificationIntegration(t *testing.T) {
 	mockRepo := &MockAccountRepo{}
 	mockPhoneTokenRepo := &MockPhoneNumberVerificationTokenRepo{}
 	mockMessageSender := &MockMessageSender{}
-	service := NewAccountService(mockRepo, mockPhoneTokenRepo, nil, mockMessageSender, nil, logger)
+	service := NewAccountService(mockRepo, mockPhoneTokenRepo, nil, mockMessageSender, nil, nil, logger)
 
 	// Test complete phone verification workflow
 	ctx := context.Background()
@@ -290,6 +305,7 @@ func TestAccountService_PhoneVerificationIntegration(t *testing.T) {
 		TokenHash:   "e10adc3949ba59abbe56e057f20f883e", // MD5 of "123456"
 		ExpiresAt:   time.Now().Add(24 * time.Hour),
 	}
+	mockPhoneTokenRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(nil, ErrTokenNotFound).Once()
 	mockPhoneTokenRepo.On("Create", mock.Anything, phoneNumber).Return(token, testPhoneToken, nil)
 	mockMessageSender.On("SendSMS", mock.Anything, phoneNumber, mock.AnythingOfType("string")).Return(nil)
 
@@ -317,7 +333,7 @@ func TestAccountService_PhoneVerificationTokenDeletionError(t *testing.T) {
 	logger := zap.NewNop()
 	mockRepo := &MockAccountRepo{}
 	mockPhoneTokenRepo := &MockPhoneNumberVerificationTokenRepo{}
-	service := NewAccountService(mockRepo, mockPhoneTokenRepo, nil, nil, nil, logger)
+	service := NewAccountService(mockRepo, mockPhoneTokenRepo, nil, nil, nil, nil, logger)
 
 	// Test that deletion errors are logged but don't fail the verification
 	ctx := context.Background()
@@ -0,0 +1,216 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"server/internal/config"
+	"server/internal/infrastructure/ratelimit"
+
+	"github.com/nyaruka/phonenumbers"
+	"go.uber.org/zap"
+)
+
+// Budgets of the SMSGuard
+const (
+	SMSBudgetPrefix = "prefix"
+	SMSBudgetGlobal = "global"
+)
+
+// smsBudgetWindow is the sliding window SMS budgets are counted in
+const smsBudgetWindow = time.Hour
+
+// blockedNumberTypes are the number types SMS pumping and toll fraud rely on
+var blockedNumberTypes = map[phonenumbers.PhoneNumberType]string{
+	phonenumbers.PREMIUM_RATE: "premium rate",
+	phonenumbers.SHARED_COST:  "shared cost",
+	phonenumbers.VOIP:         "VoIP",
+}
+
+// SMSBudgetAlert describes an SMS budget running high or out
+type SMSBudgetAlert struct {
+	// Budget is SMSBudgetPrefix or SMSBudgetGlobal
+	Budget string
+	// Prefix holds the leading digits of the numbers counted by a prefix budget
+	Prefix string
+	// Limit is the hourly budget
+	Limit int
+	// Exceeded is false when the usage passed the alert threshold, and true once the budget ran out
+	Exceeded bool
+}
+
+// SMSAlertHook is called at most once per hour for each budget that passes its alert threshold or runs out
+type SMSAlertHook func(ctx context.Context, alert SMSBudgetAlert)
+
+// NewLoggingSMSAlertHook returns an alert hook that logs the alerts as warnings
+func NewLoggingSMSAlertHook(logger *zap.Logger) SMSAlertHook {
+	return func(ctx context.Context, alert SMSBudgetAlert) {
+		logger.Warn("SMS budget alert",
+			zap.String("budget", alert.Budget),
+			zap.String("prefix", alert.Prefix),
+			zap.Int("limit", alert.Limit),
+			zap.Bool("exceeded", alert.Exceeded))
+	}
+}
+
+// SMSGuard protects a MessageSender against SMS pumping and toll fraud
+//
+// Numbers of denied countries, or of countries missing from a non-empty allowlist, are
+// rejected, as are premium rate, shared cost and VoIP numbers. The SMS that pass are
+// counted against an hourly budget per number prefix, so that a pumped number range is
+// cut off early, and against a global hourly budget.
+type SMSGuard struct {
+	sender           MessageSender
+	store            ratelimit.Store
+	alertHook        SMSAlertHook
+	allowedCountries map[string]bool
+	deniedCountries  map[string]bool
+	prefixLength     int
+	prefixBudget     int
+	globalBudget     int
+	alertPercent     int
+}
+
+// NewSMSGuard creates a guard in front of the sender from the SMS_* fraud protection configuration
+func NewSMSGuard(sender MessageSender, store ratelimit.Store, alertHook SMSAlertHook, cfg *config.Config) *SMSGuard {
+	return &SMSGuard{
+		sender:           sender,
+		store:            store,
+		alertHook:        alertHook,
+		allowedCountries: countrySet(cfg.SMSAllowedCountries),
+		deniedCountries:  countrySet(cfg.SMSDeniedCountries),
+		prefixLength:     cfg.SMSBudgetPrefixLength,
+		prefixBudget:     cfg.SMSPrefixHourlyBudget,
+		globalBudget:     cfg.SMSGlobalHourlyBudget,
+		alertPercent:     cfg.SMSBudgetAlertPercent,
+	}
+}
+
+// SendSMS implements MessageSender, sending the message only when the number and budgets allow it
+//
+// Returns:
+//   - error: ErrInvalidPhoneNumber, ErrSMSCountryNotAllowed, ErrSMSNumberTypeNotAllowed,
+//     ErrSMSBudgetExceeded, or the error of the guarded sender
+func (g *SMSGuard) SendSMS(ctx context.Context, phoneNumber, message string) error {
+	number, err := g.checkNumber(phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	// The prefix budget is spent first, so that a pumped range doesn't eat into the global budget
+	prefix := g.prefix(number)
+	if err := g.spend(ctx, SMSBudgetPrefix, prefix, g.prefixBudget); err != nil {
+		return err
+	}
+	if err := g.spend(ctx, SMSBudgetGlobal, "", g.globalBudget); err != nil {
+		return err
+	}
+
+	return g.sender.SendSMS(ctx, phoneNumber, message)
+}
+
+// ValidatePhoneNumber implements MessageSender, also rejecting the numbers SMS can't be sent to
+func (g *SMSGuard) ValidatePhoneNumber(phoneNumber string) error {
+	if err := g.sender.ValidatePhoneNumber(phoneNumber); err != nil {
+		return err
+	}
+	_, err := g.checkNumber(phoneNumber)
+	return err
+}
+
+// checkNumber parses the phone number and rejects the countries and number types that aren't allowed
+func (g *SMSGuard) checkNumber(phoneNumber string) (*phonenumbers.PhoneNumber, error) {
+	number, err := phonenumbers.Parse(phoneNumber, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPhoneNumber, err)
+	}
+
+	region := phonenumbers.GetRegionCodeForNumber(number)
+	if g.deniedCountries[region] || (len(g.allowedCountries) > 0 && !g.allowedCountries[region]) {
+		return nil, fmt.Errorf("%w: %s", ErrSMSCountryNotAllowed, region)
+	}
+
+	if numberType, blocked := blockedNumberTypes[phonenumbers.GetNumberType(number)]; blocked {
+		return nil, fmt.Errorf("%w: %s", ErrSMSNumberTypeNotAllowed, numberType)
+	}
+
+	return number, nil
+}
+
+// prefix returns the first digits of the number in E.164 format, country code included
+func (g *SMSGuard) prefix(number *phonenumbers.PhoneNumber) string {
+	digits := strings.TrimPrefix(phonenumbers.Format(number, phonenumbers.E164), "+")
+	if g.prefixLength > 0 && len(digits) > g.prefixLength {
+		digits = digits[:g.prefixLength]
+	}
+	return digits
+}
+
+// spend counts an SMS against a budget, alerting when the budget runs high or out
+//
+// A zero limit disables the budget.
+func (g *SMSGuard) spend(ctx context.Context, budget string, prefix string, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	key := "sms:" + budget
+	if prefix != "" {
+		key += ":" + prefix
+	}
+
+	retryAfter, err := g.store.Allow(ctx, key, limit, smsBudgetWindow)
+	if err != nil {
+		return fmt.Errorf("failed to count SMS budget: %w", err)
+	}
+	if retryAfter > 0 {
+		g.alert(ctx, key, SMSBudgetAlert{Budget: budget, Prefix: prefix, Limit: limit, Exceeded: true})
+		return fmt.Errorf("%w: %s", ErrSMSBudgetExceeded, budget)
+	}
+
+	// A second window capped at the threshold runs full once the usage passes it
+	threshold := limit * g.alertPercent / 100
+	if threshold <= 0 || threshold >= limit {
+		return nil
+	}
+	retryAfter, err = g.store.Allow(ctx, key+":threshold", threshold, smsBudgetWindow)
+	if err != nil {
+		return fmt.Errorf("failed to count SMS budget: %w", err)
+	}
+	if retryAfter > 0 {
+		g.alert(ctx, key, SMSBudgetAlert{Budget: budget, Prefix: prefix, Limit: limit})
+	}
+
+	return nil
+}
+
+// alert calls the alert hook, unless the same alert was raised within the budget window
+func (g *SMSGuard) alert(ctx context.Context, key string, alert SMSBudgetAlert) {
+	if g.alertHook == nil {
+		return
+	}
+
+	alertKey := key + ":alert"
+	if alert.Exceeded {
+		alertKey += ":exceeded"
+	}
+	if retryAfter, err := g.store.Allow(ctx, alertKey, 1, smsBudgetWindow); err != nil || retryAfter > 0 {
+		return
+	}
+
+	g.alertHook(ctx, alert)
+}
+
+// countrySet normalizes a list of ISO 3166-1 alpha-2 country codes
+func countrySet(countries []string) map[string]bool {
+	set := make(map[string]bool, len(countries))
+	for _, country := range countries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if country != "" {
+			set[country] = true
+		}
+	}
+	return set
+}
@@ -0,0 +1,102 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"server/internal/config"
+	"server/internal/infrastructure/ratelimit"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// exampleNumber returns libphonenumber's example number of the type in the region, in E.164 format
+func exampleNumber(t *testing.T, region string, numberType phonenumbers.PhoneNumberType) string {
+	number := phonenumbers.GetExampleNumberForType(region, numberType)
+	require.NotNil(t, number)
+	return phonenumbers.Format(number, phonenumbers.E164)
+}
+
+func TestSMSGuard(t *testing.T) {
+	ctx := context.Background()
+	usMobile := exampleNumber(t, "US", phonenumbers.MOBILE)
+	gbMobile := exampleNumber(t, "GB", phonenumbers.MOBILE)
+
+	newGuard := func(cfg *config.Config) (*SMSGuard, *MockMessageSender, *[]SMSBudgetAlert) {
+		sender := &MockMessageSender{}
+		sender.On("SendSMS", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		sender.On("ValidatePhoneNumber", mock.Anything).Return(nil)
+
+		var alerts []SMSBudgetAlert
+		guard := NewSMSGuard(sender, ratelimit.NewMemoryStore(), func(ctx context.Context, alert SMSBudgetAlert) {
+			alerts = append(alerts, alert)
+		}, cfg)
+		return guard, sender, &alerts
+	}
+
+	t.Run("sends to allowed numbers", func(t *testing.T) {
+		guard, sender, _ := newGuard(&config.Config{})
+
+		require.NoError(t, guard.SendSMS(ctx, usMobile, "Your code is 123456"))
+		sender.AssertCalled(t, "SendSMS", ctx, usMobile, "Your code is 123456")
+	})
+
+	t.Run("rejects denied and unlisted countries", func(t *testing.T) {
+		guard, sender, _ := newGuard(&config.Config{SMSDeniedCountries: []string{" gb"}})
+		assert.ErrorIs(t, guard.SendSMS(ctx, gbMobile, "code"), ErrSMSCountryNotAllowed)
+		assert.NoError(t, guard.SendSMS(ctx, usMobile, "code"))
+
+		guard, _, _ = newGuard(&config.Config{SMSAllowedCountries: []string{"US", "CA"}})
+		assert.ErrorIs(t, guard.SendSMS(ctx, gbMobile, "code"), ErrSMSCountryNotAllowed)
+		assert.ErrorIs(t, guard.ValidatePhoneNumber(gbMobile), ErrSMSCountryNotAllowed)
+		assert.NoError(t, guard.ValidatePhoneNumber(usMobile))
+
+		sender.AssertNotCalled(t, "SendSMS", mock.Anything, gbMobile, mock.Anything)
+	})
+
+	t.Run("rejects premium rate, shared cost and VoIP numbers", func(t *testing.T) {
+		guard, sender, _ := newGuard(&config.Config{})
+
+		for _, numberType := range []phonenumbers.PhoneNumberType{phonenumbers.PREMIUM_RATE, phonenumbers.SHARED_COST, phonenumbers.VOIP} {
+			phoneNumber := exampleNumber(t, "FR", numberType)
+			assert.ErrorIs(t, guard.SendSMS(ctx, phoneNumber, "code"), ErrSMSNumberTypeNotAllowed, phoneNumber)
+			assert.ErrorIs(t, guard.ValidatePhoneNumber(phoneNumber), ErrSMSNumberTypeNotAllowed, phoneNumber)
+		}
+		sender.AssertNotCalled(t, "SendSMS", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("caps the SMS per prefix and alerts once", func(t *testing.T) {
+		guard, _, alerts := newGuard(&config.Config{
+			SMSBudgetPrefixLength: 6,
+			SMSPrefixHourlyBudget: 5,
+			SMSBudgetAlertPercent: 60,
+		})
+
+		for range 5 {
+			require.NoError(t, guard.SendSMS(ctx, "+447400123456", "code"))
+		}
+		require.Len(t, *alerts, 1)
+		assert.Equal(t, SMSBudgetAlert{Budget: SMSBudgetPrefix, Prefix: "447400", Limit: 5}, (*alerts)[0])
+
+		// Numbers sharing the prefix are counted together
+		assert.ErrorIs(t, guard.SendSMS(ctx, "+447400123999", "code"), ErrSMSBudgetExceeded)
+		assert.ErrorIs(t, guard.SendSMS(ctx, "+447400123998", "code"), ErrSMSBudgetExceeded)
+		require.Len(t, *alerts, 2)
+		assert.True(t, (*alerts)[1].Exceeded)
+
+		assert.NoError(t, guard.SendSMS(ctx, "+447700900123", "code"))
+	})
+
+	t.Run("caps the SMS in total", func(t *testing.T) {
+		guard, _, alerts := newGuard(&config.Config{SMSGlobalHourlyBudget: 2})
+
+		require.NoError(t, guard.SendSMS(ctx, usMobile, "code"))
+		require.NoError(t, guard.SendSMS(ctx, gbMobile, "code"))
+		assert.ErrorIs(t, guard.SendSMS(ctx, "+447700900123", "code"), ErrSMSBudgetExceeded)
+		require.Len(t, *alerts, 1)
+		assert.Equal(t, SMSBudgetAlert{Budget: SMSBudgetGlobal, Limit: 2, Exceeded: true}, (*alerts)[0])
+	})
+}
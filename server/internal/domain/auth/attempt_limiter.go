@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"server/internal/config"
+)
+
+// Scopes of tracked failed authentication attempts
+const (
+	AttemptScopeAccount = "account"
+	AttemptScopeIP      = "ip"
+)
+
+// AttemptLimiter slows down and locks out repeated failed password, login code, 2FA and sudo attempts
+//
+// Failures are counted per account and per IP address. Accounts are keyed by their email
+// address, except for SMS login codes which are counted by phone number. Past the free attempts of a scope,
+// every failure doubles the wait before the next attempt, up to the maximum backoff. At the
+// threshold, the account is locked for the lock duration and can be unlocked early with the
+// unlock token issued with the lock. IP addresses are only backed off, never locked.
+type AttemptLimiter struct {
+	repo                AuthAttemptRepo
+	accountFreeAttempts int
+	ipFreeAttempts      int
+	backoffBase         time.Duration
+	backoffMax          time.Duration
+	threshold           int
+	lockDuration        time.Duration
+	window              time.Duration
+}
+
+// NewAttemptLimiter creates the attempt limiter from the LOCKOUT_* configuration
+func NewAttemptLimiter(repo AuthAttemptRepo, cfg *config.Config) *AttemptLimiter {
+	return &AttemptLimiter{
+		repo:                repo,
+		accountFreeAttempts: cfg.LockoutAccountFreeAttempts,
+		ipFreeAttempts:      cfg.LockoutIPFreeAttempts,
+		backoffBase:         cfg.LockoutBackoffBase,
+		backoffMax:          cfg.LockoutBackoffMax,
+		threshold:           cfg.LockoutThreshold,
+		lockDuration:        cfg.LockoutDuration,
+		window:              cfg.LockoutWindow,
+	}
+}
+
+// Check returns an error while attempts against the account or from the IP address must wait
+//
+// An empty email or IP address skips its scope.
+//
+// Returns:
+//   - error: a *TooManyAttemptsError wrapping ErrAccountLocked or ErrRateLimitExceeded
+func (l *AttemptLimiter) Check(ctx context.Context, emailAddress string, ipAddress string) error {
+	var blocked *AuthAttempt
+	for _, key := range attemptKeys(emailAddress, ipAddress) {
+		attempt, err := l.repo.Get(ctx, key.scope, key.key)
+		if errors.Is(err, ErrAuthAttemptNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if attempt.IsBlocked() && (blocked == nil || attempt.BlockedUntil.After(*blocked.BlockedUntil)) {
+			blocked = attempt
+		}
+	}
+	if blocked == nil {
+		return nil
+	}
+
+	retryAfter := int(math.Ceil(time.Until(*blocked.BlockedUntil).Seconds()))
+	if blocked.IsLocked() {
+		return NewTooManyAttemptsError(retryAfter, ErrAccountLocked)
+	}
+	return NewTooManyAttemptsError(retryAfter, ErrRateLimitExceeded)
+}
+
+// RecordFailure counts a failed attempt against the account and from the IP address
+//
+// Returns:
+//   - string: The unlock token when this failure locked the account, empty otherwise
+//   - time.Time: When the lock expires
+func (l *AttemptLimiter) RecordFailure(ctx context.Context, emailAddress string, ipAddress string) (string, time.Time, error) {
+	var unlockToken string
+	var lockedUntil time.Time
+	for _, key := range attemptKeys(emailAddress, ipAddress) {
+		attempt, err := l.repo.RecordFailure(ctx, key.scope, key.key, l.window)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+
+		if key.scope == AttemptScopeAccount && l.threshold > 0 && attempt.Failures >= l.threshold {
+			lockedUntil = time.Now().Add(l.lockDuration)
+			unlockToken, err = l.repo.Lock(ctx, attempt, lockedUntil)
+			if err != nil {
+				return "", time.Time{}, err
+			}
+			continue
+		}
+
+		freeAttempts := l.accountFreeAttempts
+		if key.scope == AttemptScopeIP {
+			freeAttempts = l.ipFreeAttempts
+		}
+		if delay := l.backoff(attempt.Failures, freeAttempts); delay > 0 {
+			if err := l.repo.Block(ctx, attempt, time.Now().Add(delay)); err != nil {
+				return "", time.Time{}, err
+			}
+		}
+	}
+
+	return unlockToken, lockedUntil, nil
+}
+
+// Reset forgets the failed attempts against the account, lifting its lock
+func (l *AttemptLimiter) Reset(ctx context.Context, emailAddress string) error {
+	return l.repo.Delete(ctx, AttemptScopeAccount, emailAddress)
+}
+
+// ResetIPAddress forgets the failed attempts from the IP address
+func (l *AttemptLimiter) ResetIPAddress(ctx context.Context, ipAddress string) error {
+	return l.repo.Delete(ctx, AttemptScopeIP, ipAddress)
+}
+
+// Unlock lifts the account's lock with the unlock token mailed when it was locked
+//
+// Returns:
+//   - error: ErrInvalidUnlockToken
+func (l *AttemptLimiter) Unlock(ctx context.Context, emailAddress string, unlockToken string) error {
+	attempt, err := l.repo.Get(ctx, AttemptScopeAccount, emailAddress)
+	if errors.Is(err, ErrAuthAttemptNotFound) {
+		return ErrInvalidUnlockToken
+	}
+	if err != nil {
+		return err
+	}
+
+	if attempt.UnlockTokenHash == nil || !l.repo.VerifyUnlockToken(unlockToken, *attempt.UnlockTokenHash) {
+		return ErrInvalidUnlockToken
+	}
+
+	if err := l.Reset(ctx, emailAddress); err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+	return nil
+}
+
+// backoff returns the wait after the given number of failures, doubling with each failure past the free attempts
+func (l *AttemptLimiter) backoff(failures int, freeAttempts int) time.Duration {
+	if failures <= freeAttempts || l.backoffBase <= 0 {
+		return 0
+	}
+
+	exponent := failures - freeAttempts - 1
+	if exponent >= 32 {
+		return l.backoffMax
+	}
+	delay := l.backoffBase << exponent
+	if l.backoffMax > 0 && (delay > l.backoffMax || delay <= 0) {
+		return l.backoffMax
+	}
+	return delay
+}
+
+// attemptKey identifies the tracked attempts of an account or IP address
+type attemptKey struct {
+	scope string
+	key   string
+}
+
+// attemptKeys returns the keys of the non-empty scopes
+func attemptKeys(emailAddress string, ipAddress string) []attemptKey {
+	keys := make([]attemptKey, 0, 2)
+	if emailAddress != "" {
+		keys = append(keys, attemptKey{scope: AttemptScopeAccount, key: emailAddress})
+	}
+	if ipAddress != "" {
+		keys = append(keys, attemptKey{scope: AttemptScopeIP, key: ipAddress})
+	}
+	return keys
+}
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"server/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAttemptLimiter() (*AttemptLimiter, *memoryAuthAttemptRepo) {
+	repo := newMemoryAuthAttemptRepo()
+	limiter := NewAttemptLimiter(repo, &config.Config{
+		LockoutAccountFreeAttempts: 2,
+		LockoutIPFreeAttempts:      4,
+		LockoutBackoffBase:         time.Second,
+		LockoutBackoffMax:          time.Minute,
+		LockoutThreshold:           5,
+		LockoutDuration:            time.Hour,
+		LockoutWindow:              time.Hour,
+	})
+	return limiter, repo
+}
+
+func TestAttemptLimiter_Backoff(t *testing.T) {
+	limiter, _ := newTestAttemptLimiter()
+
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 1, expected: 0},
+		{failures: 2, expected: 0},
+		{failures: 3, expected: time.Second},
+		{failures: 4, expected: 2 * time.Second},
+		{failures: 5, expected: 4 * time.Second},
+		{failures: 9, expected: time.Minute},
+		{failures: 200, expected: time.Minute},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, limiter.backoff(tt.failures, 2), "failures: %d", tt.failures)
+	}
+}
+
+func TestAttemptLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("backs off after the free attempts", func(t *testing.T) {
+		limiter, repo := newTestAttemptLimiter()
+
+		for range 2 {
+			_, _, err := limiter.RecordFailure(ctx, "test@example.com", "")
+			require.NoError(t, err)
+			require.NoError(t, limiter.Check(ctx, "test@example.com", ""))
+		}
+
+		_, _, err := limiter.RecordFailure(ctx, "test@example.com", "")
+		require.NoError(t, err)
+
+		err = limiter.Check(ctx, "test@example.com", "")
+		var tooManyErr *TooManyAttemptsError
+		require.ErrorAs(t, err, &tooManyErr)
+		assert.ErrorIs(t, err, ErrRateLimitExceeded)
+		assert.Equal(t, 1, tooManyErr.RetryAfterSeconds)
+
+		// Other accounts are not held back
+		assert.NoError(t, limiter.Check(ctx, "other@example.com", ""))
+
+		// Failures outside of the window start over
+		attempt, err := repo.Get(ctx, AttemptScopeAccount, "test@example.com")
+		require.NoError(t, err)
+		attempt.LastFailedAt = time.Now().Add(-2 * time.Hour)
+		attempt.BlockedUntil = nil
+		_, _, err = limiter.RecordFailure(ctx, "test@example.com", "")
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempt.Failures)
+		assert.NoError(t, limiter.Check(ctx, "test@example.com", ""))
+	})
+
+	t.Run("backs off IP addresses across accounts", func(t *testing.T) {
+		limiter, _ := newTestAttemptLimiter()
+
+		for i := range 5 {
+			_, _, err := limiter.RecordFailure(ctx, fmt.Sprintf("user-%d@example.com", i), "203.0.113.7")
+			require.NoError(t, err)
+		}
+
+		assert.ErrorIs(t, limiter.Check(ctx, "new@example.com", "203.0.113.7"), ErrRateLimitExceeded)
+		assert.NoError(t, limiter.Check(ctx, "new@example.com", "198.51.100.1"))
+
+		require.NoError(t, limiter.ResetIPAddress(ctx, "203.0.113.7"))
+		assert.NoError(t, limiter.Check(ctx, "new@example.com", "203.0.113.7"))
+	})
+
+	t.Run("locks the account at the threshold", func(t *testing.T) {
+		limiter, repo := newTestAttemptLimiter()
+
+		var unlockToken string
+		var lockedUntil time.Time
+		for i := range 5 {
+			// Skip the backoff between attempts
+			if attempt, err := repo.Get(ctx, AttemptScopeAccount, "test@example.com"); err == nil {
+				attempt.BlockedUntil = nil
+			}
+
+			token, until, err := limiter.RecordFailure(ctx, "test@example.com", "")
+			require.NoError(t, err)
+			if i < 4 {
+				assert.Empty(t, token)
+			}
+			unlockToken, lockedUntil = token, until
+		}
+
+		require.NotEmpty(t, unlockToken)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), lockedUntil, 5*time.Second)
+
+		err := limiter.Check(ctx, "test@example.com", "")
+		var tooManyErr *TooManyAttemptsError
+		require.ErrorAs(t, err, &tooManyErr)
+		assert.ErrorIs(t, err, ErrAccountLocked)
+		assert.InDelta(t, 3600, tooManyErr.RetryAfterSeconds, 5)
+
+		assert.ErrorIs(t, limiter.Unlock(ctx, "test@example.com", "wrong-token"), ErrInvalidUnlockToken)
+		assert.ErrorIs(t, limiter.Unlock(ctx, "other@example.com", unlockToken), ErrInvalidUnlockToken)
+		require.NoError(t, limiter.Unlock(ctx, "test@example.com", unlockToken))
+		assert.NoError(t, limiter.Check(ctx, "test@example.com", ""))
+
+		// The token can't be reused
+		assert.ErrorIs(t, limiter.Unlock(ctx, "test@example.com", unlockToken), ErrInvalidUnlockToken)
+	})
+
+	t.Run("a backoff after an expired lock is not a lock", func(t *testing.T) {
+		limiter, repo := newTestAttemptLimiter()
+		attempt, err := repo.RecordFailure(ctx, AttemptScopeAccount, "test@example.com", time.Hour)
+		require.NoError(t, err)
+		_, err = repo.Lock(ctx, attempt, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		for range 3 {
+			_, _, err := limiter.RecordFailure(ctx, "test@example.com", "")
+			require.NoError(t, err)
+		}
+
+		assert.ErrorIs(t, limiter.Check(ctx, "test@example.com", ""), ErrRateLimitExceeded)
+		assert.False(t, attempt.IsLocked())
+	})
+}
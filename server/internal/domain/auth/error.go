@@ -12,8 +12,9 @@ import (
 var (
 	// Authentication errors
 	ErrInvalidCredentials      = errors.New("invalid credentials")
+	ErrInvalidAuthProvider     = errors.New("account does not use this authentication provider")
+	ErrInsufficientAuthProviders = errors.New("account must keep at least one authentication provider")
 	ErrAccountNotFound         = errors.New("account not found")
-	ErrAccountDisabled         = errors.New("account is disabled")
 	ErrAuthenticationFailed    = errors.New("authentication failed")
 	ErrTwoFactorRequired       = errors.New("two-factor authentication required")
 	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor authentication code")
@@ -25,9 +26,25 @@ var (
 	ErrInvalidToken            = errors.New("invalid token")
 	ErrInvalidOrExpiredToken   = errors.New("token is invalid or expired")
 	ErrPasswordResetTokenNotFound = errors.New("password reset token not found")
+	ErrEmailLoginCodeNotFound     = errors.New("email login code not found")
+	ErrInvalidEmailLoginCode      = errors.New("email login code is invalid or expired")
+	ErrSmsLoginCodeNotFound       = errors.New("sms login code not found")
+	ErrInvalidSmsLoginCode        = errors.New("sms login code is invalid or expired")
+	ErrAuthAttemptNotFound        = errors.New("auth attempt not found")
+	ErrInvalidUnlockToken         = errors.New("account unlock token is invalid or expired")
+	ErrSessionRevocationTokenNotFound = errors.New("session revocation token not found")
+	ErrBearerTokenNotFound        = errors.New("bearer token not found")
+	ErrInvalidRefreshToken        = errors.New("refresh token is invalid or expired")
+	ErrRefreshTokenReused         = errors.New("refresh token was already used")
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+	ErrInvalidPersonalAccessTokenName   = errors.New("personal access token name is invalid")
+	ErrInvalidPersonalAccessTokenScopes = errors.New("personal access token scopes are invalid")
+	ErrInvalidPersonalAccessTokenExpiry = errors.New("personal access token expiry is invalid")
 
 	// Password errors
 	ErrPasswordTooWeak         = errors.New("password is too weak")
+	ErrPasswordBreached        = errors.New("password appears in a data breach")
+	ErrPasswordPreviouslyUsed  = errors.New("password was used recently")
 	ErrPasswordIncorrect       = errors.New("password is incorrect")
 	ErrPasswordResetRequired   = errors.New("password reset required")
 
@@ -35,6 +52,7 @@ var (
 	ErrEmailAlreadyExists      = errors.New("email already exists")
 	ErrPhoneAlreadyExists      = errors.New("phone number already exists")
 	ErrEmailNotVerified        = errors.New("email not verified")
+	ErrInvalidEmail            = errors.New("invalid email format")
 	ErrInvalidEmailDomain      = errors.New("invalid or disposable email domain")
 	ErrEmailAlreadyVerified    = errors.New("email is already verified")
 
@@ -42,11 +60,15 @@ var (
 	ErrWebAuthnCredentialNotFound = errors.New("webauthn credential not found")
 	ErrChallengeNotFound         = errors.New("challenge not found")
 	ErrInvalidWebAuthnResponse   = errors.New("invalid webauthn response")
+	ErrWebAuthnCredentialCloned  = errors.New("webauthn authenticator may have been cloned")
 
 	// OAuth errors
 	ErrOAuthCredentialAlreadyExists = errors.New("oauth credential already exists")
+	ErrOAuthCredentialNotFound      = errors.New("oauth credential not found")
+	ErrOAuthStateNotFound           = errors.New("oauth state not found")
 	ErrOAuthTokenInvalid           = errors.New("oauth token is invalid")
 	ErrOAuthProviderUnsupported    = errors.New("oauth provider not supported")
+	ErrOAuthAccountExists          = errors.New("account with this email already exists")
 
 	// Rate limiting errors
 	ErrRateLimitExceeded        = errors.New("rate limit exceeded")
@@ -96,6 +118,68 @@ func (e *ServiceError) Unwrap() error {
 	return e.Err
 }
 
+// CooldownError is returned when an operation is requested again before its cooldown has elapsed
+type CooldownError struct {
+	RemainingSeconds int
+	Err              error
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("%s: retry in %d seconds", e.Err, e.RemainingSeconds)
+}
+
+func (e *CooldownError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyAttemptsError is returned when an account or IP address must wait before authenticating again
+//
+// Err is ErrAccountLocked while the account is locked, and ErrRateLimitExceeded during a backoff.
+type TooManyAttemptsError struct {
+	RetryAfterSeconds int
+	Err               error
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("%s: retry in %d seconds", e.Err, e.RetryAfterSeconds)
+}
+
+func (e *TooManyAttemptsError) Unwrap() error {
+	return e.Err
+}
+
+// TwoFactorRequiredError is returned when the first login step succeeded and a second factor is required
+//
+// Challenge identifies the pending login and must be presented when completing the 2FA step.
+type TwoFactorRequiredError struct {
+	Challenge string
+	Err       error
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TwoFactorRequiredError) Unwrap() error {
+	return e.Err
+}
+
+// PasswordPolicyError is returned when a new password doesn't satisfy the password policy
+//
+// Reason is a human readable explanation of the violated rule.
+type PasswordPolicyError struct {
+	Reason string
+	Err    error
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err, e.Reason)
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return e.Err
+}
+
 type RepositoryError struct {
 	Operation string
 	Entity    string
@@ -128,6 +212,34 @@ func NewServiceError(operation, message string, err error) *ServiceError {
 	}
 }
 
+func NewCooldownError(remainingSeconds int, err error) *CooldownError {
+	return &CooldownError{
+		RemainingSeconds: remainingSeconds,
+		Err:              err,
+	}
+}
+
+func NewTooManyAttemptsError(retryAfterSeconds int, err error) *TooManyAttemptsError {
+	return &TooManyAttemptsError{
+		RetryAfterSeconds: retryAfterSeconds,
+		Err:               err,
+	}
+}
+
+func NewTwoFactorRequiredError(challenge string) *TwoFactorRequiredError {
+	return &TwoFactorRequiredError{
+		Challenge: challenge,
+		Err:       ErrTwoFactorRequired,
+	}
+}
+
+func NewPasswordPolicyError(reason string, err error) *PasswordPolicyError {
+	return &PasswordPolicyError{
+		Reason: reason,
+		Err:    err,
+	}
+}
+
 func NewRepositoryError(operation, entity, message string, err error) *RepositoryError {
 	return &RepositoryError{
 		Operation: operation,
@@ -140,18 +252,34 @@ func NewRepositoryError(operation, entity, message string, err error) *Repositor
 // Constants for error messages
 const (
 	MsgInvalidCredentials         = "invalid email or password"
+	MsgInvalidAuthProvider        = "this account does not use password authentication"
 	MsgAccountNotFound            = "account not found"
-	MsgAccountDisabled            = "account is disabled"
 	MsgTwoFactorRequired          = "two-factor authentication is required"
 	MsgInvalidTwoFactorCode       = "invalid two-factor authentication code"
-	MsgPasswordTooWeak            = "password must be at least 8 characters and contain uppercase, lowercase, digit, and special character"
+	MsgPasswordTooShort           = "password must be at least %d characters"
+	MsgPasswordTooWeak            = "password is too easy to guess, use a longer passphrase and avoid your name, email and common words"
+	MsgPasswordBreached           = "password has appeared in a data breach, choose a different one"
+	MsgPasswordPreviouslyUsed     = "password was used recently, choose a different one"
 	MsgPasswordIncorrect          = "current password is incorrect"
 	MsgEmailAlreadyExists         = "email is already registered"
 	MsgPhoneAlreadyExists         = "phone number is already registered"
 	MsgEmailNotVerified           = "email address must be verified before this operation"
+	MsgInvalidEmail               = "email address is invalid"
 	MsgInvalidEmailDomain         = "email domain is not allowed or is disposable"
 	MsgRateLimitExceeded          = "too many requests, please try again later"
-	MsgAccountLocked              = "account is temporarily locked due to too many failed attempts"
+	MsgAccountLocked              = "account is temporarily locked due to too many failed attempts, check your email to unlock it"
+	MsgTooManyAttempts            = "too many failed attempts, please wait before trying again"
+	MsgAccountUnlocked            = "account has been unlocked"
+	MsgInvalidUnlockToken         = "account unlock link is invalid or expired"
+	MsgSessionsRevoked            = "all sessions have been signed out"
+	MsgInvalidSessionRevocationToken = "session revocation link is invalid or expired"
+	MsgInvalidRefreshToken        = "refresh token is invalid or expired, please sign in again"
+	MsgRefreshTokenReused         = "refresh token was already used, the session has been signed out"
+	MsgSessionCookieRequired      = "bearer tokens can only be created for a session signed in with a cookie"
+	MsgPersonalAccessTokenNotFound = "personal access token not found"
+	MsgInvalidPersonalAccessTokenName   = "personal access token name must be between 1 and %d characters"
+	MsgInvalidPersonalAccessTokenScopes = "personal access token must be granted at least one scope"
+	MsgInvalidPersonalAccessTokenExpiry = "personal access token expiry must be in the future"
 	MsgEmailCooldown              = "please wait before requesting another verification email"
 	MsgCAPTCHARequired            = "captcha verification is required for this operation"
 	MsgCAPTCHAInvalid             = "captcha verification failed"
@@ -159,7 +287,20 @@ const (
 	MsgTwoFactorNotEnabled        = "two-factor authentication is not enabled for this account"
 	MsgOAuthTokenInvalid          = "oauth token is invalid or expired"
 	MsgOAuthProviderUnsupported   = "oauth provider is not supported"
+	MsgOAuthIdentityNotFound      = "social login identity not found"
+	MsgOAuthAccountExists         = "an account with this email already exists, sign in and link the provider from the account settings"
 	MsgInvalidWebAuthnResponse    = "webauthn response is invalid"
 	MsgChallengeNotFound          = "challenge not found or expired"
+	MsgWebAuthnCredentialCloned   = "the passkey's signature counter did not increase, it may have been cloned"
 	MsgInvalidToken               = "token is invalid"
+	MsgInvalidPasswordResetToken  = "password reset token is invalid or expired"
+	MsgTwoFactorChallengeNotFound = "two-factor authentication challenge not found or expired"
+	MsgPasswordResetRequested     = "if an account exists for this email, a password reset link has been sent"
+	MsgEmailLoginCodeRequested    = "if an account exists for this email, a login code has been sent"
+	MsgInvalidEmailLoginCode      = "login code is invalid or expired"
+	MsgSmsLoginCodeRequested      = "if an account uses this phone number, a login code has been sent"
+	MsgInvalidSmsLoginCode        = "login code is invalid or expired"
+	MsgInsufficientAuthProviders  = "at least one sign in method must remain on the account"
+	MsgSessionNotFound            = "session not found"
+	MsgWebAuthnCredentialNotFound = "passkey not found"
 )
\ No newline at end of file
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"server/internal/config"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+const defaultGitHubAPIURL = "https://api.github.com"
+
+// gitHubDefaultScopes are requested when the provider configures no scopes
+var gitHubDefaultScopes = []string{"read:user", "user:email"}
+
+type gitHubUser struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+type gitHubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+// GitHubProvider signs in with GitHub, which supports OAuth2 but not OpenID Connect
+//
+// The identity is read from the REST API, using the account's primary email address.
+type GitHubProvider struct {
+	name        string
+	oauthConfig *oauth2.Config
+	apiURL      string
+	httpClient  *http.Client
+}
+
+func NewGitHubProvider(name string, cfg config.OAuthProviderConfig, redirectURL string, httpClient *http.Client) *GitHubProvider {
+	endpoint := github.Endpoint
+	if cfg.AuthURL != "" {
+		endpoint.AuthURL = cfg.AuthURL
+	}
+	if cfg.TokenURL != "" {
+		endpoint.TokenURL = cfg.TokenURL
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = gitHubDefaultScopes
+	}
+
+	apiURL := cfg.APIURL
+	if apiURL == "" {
+		apiURL = defaultGitHubAPIURL
+	}
+
+	return &GitHubProvider{
+		name: name,
+		oauthConfig: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			Endpoint:     endpoint,
+			RedirectURL:  redirectURL,
+			Scopes:       scopes,
+		},
+		apiURL:     strings.TrimRight(apiURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+func (p *GitHubProvider) Name() string {
+	return p.name
+}
+
+func (p *GitHubProvider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	return p.oauthConfig.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+// Exchange redeems the authorization code and reads the user and its primary email from the API
+//
+// Returns:
+//   - *OAuthIdentity: The GitHub user's identity
+//   - error: ErrOAuthTokenInvalid if the code is rejected
+func (p *GitHubProvider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*OAuthIdentity, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	token, err := p.oauthConfig.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOAuthTokenInvalid, err)
+	}
+	client := p.oauthConfig.Client(ctx, token)
+
+	var user gitHubUser
+	if err := p.get(ctx, client, "/user", &user); err != nil {
+		return nil, err
+	}
+
+	var emails []gitHubEmail
+	if err := p.get(ctx, client, "/user/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	identity := &OAuthIdentity{
+		Subject: strconv.FormatInt(user.ID, 10),
+		Name:    user.Name,
+	}
+	if identity.Name == "" {
+		identity.Name = user.Login
+	}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+			break
+		}
+	}
+
+	return identity, nil
+}
+
+// get decodes the JSON response of a GitHub API request
+func (p *GitHubProvider) get(ctx context.Context, client *http.Client, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create github request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request github %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to request github %s: unexpected status %d", path, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode github %s: %w", path, err)
+	}
+	return nil
+}
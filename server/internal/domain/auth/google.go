@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"server/internal/config"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultGoogleJWKSURL = "https://www.googleapis.com/oauth2/v3/certs"
+
+	// idTokenLeeway is the clock skew tolerated when checking ID token timestamps
+	idTokenLeeway = time.Minute
+)
+
+// googleIssuers are the issuers Google uses for ID tokens
+var googleIssuers = []string{"accounts.google.com", "https://accounts.google.com"}
+
+type googleIDTokenClaims struct {
+	jwt.Claims
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// GoogleTokenVerifier verifies Google (One Tap) ID tokens against the keys published at a JWKS URL
+type GoogleTokenVerifier struct {
+	clientID string
+	keySet   *remoteKeySet
+}
+
+func NewGoogleTokenVerifier(cfg *config.Config, logger *zap.Logger) *GoogleTokenVerifier {
+	jwksURL := cfg.GoogleJWKSURL
+	if jwksURL == "" {
+		jwksURL = DefaultGoogleJWKSURL
+	}
+
+	return &GoogleTokenVerifier{
+		clientID: cfg.GoogleClientID,
+		keySet:   newRemoteKeySet(jwksURL, &http.Client{Timeout: 10 * time.Second}, logger),
+	}
+}
+
+// Verify checks the token's signature, audience, issuer and expiry and returns its identity
+//
+// Returns:
+//   - *OAuthIdentity: The verified identity
+//   - error: ErrOAuthProviderUnsupported if no client ID is configured, ErrOAuthTokenInvalid
+//     or ErrEmailNotVerified
+func (v *GoogleTokenVerifier) Verify(ctx context.Context, token string) (*OAuthIdentity, error) {
+	if v.clientID == "" {
+		return nil, ErrOAuthProviderUnsupported
+	}
+
+	parsed, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.RS256})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOAuthTokenInvalid, err)
+	}
+	if len(parsed.Headers) != 1 || parsed.Headers[0].KeyID == "" {
+		return nil, fmt.Errorf("%w: missing key ID", ErrOAuthTokenInvalid)
+	}
+
+	key, err := v.keySet.Key(ctx, parsed.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims googleIDTokenClaims
+	if err := parsed.Claims(key, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOAuthTokenInvalid, err)
+	}
+
+	if err := claims.ValidateWithLeeway(jwt.Expected{
+		AnyAudience: jwt.Audience{v.clientID},
+		Time:        time.Now(),
+	}, idTokenLeeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOAuthTokenInvalid, err)
+	}
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: missing expiry", ErrOAuthTokenInvalid)
+	}
+	if !slices.Contains(googleIssuers, claims.Issuer) {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrOAuthTokenInvalid, claims.Issuer)
+	}
+	if claims.Subject == "" || claims.Email == "" {
+		return nil, fmt.Errorf("%w: missing subject or email", ErrOAuthTokenInvalid)
+	}
+	if !claims.EmailVerified {
+		return nil, ErrEmailNotVerified
+	}
+
+	return &OAuthIdentity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: true,
+		Name:          claims.Name,
+	}, nil
+}
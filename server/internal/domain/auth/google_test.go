@@ -0,0 +1,236 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"server/internal/config"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testGoogleClientID = "test-client-id.apps.googleusercontent.com"
+
+// testSigningKey is an RSA key signing the ID tokens of the test JWKS and OIDC servers
+type testSigningKey struct {
+	keyID      string
+	privateKey *rsa.PrivateKey
+}
+
+func newTestSigningKey(t *testing.T, keyID string) *testSigningKey {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return &testSigningKey{keyID: keyID, privateKey: privateKey}
+}
+
+// sign issues an ID token with the given claims, signed by the key
+func (k *testSigningKey) sign(t *testing.T, claims any) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: k.privateKey, KeyID: k.keyID}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	require.NoError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+// testJWKSServer serves the public keys of the current key set and counts its requests
+type testJWKSServer struct {
+	*httptest.Server
+	keys     atomic.Pointer[[]*testSigningKey]
+	requests atomic.Int32
+}
+
+func newTestJWKSServer(t *testing.T, keys ...*testSigningKey) *testJWKSServer {
+	server := &testJWKSServer{}
+	server.setKeys(keys...)
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.requests.Add(1)
+
+		var keySet jose.JSONWebKeySet
+		for _, key := range *server.keys.Load() {
+			keySet.Keys = append(keySet.Keys, jose.JSONWebKey{
+				Key:       &key.privateKey.PublicKey,
+				KeyID:     key.keyID,
+				Algorithm: string(jose.RS256),
+				Use:       "sig",
+			})
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(keySet)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *testJWKSServer) setKeys(keys ...*testSigningKey) {
+	s.keys.Store(&keys)
+}
+
+func newTestGoogleTokenVerifier(jwksURL string) *GoogleTokenVerifier {
+	return NewGoogleTokenVerifier(&config.Config{
+		GoogleClientID: testGoogleClientID,
+		GoogleJWKSURL:  jwksURL,
+	}, zap.NewNop())
+}
+
+// validGoogleClaims returns the claims of a valid ID token for the test client
+func validGoogleClaims() map[string]any {
+	now := time.Now()
+	return map[string]any{
+		"iss":            "https://accounts.google.com",
+		"aud":            testGoogleClientID,
+		"sub":            "110169484474386276334",
+		"email":          "test@example.com",
+		"email_verified": true,
+		"name":           "Test User",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+	}
+}
+
+func TestGoogleTokenVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+	key := newTestSigningKey(t, "key-1")
+	server := newTestJWKSServer(t, key)
+
+	tests := []struct {
+		name          string
+		modifyClaims  func(map[string]any)
+		signingKey    *testSigningKey
+		expectedError error
+	}{
+		{
+			name:         "accepts a valid token",
+			modifyClaims: func(map[string]any) {},
+		},
+		{
+			name:         "accepts the issuer without scheme",
+			modifyClaims: func(claims map[string]any) { claims["iss"] = "accounts.google.com" },
+		},
+		{
+			name:          "rejects another audience",
+			modifyClaims:  func(claims map[string]any) { claims["aud"] = "other-client-id" },
+			expectedError: ErrOAuthTokenInvalid,
+		},
+		{
+			name:          "rejects another issuer",
+			modifyClaims:  func(claims map[string]any) { claims["iss"] = "https://evil.example.com" },
+			expectedError: ErrOAuthTokenInvalid,
+		},
+		{
+			name:          "rejects an expired token",
+			modifyClaims:  func(claims map[string]any) { claims["exp"] = time.Now().Add(-time.Hour).Unix() },
+			expectedError: ErrOAuthTokenInvalid,
+		},
+		{
+			name:          "rejects a token without expiry",
+			modifyClaims:  func(claims map[string]any) { delete(claims, "exp") },
+			expectedError: ErrOAuthTokenInvalid,
+		},
+		{
+			name:          "rejects an unverified email",
+			modifyClaims:  func(claims map[string]any) { claims["email_verified"] = false },
+			expectedError: ErrEmailNotVerified,
+		},
+		{
+			name:          "rejects a token signed by another key",
+			modifyClaims:  func(map[string]any) {},
+			signingKey:    newTestSigningKey(t, "key-1"),
+			expectedError: ErrOAuthTokenInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validGoogleClaims()
+			tt.modifyClaims(claims)
+			signingKey := key
+			if tt.signingKey != nil {
+				signingKey = tt.signingKey
+			}
+
+			verifier := newTestGoogleTokenVerifier(server.URL)
+			identity, err := verifier.Verify(ctx, signingKey.sign(t, claims))
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &OAuthIdentity{
+				Subject:       "110169484474386276334",
+				Email:         "test@example.com",
+				EmailVerified: true,
+				Name:          "Test User",
+			}, identity)
+		})
+	}
+
+	t.Run("rejects malformed tokens", func(t *testing.T) {
+		verifier := newTestGoogleTokenVerifier(server.URL)
+		_, err := verifier.Verify(ctx, "not-a-token")
+		assert.ErrorIs(t, err, ErrOAuthTokenInvalid)
+	})
+
+	t.Run("requires a client ID", func(t *testing.T) {
+		verifier := NewGoogleTokenVerifier(&config.Config{GoogleJWKSURL: server.URL}, zap.NewNop())
+		_, err := verifier.Verify(ctx, key.sign(t, validGoogleClaims()))
+		assert.ErrorIs(t, err, ErrOAuthProviderUnsupported)
+	})
+}
+
+func TestGoogleTokenVerifier_KeyCache(t *testing.T) {
+	ctx := context.Background()
+	oldKey := newTestSigningKey(t, "old")
+	newKey := newTestSigningKey(t, "new")
+	server := newTestJWKSServer(t, oldKey)
+	verifier := newTestGoogleTokenVerifier(server.URL)
+
+	_, err := verifier.Verify(ctx, oldKey.sign(t, validGoogleClaims()))
+	require.NoError(t, err)
+	_, err = verifier.Verify(ctx, oldKey.sign(t, validGoogleClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), server.requests.Load(), "cached keys should be reused")
+
+	// Unknown key IDs are rejected without refetching while the keys were fetched recently
+	server.setKeys(oldKey, newKey)
+	_, err = verifier.Verify(ctx, newKey.sign(t, validGoogleClaims()))
+	assert.ErrorIs(t, err, ErrOAuthTokenInvalid)
+	assert.Equal(t, int32(1), server.requests.Load())
+
+	// Once the refresh interval has passed, an unknown key ID triggers a refetch
+	verifier.keySet.fetchedAt = time.Now().Add(-jwksMinRefreshInterval)
+	_, err = verifier.Verify(ctx, newKey.sign(t, validGoogleClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), server.requests.Load())
+
+	// Expired keys are refetched
+	server.setKeys(newKey)
+	verifier.keySet.expiresAt = time.Now()
+	_, err = verifier.Verify(ctx, oldKey.sign(t, validGoogleClaims()))
+	assert.ErrorIs(t, err, ErrOAuthTokenInvalid)
+	assert.Equal(t, int32(3), server.requests.Load())
+}
+
+func TestCacheMaxAge(t *testing.T) {
+	assert.Equal(t, 19845*time.Second, cacheMaxAge("public, max-age=19845, must-revalidate, no-transform", time.Hour))
+	assert.Equal(t, time.Hour, cacheMaxAge("no-cache", time.Hour))
+	assert.Equal(t, time.Hour, cacheMaxAge("max-age=invalid", time.Hour))
+	assert.Equal(t, time.Hour, cacheMaxAge("", time.Hour))
+}
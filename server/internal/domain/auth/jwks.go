@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	// jwksDefaultMaxAge is how long keys are cached when the JWKS response has no max-age
+	jwksDefaultMaxAge = time.Hour
+	// jwksMinRefreshInterval limits refetches triggered by tokens with an unknown key ID
+	jwksMinRefreshInterval = time.Minute
+)
+
+// remoteKeySet is a JSON Web Key Set fetched from a URL
+//
+// Keys are cached for the max-age advertised by the response. Tokens signed with an
+// unknown key trigger a refetch, so that key rotations are picked up without waiting
+// for the cache to expire.
+type remoteKeySet struct {
+	url        string
+	httpClient *http.Client
+	logger     *zap.Logger
+
+	mu        sync.Mutex
+	keys      jose.JSONWebKeySet
+	expiresAt time.Time
+	fetchedAt time.Time
+}
+
+func newRemoteKeySet(url string, httpClient *http.Client, logger *zap.Logger) *remoteKeySet {
+	return &remoteKeySet{
+		url:        url,
+		httpClient: httpClient,
+		logger:     logger,
+	}
+}
+
+// Key returns the cached key with the given ID, refreshing the key set when needed
+//
+// Returns:
+//   - *jose.JSONWebKey: The signing key
+//   - error: ErrOAuthTokenInvalid if no key has the given ID
+func (s *remoteKeySet) Key(ctx context.Context, keyID string) (*jose.JSONWebKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if keys := s.keys.Key(keyID); len(keys) > 0 && now.Before(s.expiresAt) {
+		return &keys[0], nil
+	}
+
+	if now.Before(s.expiresAt) && now.Sub(s.fetchedAt) < jwksMinRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown key ID", ErrOAuthTokenInvalid)
+	}
+
+	if err := s.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	keys := s.keys.Key(keyID)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: unknown key ID", ErrOAuthTokenInvalid)
+	}
+	return &keys[0], nil
+}
+
+// refresh fetches the key set from its URL, the caller must hold the lock
+func (s *remoteKeySet) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&keys); err != nil {
+		return fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	now := time.Now()
+	s.keys = keys
+	s.fetchedAt = now
+	s.expiresAt = now.Add(cacheMaxAge(resp.Header.Get("Cache-Control"), jwksDefaultMaxAge))
+
+	s.logger.Debug("Fetched signing keys", zap.String("url", s.url), zap.Int("key_count", len(keys.Keys)))
+	return nil
+}
+
+// cacheMaxAge extracts the max-age directive of a Cache-Control header
+func cacheMaxAge(cacheControl string, fallback time.Duration) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		value, found := strings.CutPrefix(strings.TrimSpace(directive), "max-age=")
+		if !found {
+			continue
+		}
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return fallback
+}
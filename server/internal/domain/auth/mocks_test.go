@@ -0,0 +1,868 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"server/internal/domain/account"
+	"server/internal/infrastructure/db"
+	"server/internal/infrastructure/geoip"
+	"server/internal/infrastructure/tokenhash"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/stretchr/testify/mock"
+)
+
+// testTokenHasher hashes tokens like the repositories, legacy MD5 hashes are accepted
+var testTokenHasher = func() tokenhash.TokenHasher {
+	hasher, err := tokenhash.NewTokenHasher("test-pepper", true)
+	if err != nil {
+		panic(err)
+	}
+	return hasher
+}()
+
+// MockAccountRepo is a mock implementation of account.AccountRepo for testing
+type MockAccountRepo struct {
+	mock.Mock
+}
+
+func (m *MockAccountRepo) Create(ctx context.Context, email string, fullName string, authProviders []string, password *string, accountID *int64, analyticsPreference string, phoneNumber *string) (*account.Account, error) {
+	args := m.Called(ctx, email, fullName, authProviders, password, accountID, analyticsPreference, phoneNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) Get(ctx context.Context, accountID int64) (*account.Account, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) GetByEmail(ctx context.Context, email string) (*account.Account, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) GetByPhoneNumber(ctx context.Context, phoneNumber string) (*account.Account, error) {
+	args := m.Called(ctx, phoneNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) Update(ctx context.Context, acc *account.Account, fullName *string, avatarURL *string, phoneNumber *string, termsAndPolicy *account.TermsAndPolicy, analyticsPreference *account.AnalyticsPreference) (*account.Account, error) {
+	args := m.Called(ctx, acc, fullName, avatarURL, phoneNumber, termsAndPolicy, analyticsPreference)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) UpdateAuthProviders(ctx context.Context, acc *account.Account, authProviders []string) (*account.Account, error) {
+	args := m.Called(ctx, acc, authProviders)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) DeleteAvatar(ctx context.Context, acc *account.Account) (*account.Account, error) {
+	args := m.Called(ctx, acc)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) DeletePhoneNumber(ctx context.Context, acc *account.Account) (*account.Account, error) {
+	args := m.Called(ctx, acc)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) SetTwoFactorSecret(ctx context.Context, acc *account.Account, totpSecret string) (*account.Account, error) {
+	args := m.Called(ctx, acc, totpSecret)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) DeleteTwoFactorSecret(ctx context.Context, acc *account.Account) (*account.Account, error) {
+	args := m.Called(ctx, acc)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+// UseTwoFactorTimeStep mirrors the conditional update, time steps not later than the last one are not used
+func (m *MockAccountRepo) UseTwoFactorTimeStep(ctx context.Context, acc *account.Account, timeStep int64) (bool, error) {
+	args := m.Called(ctx, acc, timeStep)
+	if args.Error(0) != nil {
+		return false, args.Error(0)
+	}
+	if timeStep <= acc.TwoFactorLastTimeStep {
+		return false, nil
+	}
+	acc.TwoFactorLastTimeStep = timeStep
+	return true, nil
+}
+
+func (m *MockAccountRepo) UpdatePassword(ctx context.Context, acc *account.Account, password string) (*account.Account, error) {
+	args := m.Called(ctx, acc, password)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) RehashPassword(ctx context.Context, acc *account.Account, password string) (*account.Account, error) {
+	args := m.Called(ctx, acc, password)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) DeletePassword(ctx context.Context, acc *account.Account) (*account.Account, error) {
+	args := m.Called(ctx, acc)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) Delete(ctx context.Context, acc *account.Account) error {
+	args := m.Called(ctx, acc)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepo) HashPassword(password string) (string, error) {
+	args := m.Called(password)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAccountRepo) VerifyPassword(password, hash string) (bool, error) {
+	args := m.Called(password, hash)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAccountRepo) Import(ctx context.Context, email string, fullName string, passwordHash *string, phoneNumber *string) (*account.Account, error) {
+	args := m.Called(ctx, email, fullName, passwordHash, phoneNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) PasswordNeedsRehash(hash string) bool {
+	args := m.Called(hash)
+	return args.Bool(0)
+}
+
+// MockSessionRepo is a mock implementation of SessionRepo for testing
+type MockSessionRepo struct {
+	mock.Mock
+}
+
+func (m *MockSessionRepo) Create(ctx context.Context, accountId int64, userAgent string, ipAddress string, device Device, location *geoip.Location, rememberMe bool, expiresAt time.Time) (string, error) {
+	args := m.Called(ctx, accountId, userAgent, ipAddress, device, location, rememberMe, expiresAt)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSessionRepo) Get(ctx context.Context, token string, fetchAccount bool) (*Session, error) {
+	args := m.Called(ctx, token, fetchAccount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Session), args.Error(1)
+}
+
+func (m *MockSessionRepo) GetBySessionAccountId(ctx context.Context, sessionId int64, accountId int64, exceptSessionToken string) (*Session, error) {
+	args := m.Called(ctx, sessionId, accountId, exceptSessionToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Session), args.Error(1)
+}
+
+func (m *MockSessionRepo) GetAllList(ctx context.Context, accountId int64, exceptSessionToken string) ([]*Session, error) {
+	args := m.Called(ctx, accountId, exceptSessionToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Session), args.Error(1)
+}
+
+func (m *MockSessionRepo) GetAllByAccountId(ctx context.Context, accountId int64, exceptSessionToken string, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*Session, int64], error) {
+	args := m.Called(ctx, accountId, exceptSessionToken, first, last, before, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*db.PaginatedResult[*Session, int64]), args.Error(1)
+}
+
+func (m *MockSessionRepo) DeleteByToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepo) Delete(ctx context.Context, session *Session) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepo) DeleteMany(ctx context.Context, sessionIds []int64) error {
+	args := m.Called(ctx, sessionIds)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepo) DeleteAll(ctx context.Context, accountId int64) error {
+	args := m.Called(ctx, accountId)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepo) UpdateSudoModeExpiresAt(ctx context.Context, session *Session, sudoModeExpiresAt *time.Time) error {
+	args := m.Called(ctx, session, sudoModeExpiresAt)
+	if args.Error(0) == nil {
+		session.SudoModeExpiresAt = sudoModeExpiresAt
+	}
+	return args.Error(0)
+}
+
+func (m *MockSessionRepo) RevokeAllSudoMode(ctx context.Context, accountId int64) error {
+	args := m.Called(ctx, accountId)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepo) Touch(ctx context.Context, session *Session, lastActiveAt time.Time, expiresAt time.Time) error {
+	args := m.Called(ctx, session, lastActiveAt, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepo) GetById(ctx context.Context, sessionId int64) (*Session, error) {
+	args := m.Called(ctx, sessionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Session), args.Error(1)
+}
+
+func (m *MockSessionRepo) GenerateSessionToken() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSessionRepo) HashSessionToken(token string) string {
+	args := m.Called(token)
+	return args.String(0)
+}
+
+// MockPasswordResetTokenRepo is a mock implementation of PasswordResetTokenRepo for testing
+type MockPasswordResetTokenRepo struct {
+	mock.Mock
+}
+
+func (m *MockPasswordResetTokenRepo) Create(ctx context.Context, accountId int64) (string, error) {
+	args := m.Called(ctx, accountId)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPasswordResetTokenRepo) Get(ctx context.Context, token string, email string) (*PasswordResetToken, error) {
+	args := m.Called(ctx, token, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PasswordResetToken), args.Error(1)
+}
+
+func (m *MockPasswordResetTokenRepo) GetByAccount(ctx context.Context, accountId int64) (*PasswordResetToken, error) {
+	args := m.Called(ctx, accountId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PasswordResetToken), args.Error(1)
+}
+
+func (m *MockPasswordResetTokenRepo) Delete(ctx context.Context, token *PasswordResetToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockPasswordResetTokenRepo) GeneratePasswordResetToken() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPasswordResetTokenRepo) HashPasswordResetToken(token string) string {
+	args := m.Called(token)
+	return args.String(0)
+}
+
+// MockSessionRevocationTokenRepo is a mock implementation of SessionRevocationTokenRepo for testing
+type MockSessionRevocationTokenRepo struct {
+	mock.Mock
+}
+
+func (m *MockSessionRevocationTokenRepo) Create(ctx context.Context, accountId int64, expiresAt time.Time) (string, error) {
+	args := m.Called(ctx, accountId, expiresAt)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSessionRevocationTokenRepo) Get(ctx context.Context, token string) (*SessionRevocationToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionRevocationToken), args.Error(1)
+}
+
+func (m *MockSessionRevocationTokenRepo) DeleteAll(ctx context.Context, accountId int64) error {
+	args := m.Called(ctx, accountId)
+	return args.Error(0)
+}
+
+func (m *MockSessionRevocationTokenRepo) GenerateSessionRevocationToken() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSessionRevocationTokenRepo) HashSessionRevocationToken(token string) string {
+	args := m.Called(token)
+	return args.String(0)
+}
+
+// MockBearerTokenRepo is a mock implementation of BearerTokenRepo for testing
+type MockBearerTokenRepo struct {
+	mock.Mock
+}
+
+func (m *MockBearerTokenRepo) Create(ctx context.Context, sessionId int64, accessTokenExpiresAt time.Time, refreshTokenExpiresAt time.Time) (string, string, error) {
+	args := m.Called(ctx, sessionId, accessTokenExpiresAt, refreshTokenExpiresAt)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *MockBearerTokenRepo) GetAccessToken(ctx context.Context, token string) (*AccessToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AccessToken), args.Error(1)
+}
+
+func (m *MockBearerTokenRepo) GetRefreshToken(ctx context.Context, token string) (*RefreshToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RefreshToken), args.Error(1)
+}
+
+func (m *MockBearerTokenRepo) MarkRefreshTokenUsed(ctx context.Context, refreshToken *RefreshToken) (bool, error) {
+	args := m.Called(ctx, refreshToken)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBearerTokenRepo) DeleteAllBySession(ctx context.Context, sessionId int64) error {
+	args := m.Called(ctx, sessionId)
+	return args.Error(0)
+}
+
+func (m *MockBearerTokenRepo) GenerateBearerToken() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBearerTokenRepo) HashBearerToken(token string) string {
+	args := m.Called(token)
+	return args.String(0)
+}
+
+// MockPersonalAccessTokenRepo is a mock implementation of PersonalAccessTokenRepo for testing
+type MockPersonalAccessTokenRepo struct {
+	mock.Mock
+}
+
+func (m *MockPersonalAccessTokenRepo) Create(ctx context.Context, accountId int64, name string, scopes []string, expiresAt *time.Time) (*PersonalAccessToken, string, error) {
+	args := m.Called(ctx, accountId, name, scopes, expiresAt)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).(*PersonalAccessToken), args.String(1), args.Error(2)
+}
+
+func (m *MockPersonalAccessTokenRepo) Get(ctx context.Context, token string) (*PersonalAccessToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepo) GetByAccountTokenId(ctx context.Context, accountId int64, personalAccessTokenId int64) (*PersonalAccessToken, error) {
+	args := m.Called(ctx, accountId, personalAccessTokenId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepo) GetAllByAccountId(ctx context.Context, accountId int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*PersonalAccessToken, int64], error) {
+	args := m.Called(ctx, accountId, first, last, before, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*db.PaginatedResult[*PersonalAccessToken, int64]), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepo) UpdateLastUsedAt(ctx context.Context, personalAccessToken *PersonalAccessToken, lastUsedAt time.Time) error {
+	args := m.Called(ctx, personalAccessToken, lastUsedAt)
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenRepo) Delete(ctx context.Context, personalAccessToken *PersonalAccessToken) error {
+	args := m.Called(ctx, personalAccessToken)
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenRepo) DeleteAll(ctx context.Context, accountId int64) error {
+	args := m.Called(ctx, accountId)
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenRepo) GeneratePersonalAccessToken() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepo) HashPersonalAccessToken(token string) string {
+	args := m.Called(token)
+	return args.String(0)
+}
+
+// MockEmailLoginCodeRepo is a mock implementation of EmailLoginCodeRepo for testing
+//
+// Codes are hashed like the real repository so that tests can store the hash of a known code.
+type MockEmailLoginCodeRepo struct {
+	mock.Mock
+}
+
+func (m *MockEmailLoginCodeRepo) Create(ctx context.Context, accountId int64) (string, string, error) {
+	args := m.Called(ctx, accountId)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *MockEmailLoginCodeRepo) GetByAccount(ctx context.Context, accountId int64) (*EmailLoginCode, error) {
+	args := m.Called(ctx, accountId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*EmailLoginCode), args.Error(1)
+}
+
+// IncrementAttempts mirrors the conditional update, codes without attempts left are not found
+func (m *MockEmailLoginCodeRepo) IncrementAttempts(ctx context.Context, loginCode *EmailLoginCode, maxAttempts int) error {
+	args := m.Called(ctx, loginCode, maxAttempts)
+	if args.Error(0) != nil {
+		return args.Error(0)
+	}
+	if loginCode.Attempts >= maxAttempts {
+		return ErrEmailLoginCodeNotFound
+	}
+	loginCode.Attempts++
+	return nil
+}
+
+func (m *MockEmailLoginCodeRepo) Delete(ctx context.Context, loginCode *EmailLoginCode) error {
+	args := m.Called(ctx, loginCode)
+	return args.Error(0)
+}
+
+func (m *MockEmailLoginCodeRepo) GenerateLoginCode() (string, error) {
+	return generateNumericCode(EmailLoginCodeLength)
+}
+
+func (m *MockEmailLoginCodeRepo) GenerateLoginToken() (string, error) {
+	return generateSecureToken(32)
+}
+
+func (m *MockEmailLoginCodeRepo) HashLoginCode(code string) string {
+	return testTokenHasher.Hash(code)
+}
+
+func (m *MockEmailLoginCodeRepo) VerifyLoginCode(code string, hash string) bool {
+	return testTokenHasher.Verify(code, hash)
+}
+
+// MockSmsLoginCodeRepo is a mock implementation of SmsLoginCodeRepo for testing
+//
+// Codes are hashed like the real repository so that tests can store the hash of a known code.
+type MockSmsLoginCodeRepo struct {
+	mock.Mock
+}
+
+func (m *MockSmsLoginCodeRepo) Create(ctx context.Context, accountId int64, phoneNumber string) (string, error) {
+	args := m.Called(ctx, accountId, phoneNumber)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSmsLoginCodeRepo) GetByPhoneNumber(ctx context.Context, phoneNumber string) (*SmsLoginCode, error) {
+	args := m.Called(ctx, phoneNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SmsLoginCode), args.Error(1)
+}
+
+// IncrementAttempts mirrors the conditional update, codes without attempts left are not found
+func (m *MockSmsLoginCodeRepo) IncrementAttempts(ctx context.Context, loginCode *SmsLoginCode, maxAttempts int) error {
+	args := m.Called(ctx, loginCode, maxAttempts)
+	if args.Error(0) != nil {
+		return args.Error(0)
+	}
+	if loginCode.Attempts >= maxAttempts {
+		return ErrSmsLoginCodeNotFound
+	}
+	loginCode.Attempts++
+	return nil
+}
+
+func (m *MockSmsLoginCodeRepo) Delete(ctx context.Context, loginCode *SmsLoginCode) error {
+	args := m.Called(ctx, loginCode)
+	return args.Error(0)
+}
+
+func (m *MockSmsLoginCodeRepo) GenerateLoginCode() (string, error) {
+	return generateNumericCode(SmsLoginCodeLength)
+}
+
+func (m *MockSmsLoginCodeRepo) HashLoginCode(code string) string {
+	return testTokenHasher.Hash(code)
+}
+
+func (m *MockSmsLoginCodeRepo) VerifyLoginCode(code string, hash string) bool {
+	return testTokenHasher.Verify(code, hash)
+}
+
+// MockMessageSender is a mock implementation of account.MessageSender for testing
+//
+// Phone numbers are validated like the dummy sender so that tests only need to mock SendSMS.
+type MockMessageSender struct {
+	mock.Mock
+}
+
+func (m *MockMessageSender) SendSMS(ctx context.Context, phoneNumber, message string) error {
+	args := m.Called(ctx, phoneNumber, message)
+	return args.Error(0)
+}
+
+func (m *MockMessageSender) ValidatePhoneNumber(phoneNumber string) error {
+	parsed, err := phonenumbers.Parse(phoneNumber, "")
+	if err != nil {
+		return err
+	}
+	if !phonenumbers.IsValidNumber(parsed) {
+		return account.ErrInvalidPhoneNumber
+	}
+	return nil
+}
+
+// MockPasswordHistoryRepo is a mock implementation of account.PasswordHistoryRepo for testing
+type MockPasswordHistoryRepo struct {
+	mock.Mock
+}
+
+func (m *MockPasswordHistoryRepo) Create(ctx context.Context, accountID int64, passwordHash string) (*account.PasswordHistoryEntry, error) {
+	args := m.Called(ctx, accountID, passwordHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.PasswordHistoryEntry), args.Error(1)
+}
+
+func (m *MockPasswordHistoryRepo) GetRecent(ctx context.Context, accountID int64, limit int, since time.Time) ([]*account.PasswordHistoryEntry, error) {
+	args := m.Called(ctx, accountID, limit, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*account.PasswordHistoryEntry), args.Error(1)
+}
+
+func (m *MockPasswordHistoryRepo) Prune(ctx context.Context, accountID int64, keep int, before time.Time) error {
+	args := m.Called(ctx, accountID, keep, before)
+	return args.Error(0)
+}
+
+// memoryAuthAttemptRepo is an in-memory implementation of AuthAttemptRepo for testing
+type memoryAuthAttemptRepo struct {
+	attempts map[attemptKey]*AuthAttempt
+}
+
+func newMemoryAuthAttemptRepo() *memoryAuthAttemptRepo {
+	return &memoryAuthAttemptRepo{attempts: make(map[attemptKey]*AuthAttempt)}
+}
+
+func (r *memoryAuthAttemptRepo) Get(ctx context.Context, scope string, key string) (*AuthAttempt, error) {
+	attempt, ok := r.attempts[attemptKey{scope: scope, key: key}]
+	if !ok {
+		return nil, ErrAuthAttemptNotFound
+	}
+	return attempt, nil
+}
+
+func (r *memoryAuthAttemptRepo) RecordFailure(ctx context.Context, scope string, key string, window time.Duration) (*AuthAttempt, error) {
+	now := time.Now()
+	attempt, ok := r.attempts[attemptKey{scope: scope, key: key}]
+	if !ok {
+		attempt = &AuthAttempt{Scope: scope, Key: key}
+		r.attempts[attemptKey{scope: scope, key: key}] = attempt
+	}
+	if attempt.LastFailedAt.After(now.Add(-window)) {
+		attempt.Failures++
+	} else {
+		attempt.Failures = 1
+	}
+	attempt.LastFailedAt = now
+	return attempt, nil
+}
+
+func (r *memoryAuthAttemptRepo) Block(ctx context.Context, attempt *AuthAttempt, blockedUntil time.Time) error {
+	attempt.BlockedUntil = &blockedUntil
+	attempt.UnlockTokenHash = nil
+	return nil
+}
+
+func (r *memoryAuthAttemptRepo) Lock(ctx context.Context, attempt *AuthAttempt, lockedUntil time.Time) (string, error) {
+	token, err := generateSecureToken(32)
+	if err != nil {
+		return "", err
+	}
+	tokenHash := testTokenHasher.Hash(token)
+	attempt.Failures = 0
+	attempt.BlockedUntil = &lockedUntil
+	attempt.UnlockTokenHash = &tokenHash
+	return token, nil
+}
+
+func (r *memoryAuthAttemptRepo) Delete(ctx context.Context, scope string, key string) error {
+	delete(r.attempts, attemptKey{scope: scope, key: key})
+	return nil
+}
+
+func (r *memoryAuthAttemptRepo) VerifyUnlockToken(token string, hash string) bool {
+	return testTokenHasher.Verify(token, hash)
+}
+
+// MockTemporaryTwoFactorChallengeRepo is a mock implementation of TemporaryTwoFactorChallengeRepo for testing
+type MockTemporaryTwoFactorChallengeRepo struct {
+	mock.Mock
+}
+
+func (m *MockTemporaryTwoFactorChallengeRepo) Create(ctx context.Context, accountId int64, passwordResetTokenId int64) (string, *TemporaryTwoFactorChallenge, error) {
+	args := m.Called(ctx, accountId, passwordResetTokenId)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*TemporaryTwoFactorChallenge), args.Error(2)
+}
+
+func (m *MockTemporaryTwoFactorChallengeRepo) Get(ctx context.Context, challenge string, passwordResetTokenId int64, fetchAccount bool) (*TemporaryTwoFactorChallenge, error) {
+	args := m.Called(ctx, challenge, passwordResetTokenId, fetchAccount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TemporaryTwoFactorChallenge), args.Error(1)
+}
+
+func (m *MockTemporaryTwoFactorChallengeRepo) Delete(ctx context.Context, temporaryTwoFactorChallenge *TemporaryTwoFactorChallenge) error {
+	args := m.Called(ctx, temporaryTwoFactorChallenge)
+	return args.Error(0)
+}
+
+func (m *MockTemporaryTwoFactorChallengeRepo) GenerateChallenge() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockTemporaryTwoFactorChallengeRepo) HashChallenge(challenge string) string {
+	args := m.Called(challenge)
+	return args.String(0)
+}
+
+// MockTwoFactorAuthenticationChallengeRepo is a mock implementation of TwoFactorAuthenticationChallengeRepo for testing
+type MockTwoFactorAuthenticationChallengeRepo struct {
+	mock.Mock
+}
+
+func (m *MockTwoFactorAuthenticationChallengeRepo) Create(ctx context.Context, accountId int64, totpSecret string, rememberMe bool) (string, *TwoFactorAuthenticationChallenge, error) {
+	args := m.Called(ctx, accountId, totpSecret, rememberMe)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*TwoFactorAuthenticationChallenge), args.Error(2)
+}
+
+func (m *MockTwoFactorAuthenticationChallengeRepo) Get(ctx context.Context, challenge string, fetchAccount bool) (*TwoFactorAuthenticationChallenge, error) {
+	args := m.Called(ctx, challenge, fetchAccount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TwoFactorAuthenticationChallenge), args.Error(1)
+}
+
+func (m *MockTwoFactorAuthenticationChallengeRepo) Delete(ctx context.Context, challenge *TwoFactorAuthenticationChallenge) error {
+	args := m.Called(ctx, challenge)
+	return args.Error(0)
+}
+
+func (m *MockTwoFactorAuthenticationChallengeRepo) GenerateChallenge() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockTwoFactorAuthenticationChallengeRepo) HashChallenge(challenge string) string {
+	args := m.Called(challenge)
+	return args.String(0)
+}
+
+func (m *MockTwoFactorAuthenticationChallengeRepo) GenerateTwoFactorSecret() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+// MockOAuthCredentialRepo is a mock implementation of OAuthCredentialRepo for testing
+type MockOAuthCredentialRepo struct {
+	mock.Mock
+}
+
+func (m *MockOAuthCredentialRepo) Create(ctx context.Context, accountId int64, provider string, providerUserId string) (*OAuthCredential, error) {
+	args := m.Called(ctx, accountId, provider, providerUserId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*OAuthCredential), args.Error(1)
+}
+
+func (m *MockOAuthCredentialRepo) GetByProviderUser(ctx context.Context, provider string, providerUserId string, fetchAccount bool) (*OAuthCredential, error) {
+	args := m.Called(ctx, provider, providerUserId, fetchAccount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*OAuthCredential), args.Error(1)
+}
+
+func (m *MockOAuthCredentialRepo) GetByAccountProvider(ctx context.Context, accountId int64, provider string, fetchAccount bool) (*OAuthCredential, error) {
+	args := m.Called(ctx, accountId, provider, fetchAccount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*OAuthCredential), args.Error(1)
+}
+
+func (m *MockOAuthCredentialRepo) GetByAccountCredentialId(ctx context.Context, accountId int64, oauthCredentialId int64) (*OAuthCredential, error) {
+	args := m.Called(ctx, accountId, oauthCredentialId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*OAuthCredential), args.Error(1)
+}
+
+func (m *MockOAuthCredentialRepo) GetAllByAccountList(ctx context.Context, accountId int64) ([]*OAuthCredential, error) {
+	args := m.Called(ctx, accountId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*OAuthCredential), args.Error(1)
+}
+
+func (m *MockOAuthCredentialRepo) GetAllByAccountId(ctx context.Context, accountId int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*OAuthCredential, int64], error) {
+	args := m.Called(ctx, accountId, first, last, before, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*db.PaginatedResult[*OAuthCredential, int64]), args.Error(1)
+}
+
+func (m *MockOAuthCredentialRepo) Delete(ctx context.Context, credential *OAuthCredential) error {
+	args := m.Called(ctx, credential)
+	return args.Error(0)
+}
+
+// MockRecoveryCodeRepo is a mock implementation of RecoveryCodeRepo for testing
+type MockRecoveryCodeRepo struct {
+	mock.Mock
+}
+
+func (m *MockRecoveryCodeRepo) Create(ctx context.Context, accountId int64, code string) (string, error) {
+	args := m.Called(ctx, accountId, code)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockRecoveryCodeRepo) CreateMany(ctx context.Context, accountId int64, codeCount int) ([]string, error) {
+	args := m.Called(ctx, accountId, codeCount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRecoveryCodeRepo) DeleteAll(ctx context.Context, accountId int64) error {
+	args := m.Called(ctx, accountId)
+	return args.Error(0)
+}
+
+func (m *MockRecoveryCodeRepo) Delete(ctx context.Context, recoveryCode *RecoveryCode) (bool, error) {
+	args := m.Called(ctx, recoveryCode)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRecoveryCodeRepo) Get(ctx context.Context, accountId int64, code string) (*RecoveryCode, error) {
+	args := m.Called(ctx, accountId, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RecoveryCode), args.Error(1)
+}
+
+func (m *MockRecoveryCodeRepo) GetAllByAccountId(ctx context.Context, accountId int64) ([]*RecoveryCode, error) {
+	args := m.Called(ctx, accountId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*RecoveryCode), args.Error(1)
+}
+
+func (m *MockRecoveryCodeRepo) GenerateRecoveryCode() (string, error) {
+	args := m.Called()
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockRecoveryCodeRepo) HashRecoveryCode(code string) string {
+	args := m.Called(code)
+	return args.String(0)
+}
+
+// MockLocator is a mock implementation of geoip.Locator for testing
+type MockLocator struct {
+	mock.Mock
+}
+
+func (m *MockLocator) Locate(ipAddress string) (*geoip.Location, error) {
+	args := m.Called(ipAddress)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*geoip.Location), args.Error(1)
+}
@@ -1,6 +1,9 @@
 package auth
 
 import (
+	"slices"
+	"time"
+
 	"server/internal/domain/account"
 	"server/internal/domain/core"
 
@@ -17,10 +20,34 @@ type Session struct {
 	ExpiresAt int64  `bun:"expires_at,notnull"`
 	AccountId int64  `bun:"account_id,notnull"`
 
+	// SudoModeExpiresAt is when the session's sudo mode grant expires, nil if none was granted
+	SudoModeExpiresAt *time.Time `bun:"sudo_mode_expires_at"`
+
+	// LastActiveAt is when the session was last used, recorded at most once per activity update interval
+	LastActiveAt time.Time `bun:"last_active_at,nullzero,notnull,default:current_timestamp"`
+
+	// RememberMe selects the longer "remember me" session policy
+	RememberMe bool `bun:"remember_me,notnull,default:false"`
+
+	// Device parsed from the user agent, see ParseUserAgent
+	Browser    string `bun:"browser,nullzero"`
+	OS         string `bun:"os,nullzero"`
+	DeviceType string `bun:"device_type,notnull"`
+
+	// Approximate location resolved from the IP address, empty if unknown
+	City        string `bun:"city,nullzero"`
+	Country     string `bun:"country,nullzero"`
+	CountryCode string `bun:"country_code,nullzero"`
+
 	// account relationship
 	Account *account.Account `bun:"rel:belongs-to,join:account_id=id"`
 }
 
+// HasSudoMode reports whether the session holds an unexpired sudo mode grant
+func (s *Session) HasSudoMode() bool {
+	return s.SudoModeExpiresAt != nil && time.Now().Before(*s.SudoModeExpiresAt)
+}
+
 // GetID returns the session ID for cursor pagination
 func (s *Session) GetID() int64 {
 	return s.ID
@@ -38,6 +65,159 @@ type PasswordResetToken struct {
 	Account *account.Account `bun:"rel:belongs-to,join:account_id=id"`
 }
 
+// SessionRevocationToken is the token of a "this wasn't me" link mailed with a security notification
+//
+// Following the link signs the account out everywhere and clears its password.
+type SessionRevocationToken struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:session_revocation_tokens,alias:srt"`
+
+	TokenHash string `bun:"token_hash,unique,notnull"`
+	ExpiresAt int64  `bun:"expires_at,notnull"`
+	AccountId int64  `bun:"account_id,notnull"`
+
+	// account relationship
+	Account *account.Account `bun:"rel:belongs-to,join:account_id=id"`
+}
+
+// AccessToken is a short lived bearer token of a native client's session
+//
+// The session is the token family: its access and refresh tokens live and die with it.
+type AccessToken struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:access_tokens,alias:at"`
+
+	TokenHash string `bun:"token_hash,unique,notnull"`
+	ExpiresAt int64  `bun:"expires_at,notnull"`
+	SessionId int64  `bun:"session_id,notnull"`
+
+	// session relationship
+	Session *Session `bun:"rel:belongs-to,join:session_id=id"`
+}
+
+// RefreshToken renews the bearer tokens of a native client's session
+//
+// Refresh tokens are rotated: each is used once, and a used token presented again
+// revokes its session, the whole token family.
+type RefreshToken struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:refresh_tokens,alias:rt"`
+
+	TokenHash string `bun:"token_hash,unique,notnull"`
+	ExpiresAt int64  `bun:"expires_at,notnull"`
+	SessionId int64  `bun:"session_id,notnull"`
+
+	// UsedAt is when the token was exchanged for new tokens, nil while unused
+	UsedAt *time.Time `bun:"used_at"`
+
+	// session relationship
+	Session *Session `bun:"rel:belongs-to,join:session_id=id"`
+}
+
+// PersonalAccessTokenPrefix starts every personal access token, so that secret scanners can recognise leaked ones
+const PersonalAccessTokenPrefix = "hjpat_"
+
+// Personal access token scopes, each granting the fields marked with the matching @requiresScope
+const (
+	ScopeReadAccount  = "read_account"
+	ScopeWriteAccount = "write_account"
+)
+
+// PersonalAccessTokenScopes lists the scopes a personal access token can be granted
+var PersonalAccessTokenScopes = []string{ScopeReadAccount, ScopeWriteAccount}
+
+// PersonalAccessToken lets scripts and CI jobs call the API as the account, within its scopes
+type PersonalAccessToken struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:personal_access_tokens,alias:pat"`
+
+	Name      string   `bun:"name,notnull"`
+	TokenHash string   `bun:"token_hash,unique,notnull"`
+	Scopes    []string `bun:"scopes,array"`
+	AccountId int64    `bun:"account_id,notnull"`
+
+	// ExpiresAt is when the token expires, nil if it never does
+	ExpiresAt *time.Time `bun:"expires_at"`
+
+	// LastUsedAt is when the token was last used, recorded at most once per activity update interval
+	LastUsedAt *time.Time `bun:"last_used_at"`
+
+	// account relationship
+	Account *account.Account `bun:"rel:belongs-to,join:account_id=id"`
+}
+
+// HasScope reports whether the token was granted the scope
+func (t *PersonalAccessToken) HasScope(scope string) bool {
+	return slices.Contains(t.Scopes, scope)
+}
+
+// GetID returns the personal access token ID for cursor pagination
+func (t *PersonalAccessToken) GetID() int64 {
+	return t.ID
+}
+
+// EmailLoginCode is a one-time login code sent by email
+//
+// The code can be entered manually, or passed through the login link by its token.
+// Each account has at most one pending code.
+type EmailLoginCode struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:email_login_codes,alias:elc"`
+
+	CodeHash  string `bun:"code_hash,notnull"`
+	TokenHash string `bun:"token_hash,unique,notnull"`
+	Attempts  int    `bun:"attempts,notnull,default:0"`
+	ExpiresAt int64  `bun:"expires_at,notnull"`
+	AccountId int64  `bun:"account_id,unique,notnull"`
+
+	// account relationship
+	Account *account.Account `bun:"rel:belongs-to,join:account_id=id"`
+}
+
+// SmsLoginCode is a one-time login code sent by SMS to an account's verified phone number
+//
+// Each phone number has at most one pending code.
+type SmsLoginCode struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:sms_login_codes,alias:slc"`
+
+	PhoneNumber string `bun:"phone_number,unique,notnull"`
+	CodeHash    string `bun:"code_hash,notnull"`
+	Attempts    int    `bun:"attempts,notnull,default:0"`
+	ExpiresAt   int64  `bun:"expires_at,notnull"`
+	AccountId   int64  `bun:"account_id,notnull"`
+
+	// account relationship
+	Account *account.Account `bun:"rel:belongs-to,join:account_id=id"`
+}
+
+// AuthAttempt counts the recent failed authentication attempts made against an account or from an IP address
+//
+// Accounts are keyed by their normalized email address, so that unknown addresses are
+// throttled like registered ones. BlockedUntil holds back the next attempt, and
+// UnlockTokenHash is set while the account is locked.
+type AuthAttempt struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:auth_attempts,alias:aa"`
+
+	Scope           string     `bun:"scope,notnull,unique:auth_attempts_scope_key"`
+	Key             string     `bun:"key,notnull,unique:auth_attempts_scope_key"`
+	Failures        int        `bun:"failures,notnull,default:0"`
+	LastFailedAt    time.Time  `bun:"last_failed_at,notnull"`
+	BlockedUntil    *time.Time `bun:"blocked_until"`
+	UnlockTokenHash *string    `bun:"unlock_token_hash"`
+}
+
+// IsLocked reports whether the attempt holds an unexpired account lock
+func (a *AuthAttempt) IsLocked() bool {
+	return a.UnlockTokenHash != nil && a.IsBlocked()
+}
+
+// IsBlocked reports whether the next attempt must still wait
+func (a *AuthAttempt) IsBlocked() bool {
+	return a.BlockedUntil != nil && time.Now().Before(*a.BlockedUntil)
+}
+
 type WebAuthnCredential struct {
 	core.CoreModel
 	bun.BaseModel `bun:"table:webauthn_credentials,alias:wac"`
@@ -83,6 +263,28 @@ type OAuthCredential struct {
 	Account *account.Account `bun:"rel:belongs-to,join:account_id=id"`
 }
 
+// GetID returns the OAuth credential ID for cursor pagination
+func (o *OAuthCredential) GetID() int64 {
+	return o.ID
+}
+
+// OAuthState is a pending social login, created when the user is redirected to the provider
+type OAuthState struct {
+	core.CoreModel
+	bun.BaseModel `bun:"table:oauth_states,alias:oas"`
+
+	StateHash    string `bun:"state_hash,notnull,unique"`
+	Provider     string `bun:"provider,notnull"`
+	Nonce        string `bun:"nonce,notnull"`
+	CodeVerifier string `bun:"code_verifier,notnull"`
+	// AccountId is set when the identity is being linked to an existing account instead of logging in
+	AccountId *int64 `bun:"account_id"`
+	ExpiresAt int64  `bun:"expires_at,notnull"`
+
+	// RememberMe carries the "remember me" choice of the pending login to its session
+	RememberMe bool `bun:"remember_me,notnull,default:false"`
+}
+
 type TwoFactorAuthenticationChallenge struct {
 	core.CoreModel
 	bun.BaseModel `bun:"table:two_factor_authentication_challenges,alias:tfac"`
@@ -92,6 +294,9 @@ type TwoFactorAuthenticationChallenge struct {
 	TOTPSecret    string `bun:"totp_secret,notnull"`
 	AccountId     int64  `bun:"account_id,notnull"`
 
+	// RememberMe carries the "remember me" choice of the pending login to its session
+	RememberMe bool `bun:"remember_me,notnull,default:false"`
+
 	// account relationship
 	Account *account.Account `bun:"rel:belongs-to,join:account_id=id"`
 }
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"server/internal/config"
+
+	"go.uber.org/zap"
+)
+
+const (
+	OAuthProviderTypeOIDC   = "oidc"
+	OAuthProviderTypeGitHub = "github"
+
+	// oauthCredentialProviderPrefix prefixes provider names in OAuthCredential.Provider and Account.AuthProviders
+	oauthCredentialProviderPrefix = "oauth_"
+)
+
+// OAuthIdentity is the identity an OAuth provider asserts for the signed in user
+type OAuthIdentity struct {
+	// Subject is the stable user ID at the provider
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// OAuthProvider is a social login provider using the authorization code flow with PKCE
+type OAuthProvider interface {
+	// Name returns the provider's name, as used in the redirect endpoints
+	Name() string
+	// AuthCodeURL returns the URL the user is redirected to for signing in
+	AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error)
+	// Exchange redeems the authorization code and returns the user's identity
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*OAuthIdentity, error)
+}
+
+// OAuthProviderRegistry holds the configured social login providers, keyed by name
+type OAuthProviderRegistry struct {
+	providers map[string]OAuthProvider
+}
+
+// NewOAuthProviderRegistry creates the providers configured through OAUTH_PROVIDERS
+//
+// Returns:
+//   - *OAuthProviderRegistry: The provider registry
+//   - error: If a provider has an unknown type or is missing its client configuration
+func NewOAuthProviderRegistry(cfg *config.Config, logger *zap.Logger) (*OAuthProviderRegistry, error) {
+	registry := &OAuthProviderRegistry{providers: make(map[string]OAuthProvider)}
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	for name, providerCfg := range cfg.OAuthProviders {
+		if providerCfg.ClientID == "" {
+			return nil, fmt.Errorf("oauth provider %q is missing a client ID", name)
+		}
+		redirectURL := OAuthRedirectURL(cfg.OAuthRedirectBaseURL, name)
+
+		var provider OAuthProvider
+		switch providerCfg.Type {
+		case OAuthProviderTypeOIDC:
+			if providerCfg.Issuer == "" {
+				return nil, fmt.Errorf("oauth provider %q is missing an issuer", name)
+			}
+			provider = NewOIDCProvider(name, providerCfg, redirectURL, httpClient, logger)
+		case OAuthProviderTypeGitHub:
+			provider = NewGitHubProvider(name, providerCfg, redirectURL, httpClient)
+		default:
+			return nil, fmt.Errorf("oauth provider %q has unsupported type %q", name, providerCfg.Type)
+		}
+
+		registry.Register(provider)
+	}
+
+	return registry, nil
+}
+
+// Register adds a provider to the registry, replacing any provider with the same name
+func (r *OAuthProviderRegistry) Register(provider OAuthProvider) {
+	r.providers[provider.Name()] = provider
+}
+
+// Get returns the provider with the given name
+//
+// Returns:
+//   - OAuthProvider: The provider
+//   - error: ErrOAuthProviderUnsupported if no provider has the given name
+func (r *OAuthProviderRegistry) Get(name string) (OAuthProvider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, ErrOAuthProviderUnsupported
+	}
+	return provider, nil
+}
+
+// Names returns the names of the registered providers in alphabetical order
+func (r *OAuthProviderRegistry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// OAuthRedirectURL returns the callback URL of the provider's redirect endpoints
+func OAuthRedirectURL(baseURL string, providerName string) string {
+	return fmt.Sprintf("%s/auth/oauth/%s/callback", strings.TrimRight(baseURL, "/"), url.PathEscape(providerName))
+}
+
+// OAuthCredentialProvider returns the provider value stored for the named provider's credentials
+//
+// The Google One Tap credentials use the same value as a provider named "google".
+func OAuthCredentialProvider(providerName string) string {
+	return oauthCredentialProviderPrefix + providerName
+}
+
+// OAuthProviderName returns the provider name of a credential's provider value, the inverse of OAuthCredentialProvider
+func OAuthProviderName(credentialProvider string) string {
+	return strings.TrimPrefix(credentialProvider, oauthCredentialProviderPrefix)
+}
@@ -0,0 +1,698 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"server/internal/config"
+	"server/internal/domain/account"
+	"server/internal/domain/core"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testOAuthClientID     = "test-client"
+	testOAuthClientSecret = "test-secret"
+	testOAuthRedirectURL  = "http://localhost:3000/auth/oauth/example/callback"
+)
+
+// testOIDCServer is a minimal OpenID Connect provider issuing ID tokens for authorization codes
+type testOIDCServer struct {
+	*httptest.Server
+	key *testSigningKey
+
+	mu    sync.Mutex
+	codes map[string]testAuthorization
+
+	// modifyClaims changes the claims of the next ID tokens
+	modifyClaims func(map[string]any)
+}
+
+// testAuthorization is an authorization code issued to the client
+type testAuthorization struct {
+	codeChallenge string
+	nonce         string
+}
+
+func newTestOIDCServer(t *testing.T) *testOIDCServer {
+	server := &testOIDCServer{
+		key:          newTestSigningKey(t, "oidc-key"),
+		codes:        make(map[string]testAuthorization),
+		modifyClaims: func(map[string]any) {},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       &server.key.privateKey.PublicKey,
+			KeyID:     server.key.keyID,
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != testOAuthClientID || clientSecret != testOAuthClientSecret {
+			writeTestOAuthError(w, "invalid_client")
+			return
+		}
+
+		server.mu.Lock()
+		authorization, ok := server.codes[r.PostFormValue("code")]
+		delete(server.codes, r.PostFormValue("code"))
+		server.mu.Unlock()
+
+		verifierHash := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(verifierHash[:]) != authorization.codeChallenge {
+			writeTestOAuthError(w, "invalid_grant")
+			return
+		}
+
+		now := time.Now()
+		claims := map[string]any{
+			"iss":            server.URL,
+			"aud":            testOAuthClientID,
+			"sub":            "oidc-user-1",
+			"email":          "test@example.com",
+			"email_verified": true,
+			"name":           "Test User",
+			"nonce":          authorization.nonce,
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Hour).Unix(),
+		}
+		server.modifyClaims(claims)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     server.key.sign(t, claims),
+		})
+	})
+
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// authorize approves the authorization request and returns the code passed to the callback
+func (s *testOIDCServer) authorize(t *testing.T, authURL string) (string, url.Values) {
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	params := parsed.Query()
+	require.Equal(t, "S256", params.Get("code_challenge_method"))
+
+	code := "code-" + params.Get("state")
+	s.mu.Lock()
+	s.codes[code] = testAuthorization{codeChallenge: params.Get("code_challenge"), nonce: params.Get("nonce")}
+	s.mu.Unlock()
+
+	return code, params
+}
+
+func writeTestOAuthError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+func newTestOIDCProvider(issuer string) *OIDCProvider {
+	return NewOIDCProvider("example", config.OAuthProviderConfig{
+		Type:         OAuthProviderTypeOIDC,
+		ClientID:     testOAuthClientID,
+		ClientSecret: testOAuthClientSecret,
+		Issuer:       issuer,
+	}, testOAuthRedirectURL, http.DefaultClient, zap.NewNop())
+}
+
+// fakeOAuthStateRepo stores pending social logins in memory
+type fakeOAuthStateRepo struct {
+	mu     sync.Mutex
+	nextID int64
+	states map[string]*OAuthState
+}
+
+func newFakeOAuthStateRepo() *fakeOAuthStateRepo {
+	return &fakeOAuthStateRepo{states: make(map[string]*OAuthState)}
+}
+
+func (r *fakeOAuthStateRepo) Create(ctx context.Context, provider string, nonce string, codeVerifier string, accountId *int64, rememberMe bool) (string, *OAuthState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, err := r.GenerateState()
+	if err != nil {
+		return "", nil, err
+	}
+	r.nextID++
+	oauthState := &OAuthState{
+		CoreModel:    core.CoreModel{ID: r.nextID},
+		StateHash:    r.HashState(state),
+		Provider:     provider,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		AccountId:    accountId,
+		ExpiresAt:    time.Now().Add(10 * time.Minute).Unix(),
+		RememberMe:   rememberMe,
+	}
+	r.states[oauthState.StateHash] = oauthState
+	return state, oauthState, nil
+}
+
+func (r *fakeOAuthStateRepo) Get(ctx context.Context, state string) (*OAuthState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	oauthState, ok := r.states[r.HashState(state)]
+	if !ok {
+		return nil, ErrOAuthStateNotFound
+	}
+	if time.Now().Unix() > oauthState.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return oauthState, nil
+}
+
+func (r *fakeOAuthStateRepo) Delete(ctx context.Context, oauthState *OAuthState) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.states, oauthState.StateHash)
+	return nil
+}
+
+func (r *fakeOAuthStateRepo) GenerateState() (string, error) {
+	return generateSecureToken(32)
+}
+
+func (r *fakeOAuthStateRepo) HashState(state string) string {
+	return testTokenHasher.Hash(state)
+}
+
+func TestOIDCProvider_Exchange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the identity of the ID token", func(t *testing.T) {
+		server := newTestOIDCServer(t)
+		provider := newTestOIDCProvider(server.URL)
+
+		authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier-0123456789-0123456789-0123456789")
+		require.NoError(t, err)
+		code, params := server.authorize(t, authURL)
+		assert.Equal(t, testOAuthClientID, params.Get("client_id"))
+		assert.Equal(t, testOAuthRedirectURL, params.Get("redirect_uri"))
+		assert.Equal(t, "openid email profile", params.Get("scope"))
+
+		identity, err := provider.Exchange(ctx, code, "verifier-0123456789-0123456789-0123456789", "nonce")
+
+		require.NoError(t, err)
+		assert.Equal(t, &OAuthIdentity{
+			Subject:       "oidc-user-1",
+			Email:         "test@example.com",
+			EmailVerified: true,
+			Name:          "Test User",
+		}, identity)
+	})
+
+	t.Run("rejects a wrong code verifier", func(t *testing.T) {
+		server := newTestOIDCServer(t)
+		provider := newTestOIDCProvider(server.URL)
+
+		authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier-0123456789-0123456789-0123456789")
+		require.NoError(t, err)
+		code, _ := server.authorize(t, authURL)
+
+		_, err = provider.Exchange(ctx, code, "other-verifier-0123456789-0123456789-0123", "nonce")
+		assert.ErrorIs(t, err, ErrOAuthTokenInvalid)
+	})
+
+	t.Run("rejects a nonce mismatch", func(t *testing.T) {
+		server := newTestOIDCServer(t)
+		provider := newTestOIDCProvider(server.URL)
+
+		authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier-0123456789-0123456789-0123456789")
+		require.NoError(t, err)
+		code, _ := server.authorize(t, authURL)
+
+		_, err = provider.Exchange(ctx, code, "verifier-0123456789-0123456789-0123456789", "other-nonce")
+		assert.ErrorIs(t, err, ErrOAuthTokenInvalid)
+	})
+
+	t.Run("rejects ID tokens for another audience or issuer", func(t *testing.T) {
+		for name, modifyClaims := range map[string]func(map[string]any){
+			"audience": func(claims map[string]any) { claims["aud"] = "other-client" },
+			"issuer":   func(claims map[string]any) { claims["iss"] = "https://evil.example.com" },
+			"expiry":   func(claims map[string]any) { claims["exp"] = time.Now().Add(-time.Hour).Unix() },
+		} {
+			t.Run(name, func(t *testing.T) {
+				server := newTestOIDCServer(t)
+				server.modifyClaims = modifyClaims
+				provider := newTestOIDCProvider(server.URL)
+
+				authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier-0123456789-0123456789-0123456789")
+				require.NoError(t, err)
+				code, _ := server.authorize(t, authURL)
+
+				_, err = provider.Exchange(ctx, code, "verifier-0123456789-0123456789-0123456789", "nonce")
+				assert.ErrorIs(t, err, ErrOAuthTokenInvalid)
+			})
+		}
+	})
+
+	t.Run("treats a missing email_verified claim as unverified", func(t *testing.T) {
+		server := newTestOIDCServer(t)
+		server.modifyClaims = func(claims map[string]any) { delete(claims, "email_verified") }
+		provider := newTestOIDCProvider(server.URL)
+
+		authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier-0123456789-0123456789-0123456789")
+		require.NoError(t, err)
+		code, _ := server.authorize(t, authURL)
+
+		identity, err := provider.Exchange(ctx, code, "verifier-0123456789-0123456789-0123456789", "nonce")
+		require.NoError(t, err)
+		assert.False(t, identity.EmailVerified)
+	})
+
+	t.Run("rejects a discovery document for another issuer", func(t *testing.T) {
+		server := newTestOIDCServer(t)
+		provider := newTestOIDCProvider(server.URL + "/other")
+
+		_, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier")
+		assert.Error(t, err)
+	})
+}
+
+func TestGitHubProvider_Exchange(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != "code" || r.PostFormValue("code_verifier") == "" {
+			writeTestOAuthError(w, "bad_verification_code")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "gh-token", "token_type": "bearer"})
+	})
+	mux.HandleFunc("GET /user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer gh-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 583231, "login": "octocat", "name": ""})
+	})
+	mux.HandleFunc("GET /user/emails", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]any{
+			{"email": "other@example.com", "primary": false, "verified": true},
+			{"email": "octocat@example.com", "primary": true, "verified": true},
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	provider := NewGitHubProvider("github", config.OAuthProviderConfig{
+		Type:     OAuthProviderTypeGitHub,
+		ClientID: testOAuthClientID,
+		AuthURL:  server.URL + "/login/oauth/authorize",
+		TokenURL: server.URL + "/login/oauth/access_token",
+		APIURL:   server.URL,
+	}, testOAuthRedirectURL, http.DefaultClient)
+
+	authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier")
+	require.NoError(t, err)
+	assert.Contains(t, authURL, "code_challenge_method=S256")
+
+	identity, err := provider.Exchange(ctx, "code", "verifier", "nonce")
+	require.NoError(t, err)
+	assert.Equal(t, &OAuthIdentity{
+		Subject:       "583231",
+		Email:         "octocat@example.com",
+		EmailVerified: true,
+		Name:          "octocat",
+	}, identity)
+
+	_, err = provider.Exchange(ctx, "wrong-code", "verifier", "nonce")
+	assert.ErrorIs(t, err, ErrOAuthTokenInvalid)
+}
+
+func TestNewOAuthProviderRegistry(t *testing.T) {
+	t.Run("creates the configured providers", func(t *testing.T) {
+		registry, err := NewOAuthProviderRegistry(&config.Config{
+			OAuthRedirectBaseURL: "http://localhost:3000",
+			OAuthProviders: map[string]config.OAuthProviderConfig{
+				"github":    {Type: OAuthProviderTypeGitHub, ClientID: "id"},
+				"microsoft": {Type: OAuthProviderTypeOIDC, ClientID: "id", Issuer: "https://login.microsoftonline.com/tenant/v2.0"},
+			},
+		}, zap.NewNop())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"github", "microsoft"}, registry.Names())
+		provider, err := registry.Get("github")
+		require.NoError(t, err)
+		assert.IsType(t, &GitHubProvider{}, provider)
+		_, err = registry.Get("gitlab")
+		assert.ErrorIs(t, err, ErrOAuthProviderUnsupported)
+	})
+
+	t.Run("rejects incomplete configurations", func(t *testing.T) {
+		for name, providerCfg := range map[string]config.OAuthProviderConfig{
+			"missing client ID": {Type: OAuthProviderTypeGitHub},
+			"missing issuer":    {Type: OAuthProviderTypeOIDC, ClientID: "id"},
+			"unknown type":      {Type: "saml", ClientID: "id"},
+		} {
+			_, err := NewOAuthProviderRegistry(&config.Config{
+				OAuthProviders: map[string]config.OAuthProviderConfig{"example": providerCfg},
+			}, zap.NewNop())
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestAuthService_OAuthLogin(t *testing.T) {
+	ctx := context.Background()
+	server := newTestOIDCServer(t)
+
+	newService := func(accountRepo *MockAccountRepo, sessionRepo *MockSessionRepo, oauthCredentialRepo *MockOAuthCredentialRepo, oauthStateRepo *fakeOAuthStateRepo) *AuthService {
+		registry := &OAuthProviderRegistry{providers: make(map[string]OAuthProvider)}
+		registry.Register(newTestOIDCProvider(server.URL))
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.oauthCredentialRepo = oauthCredentialRepo
+		service.oauthStateRepo = oauthStateRepo
+		service.oauthProviders = registry
+		return service
+	}
+
+	t.Run("creates an account through the redirect flow", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		oauthStateRepo := newFakeOAuthStateRepo()
+		created := &account.Account{CoreModel: core.CoreModel{ID: 8}, Email: "test@example.com", AuthProviders: []string{"oauth_example"}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, "oauth_example", "oidc-user-1", true).Return(nil, ErrOAuthCredentialNotFound)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrAccountNotFound)
+		accountRepo.On("Create", mock.Anything, "test@example.com", "Test User", []string{"oauth_example"}, (*string)(nil), (*int64)(nil), "", (*string)(nil)).Return(created, nil)
+		oauthCredentialRepo.On("Create", mock.Anything, int64(8), "oauth_example", "oidc-user-1").Return(&OAuthCredential{AccountId: 8}, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(8), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(8), "Mozilla/5.0", "127.0.0.1", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newService(accountRepo, sessionRepo, oauthCredentialRepo, oauthStateRepo)
+		authURL, state, err := service.StartOAuthLogin(ctx, "example", false)
+		require.NoError(t, err)
+		code, params := server.authorize(t, authURL)
+		assert.Equal(t, state, params.Get("state"))
+
+		loggedIn, sessionToken, err := service.FinishOAuthLogin(ctx, "example", state, code, "Mozilla/5.0", "127.0.0.1")
+
+		require.NoError(t, err)
+		assert.Equal(t, created, loggedIn)
+		assert.Equal(t, "session-token", sessionToken)
+
+		// The state can't be replayed
+		_, _, err = service.FinishOAuthLogin(ctx, "example", state, code, "Mozilla/5.0", "127.0.0.1")
+		assert.ErrorIs(t, err, ErrOAuthStateNotFound)
+	})
+
+	t.Run("keeps the remember me choice of the login", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 8}, Email: "test@example.com", AuthProviders: []string{"oauth_example"}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, "oauth_example", "oidc-user-1", true).Return(&OAuthCredential{AccountId: 8, Account: acc}, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(8), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(8), "", "", mock.Anything, mock.Anything, true, mock.Anything).Return("session-token", nil)
+
+		service := newService(new(MockAccountRepo), sessionRepo, oauthCredentialRepo, newFakeOAuthStateRepo())
+		authURL, state, err := service.StartOAuthLogin(ctx, "example", true)
+		require.NoError(t, err)
+		code, _ := server.authorize(t, authURL)
+
+		_, _, err = service.FinishOAuthLogin(ctx, "example", state, code, "", "")
+
+		require.NoError(t, err)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("requires a verified email to create an account", func(t *testing.T) {
+		server.modifyClaims = func(claims map[string]any) { claims["email_verified"] = false }
+		t.Cleanup(func() { server.modifyClaims = func(map[string]any) {} })
+		accountRepo := new(MockAccountRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, "oauth_example", "oidc-user-1", true).Return(nil, ErrOAuthCredentialNotFound)
+
+		service := newService(accountRepo, new(MockSessionRepo), oauthCredentialRepo, newFakeOAuthStateRepo())
+		authURL, state, err := service.StartOAuthLogin(ctx, "example", false)
+		require.NoError(t, err)
+		code, _ := server.authorize(t, authURL)
+
+		_, _, err = service.FinishOAuthLogin(ctx, "example", state, code, "", "")
+
+		assert.ErrorIs(t, err, ErrEmailNotVerified)
+		accountRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("refuses to link an existing account unless the provider is trusted", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		existing := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", AuthProviders: []string{account.AuthProviderPassword}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, "oauth_example", "oidc-user-1", true).Return(nil, ErrOAuthCredentialNotFound)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(existing, nil)
+
+		service := newService(accountRepo, new(MockSessionRepo), oauthCredentialRepo, newFakeOAuthStateRepo())
+		authURL, state, err := service.StartOAuthLogin(ctx, "example", false)
+		require.NoError(t, err)
+		code, _ := server.authorize(t, authURL)
+
+		_, _, err = service.FinishOAuthLogin(ctx, "example", state, code, "", "")
+
+		assert.ErrorIs(t, err, ErrOAuthAccountExists)
+		oauthCredentialRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("links an existing account for trusted providers", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		existing := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", AuthProviders: []string{account.AuthProviderPassword}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, "oauth_example", "oidc-user-1", true).Return(nil, ErrOAuthCredentialNotFound)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(existing, nil)
+		oauthCredentialRepo.On("Create", mock.Anything, int64(7), "oauth_example", "oidc-user-1").Return(&OAuthCredential{AccountId: 7}, nil)
+		accountRepo.On("UpdateAuthProviders", mock.Anything, existing, []string{account.AuthProviderPassword, "oauth_example"}).Return(existing, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newService(accountRepo, sessionRepo, oauthCredentialRepo, newFakeOAuthStateRepo())
+		service.cfg.OAuthProviders = map[string]config.OAuthProviderConfig{"example": {TrustEmail: true}}
+		authURL, state, err := service.StartOAuthLogin(ctx, "example", false)
+		require.NoError(t, err)
+		code, _ := server.authorize(t, authURL)
+
+		loggedIn, _, err := service.FinishOAuthLogin(ctx, "example", state, code, "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, loggedIn)
+		accountRepo.AssertExpectations(t)
+		oauthCredentialRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects states of another provider", func(t *testing.T) {
+		oauthStateRepo := newFakeOAuthStateRepo()
+		state, _, err := oauthStateRepo.Create(ctx, "other", "nonce", "verifier", nil, false)
+		require.NoError(t, err)
+
+		service := newService(new(MockAccountRepo), new(MockSessionRepo), new(MockOAuthCredentialRepo), oauthStateRepo)
+		_, _, err = service.FinishOAuthLogin(ctx, "example", state, "code", "", "")
+
+		assert.ErrorIs(t, err, ErrOAuthStateNotFound)
+	})
+
+	t.Run("rejects unknown providers", func(t *testing.T) {
+		service := newService(new(MockAccountRepo), new(MockSessionRepo), new(MockOAuthCredentialRepo), newFakeOAuthStateRepo())
+		_, _, err := service.StartOAuthLogin(ctx, "unknown", false)
+
+		assert.ErrorIs(t, err, ErrOAuthProviderUnsupported)
+	})
+}
+
+func TestAuthService_OAuthLink(t *testing.T) {
+	ctx := context.Background()
+	server := newTestOIDCServer(t)
+
+	newService := func(accountRepo *MockAccountRepo, oauthCredentialRepo *MockOAuthCredentialRepo) *AuthService {
+		registry := &OAuthProviderRegistry{providers: make(map[string]OAuthProvider)}
+		registry.Register(newTestOIDCProvider(server.URL))
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.oauthCredentialRepo = oauthCredentialRepo
+		service.oauthStateRepo = newFakeOAuthStateRepo()
+		service.oauthProviders = registry
+		return service
+	}
+
+	// authorize starts a link for the account and returns the state and code passed to the callback
+	authorize := func(t *testing.T, service *AuthService, acc *account.Account) (string, string) {
+		authURL, state, err := service.StartOAuthLink(ctx, acc, "example")
+		require.NoError(t, err)
+		code, _ := server.authorize(t, authURL)
+		return state, code
+	}
+
+	t.Run("links the identity and adds the provider", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, AuthProviders: []string{account.AuthProviderPassword}}
+		linked := &OAuthCredential{CoreModel: core.CoreModel{ID: 3}, AccountId: 7, Provider: "oauth_example"}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, "oauth_example", "oidc-user-1", false).Return(nil, ErrOAuthCredentialNotFound)
+		oauthCredentialRepo.On("GetByAccountProvider", mock.Anything, int64(7), "oauth_example", false).Return(nil, ErrOAuthCredentialNotFound)
+		oauthCredentialRepo.On("Create", mock.Anything, int64(7), "oauth_example", "oidc-user-1").Return(linked, nil)
+		accountRepo.On("UpdateAuthProviders", mock.Anything, acc, []string{account.AuthProviderPassword, "oauth_example"}).Return(acc, nil)
+
+		service := newService(accountRepo, oauthCredentialRepo)
+		state, code := authorize(t, service, acc)
+
+		credential, err := service.FinishOAuthLink(ctx, acc, "example", state, code)
+
+		require.NoError(t, err)
+		assert.Equal(t, linked, credential)
+		accountRepo.AssertExpectations(t)
+	})
+
+	t.Run("refuses identities linked to another account", func(t *testing.T) {
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, "oauth_example", "oidc-user-1", false).Return(&OAuthCredential{AccountId: 8}, nil)
+
+		service := newService(new(MockAccountRepo), oauthCredentialRepo)
+		state, code := authorize(t, service, acc)
+
+		_, err := service.FinishOAuthLink(ctx, acc, "example", state, code)
+
+		assert.ErrorIs(t, err, ErrOAuthCredentialAlreadyExists)
+		oauthCredentialRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("refuses a second identity of the same provider", func(t *testing.T) {
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, "oauth_example", "oidc-user-1", false).Return(nil, ErrOAuthCredentialNotFound)
+		oauthCredentialRepo.On("GetByAccountProvider", mock.Anything, int64(7), "oauth_example", false).Return(&OAuthCredential{AccountId: 7}, nil)
+
+		service := newService(new(MockAccountRepo), oauthCredentialRepo)
+		state, code := authorize(t, service, acc)
+
+		_, err := service.FinishOAuthLink(ctx, acc, "example", state, code)
+
+		assert.ErrorIs(t, err, ErrOAuthCredentialAlreadyExists)
+	})
+
+	t.Run("binds the link to the account that started it", func(t *testing.T) {
+		service := newService(new(MockAccountRepo), new(MockOAuthCredentialRepo))
+		state, code := authorize(t, service, &account.Account{CoreModel: core.CoreModel{ID: 7}})
+
+		_, err := service.FinishOAuthLink(ctx, &account.Account{CoreModel: core.CoreModel{ID: 8}}, "example", state, code)
+
+		assert.ErrorIs(t, err, ErrOAuthStateNotFound)
+	})
+
+	t.Run("link states can't be used to log in", func(t *testing.T) {
+		service := newService(new(MockAccountRepo), new(MockOAuthCredentialRepo))
+		state, code := authorize(t, service, &account.Account{CoreModel: core.CoreModel{ID: 7}})
+
+		_, _, err := service.FinishOAuthLogin(ctx, "example", state, code, "", "")
+
+		assert.ErrorIs(t, err, ErrOAuthStateNotFound)
+	})
+}
+
+func TestAuthService_UnlinkOAuthIdentity(t *testing.T) {
+	ctx := context.Background()
+	passwordHash := "hash"
+	credential := &OAuthCredential{CoreModel: core.CoreModel{ID: 3}, AccountId: 7, Provider: "oauth_example"}
+
+	t.Run("refuses to unlink the last sign in method", func(t *testing.T) {
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, AuthProviders: []string{"oauth_example"}}
+		oauthCredentialRepo.On("GetByAccountCredentialId", mock.Anything, int64(7), int64(3)).Return(credential, nil)
+		oauthCredentialRepo.On("GetAllByAccountList", mock.Anything, int64(7)).Return([]*OAuthCredential{credential}, nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.oauthCredentialRepo = oauthCredentialRepo
+
+		_, err := service.UnlinkOAuthIdentity(ctx, acc, 3)
+
+		assert.ErrorIs(t, err, ErrInsufficientAuthProviders)
+		oauthCredentialRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("removes the provider with its last identity", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{
+			CoreModel:     core.CoreModel{ID: 7},
+			PasswordHash:  &passwordHash,
+			AuthProviders: []string{account.AuthProviderPassword, "oauth_example"},
+		}
+		oauthCredentialRepo.On("GetByAccountCredentialId", mock.Anything, int64(7), int64(3)).Return(credential, nil)
+		oauthCredentialRepo.On("GetAllByAccountList", mock.Anything, int64(7)).Return([]*OAuthCredential{credential}, nil)
+		oauthCredentialRepo.On("Delete", mock.Anything, credential).Return(nil)
+		accountRepo.On("UpdateAuthProviders", mock.Anything, acc, []string{account.AuthProviderPassword}).Return(acc, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.oauthCredentialRepo = oauthCredentialRepo
+
+		unlinked, err := service.UnlinkOAuthIdentity(ctx, acc, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, credential, unlinked)
+		oauthCredentialRepo.AssertExpectations(t)
+		accountRepo.AssertExpectations(t)
+	})
+
+	t.Run("keeps the provider while other identities remain", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, AuthProviders: []string{"oauth_example"}}
+		other := &OAuthCredential{CoreModel: core.CoreModel{ID: 4}, AccountId: 7, Provider: "oauth_example"}
+		oauthCredentialRepo.On("GetByAccountCredentialId", mock.Anything, int64(7), int64(3)).Return(credential, nil)
+		oauthCredentialRepo.On("GetAllByAccountList", mock.Anything, int64(7)).Return([]*OAuthCredential{credential, other}, nil)
+		oauthCredentialRepo.On("Delete", mock.Anything, credential).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.oauthCredentialRepo = oauthCredentialRepo
+
+		_, err := service.UnlinkOAuthIdentity(ctx, acc, 3)
+
+		require.NoError(t, err)
+		accountRepo.AssertNotCalled(t, "UpdateAuthProviders", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("does not touch other accounts' identities", func(t *testing.T) {
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		oauthCredentialRepo.On("GetByAccountCredentialId", mock.Anything, int64(8), int64(3)).Return(nil, ErrOAuthCredentialNotFound)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.oauthCredentialRepo = oauthCredentialRepo
+
+		_, err := service.UnlinkOAuthIdentity(ctx, &account.Account{CoreModel: core.CoreModel{ID: 8}}, 3)
+
+		assert.ErrorIs(t, err, ErrOAuthCredentialNotFound)
+	})
+}
@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"server/internal/config"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+// oidcDefaultScopes are requested when a provider configures no scopes
+var oidcDefaultScopes = []string{"openid", "email", "profile"}
+
+// oidcSignatureAlgorithms are the ID token signature algorithms accepted from providers
+var oidcSignatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512, jose.PS256, jose.ES256, jose.ES384,
+}
+
+type oidcDiscoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcIDTokenClaims struct {
+	jwt.Claims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// OIDCProvider is an OpenID Connect provider configured through its discovery document
+//
+// The discovery document is fetched on first use, so that an unreachable provider does
+// not prevent the server from starting. Identities are read from the ID token returned
+// by the token endpoint.
+type OIDCProvider struct {
+	name       string
+	cfg        config.OAuthProviderConfig
+	issuer     string
+	scopes     []string
+	httpClient *http.Client
+	logger     *zap.Logger
+
+	redirectURL string
+
+	mu          sync.Mutex
+	oauthConfig *oauth2.Config
+	keySet      *remoteKeySet
+}
+
+func NewOIDCProvider(name string, cfg config.OAuthProviderConfig, redirectURL string, httpClient *http.Client, logger *zap.Logger) *OIDCProvider {
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = oidcDefaultScopes
+	} else if !slices.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	return &OIDCProvider{
+		name:        name,
+		cfg:         cfg,
+		issuer:      strings.TrimRight(cfg.Issuer, "/"),
+		scopes:      scopes,
+		httpClient:  httpClient,
+		logger:      logger,
+		redirectURL: redirectURL,
+	}
+}
+
+func (p *OIDCProvider) Name() string {
+	return p.name
+}
+
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	oauthConfig, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return oauthConfig.AuthCodeURL(state,
+		oauth2.S256ChallengeOption(codeVerifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	), nil
+}
+
+// Exchange redeems the authorization code and verifies the returned ID token
+//
+// Returns:
+//   - *OAuthIdentity: The identity asserted by the ID token
+//   - error: ErrOAuthTokenInvalid if the code or the ID token is rejected
+func (p *OIDCProvider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*OAuthIdentity, error) {
+	oauthConfig, keySet, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauthConfig.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.httpClient), code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOAuthTokenInvalid, err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("%w: missing id token", ErrOAuthTokenInvalid)
+	}
+
+	return p.verifyIDToken(ctx, keySet, rawIDToken, nonce)
+}
+
+// verifyIDToken checks the ID token's signature, issuer, audience, expiry and nonce
+func (p *OIDCProvider) verifyIDToken(ctx context.Context, keySet *remoteKeySet, rawIDToken string, nonce string) (*OAuthIdentity, error) {
+	parsed, err := jwt.ParseSigned(rawIDToken, oidcSignatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOAuthTokenInvalid, err)
+	}
+	if len(parsed.Headers) != 1 {
+		return nil, fmt.Errorf("%w: unexpected signature count", ErrOAuthTokenInvalid)
+	}
+
+	key, err := keySet.Key(ctx, parsed.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims oidcIDTokenClaims
+	if err := parsed.Claims(key, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOAuthTokenInvalid, err)
+	}
+
+	if err := claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      p.issuer,
+		AnyAudience: jwt.Audience{p.cfg.ClientID},
+		Time:        time.Now(),
+	}, idTokenLeeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOAuthTokenInvalid, err)
+	}
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: missing expiry", ErrOAuthTokenInvalid)
+	}
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrOAuthTokenInvalid)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrOAuthTokenInvalid)
+	}
+
+	return &OAuthIdentity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified != nil && *claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// discover returns the OAuth2 configuration and key set read from the provider's discovery document
+func (p *OIDCProvider) discover(ctx context.Context) (*oauth2.Config, *remoteKeySet, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauthConfig != nil {
+		return p.oauthConfig, p.keySet, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create discovery request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("failed to fetch discovery document: unexpected status %d", resp.StatusCode)
+	}
+
+	var document oidcDiscoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode discovery document: %w", err)
+	}
+
+	if strings.TrimRight(document.Issuer, "/") != p.issuer {
+		return nil, nil, fmt.Errorf("discovery document issuer %q does not match %q", document.Issuer, p.issuer)
+	}
+	if document.AuthorizationEndpoint == "" || document.TokenEndpoint == "" || document.JWKSURI == "" {
+		return nil, nil, fmt.Errorf("discovery document of %q is missing endpoints", p.issuer)
+	}
+
+	p.oauthConfig = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  document.AuthorizationEndpoint,
+			TokenURL: document.TokenEndpoint,
+		},
+		RedirectURL: p.redirectURL,
+		Scopes:      p.scopes,
+	}
+	p.keySet = newRemoteKeySet(document.JWKSURI, p.httpClient, p.logger)
+
+	p.logger.Debug("Discovered OIDC provider", zap.String("provider", p.name), zap.String("issuer", p.issuer))
+	return p.oauthConfig, p.keySet, nil
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"server/internal/config"
+	"server/internal/infrastructure/pwnedpasswords"
+
+	"github.com/nbutton23/zxcvbn-go"
+	"go.uber.org/zap"
+)
+
+// maxScoredPasswordLength bounds the part of a password that is scored, since scoring is superlinear
+const maxScoredPasswordLength = 100
+
+// PasswordPolicy decides whether a new password may be used
+//
+// A password must have a minimum length, reach a minimum zxcvbn score and must not
+// appear in a known data breach. The score penalises words taken from the user's own
+// email address and name.
+type PasswordPolicy struct {
+	minLength      int
+	minScore       int
+	pwnedPasswords pwnedpasswords.Checker
+	logger         *zap.Logger
+}
+
+// NewPasswordPolicy creates the password policy from the PASSWORD_MIN_* configuration
+func NewPasswordPolicy(cfg *config.Config, pwnedPasswords pwnedpasswords.Checker, logger *zap.Logger) *PasswordPolicy {
+	minLength := cfg.PasswordMinLength
+	if minLength <= 0 {
+		minLength = MinPasswordLength
+	}
+
+	return &PasswordPolicy{
+		minLength:      minLength,
+		minScore:       cfg.PasswordMinScore,
+		pwnedPasswords: pwnedPasswords,
+		logger:         logger,
+	}
+}
+
+// Validate checks a new password of the user with the given email address and name
+//
+// The breached password check fails open, an unreachable corpus only logs a warning.
+//
+// Returns:
+//   - error: a *PasswordPolicyError wrapping ErrPasswordTooWeak or ErrPasswordBreached
+func (p *PasswordPolicy) Validate(ctx context.Context, password string, emailAddress string, fullName string) error {
+	if utf8.RuneCountInString(password) < p.minLength {
+		return NewPasswordPolicyError(fmt.Sprintf(MsgPasswordTooShort, p.minLength), ErrPasswordTooWeak)
+	}
+
+	scored := password
+	if utf8.RuneCountInString(scored) > maxScoredPasswordLength {
+		scored = string([]rune(scored)[:maxScoredPasswordLength])
+	}
+	if zxcvbn.PasswordStrength(scored, passwordUserInputs(emailAddress, fullName)).Score < p.minScore {
+		return NewPasswordPolicyError(MsgPasswordTooWeak, ErrPasswordTooWeak)
+	}
+
+	pwned, err := p.pwnedPasswords.IsPwned(ctx, password)
+	if err != nil {
+		p.logger.Warn("Failed to check password against breached passwords", zap.Error(err))
+		return nil
+	}
+	if pwned {
+		return NewPasswordPolicyError(MsgPasswordBreached, ErrPasswordBreached)
+	}
+
+	return nil
+}
+
+// passwordUserInputs returns the words of the user's email address and name that make a password easy to guess
+func passwordUserInputs(emailAddress string, fullName string) []string {
+	emailAddress = strings.ToLower(emailAddress)
+	fullName = strings.ToLower(fullName)
+
+	inputs := []string{emailAddress, fullName}
+	localPart, domain, _ := strings.Cut(emailAddress, "@")
+	inputs = append(inputs, localPart, domain)
+
+	isSeparator := func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}
+	inputs = append(inputs, strings.FieldsFunc(localPart, isSeparator)...)
+	inputs = append(inputs, strings.FieldsFunc(fullName, isSeparator)...)
+
+	userInputs := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		if input != "" {
+			userInputs = append(userInputs, input)
+		}
+	}
+	return userInputs
+}
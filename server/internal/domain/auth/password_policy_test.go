@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"server/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// stubPwnedPasswords reports the configured result for every password
+type stubPwnedPasswords struct {
+	pwned bool
+	err   error
+}
+
+func (s stubPwnedPasswords) IsPwned(ctx context.Context, password string) (bool, error) {
+	return s.pwned, s.err
+}
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	cfg := &config.Config{PasswordMinLength: 10, PasswordMinScore: 3}
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		password       string
+		pwnedPasswords stubPwnedPasswords
+		expectedError  error
+		expectedReason string
+	}{
+		{
+			name:     "accepts strong passwords",
+			password: "Nimbus-Quartz-Lantern-42",
+		},
+		{
+			name:           "rejects short passwords",
+			password:       "x7#Qp!2z",
+			expectedError:  ErrPasswordTooWeak,
+			expectedReason: "password must be at least 10 characters",
+		},
+		{
+			name:           "rejects guessable passwords",
+			password:       "Str0ng!Password",
+			expectedError:  ErrPasswordTooWeak,
+			expectedReason: MsgPasswordTooWeak,
+		},
+		{
+			name:           "rejects passwords made of the user's name",
+			password:       "Quillfeather.Zephyrine",
+			expectedError:  ErrPasswordTooWeak,
+			expectedReason: MsgPasswordTooWeak,
+		},
+		{
+			name:           "rejects breached passwords",
+			password:       "Nimbus-Quartz-Lantern-42",
+			pwnedPasswords: stubPwnedPasswords{pwned: true},
+			expectedError:  ErrPasswordBreached,
+			expectedReason: MsgPasswordBreached,
+		},
+		{
+			name:           "accepts passwords when the breach check fails",
+			password:       "Nimbus-Quartz-Lantern-42",
+			pwnedPasswords: stubPwnedPasswords{err: errors.New("connection refused")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := NewPasswordPolicy(cfg, tt.pwnedPasswords, zap.NewNop())
+			err := policy.Validate(ctx, tt.password, "zephyrine.quillfeather@example.com", "Zephyrine Quillfeather")
+
+			if tt.expectedError == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			var policyErr *PasswordPolicyError
+			require.ErrorAs(t, err, &policyErr)
+			assert.Equal(t, tt.expectedReason, policyErr.Reason)
+		})
+	}
+
+	t.Run("the name only weakens passwords of its user", func(t *testing.T) {
+		policy := NewPasswordPolicy(cfg, stubPwnedPasswords{}, zap.NewNop())
+		assert.NoError(t, policy.Validate(ctx, "Quillfeather.Zephyrine", "test@example.com", "Test User"))
+	})
+
+	t.Run("defaults the minimum length", func(t *testing.T) {
+		policy := NewPasswordPolicy(&config.Config{}, stubPwnedPasswords{}, zap.NewNop())
+		assert.ErrorIs(t, policy.Validate(ctx, "x7#Qp!2", "", ""), ErrPasswordTooWeak)
+	})
+}
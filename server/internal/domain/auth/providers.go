@@ -4,16 +4,32 @@ import (
 	"go.uber.org/fx"
 )
 
-// AuthDomainModule contains all auth domain repositories for dependency injection
+// AuthDomainModule contains all auth domain repositories and services for dependency injection
 var AuthDomainModule = fx.Options(
 	fx.Provide(
 		NewSessionRepo,
 		NewPasswordResetTokenRepo,
+		NewSessionRevocationTokenRepo,
+		NewBearerTokenRepo,
+		NewPersonalAccessTokenRepo,
 		NewWebAuthnCredentialRepo,
 		NewWebAuthnChallengeRepo,
 		NewOAuthCredentialRepo,
 		NewTwoFactorAuthenticationChallengeRepo,
 		NewRecoveryCodeRepo,
 		NewTemporaryTwoFactorChallengeRepo,
+		NewOAuthStateRepo,
+		NewEmailLoginCodeRepo,
+		NewSmsLoginCodeRepo,
+		NewAuthAttemptRepo,
+		NewWebAuthnService,
+		NewGoogleTokenVerifier,
+		NewOAuthProviderRegistry,
+		NewPasswordPolicy,
+		NewAttemptLimiter,
+		NewAuthService,
+		NewSecurityNotifier,
 	),
+	// Security events are mailed to the account owner
+	fx.Invoke(RegisterSecurityNotifier),
 )
\ No newline at end of file
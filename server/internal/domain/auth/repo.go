@@ -2,18 +2,20 @@ package auth
 
 import (
 	"context"
-	"crypto/md5"
 	"crypto/rand"
 	"database/sql"
+	"encoding/base32"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
 	"server/internal/infrastructure/db"
+	"server/internal/infrastructure/geoip"
+	"server/internal/infrastructure/tokenhash"
 
-	"github.com/pquerna/otp/totp"
 	"github.com/uptrace/bun"
 )
 
@@ -33,12 +35,6 @@ func generateSecureToken(length int) (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
-// hashTokenMD5 hashes a token using MD5 (matching Python hashlib.md5)
-func hashTokenMD5(token string) string {
-	hash := md5.Sum([]byte(token))
-	return hex.EncodeToString(hash[:])
-}
-
 // generateRecoveryCode generates an 8-character recovery code
 func generateRecoveryCode() (string, error) {
 	const charset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
@@ -54,22 +50,33 @@ func generateRecoveryCode() (string, error) {
 	return string(bytes), nil
 }
 
-// generateTwoFactorSecret generates a TOTP secret
-func generateTwoFactorSecret() (string, error) {
-	secret, err := totp.Generate(totp.GenerateOpts{
-		Issuer:      "auth-system",
-		AccountName: "user@example.com", // Will be updated per user
-		SecretSize:  32,
-	})
+// generateNumericCode generates a cryptographically secure random code of the given number of digits
+func generateNumericCode(digits int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	n, err := rand.Int(rand.Reader, max)
 	if err != nil {
+		return "", fmt.Errorf("failed to generate numeric code: %w", err)
+	}
+
+	return fmt.Sprintf("%0*d", digits, n), nil
+}
+
+// generateTwoFactorSecret generates a base32 encoded TOTP secret
+//
+// The secret is not bound to an issuer or account, the otpauth URI is built for
+// the account when enrollment starts.
+func generateTwoFactorSecret() (string, error) {
+	bytes := make([]byte, 20)
+	if _, err := rand.Read(bytes); err != nil {
 		return "", fmt.Errorf("failed to generate 2FA secret: %w", err)
 	}
-	return secret.Secret(), nil
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(bytes), nil
 }
 
 // SessionRepo interface defines methods for session management
 type SessionRepo interface {
-	Create(ctx context.Context, accountId int64, userAgent string, ipAddress string) (string, error)
+	Create(ctx context.Context, accountId int64, userAgent string, ipAddress string, device Device, location *geoip.Location, rememberMe bool, expiresAt time.Time) (string, error)
 	Get(ctx context.Context, token string, fetchAccount bool) (*Session, error)
 	GetBySessionAccountId(ctx context.Context, sessionId int64, accountId int64, exceptSessionToken string) (*Session, error)
 	GetAllList(ctx context.Context, accountId int64, exceptSessionToken string) ([]*Session, error)
@@ -78,6 +85,10 @@ type SessionRepo interface {
 	Delete(ctx context.Context, session *Session) error
 	DeleteMany(ctx context.Context, sessionIds []int64) error
 	DeleteAll(ctx context.Context, accountId int64) error
+	UpdateSudoModeExpiresAt(ctx context.Context, session *Session, sudoModeExpiresAt *time.Time) error
+	RevokeAllSudoMode(ctx context.Context, accountId int64) error
+	Touch(ctx context.Context, session *Session, lastActiveAt time.Time, expiresAt time.Time) error
+	GetById(ctx context.Context, sessionId int64) (*Session, error)
 
 	// Static methods for token operations
 	GenerateSessionToken() (string, error)
@@ -86,11 +97,12 @@ type SessionRepo interface {
 
 // Session repository implementation
 type sessionRepo struct {
-	db *bun.DB
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
 }
 
-func NewSessionRepo(db *bun.DB) SessionRepo {
-	return &sessionRepo{db: db}
+func NewSessionRepo(db *bun.DB, hasher tokenhash.TokenHasher) SessionRepo {
+	return &sessionRepo{db: db, hasher: hasher}
 }
 
 // Static methods
@@ -99,23 +111,36 @@ func (r *sessionRepo) GenerateSessionToken() (string, error) {
 }
 
 func (r *sessionRepo) HashSessionToken(token string) string {
-	return hashTokenMD5(token)
+	return r.hasher.Hash(token)
 }
 
 // Session management
-func (r *sessionRepo) Create(ctx context.Context, accountId int64, userAgent string, ipAddress string) (string, error) {
+
+// Create creates a session expiring at the given time, as computed from its SessionPolicy
+//
+// The device and location describe the user agent and IP address, the location is nil if unknown.
+func (r *sessionRepo) Create(ctx context.Context, accountId int64, userAgent string, ipAddress string, device Device, location *geoip.Location, rememberMe bool, expiresAt time.Time) (string, error) {
 	sessionToken, err := r.GenerateSessionToken()
 	if err != nil {
 		return "", fmt.Errorf("failed to generate session token: %w", err)
 	}
 
-	expiresAt := time.Now().Add(24 * time.Hour) // 24 hour session
 	session := &Session{
-		TokenHash: r.HashSessionToken(sessionToken),
-		UserAgent: userAgent,
-		IPAddress: ipAddress,
-		ExpiresAt: expiresAt.Unix(),
-		AccountId: accountId,
+		TokenHash:    r.HashSessionToken(sessionToken),
+		UserAgent:    userAgent,
+		IPAddress:    ipAddress,
+		ExpiresAt:    expiresAt.Unix(),
+		AccountId:    accountId,
+		LastActiveAt: time.Now(),
+		RememberMe:   rememberMe,
+		Browser:      device.Browser,
+		OS:           device.OS,
+		DeviceType:   device.Type,
+	}
+	if location != nil {
+		session.City = location.City
+		session.Country = location.Country
+		session.CountryCode = location.CountryCode
 	}
 
 	_, err = r.db.NewInsert().
@@ -133,7 +158,7 @@ func (r *sessionRepo) Get(ctx context.Context, token string, fetchAccount bool)
 	session := &Session{}
 	query := r.db.NewSelect().
 		Model(session).
-		Where("token_hash = ?", r.HashSessionToken(token))
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(token)))
 
 	if fetchAccount {
 		query = query.Relation("Account")
@@ -152,6 +177,10 @@ func (r *sessionRepo) Get(ctx context.Context, token string, fetchAccount bool)
 		return nil, ErrTokenExpired
 	}
 
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, session, "token_hash", &session.TokenHash, token); err != nil {
+		return nil, err
+	}
+
 	return session, nil
 }
 
@@ -163,7 +192,7 @@ func (r *sessionRepo) GetBySessionAccountId(ctx context.Context, sessionId int64
 		Where("account_id = ?", accountId)
 
 	if exceptSessionToken != "" {
-		query = query.Where("token_hash != ?", r.HashSessionToken(exceptSessionToken))
+		query = query.Where("token_hash NOT IN (?)", bun.In(r.hasher.Candidates(exceptSessionToken)))
 	}
 
 	err := query.Scan(ctx)
@@ -184,7 +213,7 @@ func (r *sessionRepo) GetAllList(ctx context.Context, accountId int64, exceptSes
 		Where("account_id = ?", accountId)
 
 	if exceptSessionToken != "" {
-		query = query.Where("token_hash != ?", r.HashSessionToken(exceptSessionToken))
+		query = query.Where("token_hash NOT IN (?)", bun.In(r.hasher.Candidates(exceptSessionToken)))
 	}
 
 	err := query.Order("created_at DESC").Scan(ctx)
@@ -202,7 +231,7 @@ func (r *sessionRepo) GetAllByAccountId(ctx context.Context, accountId int64, ex
 		Where("account_id = ?", accountId)
 
 	if exceptSessionToken != "" {
-		query = query.Where("token_hash != ?", r.HashSessionToken(exceptSessionToken))
+		query = query.Where("token_hash NOT IN (?)", bun.In(r.hasher.Candidates(exceptSessionToken)))
 	}
 
 	// Apply pagination using simplified API
@@ -230,155 +259,1024 @@ func (r *sessionRepo) GetAllByAccountId(ctx context.Context, accountId int64, ex
 	return &result, nil
 }
 
-func (r *sessionRepo) DeleteByToken(ctx context.Context, token string) error {
-	_, err := r.db.NewDelete().
-		Model((*Session)(nil)).
-		Where("token_hash = ?", r.HashSessionToken(token)).
+func (r *sessionRepo) DeleteByToken(ctx context.Context, token string) error {
+	_, err := r.db.NewDelete().
+		Model((*Session)(nil)).
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(token))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete session by token: %w", err)
+	}
+	return nil
+}
+
+func (r *sessionRepo) Delete(ctx context.Context, session *Session) error {
+	_, err := r.db.NewDelete().
+		Model(session).
+		Where("id = ?", session.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+func (r *sessionRepo) DeleteMany(ctx context.Context, sessionIds []int64) error {
+	if len(sessionIds) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewDelete().
+		Model((*Session)(nil)).
+		Where("id IN (?)", bun.In(sessionIds)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete many sessions: %w", err)
+	}
+	return nil
+}
+
+func (r *sessionRepo) DeleteAll(ctx context.Context, accountId int64) error {
+	_, err := r.db.NewDelete().
+		Model((*Session)(nil)).
+		Where("account_id = ?", accountId).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete all sessions for account: %w", err)
+	}
+	return nil
+}
+
+// UpdateSudoModeExpiresAt records a sudo mode grant on the session, a nil expiry revokes it
+func (r *sessionRepo) UpdateSudoModeExpiresAt(ctx context.Context, session *Session, sudoModeExpiresAt *time.Time) error {
+	session.SudoModeExpiresAt = sudoModeExpiresAt
+	session.UpdatedAt = time.Now()
+
+	_, err := r.db.NewUpdate().
+		Model(session).
+		Column("sudo_mode_expires_at", "updated_at").
+		Where("id = ?", session.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update session sudo mode: %w", err)
+	}
+	return nil
+}
+
+// RevokeAllSudoMode revokes the sudo mode grants of all of the account's sessions
+func (r *sessionRepo) RevokeAllSudoMode(ctx context.Context, accountId int64) error {
+	_, err := r.db.NewUpdate().
+		Model((*Session)(nil)).
+		Set("sudo_mode_expires_at = NULL").
+		Set("updated_at = ?", time.Now()).
+		Where("account_id = ?", accountId).
+		Where("sudo_mode_expires_at IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sudo mode for account: %w", err)
+	}
+	return nil
+}
+
+// Touch records the use of the session and slides its expiry
+func (r *sessionRepo) Touch(ctx context.Context, session *Session, lastActiveAt time.Time, expiresAt time.Time) error {
+	session.LastActiveAt = lastActiveAt
+	session.ExpiresAt = expiresAt.Unix()
+
+	_, err := r.db.NewUpdate().
+		Model(session).
+		Column("last_active_at", "expires_at").
+		Where("id = ?", session.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update session activity: %w", err)
+	}
+	return nil
+}
+
+// GetById returns the unexpired session with the given ID, with its account loaded
+func (r *sessionRepo) GetById(ctx context.Context, sessionId int64) (*Session, error) {
+	session := &Session{}
+	err := r.db.NewSelect().
+		Model(session).
+		Where("ses.id = ?", sessionId).
+		Relation("Account").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to get session by ID: %w", err)
+	}
+
+	// Check if session is expired
+	if time.Now().Unix() > session.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	return session, nil
+}
+
+// PasswordResetTokenRepo interface defines methods for password reset token management
+type PasswordResetTokenRepo interface {
+	Create(ctx context.Context, accountId int64) (string, error)
+	Get(ctx context.Context, token string, email string) (*PasswordResetToken, error)
+	GetByAccount(ctx context.Context, accountId int64) (*PasswordResetToken, error)
+	Delete(ctx context.Context, token *PasswordResetToken) error
+
+	// Static methods for token operations
+	GeneratePasswordResetToken() (string, error)
+	HashPasswordResetToken(token string) string
+}
+
+// Password reset token repository implementation
+type passwordResetTokenRepo struct {
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
+}
+
+func NewPasswordResetTokenRepo(db *bun.DB, hasher tokenhash.TokenHasher) PasswordResetTokenRepo {
+	return &passwordResetTokenRepo{db: db, hasher: hasher}
+}
+
+// Static methods
+func (r *passwordResetTokenRepo) GeneratePasswordResetToken() (string, error) {
+	return generateSecureToken(32)
+}
+
+func (r *passwordResetTokenRepo) HashPasswordResetToken(token string) string {
+	return r.hasher.Hash(token)
+}
+
+func (r *passwordResetTokenRepo) Create(ctx context.Context, accountId int64) (string, error) {
+	token, err := r.GeneratePasswordResetToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate password reset token: %w", err)
+	}
+
+	expiresAt := time.Now().Add(1 * time.Hour) // 1 hour expiry
+	passwordResetToken := &PasswordResetToken{
+		TokenHash: r.HashPasswordResetToken(token),
+		ExpiresAt: expiresAt.Unix(),
+		AccountId: accountId,
+	}
+
+	_, err = r.db.NewInsert().
+		Model(passwordResetToken).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (r *passwordResetTokenRepo) Get(ctx context.Context, token string, email string) (*PasswordResetToken, error) {
+	passwordResetToken := &PasswordResetToken{}
+	err := r.db.NewSelect().
+		Model(passwordResetToken).
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(token))).
+		Relation("Account", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("email = ?", email)
+		}).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPasswordResetTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+	// Check if token is expired
+	if time.Now().Unix() > passwordResetToken.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, passwordResetToken, "token_hash", &passwordResetToken.TokenHash, token); err != nil {
+		return nil, err
+	}
+
+	return passwordResetToken, nil
+}
+
+func (r *passwordResetTokenRepo) GetByAccount(ctx context.Context, accountId int64) (*PasswordResetToken, error) {
+	passwordResetToken := &PasswordResetToken{}
+	err := r.db.NewSelect().
+		Model(passwordResetToken).
+		Where("account_id = ?", accountId).
+		Relation("Account").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPasswordResetTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get password reset token by account: %w", err)
+	}
+
+	return passwordResetToken, nil
+}
+
+func (r *passwordResetTokenRepo) Delete(ctx context.Context, token *PasswordResetToken) error {
+	_, err := r.db.NewDelete().
+		Model(token).
+		Where("id = ?", token.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete password reset token: %w", err)
+	}
+	return nil
+}
+
+// SessionRevocationTokenRepo interface defines methods for session revocation token management
+type SessionRevocationTokenRepo interface {
+	Create(ctx context.Context, accountId int64, expiresAt time.Time) (string, error)
+	Get(ctx context.Context, token string) (*SessionRevocationToken, error)
+	DeleteAll(ctx context.Context, accountId int64) error
+
+	// Static methods for token operations
+	GenerateSessionRevocationToken() (string, error)
+	HashSessionRevocationToken(token string) string
+}
+
+// Session revocation token repository implementation
+type sessionRevocationTokenRepo struct {
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
+}
+
+func NewSessionRevocationTokenRepo(db *bun.DB, hasher tokenhash.TokenHasher) SessionRevocationTokenRepo {
+	return &sessionRevocationTokenRepo{db: db, hasher: hasher}
+}
+
+// Static methods
+func (r *sessionRevocationTokenRepo) GenerateSessionRevocationToken() (string, error) {
+	return generateSecureToken(32)
+}
+
+func (r *sessionRevocationTokenRepo) HashSessionRevocationToken(token string) string {
+	return r.hasher.Hash(token)
+}
+
+func (r *sessionRevocationTokenRepo) Create(ctx context.Context, accountId int64, expiresAt time.Time) (string, error) {
+	token, err := r.GenerateSessionRevocationToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate session revocation token: %w", err)
+	}
+
+	revocationToken := &SessionRevocationToken{
+		TokenHash: r.HashSessionRevocationToken(token),
+		ExpiresAt: expiresAt.Unix(),
+		AccountId: accountId,
+	}
+
+	_, err = r.db.NewInsert().
+		Model(revocationToken).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create session revocation token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (r *sessionRevocationTokenRepo) Get(ctx context.Context, token string) (*SessionRevocationToken, error) {
+	revocationToken := &SessionRevocationToken{}
+	err := r.db.NewSelect().
+		Model(revocationToken).
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(token))).
+		Relation("Account").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSessionRevocationTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get session revocation token: %w", err)
+	}
+	// Check if token is expired
+	if time.Now().Unix() > revocationToken.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, revocationToken, "token_hash", &revocationToken.TokenHash, token); err != nil {
+		return nil, err
+	}
+
+	return revocationToken, nil
+}
+
+func (r *sessionRevocationTokenRepo) DeleteAll(ctx context.Context, accountId int64) error {
+	_, err := r.db.NewDelete().
+		Model((*SessionRevocationToken)(nil)).
+		Where("account_id = ?", accountId).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete session revocation tokens: %w", err)
+	}
+	return nil
+}
+
+// BearerTokenRepo interface defines methods for the access and refresh tokens of native clients
+type BearerTokenRepo interface {
+	Create(ctx context.Context, sessionId int64, accessTokenExpiresAt time.Time, refreshTokenExpiresAt time.Time) (string, string, error)
+	GetAccessToken(ctx context.Context, token string) (*AccessToken, error)
+	GetRefreshToken(ctx context.Context, token string) (*RefreshToken, error)
+	MarkRefreshTokenUsed(ctx context.Context, refreshToken *RefreshToken) (bool, error)
+	DeleteAllBySession(ctx context.Context, sessionId int64) error
+
+	// Static methods for token operations
+	GenerateBearerToken() (string, error)
+	HashBearerToken(token string) string
+}
+
+// Bearer token repository implementation
+type bearerTokenRepo struct {
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
+}
+
+func NewBearerTokenRepo(db *bun.DB, hasher tokenhash.TokenHasher) BearerTokenRepo {
+	return &bearerTokenRepo{db: db, hasher: hasher}
+}
+
+// Static methods
+func (r *bearerTokenRepo) GenerateBearerToken() (string, error) {
+	return generateSecureToken(32)
+}
+
+func (r *bearerTokenRepo) HashBearerToken(token string) string {
+	return r.hasher.Hash(token)
+}
+
+// Create issues an access token and a refresh token for the session
+//
+// Returns:
+//   - string: The access token
+//   - string: The refresh token
+func (r *bearerTokenRepo) Create(ctx context.Context, sessionId int64, accessTokenExpiresAt time.Time, refreshTokenExpiresAt time.Time) (string, string, error) {
+	accessToken, err := r.GenerateBearerToken()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate access token: %w", err)
+	}
+	refreshToken, err := r.GenerateBearerToken()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	err = r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().
+			Model(&AccessToken{
+				TokenHash: r.HashBearerToken(accessToken),
+				ExpiresAt: accessTokenExpiresAt.Unix(),
+				SessionId: sessionId,
+			}).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create access token: %w", err)
+		}
+
+		if _, err := tx.NewInsert().
+			Model(&RefreshToken{
+				TokenHash: r.HashBearerToken(refreshToken),
+				ExpiresAt: refreshTokenExpiresAt.Unix(),
+				SessionId: sessionId,
+			}).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create refresh token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+func (r *bearerTokenRepo) GetAccessToken(ctx context.Context, token string) (*AccessToken, error) {
+	accessToken := &AccessToken{}
+	err := r.db.NewSelect().
+		Model(accessToken).
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(token))).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrBearerTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get access token: %w", err)
+	}
+	// Check if token is expired
+	if time.Now().Unix() > accessToken.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, accessToken, "token_hash", &accessToken.TokenHash, token); err != nil {
+		return nil, err
+	}
+
+	return accessToken, nil
+}
+
+// GetRefreshToken returns an unexpired refresh token, used or not, so that reuse can be detected
+func (r *bearerTokenRepo) GetRefreshToken(ctx context.Context, token string) (*RefreshToken, error) {
+	refreshToken := &RefreshToken{}
+	err := r.db.NewSelect().
+		Model(refreshToken).
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(token))).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrBearerTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+	// Check if token is expired
+	if time.Now().Unix() > refreshToken.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, refreshToken, "token_hash", &refreshToken.TokenHash, token); err != nil {
+		return nil, err
+	}
+
+	return refreshToken, nil
+}
+
+// MarkRefreshTokenUsed records the use of a refresh token
+//
+// Only one of concurrent uses succeeds, the others are told the token was already used.
+//
+// Returns:
+//   - bool: Whether the token was unused until now
+func (r *bearerTokenRepo) MarkRefreshTokenUsed(ctx context.Context, refreshToken *RefreshToken) (bool, error) {
+	usedAt := time.Now()
+	result, err := r.db.NewUpdate().
+		Model((*RefreshToken)(nil)).
+		Set("used_at = ?", usedAt).
+		Set("updated_at = ?", usedAt).
+		Where("id = ?", refreshToken.ID).
+		Where("used_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark refresh token used: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark refresh token used: %w", err)
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	refreshToken.UsedAt = &usedAt
+	return true, nil
+}
+
+// DeleteAllBySession deletes the access and refresh tokens of the session
+func (r *bearerTokenRepo) DeleteAllBySession(ctx context.Context, sessionId int64) error {
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*AccessToken)(nil)).
+			Where("session_id = ?", sessionId).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete access tokens: %w", err)
+		}
+
+		if _, err := tx.NewDelete().
+			Model((*RefreshToken)(nil)).
+			Where("session_id = ?", sessionId).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete refresh tokens: %w", err)
+		}
+		return nil
+	})
+}
+
+// PersonalAccessTokenRepo interface defines methods for personal access token management
+type PersonalAccessTokenRepo interface {
+	Create(ctx context.Context, accountId int64, name string, scopes []string, expiresAt *time.Time) (*PersonalAccessToken, string, error)
+	Get(ctx context.Context, token string) (*PersonalAccessToken, error)
+	GetByAccountTokenId(ctx context.Context, accountId int64, personalAccessTokenId int64) (*PersonalAccessToken, error)
+	GetAllByAccountId(ctx context.Context, accountId int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*PersonalAccessToken, int64], error)
+	UpdateLastUsedAt(ctx context.Context, personalAccessToken *PersonalAccessToken, lastUsedAt time.Time) error
+	Delete(ctx context.Context, personalAccessToken *PersonalAccessToken) error
+	DeleteAll(ctx context.Context, accountId int64) error
+
+	// Static methods for token operations
+	GeneratePersonalAccessToken() (string, error)
+	HashPersonalAccessToken(token string) string
+}
+
+// Personal access token repository implementation
+type personalAccessTokenRepo struct {
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
+}
+
+func NewPersonalAccessTokenRepo(db *bun.DB, hasher tokenhash.TokenHasher) PersonalAccessTokenRepo {
+	return &personalAccessTokenRepo{db: db, hasher: hasher}
+}
+
+// Static methods
+func (r *personalAccessTokenRepo) GeneratePersonalAccessToken() (string, error) {
+	token, err := generateSecureToken(32)
+	if err != nil {
+		return "", err
+	}
+	return PersonalAccessTokenPrefix + token, nil
+}
+
+func (r *personalAccessTokenRepo) HashPersonalAccessToken(token string) string {
+	return r.hasher.Hash(token)
+}
+
+// Create creates a personal access token, a nil expiry meaning it never expires
+//
+// Returns:
+//   - *PersonalAccessToken: The created token
+//   - string: The token itself, only ever available now
+func (r *personalAccessTokenRepo) Create(ctx context.Context, accountId int64, name string, scopes []string, expiresAt *time.Time) (*PersonalAccessToken, string, error) {
+	token, err := r.GeneratePersonalAccessToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate personal access token: %w", err)
+	}
+
+	personalAccessToken := &PersonalAccessToken{
+		Name:      name,
+		TokenHash: r.HashPersonalAccessToken(token),
+		Scopes:    scopes,
+		AccountId: accountId,
+		ExpiresAt: expiresAt,
+	}
+
+	_, err = r.db.NewInsert().
+		Model(personalAccessToken).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	return personalAccessToken, token, nil
+}
+
+// Get returns the unexpired personal access token, with its account loaded
+func (r *personalAccessTokenRepo) Get(ctx context.Context, token string) (*PersonalAccessToken, error) {
+	personalAccessToken := &PersonalAccessToken{}
+	err := r.db.NewSelect().
+		Model(personalAccessToken).
+		Where("token_hash IN (?)", bun.In(r.hasher.Candidates(token))).
+		Relation("Account").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPersonalAccessTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get personal access token: %w", err)
+	}
+	// Check if token is expired
+	if personalAccessToken.ExpiresAt != nil && time.Now().After(*personalAccessToken.ExpiresAt) {
+		return nil, ErrTokenExpired
+	}
+
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, personalAccessToken, "token_hash", &personalAccessToken.TokenHash, token); err != nil {
+		return nil, err
+	}
+
+	return personalAccessToken, nil
+}
+
+func (r *personalAccessTokenRepo) GetByAccountTokenId(ctx context.Context, accountId int64, personalAccessTokenId int64) (*PersonalAccessToken, error) {
+	personalAccessToken := &PersonalAccessToken{}
+	err := r.db.NewSelect().
+		Model(personalAccessToken).
+		Where("id = ?", personalAccessTokenId).
+		Where("account_id = ?", accountId).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPersonalAccessTokenNotFound
+		}
+		return nil, fmt.Errorf("failed to get personal access token by ID: %w", err)
+	}
+
+	return personalAccessToken, nil
+}
+
+func (r *personalAccessTokenRepo) GetAllByAccountId(ctx context.Context, accountId int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*PersonalAccessToken, int64], error) {
+	personalAccessTokens := make([]*PersonalAccessToken, 0)
+	query := r.db.NewSelect().
+		Model(&personalAccessTokens).
+		Where("account_id = ?", accountId)
+
+	paginationOptions := db.PaginationOptions{
+		First:  first,
+		Last:   last,
+		After:  after,
+		Before: before,
+	}
+
+	if err := db.ValidatePagination(paginationOptions); err != nil {
+		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	query = db.ApplyPagination(query, paginationOptions)
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paginated personal access tokens: %w", err)
+	}
+
+	result := db.ProcessPaginatedResult[*PersonalAccessToken, int64](personalAccessTokens, first, last)
+	return &result, nil
+}
+
+// UpdateLastUsedAt records the use of the personal access token
+func (r *personalAccessTokenRepo) UpdateLastUsedAt(ctx context.Context, personalAccessToken *PersonalAccessToken, lastUsedAt time.Time) error {
+	personalAccessToken.LastUsedAt = &lastUsedAt
+
+	_, err := r.db.NewUpdate().
+		Model(personalAccessToken).
+		Column("last_used_at").
+		Where("id = ?", personalAccessToken.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update personal access token last use: %w", err)
+	}
+	return nil
+}
+
+func (r *personalAccessTokenRepo) Delete(ctx context.Context, personalAccessToken *PersonalAccessToken) error {
+	_, err := r.db.NewDelete().
+		Model(personalAccessToken).
+		Where("id = ?", personalAccessToken.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete personal access token: %w", err)
+	}
+	return nil
+}
+
+func (r *personalAccessTokenRepo) DeleteAll(ctx context.Context, accountId int64) error {
+	_, err := r.db.NewDelete().
+		Model((*PersonalAccessToken)(nil)).
+		Where("account_id = ?", accountId).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete personal access tokens: %w", err)
+	}
+	return nil
+}
+
+// EmailLoginCodeRepo interface defines methods for email login code management
+type EmailLoginCodeRepo interface {
+	Create(ctx context.Context, accountId int64) (string, string, error)
+	GetByAccount(ctx context.Context, accountId int64) (*EmailLoginCode, error)
+	IncrementAttempts(ctx context.Context, loginCode *EmailLoginCode, maxAttempts int) error
+	Delete(ctx context.Context, loginCode *EmailLoginCode) error
+
+	// Static methods for code operations
+	GenerateLoginCode() (string, error)
+	GenerateLoginToken() (string, error)
+	HashLoginCode(code string) string
+	VerifyLoginCode(code string, hash string) bool
+}
+
+// Email login code repository implementation
+type emailLoginCodeRepo struct {
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
+}
+
+func NewEmailLoginCodeRepo(db *bun.DB, hasher tokenhash.TokenHasher) EmailLoginCodeRepo {
+	return &emailLoginCodeRepo{db: db, hasher: hasher}
+}
+
+// Static methods
+func (r *emailLoginCodeRepo) GenerateLoginCode() (string, error) {
+	return generateNumericCode(EmailLoginCodeLength)
+}
+
+func (r *emailLoginCodeRepo) GenerateLoginToken() (string, error) {
+	return generateSecureToken(32)
+}
+
+func (r *emailLoginCodeRepo) HashLoginCode(code string) string {
+	return r.hasher.Hash(code)
+}
+
+// VerifyLoginCode compares a code or login link token with a stored hash in constant time
+func (r *emailLoginCodeRepo) VerifyLoginCode(code string, hash string) bool {
+	return r.hasher.Verify(code, hash)
+}
+
+// Create creates a login code for the account and returns the code and its login link token
+func (r *emailLoginCodeRepo) Create(ctx context.Context, accountId int64) (string, string, error) {
+	code, err := r.GenerateLoginCode()
+	if err != nil {
+		return "", "", err
+	}
+
+	token, err := r.GenerateLoginToken()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate email login token: %w", err)
+	}
+
+	expiresAt := time.Now().Add(EmailLoginCodeLifetime)
+	loginCode := &EmailLoginCode{
+		CodeHash:  r.HashLoginCode(code),
+		TokenHash: r.HashLoginCode(token),
+		ExpiresAt: expiresAt.Unix(),
+		AccountId: accountId,
+	}
+
+	_, err = r.db.NewInsert().
+		Model(loginCode).
+		Returning("*").
 		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete session by token: %w", err)
+		return "", "", fmt.Errorf("failed to create email login code: %w", err)
 	}
-	return nil
+
+	return code, token, nil
 }
 
-func (r *sessionRepo) Delete(ctx context.Context, session *Session) error {
-	_, err := r.db.NewDelete().
-		Model(session).
-		Where("id = ?", session.ID).
-		Exec(ctx)
+func (r *emailLoginCodeRepo) GetByAccount(ctx context.Context, accountId int64) (*EmailLoginCode, error) {
+	loginCode := &EmailLoginCode{}
+	err := r.db.NewSelect().
+		Model(loginCode).
+		Where("account_id = ?", accountId).
+		Scan(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete session: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEmailLoginCodeNotFound
+		}
+		return nil, fmt.Errorf("failed to get email login code by account: %w", err)
 	}
-	return nil
-}
 
-func (r *sessionRepo) DeleteMany(ctx context.Context, sessionIds []int64) error {
-	if len(sessionIds) == 0 {
-		return nil
-	}
+	return loginCode, nil
+}
 
-	_, err := r.db.NewDelete().
-		Model((*Session)(nil)).
-		Where("id IN (?)", bun.In(sessionIds)).
-		Exec(ctx)
+// IncrementAttempts records an attempt at the login code, unless it already had maxAttempts
+//
+// The attempt is counted before the code is checked, so that concurrent guesses can't exceed
+// the limit. Codes without attempts left, or already deleted, return ErrEmailLoginCodeNotFound.
+func (r *emailLoginCodeRepo) IncrementAttempts(ctx context.Context, loginCode *EmailLoginCode, maxAttempts int) error {
+	err := r.db.NewUpdate().
+		Model(loginCode).
+		Set("attempts = attempts + 1").
+		Where("id = ?", loginCode.ID).
+		Where("attempts < ?", maxAttempts).
+		Returning("attempts").
+		Scan(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete many sessions: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrEmailLoginCodeNotFound
+		}
+		return fmt.Errorf("failed to increment email login code attempts: %w", err)
 	}
 	return nil
 }
 
-func (r *sessionRepo) DeleteAll(ctx context.Context, accountId int64) error {
+func (r *emailLoginCodeRepo) Delete(ctx context.Context, loginCode *EmailLoginCode) error {
 	_, err := r.db.NewDelete().
-		Model((*Session)(nil)).
-		Where("account_id = ?", accountId).
+		Model(loginCode).
+		Where("id = ?", loginCode.ID).
 		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete all sessions for account: %w", err)
+		return fmt.Errorf("failed to delete email login code: %w", err)
 	}
 	return nil
 }
 
-// PasswordResetTokenRepo interface defines methods for password reset token management
-type PasswordResetTokenRepo interface {
-	Create(ctx context.Context, accountId int64) (string, error)
-	Get(ctx context.Context, token string, email string) (*PasswordResetToken, error)
-	GetByAccount(ctx context.Context, accountId int64) (*PasswordResetToken, error)
-	Delete(ctx context.Context, token *PasswordResetToken) error
+// SmsLoginCodeRepo interface defines methods for SMS login code management
+type SmsLoginCodeRepo interface {
+	Create(ctx context.Context, accountId int64, phoneNumber string) (string, error)
+	GetByPhoneNumber(ctx context.Context, phoneNumber string) (*SmsLoginCode, error)
+	IncrementAttempts(ctx context.Context, loginCode *SmsLoginCode, maxAttempts int) error
+	Delete(ctx context.Context, loginCode *SmsLoginCode) error
 
-	// Static methods for token operations
-	GeneratePasswordResetToken() (string, error)
-	HashPasswordResetToken(token string) string
+	// Static methods for code operations
+	GenerateLoginCode() (string, error)
+	HashLoginCode(code string) string
+	VerifyLoginCode(code string, hash string) bool
 }
 
-// Password reset token repository implementation
-type passwordResetTokenRepo struct {
-	db *bun.DB
+// SMS login code repository implementation
+type smsLoginCodeRepo struct {
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
 }
 
-func NewPasswordResetTokenRepo(db *bun.DB) PasswordResetTokenRepo {
-	return &passwordResetTokenRepo{db: db}
+func NewSmsLoginCodeRepo(db *bun.DB, hasher tokenhash.TokenHasher) SmsLoginCodeRepo {
+	return &smsLoginCodeRepo{db: db, hasher: hasher}
 }
 
 // Static methods
-func (r *passwordResetTokenRepo) GeneratePasswordResetToken() (string, error) {
-	return generateSecureToken(32)
+func (r *smsLoginCodeRepo) GenerateLoginCode() (string, error) {
+	return generateNumericCode(SmsLoginCodeLength)
 }
 
-func (r *passwordResetTokenRepo) HashPasswordResetToken(token string) string {
-	return hashTokenMD5(token)
+func (r *smsLoginCodeRepo) HashLoginCode(code string) string {
+	return r.hasher.Hash(code)
 }
 
-func (r *passwordResetTokenRepo) Create(ctx context.Context, accountId int64) (string, error) {
-	token, err := r.GeneratePasswordResetToken()
+// VerifyLoginCode compares a code with a stored hash in constant time
+func (r *smsLoginCodeRepo) VerifyLoginCode(code string, hash string) bool {
+	return r.hasher.Verify(code, hash)
+}
+
+func (r *smsLoginCodeRepo) Create(ctx context.Context, accountId int64, phoneNumber string) (string, error) {
+	code, err := r.GenerateLoginCode()
 	if err != nil {
-		return "", fmt.Errorf("failed to generate password reset token: %w", err)
+		return "", err
 	}
 
-	expiresAt := time.Now().Add(1 * time.Hour) // 1 hour expiry
-	passwordResetToken := &PasswordResetToken{
-		TokenHash: r.HashPasswordResetToken(token),
-		ExpiresAt: expiresAt.Unix(),
-		AccountId: accountId,
+	expiresAt := time.Now().Add(SmsLoginCodeLifetime)
+	loginCode := &SmsLoginCode{
+		PhoneNumber: phoneNumber,
+		CodeHash:    r.HashLoginCode(code),
+		ExpiresAt:   expiresAt.Unix(),
+		AccountId:   accountId,
 	}
 
 	_, err = r.db.NewInsert().
-		Model(passwordResetToken).
+		Model(loginCode).
 		Returning("*").
 		Exec(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to create password reset token: %w", err)
+		return "", fmt.Errorf("failed to create sms login code: %w", err)
 	}
 
-	return token, nil
+	return code, nil
 }
 
-func (r *passwordResetTokenRepo) Get(ctx context.Context, token string, email string) (*PasswordResetToken, error) {
-	passwordResetToken := &PasswordResetToken{}
+func (r *smsLoginCodeRepo) GetByPhoneNumber(ctx context.Context, phoneNumber string) (*SmsLoginCode, error) {
+	loginCode := &SmsLoginCode{}
 	err := r.db.NewSelect().
-		Model(passwordResetToken).
-		Where("token_hash = ?", r.HashPasswordResetToken(token)).
-		Relation("Account", func(q *bun.SelectQuery) *bun.SelectQuery {
-			return q.Where("email = ?", email)
-		}).
+		Model(loginCode).
+		Where("phone_number = ?", phoneNumber).
 		Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrPasswordResetTokenNotFound
+			return nil, ErrSmsLoginCodeNotFound
 		}
-		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+		return nil, fmt.Errorf("failed to get sms login code by phone number: %w", err)
 	}
-	// Check if token is expired
-	if time.Now().Unix() > passwordResetToken.ExpiresAt {
-		return nil, ErrTokenExpired
+
+	return loginCode, nil
+}
+
+// IncrementAttempts records an attempt at the login code, unless it already had maxAttempts
+//
+// The attempt is counted before the code is checked, so that concurrent guesses can't exceed
+// the limit. Codes without attempts left, or already deleted, return ErrSmsLoginCodeNotFound.
+func (r *smsLoginCodeRepo) IncrementAttempts(ctx context.Context, loginCode *SmsLoginCode, maxAttempts int) error {
+	err := r.db.NewUpdate().
+		Model(loginCode).
+		Set("attempts = attempts + 1").
+		Where("id = ?", loginCode.ID).
+		Where("attempts < ?", maxAttempts).
+		Returning("attempts").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSmsLoginCodeNotFound
+		}
+		return fmt.Errorf("failed to increment sms login code attempts: %w", err)
 	}
+	return nil
+}
 
-	return passwordResetToken, nil
+func (r *smsLoginCodeRepo) Delete(ctx context.Context, loginCode *SmsLoginCode) error {
+	_, err := r.db.NewDelete().
+		Model(loginCode).
+		Where("id = ?", loginCode.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete sms login code: %w", err)
+	}
+	return nil
 }
 
-func (r *passwordResetTokenRepo) GetByAccount(ctx context.Context, accountId int64) (*PasswordResetToken, error) {
-	passwordResetToken := &PasswordResetToken{}
+// AuthAttemptRepo interface defines methods for failed authentication attempt tracking
+type AuthAttemptRepo interface {
+	Get(ctx context.Context, scope string, key string) (*AuthAttempt, error)
+	RecordFailure(ctx context.Context, scope string, key string, window time.Duration) (*AuthAttempt, error)
+	Block(ctx context.Context, attempt *AuthAttempt, blockedUntil time.Time) error
+	Lock(ctx context.Context, attempt *AuthAttempt, lockedUntil time.Time) (string, error)
+	Delete(ctx context.Context, scope string, key string) error
+
+	// Static methods for token operations
+	VerifyUnlockToken(token string, hash string) bool
+}
+
+// Auth attempt repository implementation
+type authAttemptRepo struct {
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
+}
+
+func NewAuthAttemptRepo(db *bun.DB, hasher tokenhash.TokenHasher) AuthAttemptRepo {
+	return &authAttemptRepo{db: db, hasher: hasher}
+}
+
+// VerifyUnlockToken compares an unlock token with a stored hash in constant time
+func (r *authAttemptRepo) VerifyUnlockToken(token string, hash string) bool {
+	return r.hasher.Verify(token, hash)
+}
+
+func (r *authAttemptRepo) Get(ctx context.Context, scope string, key string) (*AuthAttempt, error) {
+	attempt := &AuthAttempt{}
 	err := r.db.NewSelect().
-		Model(passwordResetToken).
-		Where("account_id = ?", accountId).
-		Relation("Account").
+		Model(attempt).
+		Where("scope = ?", scope).
+		Where("key = ?", key).
 		Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrPasswordResetTokenNotFound
+			return nil, ErrAuthAttemptNotFound
 		}
-		return nil, fmt.Errorf("failed to get password reset token by account: %w", err)
+		return nil, fmt.Errorf("failed to get auth attempt: %w", err)
 	}
 
-	return passwordResetToken, nil
+	return attempt, nil
 }
 
-func (r *passwordResetTokenRepo) Delete(ctx context.Context, token *PasswordResetToken) error {
+// RecordFailure counts a failed attempt, starting over when the previous failure is older than the window
+func (r *authAttemptRepo) RecordFailure(ctx context.Context, scope string, key string, window time.Duration) (*AuthAttempt, error) {
+	now := time.Now()
+	attempt := &AuthAttempt{
+		Scope:        scope,
+		Key:          key,
+		Failures:     1,
+		LastFailedAt: now,
+	}
+
+	_, err := r.db.NewInsert().
+		Model(attempt).
+		On("CONFLICT (scope, key) DO UPDATE").
+		Set("failures = CASE WHEN aa.last_failed_at > ? THEN aa.failures + 1 ELSE 1 END", now.Add(-window)).
+		Set("last_failed_at = EXCLUDED.last_failed_at").
+		Set("updated_at = current_timestamp").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record failed auth attempt: %w", err)
+	}
+
+	return attempt, nil
+}
+
+// Block holds back the next attempt until the given time, replacing an expired lock
+func (r *authAttemptRepo) Block(ctx context.Context, attempt *AuthAttempt, blockedUntil time.Time) error {
+	attempt.BlockedUntil = &blockedUntil
+	attempt.UnlockTokenHash = nil
+	_, err := r.db.NewUpdate().
+		Model(attempt).
+		Column("blocked_until", "unlock_token_hash").
+		Where("id = ?", attempt.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to block auth attempts: %w", err)
+	}
+	return nil
+}
+
+// Lock blocks the attempts until the given time and returns a new unlock token
+//
+// The failures start over, so that attempts after the lock expires are backed off from the start.
+func (r *authAttemptRepo) Lock(ctx context.Context, attempt *AuthAttempt, lockedUntil time.Time) (string, error) {
+	token, err := generateSecureToken(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate unlock token: %w", err)
+	}
+
+	tokenHash := r.hasher.Hash(token)
+	attempt.Failures = 0
+	attempt.BlockedUntil = &lockedUntil
+	attempt.UnlockTokenHash = &tokenHash
+
+	_, err = r.db.NewUpdate().
+		Model(attempt).
+		Column("failures", "blocked_until", "unlock_token_hash").
+		Where("id = ?", attempt.ID).
+		Exec(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to lock auth attempts: %w", err)
+	}
+
+	return token, nil
+}
+
+func (r *authAttemptRepo) Delete(ctx context.Context, scope string, key string) error {
 	_, err := r.db.NewDelete().
-		Model(token).
-		Where("id = ?", token.ID).
+		Model((*AuthAttempt)(nil)).
+		Where("scope = ?", scope).
+		Where("key = ?", key).
 		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete password reset token: %w", err)
+		return fmt.Errorf("failed to delete auth attempts: %w", err)
 	}
 	return nil
 }
@@ -563,7 +1461,7 @@ func (r *webAuthnCredentialRepo) GetAllByAccountId(ctx context.Context, accountI
 
 // WebAuthnChallengeRepo interface defines methods for WebAuthn challenge management
 type WebAuthnChallengeRepo interface {
-	Create(ctx context.Context, challenge []byte, generatedAccountId int64) (*WebAuthnChallenge, error)
+	Create(ctx context.Context, challenge []byte, generatedAccountId int64, expiresAt time.Time) (*WebAuthnChallenge, error)
 	Get(ctx context.Context, challenge []byte) (*WebAuthnChallenge, error)
 	Delete(ctx context.Context, webauthnChallenge *WebAuthnChallenge) error
 }
@@ -577,8 +1475,7 @@ func NewWebAuthnChallengeRepo(db *bun.DB) WebAuthnChallengeRepo {
 	return &webAuthnChallengeRepo{db: db}
 }
 
-func (r *webAuthnChallengeRepo) Create(ctx context.Context, challenge []byte, generatedAccountId int64) (*WebAuthnChallenge, error) {
-	expiresAt := time.Now().Add(5 * time.Minute) // 5 minute expiry
+func (r *webAuthnChallengeRepo) Create(ctx context.Context, challenge []byte, generatedAccountId int64, expiresAt time.Time) (*WebAuthnChallenge, error) {
 	webauthnChallenge := &WebAuthnChallenge{
 		Challenge:          challenge,
 		ExpiresAt:          expiresAt.Unix(),
@@ -632,6 +1529,9 @@ type OAuthCredentialRepo interface {
 	Create(ctx context.Context, accountId int64, provider string, providerUserId string) (*OAuthCredential, error)
 	GetByProviderUser(ctx context.Context, provider string, providerUserId string, fetchAccount bool) (*OAuthCredential, error)
 	GetByAccountProvider(ctx context.Context, accountId int64, provider string, fetchAccount bool) (*OAuthCredential, error)
+	GetByAccountCredentialId(ctx context.Context, accountId int64, oauthCredentialId int64) (*OAuthCredential, error)
+	GetAllByAccountList(ctx context.Context, accountId int64) ([]*OAuthCredential, error)
+	GetAllByAccountId(ctx context.Context, accountId int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*OAuthCredential, int64], error)
 	Delete(ctx context.Context, credential *OAuthCredential) error
 }
 
@@ -678,7 +1578,7 @@ func (r *oAuthCredentialRepo) GetByProviderUser(ctx context.Context, provider st
 	err := query.Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrOAuthCredentialAlreadyExists
+			return nil, ErrOAuthCredentialNotFound
 		}
 		return nil, fmt.Errorf("failed to get oauth credential by provider user: %w", err)
 	}
@@ -700,7 +1600,7 @@ func (r *oAuthCredentialRepo) GetByAccountProvider(ctx context.Context, accountI
 	err := query.Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrOAuthCredentialAlreadyExists
+			return nil, ErrOAuthCredentialNotFound
 		}
 		return nil, fmt.Errorf("failed to get oauth credential by account provider: %w", err)
 	}
@@ -708,6 +1608,64 @@ func (r *oAuthCredentialRepo) GetByAccountProvider(ctx context.Context, accountI
 	return oauthCredential, nil
 }
 
+func (r *oAuthCredentialRepo) GetByAccountCredentialId(ctx context.Context, accountId int64, oauthCredentialId int64) (*OAuthCredential, error) {
+	oauthCredential := &OAuthCredential{}
+	err := r.db.NewSelect().
+		Model(oauthCredential).
+		Where("id = ?", oauthCredentialId).
+		Where("account_id = ?", accountId).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOAuthCredentialNotFound
+		}
+		return nil, fmt.Errorf("failed to get oauth credential by ID: %w", err)
+	}
+
+	return oauthCredential, nil
+}
+
+func (r *oAuthCredentialRepo) GetAllByAccountList(ctx context.Context, accountId int64) ([]*OAuthCredential, error) {
+	credentials := make([]*OAuthCredential, 0)
+	err := r.db.NewSelect().
+		Model(&credentials).
+		Where("account_id = ?", accountId).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get oauth credentials: %w", err)
+	}
+
+	return credentials, nil
+}
+
+func (r *oAuthCredentialRepo) GetAllByAccountId(ctx context.Context, accountId int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*OAuthCredential, int64], error) {
+	credentials := make([]*OAuthCredential, 0)
+	query := r.db.NewSelect().
+		Model(&credentials).
+		Where("account_id = ?", accountId)
+
+	paginationOptions := db.PaginationOptions{
+		First:  first,
+		Last:   last,
+		After:  after,
+		Before: before,
+	}
+
+	if err := db.ValidatePagination(paginationOptions); err != nil {
+		return nil, fmt.Errorf("invalid pagination parameters: %w", err)
+	}
+
+	query = db.ApplyPagination(query, paginationOptions)
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paginated oauth credentials: %w", err)
+	}
+
+	result := db.ProcessPaginatedResult[*OAuthCredential, int64](credentials, first, last)
+	return &result, nil
+}
+
 func (r *oAuthCredentialRepo) Delete(ctx context.Context, credential *OAuthCredential) error {
 	_, err := r.db.NewDelete().
 		Model(credential).
@@ -719,9 +1677,103 @@ func (r *oAuthCredentialRepo) Delete(ctx context.Context, credential *OAuthCrede
 	return nil
 }
 
+// OAuthStateRepo interface defines methods for pending social login management
+type OAuthStateRepo interface {
+	Create(ctx context.Context, provider string, nonce string, codeVerifier string, accountId *int64, rememberMe bool) (string, *OAuthState, error)
+	Get(ctx context.Context, state string) (*OAuthState, error)
+	Delete(ctx context.Context, oauthState *OAuthState) error
+
+	// Static methods for state operations
+	GenerateState() (string, error)
+	HashState(state string) string
+}
+
+// OAuth state repository implementation
+type oAuthStateRepo struct {
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
+}
+
+func NewOAuthStateRepo(db *bun.DB, hasher tokenhash.TokenHasher) OAuthStateRepo {
+	return &oAuthStateRepo{db: db, hasher: hasher}
+}
+
+// Static methods
+func (r *oAuthStateRepo) GenerateState() (string, error) {
+	return generateSecureToken(32)
+}
+
+func (r *oAuthStateRepo) HashState(state string) string {
+	return r.hasher.Hash(state)
+}
+
+func (r *oAuthStateRepo) Create(ctx context.Context, provider string, nonce string, codeVerifier string, accountId *int64, rememberMe bool) (string, *OAuthState, error) {
+	state, err := r.GenerateState()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate oauth state: %w", err)
+	}
+
+	expiresAt := time.Now().Add(10 * time.Minute) // 10 minute expiry
+	oauthState := &OAuthState{
+		StateHash:    r.HashState(state),
+		Provider:     provider,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		AccountId:    accountId,
+		ExpiresAt:    expiresAt.Unix(),
+		RememberMe:   rememberMe,
+	}
+
+	_, err = r.db.NewInsert().
+		Model(oauthState).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create oauth state: %w", err)
+	}
+
+	return state, oauthState, nil
+}
+
+func (r *oAuthStateRepo) Get(ctx context.Context, state string) (*OAuthState, error) {
+	oauthState := &OAuthState{}
+	err := r.db.NewSelect().
+		Model(oauthState).
+		Where("state_hash IN (?)", bun.In(r.hasher.Candidates(state))).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOAuthStateNotFound
+		}
+		return nil, fmt.Errorf("failed to get oauth state: %w", err)
+	}
+
+	// Check if state is expired
+	if time.Now().Unix() > oauthState.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, oauthState, "state_hash", &oauthState.StateHash, state); err != nil {
+		return nil, err
+	}
+
+	return oauthState, nil
+}
+
+func (r *oAuthStateRepo) Delete(ctx context.Context, oauthState *OAuthState) error {
+	_, err := r.db.NewDelete().
+		Model(oauthState).
+		Where("id = ?", oauthState.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete oauth state: %w", err)
+	}
+	return nil
+}
+
 // TwoFactorAuthenticationChallengeRepo interface defines methods for 2FA challenge management
 type TwoFactorAuthenticationChallengeRepo interface {
-	Create(ctx context.Context, accountId int64, totpSecret string) (string, *TwoFactorAuthenticationChallenge, error)
+	Create(ctx context.Context, accountId int64, totpSecret string, rememberMe bool) (string, *TwoFactorAuthenticationChallenge, error)
 	Get(ctx context.Context, challenge string, fetchAccount bool) (*TwoFactorAuthenticationChallenge, error)
 	Delete(ctx context.Context, challenge *TwoFactorAuthenticationChallenge) error
 
@@ -733,11 +1785,12 @@ type TwoFactorAuthenticationChallengeRepo interface {
 
 // Two-factor authentication challenge repository implementation
 type twoFactorAuthenticationChallengeRepo struct {
-	db *bun.DB
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
 }
 
-func NewTwoFactorAuthenticationChallengeRepo(db *bun.DB) TwoFactorAuthenticationChallengeRepo {
-	return &twoFactorAuthenticationChallengeRepo{db: db}
+func NewTwoFactorAuthenticationChallengeRepo(db *bun.DB, hasher tokenhash.TokenHasher) TwoFactorAuthenticationChallengeRepo {
+	return &twoFactorAuthenticationChallengeRepo{db: db, hasher: hasher}
 }
 
 // Static methods
@@ -746,14 +1799,14 @@ func (r *twoFactorAuthenticationChallengeRepo) GenerateChallenge() (string, erro
 }
 
 func (r *twoFactorAuthenticationChallengeRepo) HashChallenge(challenge string) string {
-	return hashTokenMD5(challenge)
+	return r.hasher.Hash(challenge)
 }
 
 func (r *twoFactorAuthenticationChallengeRepo) GenerateTwoFactorSecret() (string, error) {
 	return generateTwoFactorSecret()
 }
 
-func (r *twoFactorAuthenticationChallengeRepo) Create(ctx context.Context, accountId int64, totpSecret string) (string, *TwoFactorAuthenticationChallenge, error) {
+func (r *twoFactorAuthenticationChallengeRepo) Create(ctx context.Context, accountId int64, totpSecret string, rememberMe bool) (string, *TwoFactorAuthenticationChallenge, error) {
 	challenge, err := r.GenerateChallenge()
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to generate challenge: %w", err)
@@ -775,6 +1828,7 @@ func (r *twoFactorAuthenticationChallengeRepo) Create(ctx context.Context, accou
 		ExpiresAt:     expiresAt.Unix(),
 		TOTPSecret:    secret,
 		AccountId:     accountId,
+		RememberMe:    rememberMe,
 	}
 
 	_, err = r.db.NewInsert().
@@ -792,7 +1846,7 @@ func (r *twoFactorAuthenticationChallengeRepo) Get(ctx context.Context, challeng
 	twoFactorChallenge := &TwoFactorAuthenticationChallenge{}
 	query := r.db.NewSelect().
 		Model(twoFactorChallenge).
-		Where("challenge_hash = ?", r.HashChallenge(challenge))
+		Where("challenge_hash IN (?)", bun.In(r.hasher.Candidates(challenge)))
 
 	if fetchAccount {
 		query = query.Relation("Account")
@@ -811,6 +1865,10 @@ func (r *twoFactorAuthenticationChallengeRepo) Get(ctx context.Context, challeng
 		return nil, ErrTokenExpired
 	}
 
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, twoFactorChallenge, "challenge_hash", &twoFactorChallenge.ChallengeHash, challenge); err != nil {
+		return nil, err
+	}
+
 	return twoFactorChallenge, nil
 }
 
@@ -830,7 +1888,7 @@ type RecoveryCodeRepo interface {
 	Create(ctx context.Context, accountId int64, code string) (string, error)
 	CreateMany(ctx context.Context, accountId int64, codeCount int) ([]string, error)
 	DeleteAll(ctx context.Context, accountId int64) error
-	Delete(ctx context.Context, recoveryCode *RecoveryCode) error
+	Delete(ctx context.Context, recoveryCode *RecoveryCode) (bool, error)
 	Get(ctx context.Context, accountId int64, code string) (*RecoveryCode, error)
 	GetAllByAccountId(ctx context.Context, accountId int64) ([]*RecoveryCode, error)
 
@@ -841,11 +1899,12 @@ type RecoveryCodeRepo interface {
 
 // Recovery code repository implementation
 type recoveryCodeRepo struct {
-	db *bun.DB
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
 }
 
-func NewRecoveryCodeRepo(db *bun.DB) RecoveryCodeRepo {
-	return &recoveryCodeRepo{db: db}
+func NewRecoveryCodeRepo(db *bun.DB, hasher tokenhash.TokenHasher) RecoveryCodeRepo {
+	return &recoveryCodeRepo{db: db, hasher: hasher}
 }
 
 // Static methods
@@ -854,7 +1913,7 @@ func (r *recoveryCodeRepo) GenerateRecoveryCode() (string, error) {
 }
 
 func (r *recoveryCodeRepo) HashRecoveryCode(code string) string {
-	return hashTokenMD5(code)
+	return r.hasher.Hash(code)
 }
 
 func (r *recoveryCodeRepo) Create(ctx context.Context, accountId int64, code string) (string, error) {
@@ -923,15 +1982,26 @@ func (r *recoveryCodeRepo) DeleteAll(ctx context.Context, accountId int64) error
 	return nil
 }
 
-func (r *recoveryCodeRepo) Delete(ctx context.Context, recoveryCode *RecoveryCode) error {
-	_, err := r.db.NewDelete().
+// Delete consumes a recovery code
+//
+// Only one of concurrent redemptions of the same code removes it, the others are told it was already used.
+//
+// Returns:
+//   - bool: Whether the code was removed by this call
+func (r *recoveryCodeRepo) Delete(ctx context.Context, recoveryCode *RecoveryCode) (bool, error) {
+	result, err := r.db.NewDelete().
 		Model(recoveryCode).
 		Where("id = ?", recoveryCode.ID).
 		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete recovery code: %w", err)
+		return false, fmt.Errorf("failed to delete recovery code: %w", err)
 	}
-	return nil
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete recovery code: %w", err)
+	}
+	return rowsAffected > 0, nil
 }
 
 func (r *recoveryCodeRepo) Get(ctx context.Context, accountId int64, code string) (*RecoveryCode, error) {
@@ -939,7 +2009,7 @@ func (r *recoveryCodeRepo) Get(ctx context.Context, accountId int64, code string
 	err := r.db.NewSelect().
 		Model(recoveryCode).
 		Where("account_id = ?", accountId).
-		Where("code_hash = ?", r.HashRecoveryCode(code)).
+		Where("code_hash IN (?)", bun.In(r.hasher.Candidates(code))).
 		Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -948,6 +2018,10 @@ func (r *recoveryCodeRepo) Get(ctx context.Context, accountId int64, code string
 		return nil, fmt.Errorf("failed to get recovery code: %w", err)
 	}
 
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, recoveryCode, "code_hash", &recoveryCode.CodeHash, code); err != nil {
+		return nil, err
+	}
+
 	return recoveryCode, nil
 }
 
@@ -978,11 +2052,12 @@ type TemporaryTwoFactorChallengeRepo interface {
 
 // Temporary two-factor challenge repository implementation
 type temporaryTwoFactorChallengeRepo struct {
-	db *bun.DB
+	db     *bun.DB
+	hasher tokenhash.TokenHasher
 }
 
-func NewTemporaryTwoFactorChallengeRepo(db *bun.DB) TemporaryTwoFactorChallengeRepo {
-	return &temporaryTwoFactorChallengeRepo{db: db}
+func NewTemporaryTwoFactorChallengeRepo(db *bun.DB, hasher tokenhash.TokenHasher) TemporaryTwoFactorChallengeRepo {
+	return &temporaryTwoFactorChallengeRepo{db: db, hasher: hasher}
 }
 
 // Static methods
@@ -991,7 +2066,7 @@ func (r *temporaryTwoFactorChallengeRepo) GenerateChallenge() (string, error) {
 }
 
 func (r *temporaryTwoFactorChallengeRepo) HashChallenge(challenge string) string {
-	return hashTokenMD5(challenge)
+	return r.hasher.Hash(challenge)
 }
 
 func (r *temporaryTwoFactorChallengeRepo) Create(ctx context.Context, accountId int64, passwordResetTokenId int64) (string, *TemporaryTwoFactorChallenge, error) {
@@ -1023,7 +2098,7 @@ func (r *temporaryTwoFactorChallengeRepo) Get(ctx context.Context, challenge str
 	temporaryTwoFactorChallenge := &TemporaryTwoFactorChallenge{}
 	query := r.db.NewSelect().
 		Model(temporaryTwoFactorChallenge).
-		Where("challenge_hash = ?", r.HashChallenge(challenge)).
+		Where("challenge_hash IN (?)", bun.In(r.hasher.Candidates(challenge))).
 		Where("password_reset_token = ?", fmt.Sprintf("%d", passwordResetTokenId))
 
 	if fetchAccount {
@@ -1043,6 +2118,10 @@ func (r *temporaryTwoFactorChallengeRepo) Get(ctx context.Context, challenge str
 		return nil, ErrTokenExpired
 	}
 
+	if err := tokenhash.Rehash(ctx, r.db, r.hasher, temporaryTwoFactorChallenge, "challenge_hash", &temporaryTwoFactorChallenge.ChallengeHash, challenge); err != nil {
+		return nil, err
+	}
+
 	return temporaryTwoFactorChallenge, nil
 }
 
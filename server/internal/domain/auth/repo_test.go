@@ -2,6 +2,7 @@ package auth
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -34,12 +35,16 @@ func TestAuthRepoInterfaceSatisfaction(t *testing.T) {
 		// This test ensures our implementations satisfy the interfaces
 		var _ SessionRepo = (*sessionRepo)(nil)
 		var _ PasswordResetTokenRepo = (*passwordResetTokenRepo)(nil)
+		var _ EmailLoginCodeRepo = (*emailLoginCodeRepo)(nil)
+		var _ SmsLoginCodeRepo = (*smsLoginCodeRepo)(nil)
 		var _ WebAuthnCredentialRepo = (*webAuthnCredentialRepo)(nil)
 		var _ WebAuthnChallengeRepo = (*webAuthnChallengeRepo)(nil)
 		var _ OAuthCredentialRepo = (*oAuthCredentialRepo)(nil)
+		var _ OAuthStateRepo = (*oAuthStateRepo)(nil)
 		var _ TwoFactorAuthenticationChallengeRepo = (*twoFactorAuthenticationChallengeRepo)(nil)
 		var _ RecoveryCodeRepo = (*recoveryCodeRepo)(nil)
 		var _ TemporaryTwoFactorChallengeRepo = (*temporaryTwoFactorChallengeRepo)(nil)
+		var _ PersonalAccessTokenRepo = (*personalAccessTokenRepo)(nil)
 
 		// If we reach here, all implementations satisfy their interfaces
 		assert.True(t, true, "All repository implementations satisfy their interfaces")
@@ -47,7 +52,7 @@ func TestAuthRepoInterfaceSatisfaction(t *testing.T) {
 }
 
 func TestSessionRepoStaticMethods(t *testing.T) {
-	repo := &sessionRepo{}
+	repo := &sessionRepo{hasher: testTokenHasher}
 
 	t.Run("GenerateSessionToken creates unique tokens", func(t *testing.T) {
 		token1, err := repo.GenerateSessionToken()
@@ -68,12 +73,12 @@ func TestSessionRepoStaticMethods(t *testing.T) {
 
 		assert.Equal(t, hash1, hash2)
 		assert.NotEmpty(t, hash1)
-		assert.Len(t, hash1, 32) // MD5 hash = 32 hex chars
+		assert.Len(t, hash1, 64) // HMAC-SHA256 = 64 hex chars
 	})
 }
 
 func TestPasswordResetTokenRepoStaticMethods(t *testing.T) {
-	repo := &passwordResetTokenRepo{}
+	repo := &passwordResetTokenRepo{hasher: testTokenHasher}
 
 	t.Run("GeneratePasswordResetToken creates unique tokens", func(t *testing.T) {
 		token1, err := repo.GeneratePasswordResetToken()
@@ -96,8 +101,83 @@ func TestPasswordResetTokenRepoStaticMethods(t *testing.T) {
 	})
 }
 
+func TestPersonalAccessTokenRepoStaticMethods(t *testing.T) {
+	repo := &personalAccessTokenRepo{hasher: testTokenHasher}
+
+	t.Run("GeneratePersonalAccessToken creates prefixed unique tokens", func(t *testing.T) {
+		token1, err := repo.GeneratePersonalAccessToken()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(token1, PersonalAccessTokenPrefix))
+		assert.Len(t, token1, len(PersonalAccessTokenPrefix)+64)
+
+		token2, err := repo.GeneratePersonalAccessToken()
+		require.NoError(t, err)
+		assert.NotEqual(t, token1, token2)
+	})
+
+	t.Run("HashPersonalAccessToken produces consistent hashes", func(t *testing.T) {
+		token := PersonalAccessTokenPrefix + "12345"
+		hash1 := repo.HashPersonalAccessToken(token)
+		hash2 := repo.HashPersonalAccessToken(token)
+
+		assert.Equal(t, hash1, hash2)
+		assert.NotEqual(t, token, hash1)
+	})
+}
+
+func TestEmailLoginCodeRepoStaticMethods(t *testing.T) {
+	repo := &emailLoginCodeRepo{hasher: testTokenHasher}
+
+	t.Run("GenerateLoginCode creates numeric codes", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			code, err := repo.GenerateLoginCode()
+			require.NoError(t, err)
+			assert.Len(t, code, EmailLoginCodeLength)
+			for _, c := range code {
+				assert.True(t, c >= '0' && c <= '9', "code should only contain digits")
+			}
+		}
+	})
+
+	t.Run("GenerateLoginToken creates unique tokens", func(t *testing.T) {
+		token1, err := repo.GenerateLoginToken()
+		require.NoError(t, err)
+		assert.Len(t, token1, 64)
+
+		token2, err := repo.GenerateLoginToken()
+		require.NoError(t, err)
+		assert.NotEqual(t, token1, token2)
+	})
+
+	t.Run("HashLoginCode is consistent", func(t *testing.T) {
+		assert.Equal(t, repo.HashLoginCode("123456"), repo.HashLoginCode("123456"))
+		assert.NotEqual(t, repo.HashLoginCode("123456"), repo.HashLoginCode("654321"))
+	})
+}
+
+func TestSmsLoginCodeRepoStaticMethods(t *testing.T) {
+	repo := &smsLoginCodeRepo{hasher: testTokenHasher}
+
+	t.Run("GenerateLoginCode creates numeric codes", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			code, err := repo.GenerateLoginCode()
+			require.NoError(t, err)
+			assert.Len(t, code, SmsLoginCodeLength)
+			for _, c := range code {
+				assert.True(t, c >= '0' && c <= '9', "code should only contain digits")
+			}
+		}
+	})
+
+	t.Run("VerifyLoginCode accepts current and legacy hashes", func(t *testing.T) {
+		assert.True(t, repo.VerifyLoginCode("123456", repo.HashLoginCode("123456")))
+		assert.True(t, repo.VerifyLoginCode("123456", "e10adc3949ba59abbe56e057f20f883e"))
+		assert.False(t, repo.VerifyLoginCode("654321", repo.HashLoginCode("123456")))
+	})
+}
+
 func TestRecoveryCodeRepoStaticMethods(t *testing.T) {
-	repo := &recoveryCodeRepo{}
+	repo := &recoveryCodeRepo{hasher: testTokenHasher}
 
 	t.Run("GenerateRecoveryCode creates valid codes", func(t *testing.T) {
 		code1, err := repo.GenerateRecoveryCode()
@@ -121,7 +201,7 @@ func TestRecoveryCodeRepoStaticMethods(t *testing.T) {
 }
 
 func TestTwoFactorChallengeRepoStaticMethods(t *testing.T) {
-	repo := &twoFactorAuthenticationChallengeRepo{}
+	repo := &twoFactorAuthenticationChallengeRepo{hasher: testTokenHasher}
 
 	t.Run("GenerateChallenge creates unique challenges", func(t *testing.T) {
 		challenge1, err := repo.GenerateChallenge()
@@ -145,7 +225,7 @@ func TestTwoFactorChallengeRepoStaticMethods(t *testing.T) {
 }
 
 func TestTemporaryTwoFactorChallengeRepoStaticMethods(t *testing.T) {
-	repo := &temporaryTwoFactorChallengeRepo{}
+	repo := &temporaryTwoFactorChallengeRepo{hasher: testTokenHasher}
 
 	t.Run("GenerateChallenge creates unique challenges", func(t *testing.T) {
 		challenge1, err := repo.GenerateChallenge()
@@ -177,6 +257,8 @@ func TestErrorDefinitions(t *testing.T) {
 			ErrChallengeNotFound,
 			ErrRecoveryCodeInvalid,
 			ErrOAuthCredentialAlreadyExists,
+			ErrOAuthCredentialNotFound,
+			ErrOAuthStateNotFound,
 			ErrPasswordResetTokenNotFound,
 			ErrTwoFactorAuthenticationNotFound,
 			ErrTemporaryTwoFactorNotFound,
@@ -201,15 +283,6 @@ func TestUtilityFunctions(t *testing.T) {
 		assert.NotEqual(t, token1, token2)
 	})
 
-	t.Run("hashTokenMD5 produces consistent hashes", func(t *testing.T) {
-		input := "test-input"
-		hash1 := hashTokenMD5(input)
-		hash2 := hashTokenMD5(input)
-
-		assert.Equal(t, hash1, hash2)
-		assert.Len(t, hash1, 32) // MD5 hash = 32 hex chars
-	})
-
 	t.Run("isUniqueViolation detects unique constraint errors", func(t *testing.T) {
 		// Test with PostgreSQL unique violation
 		pgErr := fmt.Errorf("duplicate key value violates unique constraint")
@@ -245,10 +318,10 @@ func TestRepositoryConstructors(t *testing.T) {
 		// Create a mock DB that doesn't need actual connection for constructor test
 		testDB := setupTestDB(t)
 
-		sessionRepo := NewSessionRepo(testDB)
+		sessionRepo := NewSessionRepo(testDB, testTokenHasher)
 		assert.NotNil(t, sessionRepo)
 
-		passwordResetRepo := NewPasswordResetTokenRepo(testDB)
+		passwordResetRepo := NewPasswordResetTokenRepo(testDB, testTokenHasher)
 		assert.NotNil(t, passwordResetRepo)
 
 		webauthnCredRepo := NewWebAuthnCredentialRepo(testDB)
@@ -260,13 +333,22 @@ func TestRepositoryConstructors(t *testing.T) {
 		oauthRepo := NewOAuthCredentialRepo(testDB)
 		assert.NotNil(t, oauthRepo)
 
-		twoFactorRepo := NewTwoFactorAuthenticationChallengeRepo(testDB)
+		oauthStateRepo := NewOAuthStateRepo(testDB, testTokenHasher)
+		assert.NotNil(t, oauthStateRepo)
+
+		emailLoginCodeRepo := NewEmailLoginCodeRepo(testDB, testTokenHasher)
+		assert.NotNil(t, emailLoginCodeRepo)
+
+		smsLoginCodeRepo := NewSmsLoginCodeRepo(testDB, testTokenHasher)
+		assert.NotNil(t, smsLoginCodeRepo)
+
+		twoFactorRepo := NewTwoFactorAuthenticationChallengeRepo(testDB, testTokenHasher)
 		assert.NotNil(t, twoFactorRepo)
 
-		recoveryRepo := NewRecoveryCodeRepo(testDB)
+		recoveryRepo := NewRecoveryCodeRepo(testDB, testTokenHasher)
 		assert.NotNil(t, recoveryRepo)
 
-		tempTwoFactorRepo := NewTemporaryTwoFactorChallengeRepo(testDB)
+		tempTwoFactorRepo := NewTemporaryTwoFactorChallengeRepo(testDB, testTokenHasher)
 		assert.NotNil(t, tempTwoFactorRepo)
 	})
 }
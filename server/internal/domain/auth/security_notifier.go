@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"server/internal/config"
+	"server/internal/domain/account"
+	"server/internal/infrastructure/email"
+	"server/internal/infrastructure/geoip"
+
+	"go.uber.org/zap"
+)
+
+// SessionRevocationTokenLifetime is how long the "this wasn't me" link of a security notification works
+const SessionRevocationTokenLifetime = 7 * 24 * time.Hour
+
+// SecurityNotifier emails account owners about sign ins from new devices and sensitive account changes
+//
+// Each notification tells the device and IP address of the request, and carries a "this wasn't me"
+// link signing the account out everywhere.
+type SecurityNotifier struct {
+	sessionRevocationTokenRepo SessionRevocationTokenRepo
+	emailClient                *email.EmailClient
+	geoLocator                 geoip.Locator
+	cfg                        *config.Config
+	logger                     *zap.Logger
+}
+
+func NewSecurityNotifier(
+	sessionRevocationTokenRepo SessionRevocationTokenRepo,
+	emailClient *email.EmailClient,
+	geoLocator geoip.Locator,
+	cfg *config.Config,
+	logger *zap.Logger,
+) *SecurityNotifier {
+	return &SecurityNotifier{
+		sessionRevocationTokenRepo: sessionRevocationTokenRepo,
+		emailClient:                emailClient,
+		geoLocator:                 geoLocator,
+		cfg:                        cfg,
+		logger:                     logger,
+	}
+}
+
+// RegisterSecurityNotifier subscribes the notifier to the security events of all accounts
+func RegisterSecurityNotifier(securityEvents *account.SecurityEventBus, notifier *SecurityNotifier) {
+	securityEvents.Subscribe(notifier.Notify)
+}
+
+// Notify emails the owner of the event's account
+//
+// The change was already made, so failures are logged rather than returned.
+func (n *SecurityNotifier) Notify(ctx context.Context, event account.SecurityEvent) {
+	if event.Account == nil {
+		return
+	}
+
+	revocationToken, err := n.sessionRevocationTokenRepo.Create(ctx, event.Account.ID, time.Now().Add(SessionRevocationTokenLifetime))
+	if err != nil {
+		n.logger.Error("Failed to create session revocation token", zap.Int64("account_id", event.Account.ID), zap.Error(err))
+		return
+	}
+	revokeLink := fmt.Sprintf("%s/auth/revoke-sessions/%s", strings.TrimRight(n.cfg.AccountsBaseURL, "/"), revocationToken)
+
+	if err := n.emailClient.SendSecurityNotification(
+		ctx,
+		n.cfg,
+		event.Account.Email,
+		string(event.Type),
+		event.Detail,
+		describeDevice(ParseUserAgent(event.UserAgent)),
+		event.IPAddress,
+		n.describeLocation(event.IPAddress),
+		time.Now().UTC().Format("January 2, 2006 at 15:04 UTC"),
+		revokeLink,
+	); err != nil {
+		n.logger.Error("Failed to send security notification email", zap.String("event_type", string(event.Type)), zap.Error(err))
+	}
+}
+
+// describeLocation returns the approximate location of an IP address, such as "Berlin, Germany", empty if unknown
+func (n *SecurityNotifier) describeLocation(ipAddress string) string {
+	location, err := n.geoLocator.Locate(ipAddress)
+	if err != nil {
+		n.logger.Warn("Failed to locate security notification IP address", zap.Error(err))
+		return ""
+	}
+	if location == nil {
+		return ""
+	}
+	if location.City == "" {
+		return location.Country
+	}
+	return location.City + ", " + location.Country
+}
+
+// describeDevice returns a readable description of a device, such as "Chrome 120 on Windows (desktop)"
+func describeDevice(device Device) string {
+	var description string
+	switch {
+	case device.Browser != "" && device.OS != "":
+		description = device.Browser + " on " + device.OS
+	case device.Browser != "":
+		description = device.Browser
+	case device.OS != "":
+		description = device.OS
+	default:
+		return "Unknown device"
+	}
+	return description + " (" + device.Type + ")"
+}
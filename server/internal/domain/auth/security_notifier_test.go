@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"server/internal/config"
+	"server/internal/domain/account"
+	"server/internal/domain/core"
+	"server/internal/infrastructure/email"
+	"server/internal/infrastructure/geoip"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newTestSecurityNotifier creates a SecurityNotifier with a dummy email client
+func newTestSecurityNotifier(t *testing.T, revocationTokenRepo *MockSessionRevocationTokenRepo, locator geoip.Locator) *SecurityNotifier {
+	cfg := &config.Config{
+		EmailProvider:     "dummy",
+		EmailTemplatePath: "../../../templates/emails",
+		AccountsBaseURL:   "https://accounts.example.com/",
+	}
+	emailClient, err := email.NewEmailClient(cfg)
+	require.NoError(t, err)
+
+	return NewSecurityNotifier(revocationTokenRepo, emailClient, locator, cfg, zap.NewNop())
+}
+
+func TestSecurityNotifier_Notify(t *testing.T) {
+	ctx := context.Background()
+	event := account.SecurityEvent{
+		Type:      account.SecurityEventPasskeyAdded,
+		Account:   &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"},
+		UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+		IPAddress: "203.0.113.7",
+		Detail:    "Work laptop",
+	}
+
+	t.Run("creates a session revocation token for the email", func(t *testing.T) {
+		revocationTokenRepo := new(MockSessionRevocationTokenRepo)
+		locator := new(MockLocator)
+		expectedExpiresAt := time.Now().Add(SessionRevocationTokenLifetime)
+		revocationTokenRepo.On("Create", mock.Anything, int64(7), mock.MatchedBy(func(expiresAt time.Time) bool {
+			return expiresAt.Sub(expectedExpiresAt).Abs() < time.Minute
+		})).Return("revocation-token", nil)
+		locator.On("Locate", "203.0.113.7").Return(&geoip.Location{City: "Berlin", Country: "Germany", CountryCode: "DE"}, nil)
+
+		newTestSecurityNotifier(t, revocationTokenRepo, locator).Notify(ctx, event)
+
+		revocationTokenRepo.AssertExpectations(t)
+		locator.AssertExpectations(t)
+	})
+
+	t.Run("doesn't send without a revocation token", func(t *testing.T) {
+		revocationTokenRepo := new(MockSessionRevocationTokenRepo)
+		locator := new(MockLocator)
+		revocationTokenRepo.On("Create", mock.Anything, int64(7), mock.Anything).Return("", errors.New("database unavailable"))
+
+		newTestSecurityNotifier(t, revocationTokenRepo, locator).Notify(ctx, event)
+
+		locator.AssertNotCalled(t, "Locate", mock.Anything)
+	})
+
+	t.Run("is subscribed to security events", func(t *testing.T) {
+		revocationTokenRepo := new(MockSessionRevocationTokenRepo)
+		revocationTokenRepo.On("Create", mock.Anything, int64(7), mock.Anything).Return("revocation-token", nil)
+		bus := account.NewSecurityEventBus()
+
+		RegisterSecurityNotifier(bus, newTestSecurityNotifier(t, revocationTokenRepo, geoip.DisabledLocator{}))
+		bus.Publish(ctx, event)
+
+		revocationTokenRepo.AssertExpectations(t)
+	})
+}
+
+func TestDescribeDevice(t *testing.T) {
+	tests := []struct {
+		device   Device
+		expected string
+	}{
+		{device: Device{Browser: "Chrome 120", OS: "Windows", Type: DeviceTypeDesktop}, expected: "Chrome 120 on Windows (desktop)"},
+		{device: Device{Browser: "Firefox 121", Type: DeviceTypeUnknown}, expected: "Firefox 121 (unknown)"},
+		{device: Device{OS: "Android 14", Type: DeviceTypeMobile}, expected: "Android 14 (mobile)"},
+		{device: Device{Type: DeviceTypeBot}, expected: "Unknown device"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, describeDevice(tt.device))
+	}
+}
@@ -1,39 +1,2387 @@
 package auth
 
-import "server/internal/domain/account"
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"server/internal/config"
+	"server/internal/domain/account"
+	"server/internal/infrastructure/db"
+	"server/internal/infrastructure/email"
+	"server/internal/infrastructure/geoip"
+
+	"github.com/pquerna/otp/hotp"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+const (
+	EmailVerificationTokenCooldown   = 3 * time.Minute
+	PasswordResetTokenCooldown       = 3 * time.Minute
+	EmailLoginCodeCooldown           = 1 * time.Minute
+	EmailLoginCodeLifetime           = 10 * time.Minute
+	EmailLoginCodeLength             = 6
+	EmailLoginCodeMaxAttempts        = 5
+	SmsLoginCodeCooldown             = 1 * time.Minute
+	SmsLoginCodeLifetime             = 5 * time.Minute
+	SmsLoginCodeLength               = 6
+	SmsLoginCodeMaxAttempts          = 5
+	MinPasswordLength                = 8
+	RecoveryCodeCount                = 10
+	PersonalAccessTokenNameMaxLength = 100
+
+	// generatedAccountIDMin is the lower bound for account IDs generated before the account is created
+	generatedAccountIDMin = 1 << 40
+	// totpPeriod is the number of seconds each TOTP code is valid for, as used by authenticator apps
+	totpPeriod = 30
+)
 
 type AuthService struct {
-	accountRepo                          *account.AccountRepo
-	sessionRepo                          *SessionRepo
-	emailVerificationTokenRepo           *account.EmailVerificationTokenRepo
-	passwordResetTokenRepo               *PasswordResetTokenRepo
-	webAuthnCredentialRepo               *WebAuthnCredentialRepo
-	oauthCredentialRepo                  *OAuthCredentialRepo
-	twoFactorAuthenticationChallengeRepo *TwoFactorAuthenticationChallengeRepo
-	recoveryCodeRepo                     *RecoveryCodeRepo
-	tempTwoFactorChallengeRepo           *TemporaryTwoFactorChallengeRepo
+	accountRepo                          account.AccountRepo
+	sessionRepo                          SessionRepo
+	emailVerificationTokenRepo           account.EmailVerificationTokenRepo
+	passwordResetTokenRepo               PasswordResetTokenRepo
+	sessionRevocationTokenRepo           SessionRevocationTokenRepo
+	bearerTokenRepo                      BearerTokenRepo
+	personalAccessTokenRepo              PersonalAccessTokenRepo
+	webAuthnCredentialRepo               WebAuthnCredentialRepo
+	oauthCredentialRepo                  OAuthCredentialRepo
+	twoFactorAuthenticationChallengeRepo TwoFactorAuthenticationChallengeRepo
+	recoveryCodeRepo                     RecoveryCodeRepo
+	tempTwoFactorChallengeRepo           TemporaryTwoFactorChallengeRepo
+	oauthStateRepo                       OAuthStateRepo
+	emailLoginCodeRepo                   EmailLoginCodeRepo
+	smsLoginCodeRepo                     SmsLoginCodeRepo
+	passwordHistoryRepo                  account.PasswordHistoryRepo
+	webAuthnService                      *WebAuthnService
+	googleTokenVerifier                  *GoogleTokenVerifier
+	oauthProviders                       *OAuthProviderRegistry
+	passwordPolicy                       *PasswordPolicy
+	attemptLimiter                       *AttemptLimiter
+	emailClient                          *email.EmailClient
+	messageSender                        account.MessageSender
+	geoLocator                           geoip.Locator
+	securityEvents                       *account.SecurityEventBus
+	cfg                                  *config.Config
+	logger                               *zap.Logger
 }
 
 func NewAuthService(
-	accountRepo *account.AccountRepo,
-	sessionRepo *SessionRepo,
-	emailVerificationTokenRepo *account.EmailVerificationTokenRepo,
-	passwordResetTokenRepo *PasswordResetTokenRepo,
-	webAuthnCredentialRepo *WebAuthnCredentialRepo,
-	oauthCredentialRepo *OAuthCredentialRepo,
-	twoFactorAuthenticationChallengeRepo *TwoFactorAuthenticationChallengeRepo,
-	recoveryCodeRepo *RecoveryCodeRepo,
-	tempTwoFactorChallengeRepo *TemporaryTwoFactorChallengeRepo,
+	accountRepo account.AccountRepo,
+	sessionRepo SessionRepo,
+	emailVerificationTokenRepo account.EmailVerificationTokenRepo,
+	passwordResetTokenRepo PasswordResetTokenRepo,
+	sessionRevocationTokenRepo SessionRevocationTokenRepo,
+	bearerTokenRepo BearerTokenRepo,
+	personalAccessTokenRepo PersonalAccessTokenRepo,
+	webAuthnCredentialRepo WebAuthnCredentialRepo,
+	oauthCredentialRepo OAuthCredentialRepo,
+	twoFactorAuthenticationChallengeRepo TwoFactorAuthenticationChallengeRepo,
+	recoveryCodeRepo RecoveryCodeRepo,
+	tempTwoFactorChallengeRepo TemporaryTwoFactorChallengeRepo,
+	oauthStateRepo OAuthStateRepo,
+	emailLoginCodeRepo EmailLoginCodeRepo,
+	smsLoginCodeRepo SmsLoginCodeRepo,
+	passwordHistoryRepo account.PasswordHistoryRepo,
+	webAuthnService *WebAuthnService,
+	googleTokenVerifier *GoogleTokenVerifier,
+	oauthProviders *OAuthProviderRegistry,
+	passwordPolicy *PasswordPolicy,
+	attemptLimiter *AttemptLimiter,
+	emailClient *email.EmailClient,
+	messageSender account.MessageSender,
+	geoLocator geoip.Locator,
+	securityEvents *account.SecurityEventBus,
+	cfg *config.Config,
+	logger *zap.Logger,
 ) *AuthService {
 	return &AuthService{
 		accountRepo:                          accountRepo,
 		sessionRepo:                          sessionRepo,
 		emailVerificationTokenRepo:           emailVerificationTokenRepo,
 		passwordResetTokenRepo:               passwordResetTokenRepo,
+		sessionRevocationTokenRepo:           sessionRevocationTokenRepo,
+		bearerTokenRepo:                      bearerTokenRepo,
+		personalAccessTokenRepo:              personalAccessTokenRepo,
 		webAuthnCredentialRepo:               webAuthnCredentialRepo,
 		oauthCredentialRepo:                  oauthCredentialRepo,
 		twoFactorAuthenticationChallengeRepo: twoFactorAuthenticationChallengeRepo,
 		recoveryCodeRepo:                     recoveryCodeRepo,
 		tempTwoFactorChallengeRepo:           tempTwoFactorChallengeRepo,
+		oauthStateRepo:                       oauthStateRepo,
+		emailLoginCodeRepo:                   emailLoginCodeRepo,
+		smsLoginCodeRepo:                     smsLoginCodeRepo,
+		passwordHistoryRepo:                  passwordHistoryRepo,
+		webAuthnService:                      webAuthnService,
+		googleTokenVerifier:                  googleTokenVerifier,
+		oauthProviders:                       oauthProviders,
+		passwordPolicy:                       passwordPolicy,
+		attemptLimiter:                       attemptLimiter,
+		emailClient:                          emailClient,
+		messageSender:                        messageSender,
+		geoLocator:                           geoLocator,
+		securityEvents:                       securityEvents,
+		cfg:                                  cfg,
+		logger:                               logger,
+	}
+}
+
+// RequestEmailVerificationToken creates an email verification token and mails it to the given address
+//
+// A new token can only be requested once the cooldown of the previous one has elapsed.
+// On success, the number of seconds until another token can be requested is returned.
+//
+// Returns:
+//   - int: Remaining cooldown seconds for the newly issued token
+//   - error: ErrInvalidEmail, ErrEmailAlreadyExists, or a *CooldownError wrapping ErrEmailCooldown
+func (s *AuthService) RequestEmailVerificationToken(ctx context.Context, emailAddress string, userAgent string) (int, error) {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.ensureEmailAvailable(ctx, emailAddress); err != nil {
+		return 0, err
+	}
+
+	existingToken, err := s.emailVerificationTokenRepo.GetByEmail(ctx, emailAddress)
+	if err != nil && !errors.Is(err, account.ErrTokenNotFound) {
+		return 0, fmt.Errorf("failed to get email verification token: %w", err)
+	}
+
+	if existingToken != nil {
+		if remaining := cooldownRemaining(existingToken.CreatedAt, EmailVerificationTokenCooldown); remaining > 0 {
+			return 0, NewCooldownError(remaining, ErrEmailCooldown)
+		}
+
+		// Only one token is kept per email address
+		if err := s.emailVerificationTokenRepo.Delete(ctx, existingToken); err != nil {
+			return 0, fmt.Errorf("failed to delete previous email verification token: %w", err)
+		}
+	}
+
+	token, _, err := s.emailVerificationTokenRepo.Create(ctx, emailAddress)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create email verification token: %w", err)
+	}
+
+	if err := s.emailClient.SendEmailVerification(ctx, s.cfg, emailAddress, token, userAgent); err != nil {
+		s.logger.Error("Failed to send email verification", zap.Error(err))
+		return 0, fmt.Errorf("failed to send email verification: %w", err)
+	}
+
+	return int(EmailVerificationTokenCooldown.Seconds()), nil
+}
+
+// VerifyEmail checks that the email verification token is valid for the given email address
+//
+// The token is not consumed, so it can be used afterwards to complete registration.
+//
+// Returns:
+//   - error: ErrInvalidEmail, ErrEmailAlreadyExists, or ErrInvalidOrExpiredToken
+func (s *AuthService) VerifyEmail(ctx context.Context, emailAddress string, emailVerificationToken string) error {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ensureEmailAvailable(ctx, emailAddress); err != nil {
+		return err
+	}
+
+	_, err = s.getValidEmailVerificationToken(ctx, emailAddress, emailVerificationToken)
+	return err
+}
+
+// RegisterWithPassword creates a new account with a password and logs it in
+//
+// The email verification token is consumed on success and a new session is created
+// for the account.
+//
+// Returns:
+//   - *account.Account: The created account
+//   - string: The session token of the new session
+//   - error: ErrInvalidEmail, ErrEmailAlreadyExists, ErrInvalidOrExpiredToken, a *PasswordPolicyError,
+//     or a *ValidationError for an invalid full name
+func (s *AuthService) RegisterWithPassword(ctx context.Context, emailAddress string, emailVerificationToken string, password string, fullName string, userAgent string, ipAddress string) (*account.Account, string, error) {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fullName = strings.TrimSpace(fullName)
+	if fullName == "" {
+		return nil, "", NewValidationError("fullName", account.MsgFullNameRequired, account.ErrInvalidFullName)
+	}
+
+	if err := s.ensureEmailAvailable(ctx, emailAddress); err != nil {
+		return nil, "", err
+	}
+
+	verificationToken, err := s.getValidEmailVerificationToken(ctx, emailAddress, emailVerificationToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.passwordPolicy.Validate(ctx, password, emailAddress, fullName); err != nil {
+		return nil, "", err
+	}
+
+	createdAccount, err := s.accountRepo.Create(ctx, emailAddress, fullName, []string{account.AuthProviderPassword}, &password, nil, "", nil)
+	if err != nil {
+		if errors.Is(err, account.ErrEmailAlreadyExists) {
+			return nil, "", ErrEmailAlreadyExists
+		}
+		return nil, "", fmt.Errorf("failed to create account: %w", err)
+	}
+
+	if err := s.emailVerificationTokenRepo.Delete(ctx, verificationToken); err != nil {
+		// The account already exists, so a stale token is harmless
+		s.logger.Warn("Failed to delete used email verification token", zap.Error(err))
+	}
+
+	sessionToken, err := s.createSession(ctx, createdAccount.ID, userAgent, ipAddress, false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return createdAccount, sessionToken, nil
+}
+
+// GeneratePasskeyRegistrationOptions generates passkey creation options for a new account
+//
+// The account ID is generated up front and bound to the challenge, so that the
+// account created by RegisterWithPasskey matches the passkey's user handle.
+//
+// Returns:
+//   - string: JSON encoded registration options
+//   - error: ErrInvalidEmail or ErrEmailAlreadyExists
+func (s *AuthService) GeneratePasskeyRegistrationOptions(ctx context.Context, emailAddress string, fullName string) (string, error) {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.ensureEmailAvailable(ctx, emailAddress); err != nil {
+		return "", err
+	}
+
+	accountID, err := generateAccountID()
+	if err != nil {
+		return "", err
+	}
+
+	return s.webAuthnService.BeginRegistration(ctx, accountID, emailAddress, strings.TrimSpace(fullName), nil)
+}
+
+// RegisterWithPasskey creates a new account from a passkey registration response and logs it in
+//
+// Returns:
+//   - *account.Account: The created account
+//   - string: The session token of the new session
+//   - error: ErrInvalidEmail, ErrEmailAlreadyExists, ErrInvalidOrExpiredToken, ErrChallengeNotFound,
+//     ErrInvalidWebAuthnResponse, or a *ValidationError for an invalid full name
+func (s *AuthService) RegisterWithPasskey(ctx context.Context, emailAddress string, emailVerificationToken string, registrationResponse string, passkeyNickname string, fullName string, userAgent string, ipAddress string) (*account.Account, string, error) {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fullName = strings.TrimSpace(fullName)
+	if fullName == "" {
+		return nil, "", NewValidationError("fullName", account.MsgFullNameRequired, account.ErrInvalidFullName)
+	}
+
+	if err := s.ensureEmailAvailable(ctx, emailAddress); err != nil {
+		return nil, "", err
+	}
+
+	verificationToken, err := s.getValidEmailVerificationToken(ctx, emailAddress, emailVerificationToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	registration, err := s.webAuthnService.FinishRegistration(ctx, registrationResponse, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	createdAccount, err := s.accountRepo.Create(ctx, emailAddress, fullName, []string{account.AuthProviderWebAuthnCredential}, nil, &registration.AccountID, "", nil)
+	if err != nil {
+		if errors.Is(err, account.ErrEmailAlreadyExists) {
+			return nil, "", ErrEmailAlreadyExists
+		}
+		return nil, "", fmt.Errorf("failed to create account: %w", err)
+	}
+
+	if _, err := s.webAuthnService.SaveCredential(ctx, createdAccount.ID, registration.Credential, passkeyNickname); err != nil {
+		return nil, "", err
+	}
+
+	if err := s.emailVerificationTokenRepo.Delete(ctx, verificationToken); err != nil {
+		s.logger.Warn("Failed to delete used email verification token", zap.Error(err))
+	}
+
+	sessionToken, err := s.createSession(ctx, createdAccount.ID, userAgent, ipAddress, false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return createdAccount, sessionToken, nil
+}
+
+// GenerateAuthenticationOptions generates passkey request options for a discoverable login
+func (s *AuthService) GenerateAuthenticationOptions(ctx context.Context) (string, error) {
+	return s.webAuthnService.BeginLogin(ctx, 0, nil)
+}
+
+// LoginWithPasskey verifies a passkey authentication response and logs the account in
+//
+// Returns:
+//   - *account.Account: The authenticated account
+//   - string: The session token of the new session
+//   - error: ErrChallengeNotFound, ErrInvalidWebAuthnResponse or ErrWebAuthnCredentialCloned
+func (s *AuthService) LoginWithPasskey(ctx context.Context, authenticationResponse string, userAgent string, ipAddress string, rememberMe bool) (*account.Account, string, error) {
+	credential, err := s.webAuthnService.FinishLogin(ctx, authenticationResponse)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sessionToken, err := s.createLoginSession(ctx, credential.Account, userAgent, ipAddress, rememberMe)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return credential.Account, sessionToken, nil
+}
+
+// GenerateWebAuthnCredentialCreationOptions generates passkey creation options for an existing account
+//
+// Passkeys already registered to the account are excluded.
+func (s *AuthService) GenerateWebAuthnCredentialCreationOptions(ctx context.Context, acc *account.Account) (string, error) {
+	existingCredentials, err := s.webAuthnCredentialRepo.GetAllByAccountList(ctx, acc.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.webAuthnService.BeginRegistration(ctx, acc.ID, acc.Email, acc.FullName, existingCredentials)
+}
+
+// CreateWebAuthnCredential adds a passkey to an existing account
+//
+// Returns:
+//   - *WebAuthnCredential: The stored credential
+//   - error: ErrChallengeNotFound or ErrInvalidWebAuthnResponse
+func (s *AuthService) CreateWebAuthnCredential(ctx context.Context, acc *account.Account, registrationResponse string, nickname string, userAgent string, ipAddress string) (*WebAuthnCredential, error) {
+	registration, err := s.webAuthnService.FinishRegistration(ctx, registrationResponse, &acc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := s.webAuthnService.SaveCredential(ctx, acc.ID, registration.Credential, nickname)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(acc.AuthProviders, account.AuthProviderWebAuthnCredential) {
+		authProviders := append(slices.Clone(acc.AuthProviders), account.AuthProviderWebAuthnCredential)
+		if _, err := s.accountRepo.UpdateAuthProviders(ctx, acc, authProviders); err != nil {
+			return nil, fmt.Errorf("failed to update auth providers: %w", err)
+		}
+	}
+
+	s.publishSecurityEvent(ctx, account.SecurityEventPasskeyAdded, acc, userAgent, ipAddress, credential.Nickname)
+
+	return credential, nil
+}
+
+// GetSessions returns a page of the account's sessions, newest first
+func (s *AuthService) GetSessions(ctx context.Context, accountID int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*Session, int64], error) {
+	return s.sessionRepo.GetAllByAccountId(ctx, accountID, "", first, last, before, after)
+}
+
+// DeleteSession deletes one of the account's sessions other than the current one
+//
+// The current session is told by its ID, as bearer token sessions have no session token at hand.
+//
+// Returns:
+//   - *Session: The deleted session
+//   - error: ErrSessionNotFound
+func (s *AuthService) DeleteSession(ctx context.Context, accountID int64, sessionID int64, currentSessionID int64) (*Session, error) {
+	if sessionID == currentSessionID {
+		return nil, ErrSessionNotFound
+	}
+
+	session, err := s.sessionRepo.GetBySessionAccountId(ctx, sessionID, accountID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepo.Delete(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// DeleteOtherSessions deletes all of the account's sessions except the current one
+//
+// Returns:
+//   - []int64: The IDs of the deleted sessions
+func (s *AuthService) DeleteOtherSessions(ctx context.Context, accountID int64, currentSessionID int64) ([]int64, error) {
+	sessions, err := s.sessionRepo.GetAllList(ctx, accountID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIDs := make([]int64, 0, len(sessions))
+	for _, session := range sessions {
+		if session.ID != currentSessionID {
+			sessionIDs = append(sessionIDs, session.ID)
+		}
+	}
+
+	if err := s.sessionRepo.DeleteMany(ctx, sessionIDs); err != nil {
+		return nil, err
+	}
+
+	return sessionIDs, nil
+}
+
+// GetWebAuthnCredentials returns a page of the account's passkeys, newest first
+func (s *AuthService) GetWebAuthnCredentials(ctx context.Context, accountID int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*WebAuthnCredential, int64], error) {
+	return s.webAuthnCredentialRepo.GetAllByAccountId(ctx, accountID, first, last, before, after)
+}
+
+// UpdateWebAuthnCredential renames one of the account's passkeys
+//
+// Returns:
+//   - *WebAuthnCredential: The updated credential
+//   - error: ErrWebAuthnCredentialNotFound
+func (s *AuthService) UpdateWebAuthnCredential(ctx context.Context, accountID int64, webAuthnCredentialID int64, nickname string) (*WebAuthnCredential, error) {
+	if _, err := s.webAuthnCredentialRepo.GetByAccountCredentialId(ctx, accountID, webAuthnCredentialID); err != nil {
+		return nil, err
+	}
+
+	return s.webAuthnCredentialRepo.Update(ctx, webAuthnCredentialID, nickname)
+}
+
+// DeleteWebAuthnCredential deletes one of the account's passkeys
+//
+// Deleting the last passkey removes the passkey auth provider from the account, which
+// is refused when it would leave the account without any way to sign in.
+//
+// Returns:
+//   - *WebAuthnCredential: The deleted credential
+//   - error: ErrWebAuthnCredentialNotFound or ErrInsufficientAuthProviders
+func (s *AuthService) DeleteWebAuthnCredential(ctx context.Context, acc *account.Account, webAuthnCredentialID int64, userAgent string, ipAddress string) (*WebAuthnCredential, error) {
+	credential, err := s.webAuthnCredentialRepo.GetByAccountCredentialId(ctx, acc.ID, webAuthnCredentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := s.webAuthnCredentialRepo.GetAllByAccountList(ctx, acc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	isLastCredential := len(credentials) <= 1
+	if isLastCredential {
+		if err := ensureOtherAuthProviders(acc, account.AuthProviderWebAuthnCredential); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.webAuthnCredentialRepo.Delete(ctx, credential); err != nil {
+		return nil, err
+	}
+
+	if isLastCredential {
+		authProviders := slices.DeleteFunc(slices.Clone(acc.AuthProviders), func(provider string) bool {
+			return provider == account.AuthProviderWebAuthnCredential
+		})
+		if _, err := s.accountRepo.UpdateAuthProviders(ctx, acc, authProviders); err != nil {
+			return nil, fmt.Errorf("failed to update auth providers: %w", err)
+		}
+	}
+
+	s.publishSecurityEvent(ctx, account.SecurityEventPasskeyRemoved, acc, userAgent, ipAddress, credential.Nickname)
+
+	return credential, nil
+}
+
+// GetViewerSession returns the unexpired session for a session token, with its account loaded
+//
+// The use of the session is recorded, sliding its idle expiry forward within its absolute
+// lifetime. To spare a write per request, this happens at most once per activity update interval.
+//
+// Returns:
+//   - *Session: The session
+//   - error: ErrSessionNotFound if the session does not exist or has expired
+func (s *AuthService) GetViewerSession(ctx context.Context, sessionToken string) (*Session, error) {
+	session, err := s.sessionRepo.Get(ctx, sessionToken, true)
+	if err != nil {
+		if errors.Is(err, ErrTokenExpired) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	s.recordSessionActivity(ctx, session)
+	return session, nil
+}
+
+// BearerTokens are the access and refresh tokens of a native client's session
+type BearerTokens struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// CreateBearerTokens issues the first access and refresh tokens of a session
+//
+// Native clients sign in like browsers, then exchange the session cookie for bearer tokens.
+func (s *AuthService) CreateBearerTokens(ctx context.Context, session *Session) (*BearerTokens, error) {
+	return s.issueBearerTokens(ctx, session)
+}
+
+// RefreshBearerTokens exchanges a refresh token for new access and refresh tokens
+//
+// Refresh tokens are used once. A used refresh token presented again was stolen, or the
+// client lost the tokens it was given, so the session and all of its tokens are revoked.
+//
+// Returns:
+//   - *BearerTokens: The new tokens
+//   - error: ErrInvalidRefreshToken, or ErrRefreshTokenReused if the token family was revoked
+func (s *AuthService) RefreshBearerTokens(ctx context.Context, refreshToken string) (*BearerTokens, error) {
+	token, err := s.bearerTokenRepo.GetRefreshToken(ctx, refreshToken)
+	if err != nil {
+		if errors.Is(err, ErrBearerTokenNotFound) || errors.Is(err, ErrTokenExpired) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	if token.UsedAt != nil {
+		return nil, s.revokeTokenFamily(ctx, token.SessionId)
+	}
+
+	session, err := s.sessionRepo.GetById(ctx, token.SessionId)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) || errors.Is(err, ErrTokenExpired) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	unused, err := s.bearerTokenRepo.MarkRefreshTokenUsed(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !unused {
+		// A concurrent request used the token first
+		return nil, s.revokeTokenFamily(ctx, token.SessionId)
+	}
+
+	s.recordSessionActivity(ctx, session)
+	return s.issueBearerTokens(ctx, session)
+}
+
+// GetBearerViewerSession returns the unexpired session for an access token, with its account loaded
+//
+// Like GetViewerSession, the use of the session is recorded.
+//
+// Returns:
+//   - *Session: The session
+//   - error: ErrSessionNotFound if the access token or its session does not exist or has expired
+func (s *AuthService) GetBearerViewerSession(ctx context.Context, accessToken string) (*Session, error) {
+	token, err := s.bearerTokenRepo.GetAccessToken(ctx, accessToken)
+	if err != nil {
+		if errors.Is(err, ErrBearerTokenNotFound) || errors.Is(err, ErrTokenExpired) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	session, err := s.sessionRepo.GetById(ctx, token.SessionId)
+	if err != nil {
+		if errors.Is(err, ErrTokenExpired) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	s.recordSessionActivity(ctx, session)
+	return session, nil
+}
+
+// CreatePersonalAccessToken creates a personal access token for scripts and CI jobs to call the API as the account
+//
+// The token is granted the given scopes only, and expires at the given time, or never when
+// nil. The account owner is notified of the new token.
+//
+// Returns:
+//   - *PersonalAccessToken: The created token
+//   - string: The token itself, which can't be retrieved later
+//   - error: ErrInvalidPersonalAccessTokenName, ErrInvalidPersonalAccessTokenScopes or ErrInvalidPersonalAccessTokenExpiry
+func (s *AuthService) CreatePersonalAccessToken(ctx context.Context, acc *account.Account, name string, scopes []string, expiresAt *time.Time, userAgent string, ipAddress string) (*PersonalAccessToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > PersonalAccessTokenNameMaxLength {
+		return nil, "", ErrInvalidPersonalAccessTokenName
+	}
+
+	grantedScopes := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !slices.Contains(PersonalAccessTokenScopes, scope) {
+			return nil, "", ErrInvalidPersonalAccessTokenScopes
+		}
+		if !slices.Contains(grantedScopes, scope) {
+			grantedScopes = append(grantedScopes, scope)
+		}
+	}
+	if len(grantedScopes) == 0 {
+		return nil, "", ErrInvalidPersonalAccessTokenScopes
+	}
+
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", ErrInvalidPersonalAccessTokenExpiry
+	}
+
+	personalAccessToken, token, err := s.personalAccessTokenRepo.Create(ctx, acc.ID, name, grantedScopes, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.publishSecurityEvent(ctx, account.SecurityEventPersonalAccessTokenCreated, acc, userAgent, ipAddress, name)
+	return personalAccessToken, token, nil
+}
+
+// GetPersonalAccessTokens returns a page of the account's personal access tokens, newest first
+func (s *AuthService) GetPersonalAccessTokens(ctx context.Context, accountID int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*PersonalAccessToken, int64], error) {
+	return s.personalAccessTokenRepo.GetAllByAccountId(ctx, accountID, first, last, before, after)
+}
+
+// RevokePersonalAccessToken deletes one of the account's personal access tokens
+//
+// Returns:
+//   - *PersonalAccessToken: The revoked token
+//   - error: ErrPersonalAccessTokenNotFound
+func (s *AuthService) RevokePersonalAccessToken(ctx context.Context, accountID int64, personalAccessTokenID int64) (*PersonalAccessToken, error) {
+	personalAccessToken, err := s.personalAccessTokenRepo.GetByAccountTokenId(ctx, accountID, personalAccessTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.personalAccessTokenRepo.Delete(ctx, personalAccessToken); err != nil {
+		return nil, err
+	}
+
+	return personalAccessToken, nil
+}
+
+// GetPersonalAccessTokenViewer returns the unexpired personal access token for a token, with its account loaded
+//
+// The use of the token is recorded at most once per activity update interval.
+//
+// Returns:
+//   - *PersonalAccessToken: The token
+//   - error: ErrPersonalAccessTokenNotFound if the token does not exist or has expired
+func (s *AuthService) GetPersonalAccessTokenViewer(ctx context.Context, token string) (*PersonalAccessToken, error) {
+	personalAccessToken, err := s.personalAccessTokenRepo.Get(ctx, token)
+	if err != nil {
+		if errors.Is(err, ErrTokenExpired) {
+			return nil, ErrPersonalAccessTokenNotFound
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if personalAccessToken.LastUsedAt == nil || now.Sub(*personalAccessToken.LastUsedAt) >= s.cfg.SessionActivityUpdateInterval {
+		if err := s.personalAccessTokenRepo.UpdateLastUsedAt(ctx, personalAccessToken, now); err != nil {
+			// The token is still valid, its use is recorded on a later request
+			s.logger.Warn("Failed to record personal access token use", zap.Int64("personal_access_token_id", personalAccessToken.ID), zap.Error(err))
+		}
+	}
+
+	return personalAccessToken, nil
+}
+
+// issueBearerTokens creates an access and a refresh token for the session
+//
+// Neither outlives the session: the refresh token expires with the session's absolute
+// lifetime, and the access token no later than the session's current expiry.
+func (s *AuthService) issueBearerTokens(ctx context.Context, session *Session) (*BearerTokens, error) {
+	sessionExpiresAt := time.Unix(session.ExpiresAt, 0)
+	accessTokenExpiresAt := time.Now().Add(s.cfg.AccessTokenLifetime)
+	if accessTokenExpiresAt.After(sessionExpiresAt) {
+		accessTokenExpiresAt = sessionExpiresAt
+	}
+	refreshTokenExpiresAt := session.CreatedAt.Add(NewSessionPolicy(s.cfg, session.RememberMe).Lifetime)
+
+	accessToken, refreshToken, err := s.bearerTokenRepo.Create(ctx, session.ID, accessTokenExpiresAt, refreshTokenExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bearer tokens: %w", err)
+	}
+
+	return &BearerTokens{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshTokenExpiresAt,
+	}, nil
+}
+
+// revokeTokenFamily signs out the session of a reused refresh token, deleting all of its tokens
+func (s *AuthService) revokeTokenFamily(ctx context.Context, sessionID int64) error {
+	s.logger.Warn("Refresh token reused, revoking its session", zap.Int64("session_id", sessionID))
+
+	if err := s.bearerTokenRepo.DeleteAllBySession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to delete bearer tokens: %w", err)
+	}
+
+	if err := s.sessionRepo.DeleteMany(ctx, []int64{sessionID}); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	return ErrRefreshTokenReused
+}
+
+// recordSessionActivity records the use of the session and slides its idle expiry forward
+//
+// To spare a write per request, this happens at most once per activity update interval.
+func (s *AuthService) recordSessionActivity(ctx context.Context, session *Session) {
+	now := time.Now()
+	if now.Sub(session.LastActiveAt) < s.cfg.SessionActivityUpdateInterval {
+		return
+	}
+
+	expiresAt := NewSessionPolicy(s.cfg, session.RememberMe).ExpiresAt(session.CreatedAt, now)
+	if err := s.sessionRepo.Touch(ctx, session, now, expiresAt); err != nil {
+		// The session is still valid, its activity is recorded on a later request
+		s.logger.Warn("Failed to record session activity", zap.Int64("session_id", session.ID), zap.Error(err))
+	}
+}
+
+// LoginWithPassword verifies an email and password and logs the account in
+//
+// Accounts with 2FA enabled are not logged in, a pending login is started instead and
+// returned as a *TwoFactorRequiredError carrying its challenge. The login is completed
+// with VerifyTwoFactorWithAuthenticator or VerifyTwoFactorWithRecoveryCode.
+//
+// Failed attempts are counted per email address and IP address, unknown addresses and
+// accounts without a password included, so that locked out accounts can't be told apart
+// from unregistered ones.
+//
+// With rememberMe, the session follows the longer "remember me" session policy.
+//
+// Returns:
+//   - *account.Account: The authenticated account
+//   - string: The session token of the new session
+//   - error: ErrInvalidCredentials, a *TooManyAttemptsError or a *TwoFactorRequiredError
+func (s *AuthService) LoginWithPassword(ctx context.Context, emailAddress string, password string, userAgent string, ipAddress string, rememberMe bool) (*account.Account, string, error) {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return nil, "", ErrInvalidCredentials
+	}
+
+	if err := s.attemptLimiter.Check(ctx, emailAddress, ipAddress); err != nil {
+		return nil, "", err
+	}
+
+	acc, err := s.accountRepo.GetByEmail(ctx, emailAddress)
+	if err != nil {
+		if errors.Is(err, account.ErrAccountNotFound) {
+			s.recordFailedAttempt(ctx, nil, emailAddress, ipAddress)
+			return nil, "", ErrInvalidCredentials
+		}
+		return nil, "", fmt.Errorf("failed to get account by email: %w", err)
+	}
+
+	// Passwordless accounts fail like a wrong password, the sign in methods of an
+	// account are not disclosed to whoever knows its email address
+	if acc.PasswordHash == nil {
+		s.recordFailedAttempt(ctx, acc, emailAddress, ipAddress)
+		return nil, "", ErrInvalidCredentials
+	}
+
+	valid, err := s.verifyPassword(ctx, acc, password)
+	if err != nil {
+		return nil, "", err
+	}
+	if !valid {
+		s.recordFailedAttempt(ctx, acc, emailAddress, ipAddress)
+		return nil, "", ErrInvalidCredentials
+	}
+
+	// The failures are only reset once the login is complete, or the password could be
+	// used to reset them between guesses of the second factor
+	acc, sessionToken, err := s.startLogin(ctx, acc, userAgent, ipAddress, rememberMe)
+	if err != nil {
+		return nil, "", err
+	}
+	s.resetFailedAttempts(ctx, emailAddress)
+
+	return acc, sessionToken, nil
+}
+
+// LoginWithGoogle logs in with a Google ID token, creating the account on first sign in
+//
+// The Google account is matched by its subject first. Otherwise a new account is created
+// for it. An existing account using the same email address is only linked when
+// GOOGLE_TRUST_EMAIL is enabled, else ErrOAuthAccountExists is returned.
+//
+// Returns:
+//   - *account.Account: The authenticated account
+//   - string: The session token of the new session
+//   - error: ErrOAuthProviderUnsupported, ErrOAuthTokenInvalid, ErrEmailNotVerified,
+//     ErrInvalidEmail, ErrOAuthAccountExists or a *TwoFactorRequiredError
+func (s *AuthService) LoginWithGoogle(ctx context.Context, idToken string, userAgent string, ipAddress string, rememberMe bool) (*account.Account, string, error) {
+	identity, err := s.googleTokenVerifier.Verify(ctx, idToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.loginWithOAuthIdentity(ctx, account.AuthProviderOAuthGoogle, identity, s.cfg.GoogleTrustEmail, userAgent, ipAddress, rememberMe)
+}
+
+// StartOAuthLogin creates a pending social login and returns the provider's sign in URL
+//
+// The returned state is passed back to the callback by the provider, it must be bound
+// to the user's browser so that the login can't be completed from another one. The
+// "remember me" choice is kept with the state for the session created by the callback.
+//
+// Returns:
+//   - string: The URL to redirect the user to
+//   - string: The state of the pending login
+//   - error: ErrOAuthProviderUnsupported
+func (s *AuthService) StartOAuthLogin(ctx context.Context, providerName string, rememberMe bool) (string, string, error) {
+	return s.startOAuth(ctx, providerName, nil, rememberMe)
+}
+
+// FinishOAuthLogin completes a pending social login with the authorization code passed to the callback
+//
+// The provider's identity is matched like in LoginWithGoogle, existing accounts are
+// only linked for providers configured with OAUTH_<NAME>_TRUST_EMAIL.
+//
+// Returns:
+//   - *account.Account: The authenticated account
+//   - string: The session token of the new session
+//   - error: ErrOAuthProviderUnsupported, ErrOAuthStateNotFound, ErrOAuthTokenInvalid,
+//     ErrEmailNotVerified, ErrInvalidEmail, ErrOAuthAccountExists or a *TwoFactorRequiredError
+func (s *AuthService) FinishOAuthLogin(ctx context.Context, providerName string, state string, code string, userAgent string, ipAddress string) (*account.Account, string, error) {
+	identity, oauthState, err := s.exchangeOAuthCode(ctx, providerName, state, code, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	trustEmail := s.cfg.OAuthProviders[providerName].TrustEmail
+	return s.loginWithOAuthIdentity(ctx, OAuthCredentialProvider(providerName), identity, trustEmail, userAgent, ipAddress, oauthState.RememberMe)
+}
+
+// StartOAuthLink creates a pending social identity link for the account and returns the provider's sign in URL
+//
+// The flow is the same as StartOAuthLogin, except that the callback links the identity
+// to the account instead of logging in.
+//
+// Returns:
+//   - string: The URL to redirect the user to
+//   - string: The state of the pending link
+//   - error: ErrOAuthProviderUnsupported
+func (s *AuthService) StartOAuthLink(ctx context.Context, acc *account.Account, providerName string) (string, string, error) {
+	return s.startOAuth(ctx, providerName, &acc.ID, false)
+}
+
+// FinishOAuthLink links the provider's identity to the account that started the pending link
+//
+// An account can have a single identity per provider, and an identity can only be linked
+// to a single account. Linking an identity that is already linked to the account is a no-op.
+//
+// Returns:
+//   - *OAuthCredential: The linked identity
+//   - error: ErrOAuthProviderUnsupported, ErrOAuthStateNotFound, ErrOAuthTokenInvalid
+//     or ErrOAuthCredentialAlreadyExists
+func (s *AuthService) FinishOAuthLink(ctx context.Context, acc *account.Account, providerName string, state string, code string) (*OAuthCredential, error) {
+	identity, _, err := s.exchangeOAuthCode(ctx, providerName, state, code, &acc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialProvider := OAuthCredentialProvider(providerName)
+
+	credential, err := s.oauthCredentialRepo.GetByProviderUser(ctx, credentialProvider, identity.Subject, false)
+	if err == nil {
+		if credential.AccountId != acc.ID {
+			return nil, ErrOAuthCredentialAlreadyExists
+		}
+		return credential, nil
+	}
+	if !errors.Is(err, ErrOAuthCredentialNotFound) {
+		return nil, fmt.Errorf("failed to get oauth credential: %w", err)
+	}
+
+	_, err = s.oauthCredentialRepo.GetByAccountProvider(ctx, acc.ID, credentialProvider, false)
+	if err == nil {
+		return nil, ErrOAuthCredentialAlreadyExists
+	}
+	if !errors.Is(err, ErrOAuthCredentialNotFound) {
+		return nil, fmt.Errorf("failed to get oauth credential: %w", err)
+	}
+
+	credential, err = s.oauthCredentialRepo.Create(ctx, acc.ID, credentialProvider, identity.Subject)
+	if err != nil {
+		if errors.Is(err, ErrOAuthCredentialAlreadyExists) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create oauth credential: %w", err)
+	}
+
+	if !slices.Contains(acc.AuthProviders, credentialProvider) {
+		authProviders := append(slices.Clone(acc.AuthProviders), credentialProvider)
+		if _, err := s.accountRepo.UpdateAuthProviders(ctx, acc, authProviders); err != nil {
+			return nil, fmt.Errorf("failed to update auth providers: %w", err)
+		}
+	}
+
+	return credential, nil
+}
+
+// GetOAuthIdentities returns a page of the account's linked social identities, newest first
+func (s *AuthService) GetOAuthIdentities(ctx context.Context, accountID int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*OAuthCredential, int64], error) {
+	return s.oauthCredentialRepo.GetAllByAccountId(ctx, accountID, first, last, before, after)
+}
+
+// UnlinkOAuthIdentity removes one of the account's linked social identities
+//
+// Removing the last identity of a provider removes the provider from the account's auth
+// providers, which is refused when it would leave the account without any way to sign in.
+//
+// Returns:
+//   - *OAuthCredential: The unlinked identity
+//   - error: ErrOAuthCredentialNotFound or ErrInsufficientAuthProviders
+func (s *AuthService) UnlinkOAuthIdentity(ctx context.Context, acc *account.Account, oauthCredentialID int64) (*OAuthCredential, error) {
+	credential, err := s.oauthCredentialRepo.GetByAccountCredentialId(ctx, acc.ID, oauthCredentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := s.oauthCredentialRepo.GetAllByAccountList(ctx, acc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	isLastCredential := !slices.ContainsFunc(credentials, func(other *OAuthCredential) bool {
+		return other.ID != credential.ID && other.Provider == credential.Provider
+	})
+	if isLastCredential {
+		if err := ensureOtherAuthProviders(acc, credential.Provider); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.oauthCredentialRepo.Delete(ctx, credential); err != nil {
+		return nil, err
+	}
+
+	if isLastCredential {
+		authProviders := slices.DeleteFunc(slices.Clone(acc.AuthProviders), func(provider string) bool {
+			return provider == credential.Provider
+		})
+		if _, err := s.accountRepo.UpdateAuthProviders(ctx, acc, authProviders); err != nil {
+			return nil, fmt.Errorf("failed to update auth providers: %w", err)
+		}
+	}
+
+	return credential, nil
+}
+
+// VerifyTwoFactorWithAuthenticator completes a pending login with a TOTP code
+//
+// Returns:
+//   - *account.Account: The authenticated account
+//   - string: The session token of the new session
+//   - error: ErrTwoFactorAuthenticationNotFound, ErrTwoFactorNotEnabled, ErrInvalidTwoFactorCode
+//     or a *TooManyAttemptsError
+func (s *AuthService) VerifyTwoFactorWithAuthenticator(ctx context.Context, twoFactorChallenge string, twoFactorToken string, userAgent string, ipAddress string) (*account.Account, string, error) {
+	challenge, err := s.getTwoFactorChallenge(ctx, twoFactorChallenge)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !challenge.Account.Has2FAEnabled() {
+		return nil, "", ErrTwoFactorNotEnabled
+	}
+
+	if err := s.attemptLimiter.Check(ctx, challenge.Account.Email, ipAddress); err != nil {
+		return nil, "", err
+	}
+
+	valid, err := s.verifyTwoFactorCode(ctx, challenge.Account, *challenge.Account.TwoFactorSecret, twoFactorToken)
+	if err != nil {
+		return nil, "", err
+	}
+	if !valid {
+		s.recordFailedAttempt(ctx, challenge.Account, challenge.Account.Email, ipAddress)
+		return nil, "", ErrInvalidTwoFactorCode
+	}
+
+	return s.completeTwoFactorLogin(ctx, challenge, userAgent, ipAddress)
+}
+
+// VerifyTwoFactorWithRecoveryCode completes a pending login with a recovery code
+//
+// Recovery codes are single-use and are consumed on success. Of concurrent logins with the
+// same code, only the one that consumed it succeeds.
+//
+// Returns:
+//   - *account.Account: The authenticated account
+//   - string: The session token of the new session
+//   - error: ErrTwoFactorAuthenticationNotFound, ErrTwoFactorNotEnabled, ErrRecoveryCodeInvalid
+//     or a *TooManyAttemptsError
+func (s *AuthService) VerifyTwoFactorWithRecoveryCode(ctx context.Context, twoFactorChallenge string, recoveryCode string, userAgent string, ipAddress string) (*account.Account, string, error) {
+	challenge, err := s.getTwoFactorChallenge(ctx, twoFactorChallenge)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !challenge.Account.Has2FAEnabled() {
+		return nil, "", ErrTwoFactorNotEnabled
+	}
+
+	if err := s.attemptLimiter.Check(ctx, challenge.Account.Email, ipAddress); err != nil {
+		return nil, "", err
+	}
+
+	code, err := s.recoveryCodeRepo.Get(ctx, challenge.AccountId, strings.TrimSpace(recoveryCode))
+	if err != nil {
+		if errors.Is(err, ErrRecoveryCodeInvalid) {
+			s.recordFailedAttempt(ctx, challenge.Account, challenge.Account.Email, ipAddress)
+			return nil, "", err
+		}
+		return nil, "", fmt.Errorf("failed to get recovery code: %w", err)
+	}
+
+	deleted, err := s.recoveryCodeRepo.Delete(ctx, code)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to delete used recovery code: %w", err)
+	}
+	if !deleted {
+		return nil, "", ErrRecoveryCodeInvalid
+	}
+
+	return s.completeTwoFactorLogin(ctx, challenge, userAgent, ipAddress)
+}
+
+// GenerateAuthenticatorChallenge starts authenticator enrollment for an account
+//
+// A new TOTP secret is generated and held by a 2FA challenge until the enrollment is
+// confirmed with EnableTwoFactorWithAuthenticator.
+//
+// Returns:
+//   - string: The enrollment challenge
+//   - string: The otpauth URI for the account, to be displayed as a QR code
+//   - string: The base32 encoded TOTP secret
+func (s *AuthService) GenerateAuthenticatorChallenge(ctx context.Context, acc *account.Account) (string, string, string, error) {
+	secret, err := s.twoFactorAuthenticationChallengeRepo.GenerateTwoFactorSecret()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	otpURI, err := s.authenticatorURI(acc, secret)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	challenge, _, err := s.twoFactorAuthenticationChallengeRepo.Create(ctx, acc.ID, secret, false)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to create 2FA challenge: %w", err)
+	}
+
+	return challenge, otpURI, secret, nil
+}
+
+// EnableTwoFactorWithAuthenticator confirms authenticator enrollment with a TOTP code
+//
+// Any previous recovery codes are replaced by a new set, which is returned in plain text
+// and cannot be retrieved again.
+//
+// Returns:
+//   - *account.Account: The account with 2FA enabled
+//   - []string: The new recovery codes
+//   - error: ErrTwoFactorAuthenticationNotFound or ErrInvalidTwoFactorCode
+func (s *AuthService) EnableTwoFactorWithAuthenticator(ctx context.Context, acc *account.Account, enrollmentChallenge string, twoFactorToken string, userAgent string, ipAddress string) (*account.Account, []string, error) {
+	challenge, err := s.getTwoFactorChallenge(ctx, enrollmentChallenge)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if challenge.AccountId != acc.ID {
+		return nil, nil, ErrTwoFactorAuthenticationNotFound
+	}
+
+	valid, err := s.verifyTwoFactorCode(ctx, acc, challenge.TOTPSecret, twoFactorToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !valid {
+		return nil, nil, ErrInvalidTwoFactorCode
+	}
+
+	updatedAccount, err := s.accountRepo.SetTwoFactorSecret(ctx, acc, challenge.TOTPSecret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to set 2FA secret: %w", err)
+	}
+
+	if err := s.twoFactorAuthenticationChallengeRepo.Delete(ctx, challenge); err != nil {
+		s.logger.Warn("Failed to delete used 2FA challenge", zap.Error(err))
+	}
+
+	recoveryCodes, err := s.replaceRecoveryCodes(ctx, updatedAccount.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.publishSecurityEvent(ctx, account.SecurityEventTwoFactorEnabled, updatedAccount, userAgent, ipAddress, "")
+
+	return updatedAccount, recoveryCodes, nil
+}
+
+// DisableTwoFactorWithAuthenticator removes the account's authenticator and recovery codes
+//
+// Returns:
+//   - *account.Account: The account with 2FA disabled
+//   - error: ErrTwoFactorNotEnabled
+func (s *AuthService) DisableTwoFactorWithAuthenticator(ctx context.Context, acc *account.Account, userAgent string, ipAddress string) (*account.Account, error) {
+	if !acc.Has2FAEnabled() {
+		return nil, ErrTwoFactorNotEnabled
+	}
+
+	updatedAccount, err := s.accountRepo.DeleteTwoFactorSecret(ctx, acc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete 2FA secret: %w", err)
+	}
+
+	if err := s.recoveryCodeRepo.DeleteAll(ctx, acc.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	s.publishSecurityEvent(ctx, account.SecurityEventTwoFactorDisabled, updatedAccount, userAgent, ipAddress, "")
+
+	return updatedAccount, nil
+}
+
+// GenerateRecoveryCodes replaces the account's recovery codes with a new set
+//
+// Returns:
+//   - []string: The new recovery codes
+//   - error: ErrTwoFactorNotEnabled
+func (s *AuthService) GenerateRecoveryCodes(ctx context.Context, acc *account.Account, userAgent string, ipAddress string) ([]string, error) {
+	if !acc.Has2FAEnabled() {
+		return nil, ErrTwoFactorNotEnabled
+	}
+
+	recoveryCodes, err := s.replaceRecoveryCodes(ctx, acc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishSecurityEvent(ctx, account.SecurityEventRecoveryCodesRegenerated, acc, userAgent, ipAddress, "")
+
+	return recoveryCodes, nil
+}
+
+// GenerateReauthenticationOptions generates passkey request options limited to the viewer's passkeys
+func (s *AuthService) GenerateReauthenticationOptions(ctx context.Context, session *Session) (string, error) {
+	credentials, err := s.webAuthnCredentialRepo.GetAllByAccountList(ctx, session.AccountId)
+	if err != nil {
+		return "", err
+	}
+
+	return s.webAuthnService.BeginLogin(ctx, session.AccountId, credentials)
+}
+
+// RequestSudoModeWithPassword grants sudo mode to the session after re-entering the password
+//
+// Accounts with 2FA enabled must use RequestSudoModeWithAuthenticator instead.
+//
+// Returns:
+//   - error: ErrInvalidAuthProvider, ErrTwoFactorRequired, ErrInvalidCredentials or a *TooManyAttemptsError
+func (s *AuthService) RequestSudoModeWithPassword(ctx context.Context, session *Session, password string, ipAddress string) error {
+	acc := session.Account
+	if acc.PasswordHash == nil {
+		return ErrInvalidAuthProvider
+	}
+
+	if acc.Has2FAEnabled() {
+		return ErrTwoFactorRequired
+	}
+
+	if err := s.attemptLimiter.Check(ctx, acc.Email, ipAddress); err != nil {
+		return err
+	}
+
+	valid, err := s.verifyPassword(ctx, acc, password)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		s.recordFailedAttempt(ctx, acc, acc.Email, ipAddress)
+		return ErrInvalidCredentials
+	}
+
+	if err := s.grantSudoMode(ctx, session); err != nil {
+		return err
+	}
+	s.resetFailedAttempts(ctx, acc.Email)
+
+	return nil
+}
+
+// RequestSudoModeWithAuthenticator grants sudo mode to the session after verifying a TOTP code
+//
+// Returns:
+//   - error: ErrTwoFactorNotEnabled, ErrInvalidTwoFactorCode or a *TooManyAttemptsError
+func (s *AuthService) RequestSudoModeWithAuthenticator(ctx context.Context, session *Session, twoFactorToken string, ipAddress string) error {
+	acc := session.Account
+	if !acc.Has2FAEnabled() {
+		return ErrTwoFactorNotEnabled
+	}
+
+	if err := s.attemptLimiter.Check(ctx, acc.Email, ipAddress); err != nil {
+		return err
+	}
+
+	valid, err := s.verifyTwoFactorCode(ctx, acc, *acc.TwoFactorSecret, twoFactorToken)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		s.recordFailedAttempt(ctx, acc, acc.Email, ipAddress)
+		return ErrInvalidTwoFactorCode
+	}
+
+	if err := s.grantSudoMode(ctx, session); err != nil {
+		return err
+	}
+	s.resetFailedAttempts(ctx, acc.Email)
+
+	return nil
+}
+
+// RequestSudoModeWithPasskey grants sudo mode to the session after verifying one of the account's passkeys
+//
+// Returns:
+//   - error: ErrChallengeNotFound, ErrInvalidWebAuthnResponse or ErrWebAuthnCredentialCloned
+func (s *AuthService) RequestSudoModeWithPasskey(ctx context.Context, session *Session, authenticationResponse string) error {
+	credential, err := s.webAuthnService.FinishLogin(ctx, authenticationResponse)
+	if err != nil {
+		return err
+	}
+
+	if credential.AccountId != session.AccountId {
+		return ErrInvalidWebAuthnResponse
+	}
+
+	return s.grantSudoMode(ctx, session)
+}
+
+// RevokeSudoMode revokes the sudo mode grants of all of the account's sessions
+func (s *AuthService) RevokeSudoMode(ctx context.Context, accountID int64) error {
+	return s.sessionRepo.RevokeAllSudoMode(ctx, accountID)
+}
+
+// UnlockAccount lifts the lock placed on an account after too many failed attempts
+//
+// The unlock token is the one mailed to the account when it was locked.
+//
+// Returns:
+//   - error: ErrInvalidUnlockToken
+func (s *AuthService) UnlockAccount(ctx context.Context, emailAddress string, unlockToken string) error {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return ErrInvalidUnlockToken
+	}
+
+	return s.attemptLimiter.Unlock(ctx, emailAddress, unlockToken)
+}
+
+// RevokeSessions signs the account out of all of its sessions, using the "this wasn't me" link
+// of a security notification
+//
+// Its personal access tokens are revoked too, and its password is cleared, as whoever
+// signed in may know it. The owner sets a new one with a password reset. All revocation
+// links of the account stop working once used.
+//
+// Returns:
+//   - error: ErrInvalidOrExpiredToken
+func (s *AuthService) RevokeSessions(ctx context.Context, revocationToken string) error {
+	token, err := s.sessionRevocationTokenRepo.Get(ctx, revocationToken)
+	if err != nil {
+		if errors.Is(err, ErrSessionRevocationTokenNotFound) || errors.Is(err, ErrTokenExpired) {
+			return ErrInvalidOrExpiredToken
+		}
+		return err
+	}
+
+	if err := s.sessionRepo.DeleteAll(ctx, token.AccountId); err != nil {
+		return fmt.Errorf("failed to invalidate sessions: %w", err)
+	}
+
+	if err := s.personalAccessTokenRepo.DeleteAll(ctx, token.AccountId); err != nil {
+		return fmt.Errorf("failed to revoke personal access tokens: %w", err)
+	}
+
+	if token.Account != nil && token.Account.PasswordHash != nil {
+		if _, err := s.accountRepo.DeletePassword(ctx, token.Account); err != nil {
+			return fmt.Errorf("failed to clear password: %w", err)
+		}
+	}
+
+	if err := s.sessionRevocationTokenRepo.DeleteAll(ctx, token.AccountId); err != nil {
+		return fmt.Errorf("failed to delete session revocation tokens: %w", err)
+	}
+
+	s.logger.Warn("Sessions revoked from a security notification", zap.Int64("account_id", token.AccountId))
+	return nil
+}
+
+// RequestPasswordReset creates a password reset token and mails a reset link to the account
+//
+// To avoid revealing whether an email address is registered, unknown and invalid addresses
+// succeed without sending anything, and requests made within the cooldown of the previous
+// token succeed without issuing a new one.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, emailAddress string, userAgent string) error {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return nil
+	}
+
+	acc, err := s.accountRepo.GetByEmail(ctx, emailAddress)
+	if err != nil {
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get account by email: %w", err)
+	}
+
+	existingToken, err := s.passwordResetTokenRepo.GetByAccount(ctx, acc.ID)
+	if err != nil && !errors.Is(err, ErrPasswordResetTokenNotFound) {
+		return fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	if existingToken != nil {
+		if remaining := cooldownRemaining(existingToken.CreatedAt, PasswordResetTokenCooldown); remaining > 0 {
+			s.logger.Debug("Password reset requested within cooldown", zap.Int64("account_id", acc.ID), zap.Int("remaining_seconds", remaining))
+			return nil
+		}
+
+		// Only one token is kept per account
+		if err := s.passwordResetTokenRepo.Delete(ctx, existingToken); err != nil {
+			return fmt.Errorf("failed to delete previous password reset token: %w", err)
+		}
+	}
+
+	token, err := s.passwordResetTokenRepo.Create(ctx, acc.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	resetLink := fmt.Sprintf("%s/auth/reset-password/%s?email=%s", strings.TrimRight(s.cfg.AccountsBaseURL, "/"), token, url.QueryEscape(emailAddress))
+	isInitial := acc.PasswordHash == nil
+	if err := s.emailClient.SendPasswordReset(ctx, s.cfg, resetLink, userAgent, isInitial, emailAddress); err != nil {
+		// Failing the request would reveal that the account exists
+		s.logger.Error("Failed to send password reset email", zap.Error(err))
+	}
+
+	return nil
+}
+
+// RequestEmailLoginCode mails a one-time login code and login link to the account using the given email address
+//
+// Nothing is sent when no account uses the address or while the cooldown of the previous
+// code has not elapsed, and neither case is reported so that accounts can't be enumerated.
+func (s *AuthService) RequestEmailLoginCode(ctx context.Context, emailAddress string, userAgent string) error {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return nil
+	}
+
+	acc, err := s.accountRepo.GetByEmail(ctx, emailAddress)
+	if err != nil {
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get account by email: %w", err)
+	}
+
+	existingCode, err := s.emailLoginCodeRepo.GetByAccount(ctx, acc.ID)
+	if err != nil && !errors.Is(err, ErrEmailLoginCodeNotFound) {
+		return fmt.Errorf("failed to get email login code: %w", err)
+	}
+
+	if existingCode != nil {
+		if remaining := cooldownRemaining(existingCode.CreatedAt, EmailLoginCodeCooldown); remaining > 0 {
+			s.logger.Debug("Email login code requested within cooldown", zap.Int64("account_id", acc.ID), zap.Int("remaining_seconds", remaining))
+			return nil
+		}
+
+		// Only one code is kept per account
+		if err := s.emailLoginCodeRepo.Delete(ctx, existingCode); err != nil {
+			return fmt.Errorf("failed to delete previous email login code: %w", err)
+		}
+	}
+
+	code, token, err := s.emailLoginCodeRepo.Create(ctx, acc.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create email login code: %w", err)
+	}
+
+	loginLink := fmt.Sprintf("%s/auth/login/email?%s", strings.TrimRight(s.cfg.AccountsBaseURL, "/"), url.Values{"email": {emailAddress}, "code": {token}}.Encode())
+	if err := s.emailClient.SendEmailLoginCode(ctx, s.cfg, emailAddress, code, loginLink, userAgent); err != nil {
+		// Failing the request would reveal that the account exists
+		s.logger.Error("Failed to send email login code", zap.Error(err))
+	}
+
+	return nil
+}
+
+// LoginWithEmailCode logs in with a code sent by RequestEmailLoginCode
+//
+// The code is either the numeric code or the token of the login link. Codes are single
+// use, and are discarded once expired or after too many attempts. Unknown accounts fail
+// like wrong codes.
+//
+// Failed attempts are counted per email address and IP address, like in LoginWithPassword.
+//
+// Returns:
+//   - *account.Account: The authenticated account
+//   - string: The session token of the new session
+//   - error: ErrInvalidEmailLoginCode, a *TooManyAttemptsError or a *TwoFactorRequiredError
+func (s *AuthService) LoginWithEmailCode(ctx context.Context, emailAddress string, code string, userAgent string, ipAddress string, rememberMe bool) (*account.Account, string, error) {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return nil, "", ErrInvalidEmailLoginCode
+	}
+
+	if err := s.attemptLimiter.Check(ctx, emailAddress, ipAddress); err != nil {
+		return nil, "", err
+	}
+
+	acc, err := s.accountRepo.GetByEmail(ctx, emailAddress)
+	if err != nil {
+		if errors.Is(err, account.ErrAccountNotFound) {
+			s.recordFailedAttempt(ctx, nil, emailAddress, ipAddress)
+			return nil, "", ErrInvalidEmailLoginCode
+		}
+		return nil, "", fmt.Errorf("failed to get account by email: %w", err)
+	}
+
+	loginCode, err := s.emailLoginCodeRepo.GetByAccount(ctx, acc.ID)
+	if err != nil {
+		if errors.Is(err, ErrEmailLoginCodeNotFound) {
+			s.recordFailedAttempt(ctx, acc, emailAddress, ipAddress)
+			return nil, "", ErrInvalidEmailLoginCode
+		}
+		return nil, "", fmt.Errorf("failed to get email login code: %w", err)
+	}
+
+	if time.Now().Unix() > loginCode.ExpiresAt {
+		if err := s.emailLoginCodeRepo.Delete(ctx, loginCode); err != nil {
+			return nil, "", fmt.Errorf("failed to delete email login code: %w", err)
+		}
+		s.recordFailedAttempt(ctx, acc, emailAddress, ipAddress)
+		return nil, "", ErrInvalidEmailLoginCode
+	}
+
+	// The attempt is counted before the code is checked, concurrent guesses can't exceed the limit
+	if err := s.emailLoginCodeRepo.IncrementAttempts(ctx, loginCode, EmailLoginCodeMaxAttempts); err != nil {
+		if !errors.Is(err, ErrEmailLoginCodeNotFound) {
+			return nil, "", err
+		}
+		if err := s.emailLoginCodeRepo.Delete(ctx, loginCode); err != nil {
+			return nil, "", fmt.Errorf("failed to delete email login code: %w", err)
+		}
+		s.recordFailedAttempt(ctx, acc, emailAddress, ipAddress)
+		return nil, "", ErrInvalidEmailLoginCode
+	}
+
+	code = strings.TrimSpace(code)
+	if !s.emailLoginCodeRepo.VerifyLoginCode(code, loginCode.CodeHash) &&
+		!s.emailLoginCodeRepo.VerifyLoginCode(code, loginCode.TokenHash) {
+		if loginCode.Attempts >= EmailLoginCodeMaxAttempts {
+			if err := s.emailLoginCodeRepo.Delete(ctx, loginCode); err != nil {
+				return nil, "", fmt.Errorf("failed to delete email login code: %w", err)
+			}
+		}
+		s.recordFailedAttempt(ctx, acc, emailAddress, ipAddress)
+		return nil, "", ErrInvalidEmailLoginCode
+	}
+
+	if err := s.emailLoginCodeRepo.Delete(ctx, loginCode); err != nil {
+		return nil, "", fmt.Errorf("failed to delete used email login code: %w", err)
+	}
+
+	acc, sessionToken, err := s.startLogin(ctx, acc, userAgent, ipAddress, rememberMe)
+	if err != nil {
+		return nil, "", err
+	}
+	s.resetFailedAttempts(ctx, emailAddress)
+
+	return acc, sessionToken, nil
+}
+
+// RequestSmsLoginCode sends a one-time login code to the account using the given phone number
+//
+// Nothing is sent when no account uses the phone number or while the cooldown of the
+// previous code sent to the number has not elapsed, and neither case is reported so that
+// accounts can't be enumerated.
+//
+// Returns:
+//   - error: account.ErrInvalidPhoneNumber
+func (s *AuthService) RequestSmsLoginCode(ctx context.Context, phoneNumber string) error {
+	phoneNumber = strings.TrimSpace(phoneNumber)
+	if err := s.messageSender.ValidatePhoneNumber(phoneNumber); err != nil {
+		return fmt.Errorf("%w: %w", account.ErrInvalidPhoneNumber, err)
+	}
+
+	acc, err := s.accountRepo.GetByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get account by phone number: %w", err)
+	}
+
+	existingCode, err := s.smsLoginCodeRepo.GetByPhoneNumber(ctx, phoneNumber)
+	if err != nil && !errors.Is(err, ErrSmsLoginCodeNotFound) {
+		return fmt.Errorf("failed to get sms login code: %w", err)
+	}
+
+	if existingCode != nil {
+		if remaining := cooldownRemaining(existingCode.CreatedAt, SmsLoginCodeCooldown); remaining > 0 {
+			s.logger.Debug("SMS login code requested within cooldown", zap.Int64("account_id", acc.ID), zap.Int("remaining_seconds", remaining))
+			return nil
+		}
+
+		// Only one code is kept per phone number
+		if err := s.smsLoginCodeRepo.Delete(ctx, existingCode); err != nil {
+			return fmt.Errorf("failed to delete previous sms login code: %w", err)
+		}
+	}
+
+	code, err := s.smsLoginCodeRepo.Create(ctx, acc.ID, phoneNumber)
+	if err != nil {
+		return fmt.Errorf("failed to create sms login code: %w", err)
+	}
+
+	message := fmt.Sprintf("Your login code is: %s. This code will expire in %d minutes.", code, int(SmsLoginCodeLifetime.Minutes()))
+	if err := s.messageSender.SendSMS(ctx, phoneNumber, message); err != nil {
+		// Failing the request would reveal that the account exists
+		s.logger.Error("Failed to send SMS login code", zap.Error(err))
+	}
+
+	return nil
+}
+
+// LoginWithSmsCode logs in with a code sent by RequestSmsLoginCode
+//
+// Codes are single use, and are discarded once expired or after too many attempts.
+// Unknown phone numbers fail like wrong codes.
+//
+// Failed attempts are counted per phone number and IP address. The account behind a phone
+// number is only known once the code is verified, so no unlock link is mailed when the
+// phone number gets locked, the lock expires on its own.
+//
+// Returns:
+//   - *account.Account: The authenticated account
+//   - string: The session token of the new session
+//   - error: ErrInvalidSmsLoginCode, a *TooManyAttemptsError or a *TwoFactorRequiredError
+func (s *AuthService) LoginWithSmsCode(ctx context.Context, phoneNumber string, code string, userAgent string, ipAddress string, rememberMe bool) (*account.Account, string, error) {
+	phoneNumber = strings.TrimSpace(phoneNumber)
+	if err := s.messageSender.ValidatePhoneNumber(phoneNumber); err != nil {
+		return nil, "", ErrInvalidSmsLoginCode
+	}
+
+	if err := s.attemptLimiter.Check(ctx, phoneNumber, ipAddress); err != nil {
+		return nil, "", err
+	}
+
+	loginCode, err := s.smsLoginCodeRepo.GetByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, ErrSmsLoginCodeNotFound) {
+			s.recordFailedAttempt(ctx, nil, phoneNumber, ipAddress)
+			return nil, "", ErrInvalidSmsLoginCode
+		}
+		return nil, "", fmt.Errorf("failed to get sms login code: %w", err)
+	}
+
+	if time.Now().Unix() > loginCode.ExpiresAt {
+		if err := s.smsLoginCodeRepo.Delete(ctx, loginCode); err != nil {
+			return nil, "", fmt.Errorf("failed to delete sms login code: %w", err)
+		}
+		s.recordFailedAttempt(ctx, nil, phoneNumber, ipAddress)
+		return nil, "", ErrInvalidSmsLoginCode
+	}
+
+	// The attempt is counted before the code is checked, concurrent guesses can't exceed the limit
+	if err := s.smsLoginCodeRepo.IncrementAttempts(ctx, loginCode, SmsLoginCodeMaxAttempts); err != nil {
+		if !errors.Is(err, ErrSmsLoginCodeNotFound) {
+			return nil, "", err
+		}
+		if err := s.smsLoginCodeRepo.Delete(ctx, loginCode); err != nil {
+			return nil, "", fmt.Errorf("failed to delete sms login code: %w", err)
+		}
+		s.recordFailedAttempt(ctx, nil, phoneNumber, ipAddress)
+		return nil, "", ErrInvalidSmsLoginCode
+	}
+
+	if !s.smsLoginCodeRepo.VerifyLoginCode(strings.TrimSpace(code), loginCode.CodeHash) {
+		if loginCode.Attempts >= SmsLoginCodeMaxAttempts {
+			if err := s.smsLoginCodeRepo.Delete(ctx, loginCode); err != nil {
+				return nil, "", fmt.Errorf("failed to delete sms login code: %w", err)
+			}
+		}
+		s.recordFailedAttempt(ctx, nil, phoneNumber, ipAddress)
+		return nil, "", ErrInvalidSmsLoginCode
+	}
+
+	if err := s.smsLoginCodeRepo.Delete(ctx, loginCode); err != nil {
+		return nil, "", fmt.Errorf("failed to delete used sms login code: %w", err)
+	}
+
+	// The phone number may have moved to another account since the code was sent
+	acc, err := s.accountRepo.GetByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, account.ErrAccountNotFound) {
+			return nil, "", ErrInvalidSmsLoginCode
+		}
+		return nil, "", fmt.Errorf("failed to get account by phone number: %w", err)
+	}
+	if acc.ID != loginCode.AccountId {
+		return nil, "", ErrInvalidSmsLoginCode
+	}
+
+	acc, sessionToken, err := s.startLogin(ctx, acc, userAgent, ipAddress, rememberMe)
+	if err != nil {
+		return nil, "", err
+	}
+	s.resetFailedAttempts(ctx, phoneNumber)
+
+	return acc, sessionToken, nil
+}
+
+// GetPasswordResetToken returns a valid password reset token for the given email address
+//
+// twoFactorChallenge is the challenge issued by a previous 2FA verification for this token, if any.
+//
+// Returns:
+//   - *PasswordResetToken: The token with its account loaded
+//   - bool: Whether the 2FA step must still be completed before the password can be reset
+//   - error: ErrInvalidOrExpiredToken
+func (s *AuthService) GetPasswordResetToken(ctx context.Context, emailAddress string, passwordResetToken string, twoFactorChallenge string) (*PasswordResetToken, bool, error) {
+	resetToken, err := s.getValidPasswordResetToken(ctx, emailAddress, passwordResetToken)
+	if err != nil {
+		return nil, false, err
+	}
+
+	needs2FA, err := s.passwordResetNeeds2FA(ctx, resetToken, twoFactorChallenge)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return resetToken, needs2FA, nil
+}
+
+// Verify2FAPasswordResetWithAuthenticator completes the 2FA step of a password reset with a TOTP code
+//
+// Returns:
+//   - string: The temporary 2FA challenge that authorizes the password reset
+//   - *PasswordResetToken: The verified token with its account loaded
+//   - error: ErrInvalidOrExpiredToken, ErrTwoFactorNotEnabled, ErrInvalidTwoFactorCode or a *TooManyAttemptsError
+func (s *AuthService) Verify2FAPasswordResetWithAuthenticator(ctx context.Context, emailAddress string, passwordResetToken string, twoFactorToken string, ipAddress string) (string, *PasswordResetToken, error) {
+	resetToken, err := s.getValidPasswordResetToken(ctx, emailAddress, passwordResetToken)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !resetToken.Account.Has2FAEnabled() {
+		return "", nil, ErrTwoFactorNotEnabled
+	}
+
+	if err := s.attemptLimiter.Check(ctx, resetToken.Account.Email, ipAddress); err != nil {
+		return "", nil, err
+	}
+
+	valid, err := s.verifyTwoFactorCode(ctx, resetToken.Account, *resetToken.Account.TwoFactorSecret, twoFactorToken)
+	if err != nil {
+		return "", nil, err
+	}
+	if !valid {
+		s.recordFailedAttempt(ctx, resetToken.Account, resetToken.Account.Email, ipAddress)
+		return "", nil, ErrInvalidTwoFactorCode
+	}
+
+	return s.createPasswordResetChallenge(ctx, resetToken)
+}
+
+// Verify2FAPasswordResetWithPasskey completes the 2FA step of a password reset with a passkey
+//
+// The passkey must belong to the account the password reset token was issued for.
+//
+// Returns:
+//   - string: The temporary 2FA challenge that authorizes the password reset
+//   - *PasswordResetToken: The verified token with its account loaded
+//   - error: ErrInvalidOrExpiredToken, ErrTwoFactorNotEnabled, ErrChallengeNotFound,
+//     ErrInvalidWebAuthnResponse or ErrWebAuthnCredentialCloned
+func (s *AuthService) Verify2FAPasswordResetWithPasskey(ctx context.Context, emailAddress string, passwordResetToken string, authenticationResponse string) (string, *PasswordResetToken, error) {
+	resetToken, err := s.getValidPasswordResetToken(ctx, emailAddress, passwordResetToken)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !resetToken.Account.Has2FAEnabled() {
+		return "", nil, ErrTwoFactorNotEnabled
+	}
+
+	credential, err := s.webAuthnService.FinishLogin(ctx, authenticationResponse)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if credential.AccountId != resetToken.AccountId {
+		return "", nil, ErrInvalidWebAuthnResponse
+	}
+
+	return s.createPasswordResetChallenge(ctx, resetToken)
+}
+
+// ResetPassword sets a new password using a password reset token
+//
+// Accounts with 2FA enabled must present the challenge issued by a 2FA verification for
+// the same token. On success the token and challenge are consumed, and all of the
+// account's sessions and personal access tokens are invalidated.
+//
+// Returns:
+//   - *account.Account: The updated account
+//   - error: ErrInvalidOrExpiredToken, ErrTemporaryTwoFactorNotFound, a *PasswordPolicyError
+//     or ErrPasswordPreviouslyUsed
+func (s *AuthService) ResetPassword(ctx context.Context, emailAddress string, passwordResetToken string, newPassword string, twoFactorChallenge string, userAgent string, ipAddress string) (*account.Account, error) {
+	resetToken, err := s.getValidPasswordResetToken(ctx, emailAddress, passwordResetToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var challenge *TemporaryTwoFactorChallenge
+	if resetToken.Account.Has2FAEnabled() {
+		challenge, err = s.getPasswordResetChallenge(ctx, resetToken, twoFactorChallenge)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updatedAccount, err := s.changePassword(ctx, resetToken.Account, newPassword, userAgent, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.passwordResetTokenRepo.Delete(ctx, resetToken); err != nil {
+		return nil, fmt.Errorf("failed to delete password reset token: %w", err)
+	}
+
+	if challenge != nil {
+		if err := s.tempTwoFactorChallengeRepo.Delete(ctx, challenge); err != nil {
+			s.logger.Warn("Failed to delete used password reset 2FA challenge", zap.Error(err))
+		}
+	}
+
+	if err := s.sessionRepo.DeleteAll(ctx, updatedAccount.ID); err != nil {
+		return nil, fmt.Errorf("failed to invalidate sessions: %w", err)
+	}
+
+	if err := s.personalAccessTokenRepo.DeleteAll(ctx, updatedAccount.ID); err != nil {
+		return nil, fmt.Errorf("failed to revoke personal access tokens: %w", err)
+	}
+
+	return updatedAccount, nil
+}
+
+// UpdatePassword sets a new password for the account of the session
+//
+// Accounts without a password gain password authentication.
+//
+// Returns:
+//   - *account.Account: The updated account
+//   - error: a *PasswordPolicyError or ErrPasswordPreviouslyUsed
+func (s *AuthService) UpdatePassword(ctx context.Context, session *Session, newPassword string, userAgent string, ipAddress string) (*account.Account, error) {
+	return s.changePassword(ctx, session.Account, newPassword, userAgent, ipAddress)
+}
+
+// changePassword sets a new password after checking it against the password policy and history
+//
+// The previous password is recorded in the history, which is then pruned to the configured
+// size and retention. History failures are only logged since the password already changed.
+// The account owner is then notified of the change.
+func (s *AuthService) changePassword(ctx context.Context, acc *account.Account, newPassword string, userAgent string, ipAddress string) (*account.Account, error) {
+	if err := s.passwordPolicy.Validate(ctx, newPassword, acc.Email, acc.FullName); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensurePasswordNotReused(ctx, acc, newPassword); err != nil {
+		return nil, err
+	}
+
+	previousPasswordHash := acc.PasswordHash
+	updatedAccount, err := s.accountRepo.UpdatePassword(ctx, acc, newPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if previousPasswordHash != nil && s.cfg.PasswordHistorySize > 0 {
+		if _, err := s.passwordHistoryRepo.Create(ctx, acc.ID, *previousPasswordHash); err != nil {
+			s.logger.Warn("Failed to record previous password", zap.Int64("account_id", acc.ID), zap.Error(err))
+		} else if err := s.passwordHistoryRepo.Prune(ctx, acc.ID, s.cfg.PasswordHistorySize, s.passwordHistoryCutoff()); err != nil {
+			s.logger.Warn("Failed to prune password history", zap.Int64("account_id", acc.ID), zap.Error(err))
+		}
+	}
+
+	s.publishSecurityEvent(ctx, account.SecurityEventPasswordChanged, updatedAccount, userAgent, ipAddress, "")
+
+	return updatedAccount, nil
+}
+
+// ensurePasswordNotReused rejects the account's current password and the passwords in its history
+//
+// Returns:
+//   - error: ErrPasswordPreviouslyUsed
+func (s *AuthService) ensurePasswordNotReused(ctx context.Context, acc *account.Account, newPassword string) error {
+	var passwordHashes []string
+	if acc.PasswordHash != nil {
+		passwordHashes = append(passwordHashes, *acc.PasswordHash)
+	}
+
+	if s.cfg.PasswordHistorySize > 0 {
+		entries, err := s.passwordHistoryRepo.GetRecent(ctx, acc.ID, s.cfg.PasswordHistorySize, s.passwordHistoryCutoff())
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			passwordHashes = append(passwordHashes, entry.PasswordHash)
+		}
+	}
+
+	for _, passwordHash := range passwordHashes {
+		used, err := s.accountRepo.VerifyPassword(newPassword, passwordHash)
+		if err != nil {
+			// A hash that can't be decoded can't match either
+			s.logger.Warn("Failed to compare password with a previous password", zap.Int64("account_id", acc.ID), zap.Error(err))
+			continue
+		}
+		if used {
+			return ErrPasswordPreviouslyUsed
+		}
+	}
+
+	return nil
+}
+
+// passwordHistoryCutoff returns the time before which password history entries have expired
+func (s *AuthService) passwordHistoryCutoff() time.Time {
+	if s.cfg.PasswordHistoryRetention <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-s.cfg.PasswordHistoryRetention)
+}
+
+// getValidPasswordResetToken returns the password reset token if it matches the email address and has not expired
+func (s *AuthService) getValidPasswordResetToken(ctx context.Context, emailAddress string, passwordResetToken string) (*PasswordResetToken, error) {
+	emailAddress, err := normalizeEmail(emailAddress)
+	if err != nil {
+		return nil, ErrInvalidOrExpiredToken
+	}
+
+	resetToken, err := s.passwordResetTokenRepo.Get(ctx, passwordResetToken, emailAddress)
+	if err != nil {
+		if errors.Is(err, ErrPasswordResetTokenNotFound) || errors.Is(err, ErrTokenExpired) {
+			return nil, ErrInvalidOrExpiredToken
+		}
+		return nil, fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	if resetToken.Account == nil || resetToken.Account.Email != emailAddress {
+		return nil, ErrInvalidOrExpiredToken
+	}
+
+	return resetToken, nil
+}
+
+// passwordResetNeeds2FA reports whether the 2FA step is still required for the password reset token
+func (s *AuthService) passwordResetNeeds2FA(ctx context.Context, resetToken *PasswordResetToken, twoFactorChallenge string) (bool, error) {
+	if !resetToken.Account.Has2FAEnabled() {
+		return false, nil
+	}
+
+	_, err := s.getPasswordResetChallenge(ctx, resetToken, twoFactorChallenge)
+	if errors.Is(err, ErrTemporaryTwoFactorNotFound) {
+		return true, nil
+	}
+	return false, err
+}
+
+// getPasswordResetChallenge returns the temporary 2FA challenge issued for the password reset token
+func (s *AuthService) getPasswordResetChallenge(ctx context.Context, resetToken *PasswordResetToken, twoFactorChallenge string) (*TemporaryTwoFactorChallenge, error) {
+	if twoFactorChallenge == "" {
+		return nil, ErrTemporaryTwoFactorNotFound
+	}
+
+	challenge, err := s.tempTwoFactorChallengeRepo.Get(ctx, twoFactorChallenge, resetToken.ID, false)
+	if err != nil {
+		if errors.Is(err, ErrTokenExpired) {
+			return nil, ErrTemporaryTwoFactorNotFound
+		}
+		if errors.Is(err, ErrTemporaryTwoFactorNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get temporary 2FA challenge: %w", err)
+	}
+
+	if challenge.AccountId != resetToken.AccountId {
+		return nil, ErrTemporaryTwoFactorNotFound
+	}
+
+	return challenge, nil
+}
+
+// createPasswordResetChallenge issues a temporary 2FA challenge for a verified password reset token
+func (s *AuthService) createPasswordResetChallenge(ctx context.Context, resetToken *PasswordResetToken) (string, *PasswordResetToken, error) {
+	challenge, _, err := s.tempTwoFactorChallengeRepo.Create(ctx, resetToken.AccountId, resetToken.ID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create temporary 2FA challenge: %w", err)
+	}
+	return challenge, resetToken, nil
+}
+
+// ensureOtherAuthProviders checks that the account keeps a way to sign in once the given provider is removed
+func ensureOtherAuthProviders(acc *account.Account, removedProvider string) error {
+	for _, provider := range acc.AuthProviders {
+		if provider != removedProvider {
+			return nil
+		}
+	}
+	return ErrInsufficientAuthProviders
+}
+
+// grantSudoMode records a sudo mode grant on the session, valid for the configured lifetime
+func (s *AuthService) grantSudoMode(ctx context.Context, session *Session) error {
+	sudoModeExpiresAt := time.Now().Add(s.cfg.SudoModeLifetime).UTC()
+	return s.sessionRepo.UpdateSudoModeExpiresAt(ctx, session, &sudoModeExpiresAt)
+}
+
+// verifyPassword checks the account's password and upgrades its hash when it doesn't use the current parameters
+//
+// A failed upgrade is logged and doesn't fail the verification, it is retried on the next login.
+func (s *AuthService) verifyPassword(ctx context.Context, acc *account.Account, password string) (bool, error) {
+	valid, err := s.accountRepo.VerifyPassword(password, *acc.PasswordHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify password: %w", err)
+	}
+
+	if valid && s.accountRepo.PasswordNeedsRehash(*acc.PasswordHash) {
+		if _, err := s.accountRepo.RehashPassword(ctx, acc, password); err != nil {
+			s.logger.Warn("Failed to rehash password", zap.Int64("account_id", acc.ID), zap.Error(err))
+		}
+	}
+
+	return valid, nil
+}
+
+// recordFailedAttempt counts a failed attempt and mails an unlock link when it locked the account
+//
+// acc is nil for unregistered email addresses and for phone numbers, which are counted but never mailed.
+func (s *AuthService) recordFailedAttempt(ctx context.Context, acc *account.Account, emailAddress string, ipAddress string) {
+	unlockToken, lockedUntil, err := s.attemptLimiter.RecordFailure(ctx, emailAddress, ipAddress)
+	if err != nil {
+		s.logger.Error("Failed to record failed authentication attempt", zap.Error(err))
+		return
+	}
+	if unlockToken == "" || acc == nil {
+		return
+	}
+
+	s.logger.Warn("Account locked after too many failed attempts", zap.Int64("account_id", acc.ID), zap.String("ip_address", ipAddress))
+	unlockLink := fmt.Sprintf("%s/auth/unlock-account/%s?email=%s", strings.TrimRight(s.cfg.AccountsBaseURL, "/"), unlockToken, url.QueryEscape(acc.Email))
+	if err := s.emailClient.SendAccountUnlock(ctx, s.cfg, acc.Email, unlockLink, lockedUntil.UTC().Format("January 2, 2006 at 15:04 UTC"), ipAddress); err != nil {
+		s.logger.Error("Failed to send account unlock email", zap.Error(err))
+	}
+}
+
+// resetFailedAttempts forgets the failed attempts against an account after it authenticated
+func (s *AuthService) resetFailedAttempts(ctx context.Context, emailAddress string) {
+	if err := s.attemptLimiter.Reset(ctx, emailAddress); err != nil {
+		s.logger.Error("Failed to reset failed authentication attempts", zap.Error(err))
+	}
+}
+
+// startLogin creates a session for the account, or a pending 2FA challenge if the account has 2FA enabled
+//
+// The "remember me" choice is kept by the challenge until the login is completed.
+func (s *AuthService) startLogin(ctx context.Context, acc *account.Account, userAgent string, ipAddress string, rememberMe bool) (*account.Account, string, error) {
+	if acc.Has2FAEnabled() {
+		challenge, _, err := s.twoFactorAuthenticationChallengeRepo.Create(ctx, acc.ID, *acc.TwoFactorSecret, rememberMe)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to create 2FA challenge: %w", err)
+		}
+		return nil, "", NewTwoFactorRequiredError(challenge)
+	}
+
+	sessionToken, err := s.createLoginSession(ctx, acc, userAgent, ipAddress, rememberMe)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return acc, sessionToken, nil
+}
+
+// createLoginSession creates a session for an existing account signing in
+//
+// When the account has sessions, but none created from the same kind of device, the account
+// owner is notified of the sign in. Accounts without sessions, such as ones just created by
+// a social login, have no known devices to tell a new one from.
+func (s *AuthService) createLoginSession(ctx context.Context, acc *account.Account, userAgent string, ipAddress string, rememberMe bool) (string, error) {
+	device := ParseUserAgent(userAgent)
+	sessions, err := s.sessionRepo.GetAllList(ctx, acc.ID, "")
+	if err != nil {
+		return "", err
+	}
+
+	sessionToken, err := s.createSession(ctx, acc.ID, userAgent, ipAddress, rememberMe)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sessions) > 0 && !slices.ContainsFunc(sessions, func(session *Session) bool { return isSameDevice(session, device) }) {
+		s.publishSecurityEvent(ctx, account.SecurityEventNewDeviceSignIn, acc, userAgent, ipAddress, "")
+	}
+
+	return sessionToken, nil
+}
+
+// publishSecurityEvent tells the account owner about a sign in or a sensitive change
+func (s *AuthService) publishSecurityEvent(ctx context.Context, eventType account.SecurityEventType, acc *account.Account, userAgent string, ipAddress string, detail string) {
+	s.securityEvents.Publish(ctx, account.SecurityEvent{
+		Type:      eventType,
+		Account:   acc,
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+		Detail:    detail,
+	})
+}
+
+// createSession creates a session for the account under the standard or the "remember me" session policy
+//
+// The session records the device parsed from the user agent and the approximate location of the IP address.
+func (s *AuthService) createSession(ctx context.Context, accountID int64, userAgent string, ipAddress string, rememberMe bool) (string, error) {
+	now := time.Now()
+	expiresAt := NewSessionPolicy(s.cfg, rememberMe).ExpiresAt(now, now)
+
+	location, err := s.geoLocator.Locate(ipAddress)
+	if err != nil {
+		// The location is informative only, it mustn't fail the login
+		s.logger.Warn("Failed to locate session IP address", zap.Error(err))
+	}
+
+	sessionToken, err := s.sessionRepo.Create(ctx, accountID, userAgent, ipAddress, ParseUserAgent(userAgent), location, rememberMe, expiresAt)
+	if err != nil {
+		return "", fmt.Errorf("failed to create session: %w", err)
+	}
+	return sessionToken, nil
+}
+
+// startOAuth creates a pending social login, or a pending link to the given account, and returns the provider's sign in URL
+func (s *AuthService) startOAuth(ctx context.Context, providerName string, accountID *int64, rememberMe bool) (string, string, error) {
+	provider, err := s.oauthProviders.Get(providerName)
+	if err != nil {
+		return "", "", err
+	}
+
+	nonce, err := generateSecureToken(16)
+	if err != nil {
+		return "", "", err
+	}
+	codeVerifier := oauth2.GenerateVerifier()
+
+	state, _, err := s.oauthStateRepo.Create(ctx, providerName, nonce, codeVerifier, accountID, rememberMe)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create oauth state: %w", err)
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to build %s authorization url: %w", providerName, err)
+	}
+
+	return authURL, state, nil
+}
+
+// exchangeOAuthCode consumes a pending social login or link and exchanges the authorization code for the provider's identity
+//
+// The state must have been created for the provider, and for the given account when linking.
+// It is returned with the identity for the choices made when the flow was started.
+func (s *AuthService) exchangeOAuthCode(ctx context.Context, providerName string, state string, code string, accountID *int64) (*OAuthIdentity, *OAuthState, error) {
+	provider, err := s.oauthProviders.Get(providerName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	oauthState, err := s.oauthStateRepo.Get(ctx, state)
+	if err != nil {
+		if errors.Is(err, ErrOAuthStateNotFound) || errors.Is(err, ErrTokenExpired) {
+			return nil, nil, ErrOAuthStateNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get oauth state: %w", err)
+	}
+	if oauthState.Provider != providerName {
+		return nil, nil, ErrOAuthStateNotFound
+	}
+	if (oauthState.AccountId == nil) != (accountID == nil) ||
+		(accountID != nil && *oauthState.AccountId != *accountID) {
+		return nil, nil, ErrOAuthStateNotFound
+	}
+
+	// States are single use, whatever the outcome of the exchange
+	if err := s.oauthStateRepo.Delete(ctx, oauthState); err != nil {
+		return nil, nil, fmt.Errorf("failed to delete oauth state: %w", err)
+	}
+
+	identity, err := provider.Exchange(ctx, code, oauthState.CodeVerifier, oauthState.Nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	return identity, oauthState, nil
+}
+
+// loginWithOAuthIdentity logs in with a provider's identity, creating the account on first sign in
+//
+// The identity is matched by its subject first. Otherwise a new account is created for
+// it, or, if trustEmail is set, it is linked to the account using the same email address.
+// Both require the provider to have verified the email address. Untrusted providers
+// can't take over existing accounts, their owners have to link them from the settings.
+func (s *AuthService) loginWithOAuthIdentity(ctx context.Context, credentialProvider string, identity *OAuthIdentity, trustEmail bool, userAgent string, ipAddress string, rememberMe bool) (*account.Account, string, error) {
+	credential, err := s.oauthCredentialRepo.GetByProviderUser(ctx, credentialProvider, identity.Subject, true)
+	if err == nil {
+		return s.startLogin(ctx, credential.Account, userAgent, ipAddress, rememberMe)
+	}
+	if !errors.Is(err, ErrOAuthCredentialNotFound) {
+		return nil, "", fmt.Errorf("failed to get oauth credential: %w", err)
+	}
+
+	if !identity.EmailVerified {
+		return nil, "", ErrEmailNotVerified
+	}
+
+	acc, err := s.getOrCreateOAuthAccount(ctx, credentialProvider, identity, trustEmail)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err := s.oauthCredentialRepo.Create(ctx, acc.ID, credentialProvider, identity.Subject); err != nil {
+		return nil, "", fmt.Errorf("failed to create oauth credential: %w", err)
+	}
+
+	if !slices.Contains(acc.AuthProviders, credentialProvider) {
+		authProviders := append(slices.Clone(acc.AuthProviders), credentialProvider)
+		if _, err := s.accountRepo.UpdateAuthProviders(ctx, acc, authProviders); err != nil {
+			return nil, "", fmt.Errorf("failed to update auth providers: %w", err)
+		}
+	}
+
+	return s.startLogin(ctx, acc, userAgent, ipAddress, rememberMe)
+}
+
+// getOrCreateOAuthAccount returns the account using the identity's email address, creating it if none exists
+//
+// An existing account is only returned if trustEmail is set, otherwise ErrOAuthAccountExists is returned.
+func (s *AuthService) getOrCreateOAuthAccount(ctx context.Context, credentialProvider string, identity *OAuthIdentity, trustEmail bool) (*account.Account, error) {
+	emailAddress, err := normalizeEmail(identity.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := s.accountRepo.GetByEmail(ctx, emailAddress)
+	if err == nil {
+		if !trustEmail {
+			return nil, ErrOAuthAccountExists
+		}
+		return acc, nil
+	}
+	if !errors.Is(err, account.ErrAccountNotFound) {
+		return nil, fmt.Errorf("failed to get account by email: %w", err)
+	}
+
+	fullName := strings.TrimSpace(identity.Name)
+	if fullName == "" {
+		fullName, _, _ = strings.Cut(emailAddress, "@")
+	}
+
+	acc, err = s.accountRepo.Create(ctx, emailAddress, fullName, []string{credentialProvider}, nil, nil, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account: %w", err)
+	}
+
+	return acc, nil
+}
+
+// getTwoFactorChallenge returns an unexpired 2FA challenge with its account loaded
+func (s *AuthService) getTwoFactorChallenge(ctx context.Context, twoFactorChallenge string) (*TwoFactorAuthenticationChallenge, error) {
+	if twoFactorChallenge == "" {
+		return nil, ErrTwoFactorAuthenticationNotFound
+	}
+
+	challenge, err := s.twoFactorAuthenticationChallengeRepo.Get(ctx, twoFactorChallenge, true)
+	if err != nil {
+		if errors.Is(err, ErrTwoFactorAuthenticationNotFound) || errors.Is(err, ErrTokenExpired) {
+			return nil, ErrTwoFactorAuthenticationNotFound
+		}
+		return nil, fmt.Errorf("failed to get 2FA challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// completeTwoFactorLogin consumes the pending login challenge and creates a session for its account
+func (s *AuthService) completeTwoFactorLogin(ctx context.Context, challenge *TwoFactorAuthenticationChallenge, userAgent string, ipAddress string) (*account.Account, string, error) {
+	if err := s.twoFactorAuthenticationChallengeRepo.Delete(ctx, challenge); err != nil {
+		return nil, "", fmt.Errorf("failed to delete 2FA challenge: %w", err)
+	}
+
+	sessionToken, err := s.createLoginSession(ctx, challenge.Account, userAgent, ipAddress, challenge.RememberMe)
+	if err != nil {
+		return nil, "", err
+	}
+	s.resetFailedAttempts(ctx, challenge.Account.Email)
+
+	return challenge.Account, sessionToken, nil
+}
+
+// replaceRecoveryCodes deletes the account's recovery codes and generates a new set
+func (s *AuthService) replaceRecoveryCodes(ctx context.Context, accountID int64) ([]string, error) {
+	if err := s.recoveryCodeRepo.DeleteAll(ctx, accountID); err != nil {
+		return nil, fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+
+	recoveryCodes, err := s.recoveryCodeRepo.CreateMany(ctx, accountID, RecoveryCodeCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recovery codes: %w", err)
+	}
+
+	return recoveryCodes, nil
+}
+
+// verifyTwoFactorCode checks a TOTP code against the secret and uses up its time step
+//
+// Codes are accepted for one period before and after the current one, but only once. Codes
+// of the time step of the last accepted code, or of an earlier one, are rejected like wrong
+// codes, so that an observed code can't be replayed while it is still valid.
+func (s *AuthService) verifyTwoFactorCode(ctx context.Context, acc *account.Account, secret string, code string) (bool, error) {
+	current := time.Now().Unix() / totpPeriod
+	for _, timeStep := range []int64{current, current - 1, current + 1} {
+		if !hotp.Validate(code, uint64(timeStep), secret) {
+			continue
+		}
+
+		used, err := s.accountRepo.UseTwoFactorTimeStep(ctx, acc, timeStep)
+		if err != nil {
+			return false, fmt.Errorf("failed to use 2FA time step: %w", err)
+		}
+		return used, nil
+	}
+	return false, nil
+}
+
+// authenticatorURI builds the otpauth URI for enrolling the secret in an authenticator app
+func (s *AuthService) authenticatorURI(acc *account.Account, secret string) (string, error) {
+	decodedSecret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode 2FA secret: %w", err)
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      s.cfg.TOTPIssuer,
+		AccountName: acc.Email,
+		Secret:      decodedSecret,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate otpauth uri: %w", err)
+	}
+
+	return key.URL(), nil
+}
+
+// ensureEmailAvailable returns ErrEmailAlreadyExists if an account already uses the email address
+func (s *AuthService) ensureEmailAvailable(ctx context.Context, emailAddress string) error {
+	_, err := s.accountRepo.GetByEmail(ctx, emailAddress)
+	if err == nil {
+		return ErrEmailAlreadyExists
+	}
+	if !errors.Is(err, account.ErrAccountNotFound) {
+		return fmt.Errorf("failed to get account by email: %w", err)
+	}
+	return nil
+}
+
+// getValidEmailVerificationToken returns the stored token if it matches the email address and has not expired
+func (s *AuthService) getValidEmailVerificationToken(ctx context.Context, emailAddress string, emailVerificationToken string) (*account.EmailVerificationToken, error) {
+	verificationToken, err := s.emailVerificationTokenRepo.Get(ctx, emailVerificationToken)
+	if err != nil {
+		if errors.Is(err, account.ErrTokenNotFound) {
+			return nil, ErrInvalidOrExpiredToken
+		}
+		return nil, fmt.Errorf("failed to get email verification token: %w", err)
+	}
+
+	if verificationToken.Email != emailAddress || verificationToken.IsExpired() {
+		return nil, ErrInvalidOrExpiredToken
+	}
+
+	return verificationToken, nil
+}
+
+// normalizeEmail validates the email address and returns it in lowercase without surrounding whitespace
+func normalizeEmail(emailAddress string) (string, error) {
+	emailAddress = strings.ToLower(strings.TrimSpace(emailAddress))
+	parsed, err := mail.ParseAddress(emailAddress)
+	if err != nil || parsed.Address != emailAddress {
+		return "", ErrInvalidEmail
+	}
+	return emailAddress, nil
+}
+
+// generateAccountID generates a random ID for an account that does not exist yet
+//
+// IDs are drawn above the range used by the accounts sequence so they never collide with it.
+func generateAccountID() (int64, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64-generatedAccountIDMin))
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate account id: %w", err)
+	}
+	return n.Int64() + generatedAccountIDMin, nil
+}
+
+// cooldownRemaining returns the whole seconds left before the cooldown starting at issuedAt elapses
+func cooldownRemaining(issuedAt time.Time, cooldown time.Duration) int {
+	remaining := time.Until(issuedAt.Add(cooldown))
+	if remaining <= 0 {
+		return 0
 	}
+	return int(math.Ceil(remaining.Seconds()))
 }
@@ -0,0 +1,2167 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"server/internal/config"
+	"server/internal/domain/account"
+	"server/internal/domain/core"
+	"server/internal/infrastructure/email"
+	"server/internal/infrastructure/geoip"
+	"server/internal/infrastructure/pwnedpasswords"
+
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newTestAuthService creates an AuthService with mocked repositories and a dummy email client
+func newTestAuthService(t *testing.T, accountRepo *MockAccountRepo, sessionRepo *MockSessionRepo, emailVerificationTokenRepo *account.MockEmailVerificationTokenRepo) *AuthService {
+	cfg := &config.Config{
+		EmailProvider:     "dummy",
+		EmailTemplatePath: "../../../templates/emails",
+		PasswordMinLength: 8,
+		PasswordMinScore:  3,
+
+		LockoutAccountFreeAttempts: 3,
+		LockoutIPFreeAttempts:      20,
+		LockoutBackoffBase:         time.Second,
+		LockoutBackoffMax:          15 * time.Minute,
+		LockoutThreshold:           10,
+		LockoutDuration:            time.Hour,
+		LockoutWindow:              24 * time.Hour,
+
+		SessionLifetime:               24 * time.Hour,
+		SessionIdleTimeout:            2 * time.Hour,
+		SessionRememberMeLifetime:     720 * time.Hour,
+		SessionRememberMeIdleTimeout:  336 * time.Hour,
+		SessionActivityUpdateInterval: 5 * time.Minute,
+
+		AccessTokenLifetime: 15 * time.Minute,
+	}
+	emailClient, err := email.NewEmailClient(cfg)
+	require.NoError(t, err)
+	passwordPolicy := NewPasswordPolicy(cfg, pwnedpasswords.DisabledChecker{}, zap.NewNop())
+	attemptLimiter := NewAttemptLimiter(newMemoryAuthAttemptRepo(), cfg)
+
+	return NewAuthService(accountRepo, sessionRepo, emailVerificationTokenRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, passwordPolicy, attemptLimiter, emailClient, nil, geoip.DisabledLocator{}, nil, cfg, zap.NewNop())
+}
+
+// recordSecurityEvents subscribes to the security events of the service, returning the published events
+func recordSecurityEvents(service *AuthService) *[]account.SecurityEvent {
+	events := &[]account.SecurityEvent{}
+	service.securityEvents = account.NewSecurityEventBus()
+	service.securityEvents.Subscribe(func(ctx context.Context, event account.SecurityEvent) {
+		*events = append(*events, event)
+	})
+	return events
+}
+
+func TestAuthService_RequestEmailVerificationToken(t *testing.T) {
+	tests := []struct {
+		name           string
+		email          string
+		setupMocks     func(*MockAccountRepo, *account.MockEmailVerificationTokenRepo)
+		expectedError  error
+		expectCooldown bool
+	}{
+		{
+			name:  "sends a new token",
+			email: "Test@Example.com",
+			setupMocks: func(accountRepo *MockAccountRepo, tokenRepo *account.MockEmailVerificationTokenRepo) {
+				accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrAccountNotFound)
+				tokenRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrTokenNotFound)
+				tokenRepo.On("Create", mock.Anything, "test@example.com").Return("token", &account.EmailVerificationToken{Email: "test@example.com"}, nil)
+			},
+		},
+		{
+			name:  "replaces an expired cooldown token",
+			email: "test@example.com",
+			setupMocks: func(accountRepo *MockAccountRepo, tokenRepo *account.MockEmailVerificationTokenRepo) {
+				existing := &account.EmailVerificationToken{
+					CoreModel: core.CoreModel{ID: 1, CreatedAt: time.Now().Add(-2 * EmailVerificationTokenCooldown)},
+					Email:     "test@example.com",
+				}
+				accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrAccountNotFound)
+				tokenRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(existing, nil)
+				tokenRepo.On("Delete", mock.Anything, existing).Return(nil)
+				tokenRepo.On("Create", mock.Anything, "test@example.com").Return("token", &account.EmailVerificationToken{Email: "test@example.com"}, nil)
+			},
+		},
+		{
+			name:  "rejects requests within the cooldown",
+			email: "test@example.com",
+			setupMocks: func(accountRepo *MockAccountRepo, tokenRepo *account.MockEmailVerificationTokenRepo) {
+				existing := &account.EmailVerificationToken{
+					CoreModel: core.CoreModel{ID: 1, CreatedAt: time.Now().Add(-time.Minute)},
+					Email:     "test@example.com",
+				}
+				accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrAccountNotFound)
+				tokenRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(existing, nil)
+			},
+			expectedError:  ErrEmailCooldown,
+			expectCooldown: true,
+		},
+		{
+			name:  "rejects emails already in use",
+			email: "test@example.com",
+			setupMocks: func(accountRepo *MockAccountRepo, tokenRepo *account.MockEmailVerificationTokenRepo) {
+				accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(&account.Account{Email: "test@example.com"}, nil)
+			},
+			expectedError: ErrEmailAlreadyExists,
+		},
+		{
+			name:          "rejects invalid emails",
+			email:         "not-an-email",
+			setupMocks:    func(*MockAccountRepo, *account.MockEmailVerificationTokenRepo) {},
+			expectedError: ErrInvalidEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := new(MockAccountRepo)
+			tokenRepo := new(account.MockEmailVerificationTokenRepo)
+			tt.setupMocks(accountRepo, tokenRepo)
+
+			service := newTestAuthService(t, accountRepo, new(MockSessionRepo), tokenRepo)
+			remainingSeconds, err := service.RequestEmailVerificationToken(context.Background(), tt.email, "Mozilla/5.0")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				if tt.expectCooldown {
+					var cooldownErr *CooldownError
+					require.True(t, errors.As(err, &cooldownErr))
+					assert.Greater(t, cooldownErr.RemainingSeconds, 0)
+					assert.LessOrEqual(t, cooldownErr.RemainingSeconds, int(EmailVerificationTokenCooldown.Seconds()))
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int(EmailVerificationTokenCooldown.Seconds()), remainingSeconds)
+			}
+
+			accountRepo.AssertExpectations(t)
+			tokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthService_VerifyEmail(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         *account.EmailVerificationToken
+		expectedError error
+	}{
+		{
+			name:  "valid token",
+			token: &account.EmailVerificationToken{Email: "test@example.com", ExpiresAt: time.Now().Add(time.Hour)},
+		},
+		{
+			name:          "expired token",
+			token:         &account.EmailVerificationToken{Email: "test@example.com", ExpiresAt: time.Now().Add(-time.Hour)},
+			expectedError: ErrInvalidOrExpiredToken,
+		},
+		{
+			name:          "token issued for another email",
+			token:         &account.EmailVerificationToken{Email: "other@example.com", ExpiresAt: time.Now().Add(time.Hour)},
+			expectedError: ErrInvalidOrExpiredToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := new(MockAccountRepo)
+			tokenRepo := new(account.MockEmailVerificationTokenRepo)
+			accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrAccountNotFound)
+			tokenRepo.On("Get", mock.Anything, "token").Return(tt.token, nil)
+
+			service := newTestAuthService(t, accountRepo, new(MockSessionRepo), tokenRepo)
+			err := service.VerifyEmail(context.Background(), "test@example.com", "token")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_RegisterWithPassword(t *testing.T) {
+	validToken := &account.EmailVerificationToken{Email: "test@example.com", ExpiresAt: time.Now().Add(time.Hour)}
+
+	t.Run("creates account and session", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		tokenRepo := new(account.MockEmailVerificationTokenRepo)
+
+		password := "Nimbus-Quartz-Lantern-42"
+		created := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", FullName: "Test User"}
+
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrAccountNotFound)
+		tokenRepo.On("Get", mock.Anything, "token").Return(validToken, nil)
+		accountRepo.On("Create", mock.Anything, "test@example.com", "Test User", []string{account.AuthProviderPassword}, &password, (*int64)(nil), "", (*string)(nil)).Return(created, nil)
+		tokenRepo.On("Delete", mock.Anything, validToken).Return(nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "Mozilla/5.0", "127.0.0.1", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, tokenRepo)
+		acc, sessionToken, err := service.RegisterWithPassword(context.Background(), "test@example.com", "token", password, "  Test User ", "Mozilla/5.0", "127.0.0.1")
+
+		require.NoError(t, err)
+		assert.Equal(t, created, acc)
+		assert.Equal(t, "session-token", sessionToken)
+		accountRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+		tokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects weak passwords", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		tokenRepo := new(account.MockEmailVerificationTokenRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrAccountNotFound)
+		tokenRepo.On("Get", mock.Anything, "token").Return(validToken, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), tokenRepo)
+		_, _, err := service.RegisterWithPassword(context.Background(), "test@example.com", "token", "password", "Test User", "", "")
+
+		assert.ErrorIs(t, err, ErrPasswordTooWeak)
+		accountRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("rejects emails already in use", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(&account.Account{Email: "test@example.com"}, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		_, _, err := service.RegisterWithPassword(context.Background(), "test@example.com", "token", "Str0ng!Password", "Test User", "", "")
+
+		assert.ErrorIs(t, err, ErrEmailAlreadyExists)
+	})
+}
+
+func TestAuthService_RevokeSessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deletes the sessions, personal access tokens, password and revocation tokens of the account", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		revocationTokenRepo := new(MockSessionRevocationTokenRepo)
+		personalAccessTokenRepo := new(MockPersonalAccessTokenRepo)
+		passwordHash := "hash"
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, PasswordHash: &passwordHash}
+		revocationTokenRepo.On("Get", mock.Anything, "revocation-token").Return(&SessionRevocationToken{AccountId: 7, Account: acc}, nil)
+		sessionRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+		personalAccessTokenRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+		accountRepo.On("DeletePassword", mock.Anything, acc).Return(acc, nil)
+		revocationTokenRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.sessionRevocationTokenRepo = revocationTokenRepo
+		service.personalAccessTokenRepo = personalAccessTokenRepo
+
+		require.NoError(t, service.RevokeSessions(ctx, "revocation-token"))
+		accountRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+		personalAccessTokenRepo.AssertExpectations(t)
+		revocationTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("signs passwordless accounts out without touching the password", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		revocationTokenRepo := new(MockSessionRevocationTokenRepo)
+		personalAccessTokenRepo := new(MockPersonalAccessTokenRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}}
+		revocationTokenRepo.On("Get", mock.Anything, "revocation-token").Return(&SessionRevocationToken{AccountId: 7, Account: acc}, nil)
+		sessionRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+		personalAccessTokenRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+		revocationTokenRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.sessionRevocationTokenRepo = revocationTokenRepo
+		service.personalAccessTokenRepo = personalAccessTokenRepo
+
+		require.NoError(t, service.RevokeSessions(ctx, "revocation-token"))
+		accountRepo.AssertNotCalled(t, "DeletePassword", mock.Anything, mock.Anything)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown and expired tokens", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		revocationTokenRepo := new(MockSessionRevocationTokenRepo)
+		revocationTokenRepo.On("Get", mock.Anything, "unknown").Return(nil, ErrSessionRevocationTokenNotFound)
+		revocationTokenRepo.On("Get", mock.Anything, "expired").Return(nil, ErrTokenExpired)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.sessionRevocationTokenRepo = revocationTokenRepo
+
+		assert.ErrorIs(t, service.RevokeSessions(ctx, "unknown"), ErrInvalidOrExpiredToken)
+		assert.ErrorIs(t, service.RevokeSessions(ctx, "expired"), ErrInvalidOrExpiredToken)
+		sessionRepo.AssertNotCalled(t, "DeleteAll", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_RequestPasswordReset(t *testing.T) {
+	acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+
+	t.Run("sends a reset link", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		resetTokenRepo := new(MockPasswordResetTokenRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		resetTokenRepo.On("GetByAccount", mock.Anything, int64(7)).Return(nil, ErrPasswordResetTokenNotFound)
+		resetTokenRepo.On("Create", mock.Anything, int64(7)).Return("reset-token", nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.passwordResetTokenRepo = resetTokenRepo
+
+		require.NoError(t, service.RequestPasswordReset(context.Background(), "Test@Example.com", "Mozilla/5.0"))
+		resetTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not reveal unknown emails", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		resetTokenRepo := new(MockPasswordResetTokenRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "unknown@example.com").Return(nil, account.ErrAccountNotFound)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.passwordResetTokenRepo = resetTokenRepo
+
+		assert.NoError(t, service.RequestPasswordReset(context.Background(), "unknown@example.com", ""))
+		assert.NoError(t, service.RequestPasswordReset(context.Background(), "not-an-email", ""))
+		resetTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("does not issue tokens within the cooldown", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		resetTokenRepo := new(MockPasswordResetTokenRepo)
+		existing := &PasswordResetToken{CoreModel: core.CoreModel{ID: 1, CreatedAt: time.Now().Add(-time.Minute)}, AccountId: 7}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		resetTokenRepo.On("GetByAccount", mock.Anything, int64(7)).Return(existing, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.passwordResetTokenRepo = resetTokenRepo
+
+		assert.NoError(t, service.RequestPasswordReset(context.Background(), "test@example.com", ""))
+		resetTokenRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+		resetTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_RequestEmailLoginCode(t *testing.T) {
+	acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+
+	t.Run("sends a login code", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		loginCodeRepo := new(MockEmailLoginCodeRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		loginCodeRepo.On("GetByAccount", mock.Anything, int64(7)).Return(nil, ErrEmailLoginCodeNotFound)
+		loginCodeRepo.On("Create", mock.Anything, int64(7)).Return("123456", "login-token", nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.emailLoginCodeRepo = loginCodeRepo
+
+		require.NoError(t, service.RequestEmailLoginCode(context.Background(), "Test@Example.com", "Mozilla/5.0"))
+		loginCodeRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not reveal unknown emails", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		loginCodeRepo := new(MockEmailLoginCodeRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "unknown@example.com").Return(nil, account.ErrAccountNotFound)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.emailLoginCodeRepo = loginCodeRepo
+
+		assert.NoError(t, service.RequestEmailLoginCode(context.Background(), "unknown@example.com", ""))
+		assert.NoError(t, service.RequestEmailLoginCode(context.Background(), "not-an-email", ""))
+		loginCodeRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("replaces the previous code once the cooldown has elapsed", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		loginCodeRepo := new(MockEmailLoginCodeRepo)
+		existing := &EmailLoginCode{CoreModel: core.CoreModel{ID: 1, CreatedAt: time.Now().Add(-2 * EmailLoginCodeCooldown)}, AccountId: 7}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		loginCodeRepo.On("GetByAccount", mock.Anything, int64(7)).Return(existing, nil)
+		loginCodeRepo.On("Delete", mock.Anything, existing).Return(nil)
+		loginCodeRepo.On("Create", mock.Anything, int64(7)).Return("123456", "login-token", nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.emailLoginCodeRepo = loginCodeRepo
+
+		require.NoError(t, service.RequestEmailLoginCode(context.Background(), "test@example.com", ""))
+		loginCodeRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not issue codes within the cooldown", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		loginCodeRepo := new(MockEmailLoginCodeRepo)
+		existing := &EmailLoginCode{CoreModel: core.CoreModel{ID: 1, CreatedAt: time.Now()}, AccountId: 7}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		loginCodeRepo.On("GetByAccount", mock.Anything, int64(7)).Return(existing, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.emailLoginCodeRepo = loginCodeRepo
+
+		assert.NoError(t, service.RequestEmailLoginCode(context.Background(), "test@example.com", ""))
+		loginCodeRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_LoginWithEmailCode(t *testing.T) {
+	ctx := context.Background()
+
+	newLoginCode := func(attempts int, expiresAt time.Time) *EmailLoginCode {
+		return &EmailLoginCode{
+			CoreModel: core.CoreModel{ID: 1},
+			CodeHash:  testTokenHasher.Hash("123456"),
+			TokenHash: testTokenHasher.Hash("login-token"),
+			Attempts:  attempts,
+			ExpiresAt: expiresAt.Unix(),
+			AccountId: 7,
+		}
+	}
+
+	newService := func(acc *account.Account, loginCode *EmailLoginCode) (*AuthService, *MockSessionRepo, *MockEmailLoginCodeRepo) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		loginCodeRepo := new(MockEmailLoginCodeRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("GetByEmail", mock.Anything, "unknown@example.com").Return(nil, account.ErrAccountNotFound)
+		loginCodeRepo.On("GetByAccount", mock.Anything, int64(7)).Return(loginCode, nil)
+		loginCodeRepo.On("Delete", mock.Anything, loginCode).Return(nil)
+		loginCodeRepo.On("IncrementAttempts", mock.Anything, loginCode, EmailLoginCodeMaxAttempts).Return(nil).Maybe()
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.emailLoginCodeRepo = loginCodeRepo
+		return service, sessionRepo, loginCodeRepo
+	}
+
+	for _, code := range []string{"123456", " 123456 ", "login-token"} {
+		t.Run("logs in with "+strings.TrimSpace(code), func(t *testing.T) {
+			acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+			loginCode := newLoginCode(0, time.Now().Add(EmailLoginCodeLifetime))
+			service, sessionRepo, loginCodeRepo := newService(acc, loginCode)
+			sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+			sessionRepo.On("Create", mock.Anything, int64(7), "Mozilla/5.0", "127.0.0.1", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+			loggedIn, sessionToken, err := service.LoginWithEmailCode(ctx, "test@example.com", code, "Mozilla/5.0", "127.0.0.1", false)
+
+			require.NoError(t, err)
+			assert.Equal(t, acc, loggedIn)
+			assert.Equal(t, "session-token", sessionToken)
+			loginCodeRepo.AssertCalled(t, "Delete", mock.Anything, loginCode)
+		})
+	}
+
+	t.Run("requires 2FA when enabled", func(t *testing.T) {
+		secret := "JBSWY3DPEHPK3PXP"
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", TwoFactorSecret: &secret}
+		service, _, _ := newService(acc, newLoginCode(0, time.Now().Add(EmailLoginCodeLifetime)))
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		challengeRepo.On("Create", mock.Anything, int64(7), secret, false).Return("challenge", nil, nil)
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+
+		_, _, err := service.LoginWithEmailCode(ctx, "test@example.com", "123456", "", "", false)
+
+		var twoFactorErr *TwoFactorRequiredError
+		require.ErrorAs(t, err, &twoFactorErr)
+		assert.Equal(t, "challenge", twoFactorErr.Challenge)
+	})
+
+	t.Run("counts wrong codes and discards the code after too many attempts", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+		loginCode := newLoginCode(EmailLoginCodeMaxAttempts-2, time.Now().Add(EmailLoginCodeLifetime))
+		service, _, loginCodeRepo := newService(acc, loginCode)
+
+		_, _, err := service.LoginWithEmailCode(ctx, "test@example.com", "000000", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+		loginCodeRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+
+		_, _, err = service.LoginWithEmailCode(ctx, "test@example.com", "000000", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+		loginCodeRepo.AssertCalled(t, "Delete", mock.Anything, loginCode)
+
+		// The right code no longer works once the attempts are exhausted
+		_, _, err = service.LoginWithEmailCode(ctx, "test@example.com", "123456", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+	})
+
+	t.Run("rejects the right code once concurrent attempts used up the code", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+		loginCode := newLoginCode(EmailLoginCodeMaxAttempts-1, time.Now().Add(EmailLoginCodeLifetime))
+		service, sessionRepo, _ := newService(acc, loginCode)
+		// Another request took the last attempt after this one read the code
+		loginCodeRepo := new(MockEmailLoginCodeRepo)
+		loginCodeRepo.On("GetByAccount", mock.Anything, int64(7)).Return(loginCode, nil)
+		loginCodeRepo.On("IncrementAttempts", mock.Anything, loginCode, EmailLoginCodeMaxAttempts).Return(ErrEmailLoginCodeNotFound)
+		loginCodeRepo.On("Delete", mock.Anything, loginCode).Return(nil)
+		service.emailLoginCodeRepo = loginCodeRepo
+
+		_, _, err := service.LoginWithEmailCode(ctx, "test@example.com", "123456", "", "", false)
+
+		assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+		loginCodeRepo.AssertCalled(t, "Delete", mock.Anything, loginCode)
+		sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("counts wrong codes against the email address and IP address", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+		service, _, _ := newService(acc, newLoginCode(0, time.Now().Add(EmailLoginCodeLifetime)))
+		attemptRepo := newMemoryAuthAttemptRepo()
+		service.attemptLimiter = NewAttemptLimiter(attemptRepo, service.cfg)
+
+		_, _, err := service.LoginWithEmailCode(ctx, "test@example.com", "000000", "", "127.0.0.1", false)
+
+		assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+		accountAttempt, err := attemptRepo.Get(ctx, AttemptScopeAccount, "test@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, 1, accountAttempt.Failures)
+		ipAttempt, err := attemptRepo.Get(ctx, AttemptScopeIP, "127.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, ipAttempt.Failures)
+	})
+
+	t.Run("rejects expired codes", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+		loginCode := newLoginCode(0, time.Now().Add(-time.Second))
+		service, _, loginCodeRepo := newService(acc, loginCode)
+
+		_, _, err := service.LoginWithEmailCode(ctx, "test@example.com", "123456", "", "", false)
+
+		assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+		loginCodeRepo.AssertCalled(t, "Delete", mock.Anything, loginCode)
+	})
+
+	t.Run("fails like a wrong code for unknown accounts", func(t *testing.T) {
+		service, _, _ := newService(nil, nil)
+
+		_, _, err := service.LoginWithEmailCode(ctx, "unknown@example.com", "123456", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+
+		_, _, err = service.LoginWithEmailCode(ctx, "not-an-email", "123456", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+	})
+
+	t.Run("backs off repeated failures", func(t *testing.T) {
+		service, _, _ := newService(nil, nil)
+
+		for range 4 {
+			_, _, err := service.LoginWithEmailCode(ctx, "unknown@example.com", "123456", "", "", false)
+			assert.ErrorIs(t, err, ErrInvalidEmailLoginCode)
+		}
+		_, _, err := service.LoginWithEmailCode(ctx, "unknown@example.com", "123456", "", "", false)
+		assert.ErrorIs(t, err, ErrRateLimitExceeded)
+	})
+}
+
+func TestAuthService_RequestSmsLoginCode(t *testing.T) {
+	acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+	phoneNumber := "+14155552671"
+
+	newService := func(accountRepo *MockAccountRepo, loginCodeRepo *MockSmsLoginCodeRepo, messageSender *MockMessageSender) *AuthService {
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.smsLoginCodeRepo = loginCodeRepo
+		service.messageSender = messageSender
+		return service
+	}
+
+	t.Run("sends a login code", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		loginCodeRepo := new(MockSmsLoginCodeRepo)
+		messageSender := new(MockMessageSender)
+		accountRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(acc, nil)
+		loginCodeRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(nil, ErrSmsLoginCodeNotFound)
+		loginCodeRepo.On("Create", mock.Anything, int64(7), phoneNumber).Return("123456", nil)
+		messageSender.On("SendSMS", mock.Anything, phoneNumber, mock.MatchedBy(func(message string) bool {
+			return strings.Contains(message, "123456")
+		})).Return(nil)
+
+		service := newService(accountRepo, loginCodeRepo, messageSender)
+
+		require.NoError(t, service.RequestSmsLoginCode(context.Background(), " "+phoneNumber+" "))
+		loginCodeRepo.AssertExpectations(t)
+		messageSender.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid phone numbers", func(t *testing.T) {
+		service := newService(new(MockAccountRepo), new(MockSmsLoginCodeRepo), new(MockMessageSender))
+
+		err := service.RequestSmsLoginCode(context.Background(), "12345")
+		assert.ErrorIs(t, err, account.ErrInvalidPhoneNumber)
+	})
+
+	t.Run("does not reveal unknown phone numbers", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		loginCodeRepo := new(MockSmsLoginCodeRepo)
+		messageSender := new(MockMessageSender)
+		accountRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(nil, account.ErrAccountNotFound)
+
+		service := newService(accountRepo, loginCodeRepo, messageSender)
+
+		assert.NoError(t, service.RequestSmsLoginCode(context.Background(), phoneNumber))
+		loginCodeRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+		messageSender.AssertNotCalled(t, "SendSMS", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("replaces the previous code once the cooldown has elapsed", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		loginCodeRepo := new(MockSmsLoginCodeRepo)
+		messageSender := new(MockMessageSender)
+		existing := &SmsLoginCode{CoreModel: core.CoreModel{ID: 1, CreatedAt: time.Now().Add(-2 * SmsLoginCodeCooldown)}, PhoneNumber: phoneNumber, AccountId: 7}
+		accountRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(acc, nil)
+		loginCodeRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(existing, nil)
+		loginCodeRepo.On("Delete", mock.Anything, existing).Return(nil)
+		loginCodeRepo.On("Create", mock.Anything, int64(7), phoneNumber).Return("123456", nil)
+		messageSender.On("SendSMS", mock.Anything, phoneNumber, mock.Anything).Return(nil)
+
+		service := newService(accountRepo, loginCodeRepo, messageSender)
+
+		require.NoError(t, service.RequestSmsLoginCode(context.Background(), phoneNumber))
+		loginCodeRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not issue codes within the cooldown", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		loginCodeRepo := new(MockSmsLoginCodeRepo)
+		messageSender := new(MockMessageSender)
+		existing := &SmsLoginCode{CoreModel: core.CoreModel{ID: 1, CreatedAt: time.Now()}, PhoneNumber: phoneNumber, AccountId: 7}
+		accountRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(acc, nil)
+		loginCodeRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(existing, nil)
+
+		service := newService(accountRepo, loginCodeRepo, messageSender)
+
+		assert.NoError(t, service.RequestSmsLoginCode(context.Background(), phoneNumber))
+		loginCodeRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+		messageSender.AssertNotCalled(t, "SendSMS", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_LoginWithSmsCode(t *testing.T) {
+	ctx := context.Background()
+	phoneNumber := "+14155552671"
+
+	newLoginCode := func(attempts int, expiresAt time.Time) *SmsLoginCode {
+		return &SmsLoginCode{
+			CoreModel:   core.CoreModel{ID: 1},
+			PhoneNumber: phoneNumber,
+			CodeHash:    testTokenHasher.Hash("123456"),
+			Attempts:    attempts,
+			ExpiresAt:   expiresAt.Unix(),
+			AccountId:   7,
+		}
+	}
+
+	newService := func(acc *account.Account, loginCode *SmsLoginCode) (*AuthService, *MockSessionRepo, *MockSmsLoginCodeRepo) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		loginCodeRepo := new(MockSmsLoginCodeRepo)
+		accountRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(acc, nil)
+		if loginCode != nil {
+			loginCodeRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(loginCode, nil)
+		} else {
+			loginCodeRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(nil, ErrSmsLoginCodeNotFound)
+		}
+		loginCodeRepo.On("Delete", mock.Anything, loginCode).Return(nil)
+		loginCodeRepo.On("IncrementAttempts", mock.Anything, loginCode, SmsLoginCodeMaxAttempts).Return(nil).Maybe()
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.smsLoginCodeRepo = loginCodeRepo
+		service.messageSender = new(MockMessageSender)
+		return service, sessionRepo, loginCodeRepo
+	}
+
+	t.Run("logs in with the code", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PhoneNumber: &phoneNumber}
+		loginCode := newLoginCode(0, time.Now().Add(SmsLoginCodeLifetime))
+		service, sessionRepo, loginCodeRepo := newService(acc, loginCode)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "Mozilla/5.0", "127.0.0.1", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		loggedIn, sessionToken, err := service.LoginWithSmsCode(ctx, phoneNumber, " 123456 ", "Mozilla/5.0", "127.0.0.1", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, acc, loggedIn)
+		assert.Equal(t, "session-token", sessionToken)
+		loginCodeRepo.AssertCalled(t, "Delete", mock.Anything, loginCode)
+	})
+
+	t.Run("accepts codes stored with the legacy MD5 hash", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PhoneNumber: &phoneNumber}
+		loginCode := newLoginCode(0, time.Now().Add(SmsLoginCodeLifetime))
+		loginCode.CodeHash = "e10adc3949ba59abbe56e057f20f883e" // MD5 of "123456"
+		service, sessionRepo, _ := newService(acc, loginCode)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		_, sessionToken, err := service.LoginWithSmsCode(ctx, phoneNumber, "123456", "", "", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, "session-token", sessionToken)
+	})
+
+	t.Run("requires 2FA when enabled", func(t *testing.T) {
+		secret := "JBSWY3DPEHPK3PXP"
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PhoneNumber: &phoneNumber, TwoFactorSecret: &secret}
+		service, _, _ := newService(acc, newLoginCode(0, time.Now().Add(SmsLoginCodeLifetime)))
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		challengeRepo.On("Create", mock.Anything, int64(7), secret, false).Return("challenge", nil, nil)
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+
+		_, _, err := service.LoginWithSmsCode(ctx, phoneNumber, "123456", "", "", false)
+
+		var twoFactorErr *TwoFactorRequiredError
+		require.ErrorAs(t, err, &twoFactorErr)
+		assert.Equal(t, "challenge", twoFactorErr.Challenge)
+	})
+
+	t.Run("counts wrong codes and discards the code after too many attempts", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PhoneNumber: &phoneNumber}
+		loginCode := newLoginCode(SmsLoginCodeMaxAttempts-2, time.Now().Add(SmsLoginCodeLifetime))
+		service, _, loginCodeRepo := newService(acc, loginCode)
+
+		_, _, err := service.LoginWithSmsCode(ctx, phoneNumber, "000000", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+		loginCodeRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+
+		_, _, err = service.LoginWithSmsCode(ctx, phoneNumber, "000000", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+		loginCodeRepo.AssertCalled(t, "Delete", mock.Anything, loginCode)
+
+		// The right code no longer works once the attempts are exhausted
+		_, _, err = service.LoginWithSmsCode(ctx, phoneNumber, "123456", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+	})
+
+	t.Run("rejects the right code once concurrent attempts used up the code", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PhoneNumber: &phoneNumber}
+		loginCode := newLoginCode(SmsLoginCodeMaxAttempts-1, time.Now().Add(SmsLoginCodeLifetime))
+		service, sessionRepo, _ := newService(acc, loginCode)
+		// Another request took the last attempt after this one read the code
+		loginCodeRepo := new(MockSmsLoginCodeRepo)
+		loginCodeRepo.On("GetByPhoneNumber", mock.Anything, phoneNumber).Return(loginCode, nil)
+		loginCodeRepo.On("IncrementAttempts", mock.Anything, loginCode, SmsLoginCodeMaxAttempts).Return(ErrSmsLoginCodeNotFound)
+		loginCodeRepo.On("Delete", mock.Anything, loginCode).Return(nil)
+		service.smsLoginCodeRepo = loginCodeRepo
+
+		_, _, err := service.LoginWithSmsCode(ctx, phoneNumber, "123456", "", "", false)
+
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+		loginCodeRepo.AssertCalled(t, "Delete", mock.Anything, loginCode)
+		sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("counts wrong codes against the phone number and IP address", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PhoneNumber: &phoneNumber}
+		service, _, _ := newService(acc, newLoginCode(0, time.Now().Add(SmsLoginCodeLifetime)))
+		attemptRepo := newMemoryAuthAttemptRepo()
+		service.attemptLimiter = NewAttemptLimiter(attemptRepo, service.cfg)
+
+		_, _, err := service.LoginWithSmsCode(ctx, phoneNumber, "000000", "", "127.0.0.1", false)
+
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+		phoneAttempt, err := attemptRepo.Get(ctx, AttemptScopeAccount, phoneNumber)
+		require.NoError(t, err)
+		assert.Equal(t, 1, phoneAttempt.Failures)
+		ipAttempt, err := attemptRepo.Get(ctx, AttemptScopeIP, "127.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, ipAttempt.Failures)
+	})
+
+	t.Run("rejects expired codes", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PhoneNumber: &phoneNumber}
+		loginCode := newLoginCode(0, time.Now().Add(-time.Second))
+		service, _, loginCodeRepo := newService(acc, loginCode)
+
+		_, _, err := service.LoginWithSmsCode(ctx, phoneNumber, "123456", "", "", false)
+
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+		loginCodeRepo.AssertCalled(t, "Delete", mock.Anything, loginCode)
+	})
+
+	t.Run("rejects codes issued to a previous owner of the number", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 8}, Email: "other@example.com", PhoneNumber: &phoneNumber}
+		service, sessionRepo, _ := newService(acc, newLoginCode(0, time.Now().Add(SmsLoginCodeLifetime)))
+
+		_, _, err := service.LoginWithSmsCode(ctx, phoneNumber, "123456", "", "", false)
+
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+		sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("fails like a wrong code for unknown phone numbers", func(t *testing.T) {
+		service, _, _ := newService(nil, nil)
+
+		_, _, err := service.LoginWithSmsCode(ctx, phoneNumber, "123456", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+
+		_, _, err = service.LoginWithSmsCode(ctx, "not-a-number", "123456", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidSmsLoginCode)
+	})
+}
+
+func TestAuthService_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	password := "Nimbus-Quartz-Lantern-42"
+	totpSecret := "JBSWY3DPEHPK3PXP"
+
+	newResetToken := func(twoFactorSecret *string) *PasswordResetToken {
+		return &PasswordResetToken{
+			CoreModel: core.CoreModel{ID: 3},
+			AccountId: 7,
+			Account:   &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", TwoFactorSecret: twoFactorSecret},
+		}
+	}
+
+	t.Run("resets the password and invalidates sessions and personal access tokens", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		resetTokenRepo := new(MockPasswordResetTokenRepo)
+		personalAccessTokenRepo := new(MockPersonalAccessTokenRepo)
+		resetToken := newResetToken(nil)
+
+		resetTokenRepo.On("Get", mock.Anything, "reset-token", "test@example.com").Return(resetToken, nil)
+		accountRepo.On("UpdatePassword", mock.Anything, resetToken.Account, password).Return(resetToken.Account, nil)
+		resetTokenRepo.On("Delete", mock.Anything, resetToken).Return(nil)
+		sessionRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+		personalAccessTokenRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.passwordResetTokenRepo = resetTokenRepo
+		service.personalAccessTokenRepo = personalAccessTokenRepo
+
+		acc, err := service.ResetPassword(ctx, "test@example.com", "reset-token", password, "", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, resetToken.Account, acc)
+		accountRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+		resetTokenRepo.AssertExpectations(t)
+		personalAccessTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("requires the 2FA step when 2FA is enabled", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		resetTokenRepo := new(MockPasswordResetTokenRepo)
+		challengeRepo := new(MockTemporaryTwoFactorChallengeRepo)
+		resetToken := newResetToken(&totpSecret)
+
+		resetTokenRepo.On("Get", mock.Anything, "reset-token", "test@example.com").Return(resetToken, nil)
+		challengeRepo.On("Get", mock.Anything, "stale-challenge", int64(3), false).Return(nil, ErrTokenExpired)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.passwordResetTokenRepo = resetTokenRepo
+		service.tempTwoFactorChallengeRepo = challengeRepo
+
+		_, err := service.ResetPassword(ctx, "test@example.com", "reset-token", password, "", "", "")
+		assert.ErrorIs(t, err, ErrTemporaryTwoFactorNotFound)
+		_, err = service.ResetPassword(ctx, "test@example.com", "reset-token", password, "stale-challenge", "", "")
+		assert.ErrorIs(t, err, ErrTemporaryTwoFactorNotFound)
+		accountRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("accepts a verified 2FA challenge", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		resetTokenRepo := new(MockPasswordResetTokenRepo)
+		challengeRepo := new(MockTemporaryTwoFactorChallengeRepo)
+		resetToken := newResetToken(&totpSecret)
+		challenge := &TemporaryTwoFactorChallenge{CoreModel: core.CoreModel{ID: 9}, AccountId: 7}
+
+		resetTokenRepo.On("Get", mock.Anything, "reset-token", "test@example.com").Return(resetToken, nil)
+		challengeRepo.On("Get", mock.Anything, "challenge", int64(3), false).Return(challenge, nil)
+		accountRepo.On("UpdatePassword", mock.Anything, resetToken.Account, password).Return(resetToken.Account, nil)
+		resetTokenRepo.On("Delete", mock.Anything, resetToken).Return(nil)
+		challengeRepo.On("Delete", mock.Anything, challenge).Return(nil)
+		sessionRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+		personalAccessTokenRepo := new(MockPersonalAccessTokenRepo)
+		personalAccessTokenRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.passwordResetTokenRepo = resetTokenRepo
+		service.tempTwoFactorChallengeRepo = challengeRepo
+		service.personalAccessTokenRepo = personalAccessTokenRepo
+
+		_, err := service.ResetPassword(ctx, "test@example.com", "reset-token", password, "challenge", "", "")
+		require.NoError(t, err)
+		challengeRepo.AssertExpectations(t)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid tokens", func(t *testing.T) {
+		resetTokenRepo := new(MockPasswordResetTokenRepo)
+		resetTokenRepo.On("Get", mock.Anything, "reset-token", "test@example.com").Return(nil, ErrTokenExpired)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.passwordResetTokenRepo = resetTokenRepo
+
+		_, err := service.ResetPassword(ctx, "test@example.com", "reset-token", password, "", "", "")
+		assert.ErrorIs(t, err, ErrInvalidOrExpiredToken)
+	})
+}
+
+func TestAuthService_UpdatePassword(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("updates the password", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", FullName: "Test User"}
+		session := &Session{AccountId: 7, Account: acc}
+
+		accountRepo.On("UpdatePassword", mock.Anything, acc, "Nimbus-Quartz-Lantern-42").Return(acc, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		events := recordSecurityEvents(service)
+		updated, err := service.UpdatePassword(ctx, session, "Nimbus-Quartz-Lantern-42", "Mozilla/5.0", "127.0.0.1")
+
+		require.NoError(t, err)
+		assert.Equal(t, acc, updated)
+		accountRepo.AssertExpectations(t)
+		assert.Equal(t, []account.SecurityEvent{
+			{Type: account.SecurityEventPasswordChanged, Account: acc, UserAgent: "Mozilla/5.0", IPAddress: "127.0.0.1"},
+		}, *events)
+	})
+
+	t.Run("rejects passwords based on the user's email", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "zephyrine.quillfeather@example.com", FullName: "Zee"}
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		_, err := service.UpdatePassword(ctx, &Session{AccountId: 7, Account: acc}, "Quillfeather.Zephyrine", "", "")
+
+		assert.ErrorIs(t, err, ErrPasswordTooWeak)
+		accountRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects the current password", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		currentHash := "current-hash"
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &currentHash}
+
+		accountRepo.On("VerifyPassword", "Nimbus-Quartz-Lantern-42", currentHash).Return(true, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		_, err := service.UpdatePassword(ctx, &Session{AccountId: 7, Account: acc}, "Nimbus-Quartz-Lantern-42", "", "")
+
+		assert.ErrorIs(t, err, ErrPasswordPreviouslyUsed)
+		accountRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects passwords in the history", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		historyRepo := new(MockPasswordHistoryRepo)
+		currentHash := "current-hash"
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &currentHash}
+		history := []*account.PasswordHistoryEntry{{PasswordHash: "previous-hash"}, {PasswordHash: "older-hash"}}
+
+		historyRepo.On("GetRecent", mock.Anything, int64(7), 3, mock.AnythingOfType("time.Time")).Return(history, nil)
+		accountRepo.On("VerifyPassword", "Nimbus-Quartz-Lantern-42", "current-hash").Return(false, nil)
+		accountRepo.On("VerifyPassword", "Nimbus-Quartz-Lantern-42", "previous-hash").Return(false, nil)
+		accountRepo.On("VerifyPassword", "Nimbus-Quartz-Lantern-42", "older-hash").Return(true, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.passwordHistoryRepo = historyRepo
+		service.cfg.PasswordHistorySize = 3
+		service.cfg.PasswordHistoryRetention = 24 * time.Hour
+
+		_, err := service.UpdatePassword(ctx, &Session{AccountId: 7, Account: acc}, "Nimbus-Quartz-Lantern-42", "", "")
+
+		assert.ErrorIs(t, err, ErrPasswordPreviouslyUsed)
+		accountRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+		historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("records the previous password and prunes the history", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		historyRepo := new(MockPasswordHistoryRepo)
+		currentHash := "current-hash"
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &currentHash}
+
+		historyRepo.On("GetRecent", mock.Anything, int64(7), 3, mock.AnythingOfType("time.Time")).Return([]*account.PasswordHistoryEntry{}, nil)
+		accountRepo.On("VerifyPassword", "Nimbus-Quartz-Lantern-42", "current-hash").Return(false, nil)
+		accountRepo.On("UpdatePassword", mock.Anything, acc, "Nimbus-Quartz-Lantern-42").Return(acc, nil)
+		historyRepo.On("Create", mock.Anything, int64(7), "current-hash").Return(&account.PasswordHistoryEntry{}, nil)
+		historyRepo.On("Prune", mock.Anything, int64(7), 3, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) >= 24*time.Hour && time.Since(before) < 25*time.Hour
+		})).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.passwordHistoryRepo = historyRepo
+		service.cfg.PasswordHistorySize = 3
+		service.cfg.PasswordHistoryRetention = 24 * time.Hour
+
+		_, err := service.UpdatePassword(ctx, &Session{AccountId: 7, Account: acc}, "Nimbus-Quartz-Lantern-42", "", "")
+
+		require.NoError(t, err)
+		accountRepo.AssertExpectations(t)
+		historyRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthService_Verify2FAPasswordResetWithAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	totpSecret := "JBSWY3DPEHPK3PXP"
+	resetToken := &PasswordResetToken{
+		CoreModel: core.CoreModel{ID: 3},
+		AccountId: 7,
+		Account:   &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", TwoFactorSecret: &totpSecret},
+	}
+
+	accountRepo := new(MockAccountRepo)
+	resetTokenRepo := new(MockPasswordResetTokenRepo)
+	challengeRepo := new(MockTemporaryTwoFactorChallengeRepo)
+	accountRepo.On("UseTwoFactorTimeStep", mock.Anything, resetToken.Account, mock.Anything).Return(nil)
+	resetTokenRepo.On("Get", mock.Anything, "reset-token", "test@example.com").Return(resetToken, nil)
+	challengeRepo.On("Create", mock.Anything, int64(7), int64(3)).Return("challenge", &TemporaryTwoFactorChallenge{AccountId: 7}, nil)
+
+	service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+	service.passwordResetTokenRepo = resetTokenRepo
+	service.tempTwoFactorChallengeRepo = challengeRepo
+
+	code, err := totp.GenerateCode(totpSecret, time.Now())
+	require.NoError(t, err)
+
+	wrongCode := fmt.Sprintf("%06d", (mustAtoi(t, code)+1)%1000000)
+	_, _, err = service.Verify2FAPasswordResetWithAuthenticator(ctx, "test@example.com", "reset-token", wrongCode, "")
+	assert.ErrorIs(t, err, ErrInvalidTwoFactorCode)
+
+	challenge, verified, err := service.Verify2FAPasswordResetWithAuthenticator(ctx, "test@example.com", "reset-token", code, "")
+	require.NoError(t, err)
+	assert.Equal(t, "challenge", challenge)
+	assert.Equal(t, resetToken, verified)
+
+	_, _, err = service.Verify2FAPasswordResetWithAuthenticator(ctx, "test@example.com", "reset-token", code, "")
+	assert.ErrorIs(t, err, ErrInvalidTwoFactorCode)
+}
+
+func TestAuthService_LoginWithPassword(t *testing.T) {
+	ctx := context.Background()
+	passwordHash := "hash"
+	totpSecret := "JBSWY3DPEHPK3PXP"
+
+	t.Run("creates a session", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "Mozilla/5.0", "127.0.0.1", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		loggedIn, sessionToken, err := service.LoginWithPassword(ctx, "Test@Example.com", "Str0ng!Password", "Mozilla/5.0", "127.0.0.1", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, acc, loggedIn)
+		assert.Equal(t, "session-token", sessionToken)
+	})
+
+	t.Run("records the device and location of the session", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		locator := new(MockLocator)
+		userAgent := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1"
+		location := &geoip.Location{City: "Berlin", Country: "Germany", CountryCode: "DE"}
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		locator.On("Locate", "203.0.113.7").Return(location, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), userAgent, "203.0.113.7", Device{Browser: "Safari 17", OS: "iOS 17", Type: DeviceTypeMobile}, location, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.geoLocator = locator
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", userAgent, "203.0.113.7", false)
+
+		require.NoError(t, err)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("publishes a sign in from a new device", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		userAgent := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1"
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{
+			{Browser: "Chrome 120", OS: "Windows", DeviceType: DeviceTypeDesktop},
+		}, nil)
+		sessionRepo.On("Create", mock.Anything, int64(7), userAgent, "203.0.113.7", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		events := recordSecurityEvents(service)
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", userAgent, "203.0.113.7", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []account.SecurityEvent{
+			{Type: account.SecurityEventNewDeviceSignIn, Account: acc, UserAgent: userAgent, IPAddress: "203.0.113.7"},
+		}, *events)
+	})
+
+	t.Run("doesn't publish a sign in from a known device", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		userAgent := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1"
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		// Updates of the browser and operating system keep the device known
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{
+			{Browser: "Chrome 120", OS: "Windows", DeviceType: DeviceTypeDesktop},
+			{Browser: "Safari 16", OS: "iOS 16", DeviceType: DeviceTypeMobile},
+		}, nil)
+		sessionRepo.On("Create", mock.Anything, int64(7), userAgent, "203.0.113.7", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		events := recordSecurityEvents(service)
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", userAgent, "203.0.113.7", false)
+
+		require.NoError(t, err)
+		assert.Empty(t, *events)
+	})
+
+	t.Run("logs in when the location lookup fails", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		locator := new(MockLocator)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		locator.On("Locate", "203.0.113.7").Return(nil, geoip.ErrInvalidDatabase)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "203.0.113.7", mock.Anything, (*geoip.Location)(nil), false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.geoLocator = locator
+		_, sessionToken, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "203.0.113.7", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, "session-token", sessionToken)
+	})
+
+	t.Run("remember me creates a longer lived session", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		// The remember me idle timeout expires before its absolute lifetime
+		expectedExpiresAt := time.Now().Add(336 * time.Hour)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, true, mock.MatchedBy(func(expiresAt time.Time) bool {
+			return expiresAt.Sub(expectedExpiresAt).Abs() < time.Minute
+		})).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "", true)
+
+		require.NoError(t, err)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("starts a pending login when 2FA is enabled", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash, TwoFactorSecret: &totpSecret}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		challengeRepo.On("Create", mock.Anything, int64(7), totpSecret, false).Return("challenge", &TwoFactorAuthenticationChallenge{AccountId: 7}, nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "", false)
+
+		var twoFactorErr *TwoFactorRequiredError
+		require.ErrorAs(t, err, &twoFactorErr)
+		assert.ErrorIs(t, err, ErrTwoFactorRequired)
+		assert.Equal(t, "challenge", twoFactorErr.Challenge)
+		sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("upgrades outdated password hashes", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(true)
+		accountRepo.On("RehashPassword", mock.Anything, acc, "Str0ng!Password").Return(acc, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "", false)
+
+		require.NoError(t, err)
+		accountRepo.AssertCalled(t, "RehashPassword", mock.Anything, acc, "Str0ng!Password")
+	})
+
+	t.Run("logs in when the hash upgrade fails", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(true)
+		accountRepo.On("RehashPassword", mock.Anything, acc, "Str0ng!Password").Return(nil, assert.AnError)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		_, sessionToken, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, "session-token", sessionToken)
+	})
+
+	t.Run("rejects invalid credentials", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("GetByEmail", mock.Anything, "unknown@example.com").Return(nil, account.ErrAccountNotFound)
+		accountRepo.On("VerifyPassword", "wrong", passwordHash).Return(false, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "wrong", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+		_, _, err = service.LoginWithPassword(ctx, "unknown@example.com", "wrong", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("backs off and locks the account after repeated failures", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		accountRepo.On("VerifyPassword", "wrong", passwordHash).Return(false, nil)
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "127.0.0.1", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		attemptRepo := newMemoryAuthAttemptRepo()
+		service.attemptLimiter = NewAttemptLimiter(attemptRepo, service.cfg)
+
+		for range 3 {
+			_, _, err := service.LoginWithPassword(ctx, "test@example.com", "wrong", "", "127.0.0.1", false)
+			assert.ErrorIs(t, err, ErrInvalidCredentials)
+		}
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "wrong", "", "127.0.0.1", false)
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+		// The correct password is held back too
+		_, _, err = service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "127.0.0.1", false)
+		var tooManyErr *TooManyAttemptsError
+		require.ErrorAs(t, err, &tooManyErr)
+		assert.ErrorIs(t, err, ErrRateLimitExceeded)
+		assert.Equal(t, 1, tooManyErr.RetryAfterSeconds)
+
+		attempt, err := attemptRepo.Get(ctx, AttemptScopeAccount, "test@example.com")
+		require.NoError(t, err)
+		attempt.Failures = service.cfg.LockoutThreshold - 1
+		attempt.BlockedUntil = nil
+		_, _, err = service.LoginWithPassword(ctx, "test@example.com", "wrong", "", "127.0.0.1", false)
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+		_, _, err = service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "127.0.0.1", false)
+		require.ErrorAs(t, err, &tooManyErr)
+		assert.ErrorIs(t, err, ErrAccountLocked)
+		assert.InDelta(t, 3600, tooManyErr.RetryAfterSeconds, 5)
+		require.NotNil(t, attempt.UnlockTokenHash)
+
+		// A successful login forgets the failures
+		require.NoError(t, service.attemptLimiter.Reset(ctx, "test@example.com"))
+		_, sessionToken, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "127.0.0.1", false)
+		require.NoError(t, err)
+		assert.Equal(t, "session-token", sessionToken)
+		ipAttempt, err := attemptRepo.Get(ctx, AttemptScopeIP, "127.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, 5, ipAttempt.Failures)
+		_, err = attemptRepo.Get(ctx, AttemptScopeAccount, "test@example.com")
+		assert.ErrorIs(t, err, ErrAuthAttemptNotFound)
+	})
+
+	t.Run("counts failures against unknown email addresses", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "unknown@example.com").Return(nil, account.ErrAccountNotFound)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+
+		for range 4 {
+			_, _, err := service.LoginWithPassword(ctx, "unknown@example.com", "wrong", "", "", false)
+			assert.ErrorIs(t, err, ErrInvalidCredentials)
+		}
+		_, _, err := service.LoginWithPassword(ctx, "Unknown@Example.com", "wrong", "", "", false)
+		assert.ErrorIs(t, err, ErrRateLimitExceeded)
+	})
+
+	t.Run("rejects accounts without a password like a wrong password", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(&account.Account{Email: "test@example.com"}, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		attemptRepo := newMemoryAuthAttemptRepo()
+		service.attemptLimiter = NewAttemptLimiter(attemptRepo, service.cfg)
+
+		_, _, err := service.LoginWithPassword(ctx, "test@example.com", "Str0ng!Password", "", "127.0.0.1", false)
+
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+		accountAttempt, err := attemptRepo.Get(ctx, AttemptScopeAccount, "test@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, 1, accountAttempt.Failures)
+		accountRepo.AssertNotCalled(t, "VerifyPassword", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_LoginWithGoogle(t *testing.T) {
+	ctx := context.Background()
+	key := newTestSigningKey(t, "key-1")
+	server := newTestJWKSServer(t, key)
+	idToken := key.sign(t, validGoogleClaims())
+	subject := "110169484474386276334"
+
+	newService := func(accountRepo *MockAccountRepo, sessionRepo *MockSessionRepo, oauthCredentialRepo *MockOAuthCredentialRepo) *AuthService {
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.oauthCredentialRepo = oauthCredentialRepo
+		service.googleTokenVerifier = newTestGoogleTokenVerifier(server.URL)
+		return service
+	}
+
+	t.Run("logs in with a linked Google account", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", AuthProviders: []string{account.AuthProviderOAuthGoogle}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, account.AuthProviderOAuthGoogle, subject, true).
+			Return(&OAuthCredential{AccountId: 7, Account: acc}, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "Mozilla/5.0", "127.0.0.1", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newService(accountRepo, sessionRepo, oauthCredentialRepo)
+		loggedIn, sessionToken, err := service.LoginWithGoogle(ctx, idToken, "Mozilla/5.0", "127.0.0.1", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, acc, loggedIn)
+		assert.Equal(t, "session-token", sessionToken)
+		accountRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("creates an account on first sign in", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		created := &account.Account{CoreModel: core.CoreModel{ID: 8}, Email: "test@example.com", AuthProviders: []string{account.AuthProviderOAuthGoogle}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, account.AuthProviderOAuthGoogle, subject, true).Return(nil, ErrOAuthCredentialNotFound)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(nil, account.ErrAccountNotFound)
+		accountRepo.On("Create", mock.Anything, "test@example.com", "Test User", []string{account.AuthProviderOAuthGoogle}, (*string)(nil), (*int64)(nil), "", (*string)(nil)).Return(created, nil)
+		oauthCredentialRepo.On("Create", mock.Anything, int64(8), account.AuthProviderOAuthGoogle, subject).Return(&OAuthCredential{AccountId: 8}, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(8), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(8), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newService(accountRepo, sessionRepo, oauthCredentialRepo)
+		loggedIn, sessionToken, err := service.LoginWithGoogle(ctx, idToken, "", "", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, created, loggedIn)
+		assert.Equal(t, "session-token", sessionToken)
+		accountRepo.AssertNotCalled(t, "UpdateAuthProviders", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("refuses to link an existing account with the same email", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", AuthProviders: []string{account.AuthProviderPassword}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, account.AuthProviderOAuthGoogle, subject, true).Return(nil, ErrOAuthCredentialNotFound)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+
+		service := newService(accountRepo, new(MockSessionRepo), oauthCredentialRepo)
+		_, _, err := service.LoginWithGoogle(ctx, idToken, "", "", false)
+
+		assert.ErrorIs(t, err, ErrOAuthAccountExists)
+		oauthCredentialRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		accountRepo.AssertNotCalled(t, "UpdateAuthProviders", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("links an existing account with the same email when trusted", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", AuthProviders: []string{account.AuthProviderPassword}}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, account.AuthProviderOAuthGoogle, subject, true).Return(nil, ErrOAuthCredentialNotFound)
+		accountRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(acc, nil)
+		oauthCredentialRepo.On("Create", mock.Anything, int64(7), account.AuthProviderOAuthGoogle, subject).Return(&OAuthCredential{AccountId: 7}, nil)
+		accountRepo.On("UpdateAuthProviders", mock.Anything, acc, []string{account.AuthProviderPassword, account.AuthProviderOAuthGoogle}).Return(acc, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newService(accountRepo, sessionRepo, oauthCredentialRepo)
+		service.cfg.GoogleTrustEmail = true
+		_, _, err := service.LoginWithGoogle(ctx, idToken, "", "", false)
+
+		require.NoError(t, err)
+		accountRepo.AssertExpectations(t)
+		oauthCredentialRepo.AssertExpectations(t)
+	})
+
+	t.Run("starts a pending login when 2FA is enabled", func(t *testing.T) {
+		totpSecret := "JBSWY3DPEHPK3PXP"
+		sessionRepo := new(MockSessionRepo)
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", TwoFactorSecret: &totpSecret}
+		oauthCredentialRepo.On("GetByProviderUser", mock.Anything, account.AuthProviderOAuthGoogle, subject, true).
+			Return(&OAuthCredential{AccountId: 7, Account: acc}, nil)
+		challengeRepo.On("Create", mock.Anything, int64(7), totpSecret, false).Return("challenge", &TwoFactorAuthenticationChallenge{AccountId: 7}, nil)
+
+		service := newService(new(MockAccountRepo), sessionRepo, oauthCredentialRepo)
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+		_, _, err := service.LoginWithGoogle(ctx, idToken, "", "", false)
+
+		var twoFactorErr *TwoFactorRequiredError
+		require.ErrorAs(t, err, &twoFactorErr)
+		assert.Equal(t, "challenge", twoFactorErr.Challenge)
+		sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects invalid tokens", func(t *testing.T) {
+		oauthCredentialRepo := new(MockOAuthCredentialRepo)
+
+		service := newService(new(MockAccountRepo), new(MockSessionRepo), oauthCredentialRepo)
+		_, _, err := service.LoginWithGoogle(ctx, "invalid", "", "", false)
+
+		assert.ErrorIs(t, err, ErrOAuthTokenInvalid)
+		oauthCredentialRepo.AssertNotCalled(t, "GetByProviderUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_VerifyTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	totpSecret := "JBSWY3DPEHPK3PXP"
+
+	newChallenge := func() *TwoFactorAuthenticationChallenge {
+		return &TwoFactorAuthenticationChallenge{
+			CoreModel: core.CoreModel{ID: 5},
+			AccountId: 7,
+			Account:   &account.Account{CoreModel: core.CoreModel{ID: 7}, TwoFactorSecret: &totpSecret},
+		}
+	}
+
+	t.Run("completes the pending login with a TOTP code", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		challenge := newChallenge()
+		challengeRepo.On("Get", mock.Anything, "challenge", true).Return(challenge, nil)
+		challengeRepo.On("Delete", mock.Anything, challenge).Return(nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+		accountRepo := new(MockAccountRepo)
+		accountRepo.On("UseTwoFactorTimeStep", mock.Anything, challenge.Account, mock.Anything).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+
+		code, err := totp.GenerateCode(totpSecret, time.Now())
+		require.NoError(t, err)
+		acc, sessionToken, err := service.VerifyTwoFactorWithAuthenticator(ctx, "challenge", code, "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, challenge.Account, acc)
+		assert.Equal(t, "session-token", sessionToken)
+		challengeRepo.AssertExpectations(t)
+	})
+
+	t.Run("keeps the remember me choice of the login", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		challenge := newChallenge()
+		challenge.RememberMe = true
+		challengeRepo.On("Get", mock.Anything, "challenge", true).Return(challenge, nil)
+		challengeRepo.On("Delete", mock.Anything, challenge).Return(nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, true, mock.Anything).Return("session-token", nil)
+		accountRepo := new(MockAccountRepo)
+		accountRepo.On("UseTwoFactorTimeStep", mock.Anything, challenge.Account, mock.Anything).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+
+		code, err := totp.GenerateCode(totpSecret, time.Now())
+		require.NoError(t, err)
+		_, _, err = service.VerifyTwoFactorWithAuthenticator(ctx, "challenge", code, "", "")
+
+		require.NoError(t, err)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects replayed TOTP codes", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		challenge := newChallenge()
+		challengeRepo.On("Get", mock.Anything, "challenge", true).Return(challenge, nil)
+		challengeRepo.On("Delete", mock.Anything, challenge).Return(nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+		accountRepo := new(MockAccountRepo)
+		accountRepo.On("UseTwoFactorTimeStep", mock.Anything, challenge.Account, mock.Anything).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+
+		code, err := totp.GenerateCode(totpSecret, time.Now())
+		require.NoError(t, err)
+		_, _, err = service.VerifyTwoFactorWithAuthenticator(ctx, "challenge", code, "", "")
+		require.NoError(t, err)
+
+		_, _, err = service.VerifyTwoFactorWithAuthenticator(ctx, "challenge", code, "", "")
+		assert.ErrorIs(t, err, ErrInvalidTwoFactorCode)
+		previousCode, err := totp.GenerateCode(totpSecret, time.Now().Add(-30*time.Second))
+		require.NoError(t, err)
+		_, _, err = service.VerifyTwoFactorWithAuthenticator(ctx, "challenge", previousCode, "", "")
+		assert.ErrorIs(t, err, ErrInvalidTwoFactorCode)
+		sessionRepo.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("rejects missing or expired challenges", func(t *testing.T) {
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		challengeRepo.On("Get", mock.Anything, "expired", true).Return(nil, ErrTokenExpired)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+
+		_, _, err := service.VerifyTwoFactorWithAuthenticator(ctx, "", "123456", "", "")
+		assert.ErrorIs(t, err, ErrTwoFactorAuthenticationNotFound)
+		_, _, err = service.VerifyTwoFactorWithAuthenticator(ctx, "expired", "123456", "", "")
+		assert.ErrorIs(t, err, ErrTwoFactorAuthenticationNotFound)
+	})
+
+	t.Run("consumes recovery codes", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		recoveryCodeRepo := new(MockRecoveryCodeRepo)
+		challenge := newChallenge()
+		recoveryCode := &RecoveryCode{CoreModel: core.CoreModel{ID: 2}, AccountId: 7}
+		challengeRepo.On("Get", mock.Anything, "challenge", true).Return(challenge, nil)
+		challengeRepo.On("Delete", mock.Anything, challenge).Return(nil)
+		recoveryCodeRepo.On("Get", mock.Anything, int64(7), "ABCD1234").Return(recoveryCode, nil).Once()
+		recoveryCodeRepo.On("Get", mock.Anything, int64(7), "ABCD1234").Return(nil, ErrRecoveryCodeInvalid)
+		recoveryCodeRepo.On("Delete", mock.Anything, recoveryCode).Return(true, nil)
+		sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{}, nil).Maybe()
+		sessionRepo.On("Create", mock.Anything, int64(7), "", "", mock.Anything, mock.Anything, false, mock.Anything).Return("session-token", nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+		service.recoveryCodeRepo = recoveryCodeRepo
+
+		_, sessionToken, err := service.VerifyTwoFactorWithRecoveryCode(ctx, "challenge", " ABCD1234 ", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "session-token", sessionToken)
+
+		_, _, err = service.VerifyTwoFactorWithRecoveryCode(ctx, "challenge", "ABCD1234", "", "")
+		assert.ErrorIs(t, err, ErrRecoveryCodeInvalid)
+		recoveryCodeRepo.AssertNumberOfCalls(t, "Delete", 1)
+	})
+
+	t.Run("rejects recovery codes consumed by a concurrent login", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		recoveryCodeRepo := new(MockRecoveryCodeRepo)
+		recoveryCode := &RecoveryCode{CoreModel: core.CoreModel{ID: 2}, AccountId: 7}
+		challengeRepo.On("Get", mock.Anything, "challenge", true).Return(newChallenge(), nil)
+		// Another request deleted the code after this one read it
+		recoveryCodeRepo.On("Get", mock.Anything, int64(7), "ABCD1234").Return(recoveryCode, nil)
+		recoveryCodeRepo.On("Delete", mock.Anything, recoveryCode).Return(false, nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+		service.recoveryCodeRepo = recoveryCodeRepo
+
+		_, _, err := service.VerifyTwoFactorWithRecoveryCode(ctx, "challenge", "ABCD1234", "", "")
+
+		assert.ErrorIs(t, err, ErrRecoveryCodeInvalid)
+		sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("backs off guessed codes", func(t *testing.T) {
+		challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+		challenge := newChallenge()
+		challenge.Account.Email = "test@example.com"
+		challengeRepo.On("Get", mock.Anything, "challenge", true).Return(challenge, nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.twoFactorAuthenticationChallengeRepo = challengeRepo
+
+		code, err := totp.GenerateCode(totpSecret, time.Now())
+		require.NoError(t, err)
+		wrongCode := fmt.Sprintf("%06d", (mustAtoi(t, code)+1)%1000000)
+		for range 4 {
+			_, _, err := service.VerifyTwoFactorWithAuthenticator(ctx, "challenge", wrongCode, "", "")
+			assert.ErrorIs(t, err, ErrInvalidTwoFactorCode)
+		}
+
+		_, _, err = service.VerifyTwoFactorWithAuthenticator(ctx, "challenge", code, "", "")
+		assert.ErrorIs(t, err, ErrRateLimitExceeded)
+		challengeRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_AuthenticatorEnrollment(t *testing.T) {
+	ctx := context.Background()
+	acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+	secret, err := generateTwoFactorSecret()
+	require.NoError(t, err)
+
+	accountRepo := new(MockAccountRepo)
+	challengeRepo := new(MockTwoFactorAuthenticationChallengeRepo)
+	recoveryCodeRepo := new(MockRecoveryCodeRepo)
+	challenge := &TwoFactorAuthenticationChallenge{CoreModel: core.CoreModel{ID: 5}, AccountId: 7, TOTPSecret: secret}
+	enabled := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", TwoFactorSecret: &secret}
+	recoveryCodes := []string{"code-1", "code-2"}
+
+	challengeRepo.On("GenerateTwoFactorSecret").Return(secret, nil)
+	challengeRepo.On("Create", mock.Anything, int64(7), secret, false).Return("challenge", challenge, nil)
+	challengeRepo.On("Get", mock.Anything, "challenge", true).Return(challenge, nil)
+	challengeRepo.On("Delete", mock.Anything, challenge).Return(nil)
+	accountRepo.On("SetTwoFactorSecret", mock.Anything, acc, secret).Return(enabled, nil)
+	accountRepo.On("UseTwoFactorTimeStep", mock.Anything, acc, mock.Anything).Return(nil)
+	recoveryCodeRepo.On("DeleteAll", mock.Anything, int64(7)).Return(nil)
+	recoveryCodeRepo.On("CreateMany", mock.Anything, int64(7), RecoveryCodeCount).Return(recoveryCodes, nil)
+
+	service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+	service.cfg.TOTPIssuer = "Test App"
+	service.twoFactorAuthenticationChallengeRepo = challengeRepo
+	service.recoveryCodeRepo = recoveryCodeRepo
+
+	enrollmentChallenge, otpURI, generatedSecret, err := service.GenerateAuthenticatorChallenge(ctx, acc)
+	require.NoError(t, err)
+	assert.Equal(t, "challenge", enrollmentChallenge)
+	assert.Equal(t, secret, generatedSecret)
+	assert.Contains(t, otpURI, "otpauth://totp/Test%20App:test@example.com?")
+	assert.Contains(t, otpURI, "issuer=Test%20App")
+	assert.Contains(t, otpURI, "secret="+secret)
+
+	_, _, err = service.EnableTwoFactorWithAuthenticator(ctx, &account.Account{CoreModel: core.CoreModel{ID: 8}}, "challenge", "123456", "", "")
+	assert.ErrorIs(t, err, ErrTwoFactorAuthenticationNotFound)
+
+	code, err := totp.GenerateCode(secret, time.Now())
+	require.NoError(t, err)
+	updated, codes, err := service.EnableTwoFactorWithAuthenticator(ctx, acc, "challenge", code, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, enabled, updated)
+	assert.Equal(t, recoveryCodes, codes)
+	accountRepo.AssertExpectations(t)
+	challengeRepo.AssertExpectations(t)
+	recoveryCodeRepo.AssertExpectations(t)
+}
+
+func TestAuthService_RequestSudoMode(t *testing.T) {
+	ctx := context.Background()
+	passwordHash := "hash"
+	totpSecret := "JBSWY3DPEHPK3PXP"
+
+	t.Run("grants sudo mode for the configured lifetime", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		session := &Session{
+			CoreModel: core.CoreModel{ID: 3},
+			AccountId: 7,
+			Account:   &account.Account{CoreModel: core.CoreModel{ID: 7}, PasswordHash: &passwordHash},
+		}
+		accountRepo.On("VerifyPassword", "Str0ng!Password", passwordHash).Return(true, nil)
+		accountRepo.On("PasswordNeedsRehash", passwordHash).Return(false)
+		sessionRepo.On("UpdateSudoModeExpiresAt", mock.Anything, session, mock.AnythingOfType("*time.Time")).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.cfg.SudoModeLifetime = 10 * time.Minute
+
+		require.NoError(t, service.RequestSudoModeWithPassword(ctx, session, "Str0ng!Password", ""))
+		require.NotNil(t, session.SudoModeExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), *session.SudoModeExpiresAt, 5*time.Second)
+		assert.True(t, session.HasSudoMode())
+	})
+
+	t.Run("rejects incorrect passwords", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		sessionRepo := new(MockSessionRepo)
+		session := &Session{AccountId: 7, Account: &account.Account{CoreModel: core.CoreModel{ID: 7}, PasswordHash: &passwordHash}}
+		accountRepo.On("VerifyPassword", "wrong", passwordHash).Return(false, nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+
+		assert.ErrorIs(t, service.RequestSudoModeWithPassword(ctx, session, "wrong", ""), ErrInvalidCredentials)
+		assert.False(t, session.HasSudoMode())
+		sessionRepo.AssertNotCalled(t, "UpdateSudoModeExpiresAt", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("requires the authenticator when 2FA is enabled", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		session := &Session{
+			AccountId: 7,
+			Account:   &account.Account{CoreModel: core.CoreModel{ID: 7}, PasswordHash: &passwordHash, TwoFactorSecret: &totpSecret},
+		}
+		sessionRepo.On("UpdateSudoModeExpiresAt", mock.Anything, session, mock.AnythingOfType("*time.Time")).Return(nil)
+		accountRepo := new(MockAccountRepo)
+		accountRepo.On("UseTwoFactorTimeStep", mock.Anything, session.Account, mock.Anything).Return(nil)
+
+		service := newTestAuthService(t, accountRepo, sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.cfg.SudoModeLifetime = time.Minute
+
+		assert.ErrorIs(t, service.RequestSudoModeWithPassword(ctx, session, "Str0ng!Password", ""), ErrTwoFactorRequired)
+		assert.ErrorIs(t, service.RequestSudoModeWithAuthenticator(ctx, session, "000000", ""), ErrInvalidTwoFactorCode)
+
+		code, err := totp.GenerateCode(totpSecret, time.Now())
+		require.NoError(t, err)
+		require.NoError(t, service.RequestSudoModeWithAuthenticator(ctx, session, code, ""))
+		assert.True(t, session.HasSudoMode())
+		assert.ErrorIs(t, service.RequestSudoModeWithAuthenticator(ctx, session, code, ""), ErrInvalidTwoFactorCode)
+	})
+
+	t.Run("rejects the authenticator when 2FA is disabled", func(t *testing.T) {
+		session := &Session{AccountId: 7, Account: &account.Account{CoreModel: core.CoreModel{ID: 7}}}
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+
+		assert.ErrorIs(t, service.RequestSudoModeWithAuthenticator(ctx, session, "123456", ""), ErrTwoFactorNotEnabled)
+		assert.ErrorIs(t, service.RequestSudoModeWithPassword(ctx, session, "Str0ng!Password", ""), ErrInvalidAuthProvider)
+	})
+
+	t.Run("backs off incorrect passwords", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		session := &Session{AccountId: 7, Account: &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com", PasswordHash: &passwordHash}}
+		accountRepo.On("VerifyPassword", "wrong", passwordHash).Return(false, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+
+		for range 4 {
+			assert.ErrorIs(t, service.RequestSudoModeWithPassword(ctx, session, "wrong", "127.0.0.1"), ErrInvalidCredentials)
+		}
+		assert.ErrorIs(t, service.RequestSudoModeWithPassword(ctx, session, "wrong", "127.0.0.1"), ErrRateLimitExceeded)
+	})
+
+	t.Run("revokes grants for the account", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		sessionRepo.On("RevokeAllSudoMode", mock.Anything, int64(7)).Return(nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+
+		require.NoError(t, service.RevokeSudoMode(ctx, 7))
+		sessionRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthService_GetViewerSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records the activity and slides the idle expiry", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		session := &Session{
+			CoreModel:    core.CoreModel{ID: 4, CreatedAt: time.Now().Add(-time.Hour)},
+			LastActiveAt: time.Now().Add(-10 * time.Minute),
+		}
+		sessionRepo.On("Get", mock.Anything, "session-token", true).Return(session, nil)
+		expectedExpiresAt := time.Now().Add(2 * time.Hour)
+		sessionRepo.On("Touch", mock.Anything, session, mock.Anything, mock.MatchedBy(func(expiresAt time.Time) bool {
+			return expiresAt.Sub(expectedExpiresAt).Abs() < time.Minute
+		})).Return(nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		viewerSession, err := service.GetViewerSession(ctx, "session-token")
+
+		require.NoError(t, err)
+		assert.Equal(t, session, viewerSession)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("never extends past the absolute lifetime", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		createdAt := time.Now().Add(-23 * time.Hour)
+		session := &Session{
+			CoreModel:    core.CoreModel{ID: 4, CreatedAt: createdAt},
+			LastActiveAt: time.Now().Add(-time.Hour),
+		}
+		sessionRepo.On("Get", mock.Anything, "session-token", true).Return(session, nil)
+		sessionRepo.On("Touch", mock.Anything, session, mock.Anything, createdAt.Add(24*time.Hour)).Return(nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		_, err := service.GetViewerSession(ctx, "session-token")
+
+		require.NoError(t, err)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("skips the write within the activity update interval", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		session := &Session{
+			CoreModel:    core.CoreModel{ID: 4, CreatedAt: time.Now().Add(-time.Hour)},
+			LastActiveAt: time.Now().Add(-time.Minute),
+		}
+		sessionRepo.On("Get", mock.Anything, "session-token", true).Return(session, nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		_, err := service.GetViewerSession(ctx, "session-token")
+
+		require.NoError(t, err)
+		sessionRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects expired sessions", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		sessionRepo.On("Get", mock.Anything, "expired-token", true).Return(nil, ErrTokenExpired)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		_, err := service.GetViewerSession(ctx, "expired-token")
+
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+}
+
+func TestAuthService_DeleteOtherSessions(t *testing.T) {
+	ctx := context.Background()
+	sessionRepo := new(MockSessionRepo)
+	sessionRepo.On("GetAllList", mock.Anything, int64(7), "").Return([]*Session{
+		{CoreModel: core.CoreModel{ID: 4}},
+		{CoreModel: core.CoreModel{ID: 3}},
+		{CoreModel: core.CoreModel{ID: 2}},
+	}, nil)
+	sessionRepo.On("DeleteMany", mock.Anything, []int64{4, 2}).Return(nil)
+
+	service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+	deletedSessionIDs, err := service.DeleteOtherSessions(ctx, 7, 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 2}, deletedSessionIDs)
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestAuthService_DeleteSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deletes another session", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		session := &Session{CoreModel: core.CoreModel{ID: 4}, AccountId: 7}
+		sessionRepo.On("GetBySessionAccountId", mock.Anything, int64(4), int64(7), "").Return(session, nil)
+		sessionRepo.On("Delete", mock.Anything, session).Return(nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		deleted, err := service.DeleteSession(ctx, 7, 4, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, session, deleted)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("refuses to delete the current session", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		_, err := service.DeleteSession(ctx, 7, 3, 3)
+
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+		sessionRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthService_BearerTokens(t *testing.T) {
+	ctx := context.Background()
+
+	newSession := func() *Session {
+		return &Session{
+			CoreModel:    core.CoreModel{ID: 4, CreatedAt: time.Now().Add(-time.Hour)},
+			AccountId:    7,
+			ExpiresAt:    time.Now().Add(2 * time.Hour).Unix(),
+			LastActiveAt: time.Now(),
+		}
+	}
+
+	t.Run("issues tokens bounded by the session", func(t *testing.T) {
+		session := newSession()
+		session.ExpiresAt = time.Now().Add(10 * time.Minute).Unix()
+		bearerTokenRepo := new(MockBearerTokenRepo)
+		bearerTokenRepo.On("Create", mock.Anything, int64(4), time.Unix(session.ExpiresAt, 0), session.CreatedAt.Add(24*time.Hour)).
+			Return("access-token", "refresh-token", nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.bearerTokenRepo = bearerTokenRepo
+
+		tokens, err := service.CreateBearerTokens(ctx, session)
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", tokens.AccessToken)
+		assert.Equal(t, "refresh-token", tokens.RefreshToken)
+		assert.Equal(t, session.CreatedAt.Add(24*time.Hour), tokens.RefreshTokenExpiresAt)
+		bearerTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("rotates the refresh token", func(t *testing.T) {
+		session := newSession()
+		refreshToken := &RefreshToken{CoreModel: core.CoreModel{ID: 9}, SessionId: 4}
+		sessionRepo := new(MockSessionRepo)
+		sessionRepo.On("GetById", mock.Anything, int64(4)).Return(session, nil)
+		bearerTokenRepo := new(MockBearerTokenRepo)
+		bearerTokenRepo.On("GetRefreshToken", mock.Anything, "refresh-token").Return(refreshToken, nil)
+		bearerTokenRepo.On("MarkRefreshTokenUsed", mock.Anything, refreshToken).Return(true, nil)
+		bearerTokenRepo.On("Create", mock.Anything, int64(4), mock.MatchedBy(func(expiresAt time.Time) bool {
+			return time.Until(expiresAt).Round(time.Minute) == 15*time.Minute
+		}), session.CreatedAt.Add(24*time.Hour)).Return("new-access-token", "new-refresh-token", nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.bearerTokenRepo = bearerTokenRepo
+
+		tokens, err := service.RefreshBearerTokens(ctx, "refresh-token")
+		require.NoError(t, err)
+		assert.Equal(t, "new-access-token", tokens.AccessToken)
+		assert.Equal(t, "new-refresh-token", tokens.RefreshToken)
+		bearerTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("revokes the token family when a refresh token is reused", func(t *testing.T) {
+		usedAt := time.Now().Add(-time.Minute)
+		sessionRepo := new(MockSessionRepo)
+		sessionRepo.On("DeleteMany", mock.Anything, []int64{4}).Return(nil)
+		bearerTokenRepo := new(MockBearerTokenRepo)
+		bearerTokenRepo.On("GetRefreshToken", mock.Anything, "refresh-token").Return(&RefreshToken{SessionId: 4, UsedAt: &usedAt}, nil)
+		bearerTokenRepo.On("DeleteAllBySession", mock.Anything, int64(4)).Return(nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.bearerTokenRepo = bearerTokenRepo
+
+		_, err := service.RefreshBearerTokens(ctx, "refresh-token")
+		assert.ErrorIs(t, err, ErrRefreshTokenReused)
+		sessionRepo.AssertExpectations(t)
+		bearerTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("revokes the token family when a concurrent refresh won", func(t *testing.T) {
+		refreshToken := &RefreshToken{CoreModel: core.CoreModel{ID: 9}, SessionId: 4}
+		sessionRepo := new(MockSessionRepo)
+		sessionRepo.On("GetById", mock.Anything, int64(4)).Return(newSession(), nil)
+		sessionRepo.On("DeleteMany", mock.Anything, []int64{4}).Return(nil)
+		bearerTokenRepo := new(MockBearerTokenRepo)
+		bearerTokenRepo.On("GetRefreshToken", mock.Anything, "refresh-token").Return(refreshToken, nil)
+		bearerTokenRepo.On("MarkRefreshTokenUsed", mock.Anything, refreshToken).Return(false, nil)
+		bearerTokenRepo.On("DeleteAllBySession", mock.Anything, int64(4)).Return(nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.bearerTokenRepo = bearerTokenRepo
+
+		_, err := service.RefreshBearerTokens(ctx, "refresh-token")
+		assert.ErrorIs(t, err, ErrRefreshTokenReused)
+		bearerTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects unknown refresh tokens and signed out sessions", func(t *testing.T) {
+		sessionRepo := new(MockSessionRepo)
+		sessionRepo.On("GetById", mock.Anything, int64(4)).Return(nil, ErrSessionNotFound)
+		bearerTokenRepo := new(MockBearerTokenRepo)
+		bearerTokenRepo.On("GetRefreshToken", mock.Anything, "unknown").Return(nil, ErrBearerTokenNotFound)
+		bearerTokenRepo.On("GetRefreshToken", mock.Anything, "expired").Return(nil, ErrTokenExpired)
+		bearerTokenRepo.On("GetRefreshToken", mock.Anything, "signed-out").Return(&RefreshToken{SessionId: 4}, nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.bearerTokenRepo = bearerTokenRepo
+
+		for _, token := range []string{"unknown", "expired", "signed-out"} {
+			_, err := service.RefreshBearerTokens(ctx, token)
+			assert.ErrorIs(t, err, ErrInvalidRefreshToken, token)
+		}
+		bearerTokenRepo.AssertNotCalled(t, "MarkRefreshTokenUsed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("resolves access tokens into their session", func(t *testing.T) {
+		session := newSession()
+		sessionRepo := new(MockSessionRepo)
+		sessionRepo.On("GetById", mock.Anything, int64(4)).Return(session, nil)
+		bearerTokenRepo := new(MockBearerTokenRepo)
+		bearerTokenRepo.On("GetAccessToken", mock.Anything, "access-token").Return(&AccessToken{SessionId: 4}, nil)
+		bearerTokenRepo.On("GetAccessToken", mock.Anything, "expired").Return(nil, ErrTokenExpired)
+
+		service := newTestAuthService(t, new(MockAccountRepo), sessionRepo, new(account.MockEmailVerificationTokenRepo))
+		service.bearerTokenRepo = bearerTokenRepo
+
+		viewerSession, err := service.GetBearerViewerSession(ctx, "access-token")
+		require.NoError(t, err)
+		assert.Equal(t, session, viewerSession)
+
+		_, err = service.GetBearerViewerSession(ctx, "expired")
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+}
+
+func TestAuthService_PersonalAccessTokens(t *testing.T) {
+	ctx := context.Background()
+	acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, Email: "test@example.com"}
+
+	t.Run("creates a token and notifies the account owner", func(t *testing.T) {
+		expiresAt := time.Now().Add(30 * 24 * time.Hour)
+		created := &PersonalAccessToken{CoreModel: core.CoreModel{ID: 3}, Name: "CI", Scopes: []string{ScopeReadAccount}, AccountId: 7}
+		personalAccessTokenRepo := new(MockPersonalAccessTokenRepo)
+		personalAccessTokenRepo.On("Create", mock.Anything, int64(7), "CI", []string{ScopeReadAccount}, &expiresAt).Return(created, "hjpat_token", nil)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.personalAccessTokenRepo = personalAccessTokenRepo
+		events := recordSecurityEvents(service)
+
+		personalAccessToken, token, err := service.CreatePersonalAccessToken(ctx, acc, "  CI ", []string{ScopeReadAccount, ScopeReadAccount}, &expiresAt, "Mozilla/5.0", "127.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, created, personalAccessToken)
+		assert.Equal(t, "hjpat_token", token)
+		require.Len(t, *events, 1)
+		assert.Equal(t, account.SecurityEventPersonalAccessTokenCreated, (*events)[0].Type)
+		assert.Equal(t, "CI", (*events)[0].Detail)
+	})
+
+	t.Run("validates the name, scopes and expiry", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		personalAccessTokenRepo := new(MockPersonalAccessTokenRepo)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.personalAccessTokenRepo = personalAccessTokenRepo
+
+		_, _, err := service.CreatePersonalAccessToken(ctx, acc, " ", []string{ScopeReadAccount}, nil, "", "")
+		assert.ErrorIs(t, err, ErrInvalidPersonalAccessTokenName)
+		_, _, err = service.CreatePersonalAccessToken(ctx, acc, strings.Repeat("a", PersonalAccessTokenNameMaxLength+1), []string{ScopeReadAccount}, nil, "", "")
+		assert.ErrorIs(t, err, ErrInvalidPersonalAccessTokenName)
+		_, _, err = service.CreatePersonalAccessToken(ctx, acc, "CI", nil, nil, "", "")
+		assert.ErrorIs(t, err, ErrInvalidPersonalAccessTokenScopes)
+		_, _, err = service.CreatePersonalAccessToken(ctx, acc, "CI", []string{"admin"}, nil, "", "")
+		assert.ErrorIs(t, err, ErrInvalidPersonalAccessTokenScopes)
+		_, _, err = service.CreatePersonalAccessToken(ctx, acc, "CI", []string{ScopeReadAccount}, &past, "", "")
+		assert.ErrorIs(t, err, ErrInvalidPersonalAccessTokenExpiry)
+		personalAccessTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("revokes the account's own tokens only", func(t *testing.T) {
+		personalAccessToken := &PersonalAccessToken{CoreModel: core.CoreModel{ID: 3}, AccountId: 7}
+		personalAccessTokenRepo := new(MockPersonalAccessTokenRepo)
+		personalAccessTokenRepo.On("GetByAccountTokenId", mock.Anything, int64(7), int64(3)).Return(personalAccessToken, nil)
+		personalAccessTokenRepo.On("GetByAccountTokenId", mock.Anything, int64(8), int64(3)).Return(nil, ErrPersonalAccessTokenNotFound)
+		personalAccessTokenRepo.On("Delete", mock.Anything, personalAccessToken).Return(nil).Once()
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.personalAccessTokenRepo = personalAccessTokenRepo
+
+		revoked, err := service.RevokePersonalAccessToken(ctx, 7, 3)
+		require.NoError(t, err)
+		assert.Equal(t, personalAccessToken, revoked)
+
+		_, err = service.RevokePersonalAccessToken(ctx, 8, 3)
+		assert.ErrorIs(t, err, ErrPersonalAccessTokenNotFound)
+		personalAccessTokenRepo.AssertExpectations(t)
+	})
+
+	t.Run("records the use of a token", func(t *testing.T) {
+		lastUsedAt := time.Now().Add(-time.Minute)
+		recentlyUsed := &PersonalAccessToken{CoreModel: core.CoreModel{ID: 3}, LastUsedAt: &lastUsedAt}
+		unused := &PersonalAccessToken{CoreModel: core.CoreModel{ID: 4}}
+		personalAccessTokenRepo := new(MockPersonalAccessTokenRepo)
+		personalAccessTokenRepo.On("Get", mock.Anything, "hjpat_recent").Return(recentlyUsed, nil)
+		personalAccessTokenRepo.On("Get", mock.Anything, "hjpat_unused").Return(unused, nil)
+		personalAccessTokenRepo.On("Get", mock.Anything, "hjpat_expired").Return(nil, ErrTokenExpired)
+		personalAccessTokenRepo.On("UpdateLastUsedAt", mock.Anything, unused, mock.Anything).Return(nil).Once()
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.personalAccessTokenRepo = personalAccessTokenRepo
+
+		viewer, err := service.GetPersonalAccessTokenViewer(ctx, "hjpat_unused")
+		require.NoError(t, err)
+		assert.Equal(t, unused, viewer)
+
+		_, err = service.GetPersonalAccessTokenViewer(ctx, "hjpat_recent")
+		require.NoError(t, err)
+
+		_, err = service.GetPersonalAccessTokenViewer(ctx, "hjpat_expired")
+		assert.ErrorIs(t, err, ErrPersonalAccessTokenNotFound)
+		personalAccessTokenRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthService_DeleteWebAuthnCredential(t *testing.T) {
+	ctx := context.Background()
+	passwordHash := "hash"
+
+	newCredentialRepo := func(accountID int64, count int) *fakeWebAuthnCredentialRepo {
+		credentialRepo := &fakeWebAuthnCredentialRepo{}
+		for i := 0; i < count; i++ {
+			_, err := credentialRepo.Create(ctx, accountID, []byte{byte(i)}, nil, 0, "", false, nil, "passkey")
+			require.NoError(t, err)
+		}
+		return credentialRepo
+	}
+
+	t.Run("keeps the passkey provider while other passkeys remain", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, AuthProviders: []string{account.AuthProviderWebAuthnCredential}}
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.webAuthnCredentialRepo = newCredentialRepo(7, 2)
+
+		deleted, err := service.DeleteWebAuthnCredential(ctx, acc, 1, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted.ID)
+		accountRepo.AssertNotCalled(t, "UpdateAuthProviders", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("refuses to delete the last sign in method", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 7}, AuthProviders: []string{account.AuthProviderWebAuthnCredential}}
+		credentialRepo := newCredentialRepo(7, 1)
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.webAuthnCredentialRepo = credentialRepo
+
+		_, err := service.DeleteWebAuthnCredential(ctx, acc, 1, "", "")
+		assert.ErrorIs(t, err, ErrInsufficientAuthProviders)
+		assert.Len(t, credentialRepo.credentials, 1)
+	})
+
+	t.Run("removes the passkey provider with the last passkey", func(t *testing.T) {
+		accountRepo := new(MockAccountRepo)
+		acc := &account.Account{
+			CoreModel:     core.CoreModel{ID: 7},
+			PasswordHash:  &passwordHash,
+			AuthProviders: []string{account.AuthProviderPassword, account.AuthProviderWebAuthnCredential},
+		}
+		accountRepo.On("UpdateAuthProviders", mock.Anything, acc, []string{account.AuthProviderPassword}).Return(acc, nil)
+
+		service := newTestAuthService(t, accountRepo, new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.webAuthnCredentialRepo = newCredentialRepo(7, 1)
+
+		_, err := service.DeleteWebAuthnCredential(ctx, acc, 1, "", "")
+		require.NoError(t, err)
+		accountRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not touch other accounts' passkeys", func(t *testing.T) {
+		acc := &account.Account{CoreModel: core.CoreModel{ID: 8}, AuthProviders: []string{account.AuthProviderPassword}}
+
+		service := newTestAuthService(t, new(MockAccountRepo), new(MockSessionRepo), new(account.MockEmailVerificationTokenRepo))
+		service.webAuthnCredentialRepo = newCredentialRepo(7, 2)
+
+		_, err := service.DeleteWebAuthnCredential(ctx, acc, 1, "", "")
+		assert.ErrorIs(t, err, ErrWebAuthnCredentialNotFound)
+		_, err = service.UpdateWebAuthnCredential(ctx, 8, 1, "renamed")
+		assert.ErrorIs(t, err, ErrWebAuthnCredentialNotFound)
+	})
+}
+
+// mustAtoi parses a numeric string, failing the test on error
+func mustAtoi(t *testing.T, s string) int {
+	n, err := strconv.Atoi(s)
+	require.NoError(t, err)
+	return n
+}
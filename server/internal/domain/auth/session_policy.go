@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"time"
+
+	"server/internal/config"
+)
+
+// SessionPolicy bounds how long a session lives
+//
+// A session expires Lifetime after the login at the latest, and earlier once it went
+// unused for IdleTimeout. Every recorded use slides the idle expiry forward.
+type SessionPolicy struct {
+	Lifetime    time.Duration
+	IdleTimeout time.Duration
+}
+
+// NewSessionPolicy returns the standard or the "remember me" policy from the SESSION_* configuration
+func NewSessionPolicy(cfg *config.Config, rememberMe bool) SessionPolicy {
+	if rememberMe {
+		return SessionPolicy{
+			Lifetime:    cfg.SessionRememberMeLifetime,
+			IdleTimeout: cfg.SessionRememberMeIdleTimeout,
+		}
+	}
+	return SessionPolicy{
+		Lifetime:    cfg.SessionLifetime,
+		IdleTimeout: cfg.SessionIdleTimeout,
+	}
+}
+
+// ExpiresAt returns when a session created and last used at the given times expires
+//
+// A zero idle timeout only applies the absolute lifetime.
+func (p SessionPolicy) ExpiresAt(createdAt time.Time, lastActiveAt time.Time) time.Time {
+	expiresAt := createdAt.Add(p.Lifetime)
+	if p.IdleTimeout > 0 {
+		if idleExpiresAt := lastActiveAt.Add(p.IdleTimeout); idleExpiresAt.Before(expiresAt) {
+			return idleExpiresAt
+		}
+	}
+	return expiresAt
+}
+
+// SessionCookieMaxAge returns the lifetime of the session cookie in seconds, long enough for any session
+func SessionCookieMaxAge(cfg *config.Config) int {
+	lifetime := max(cfg.SessionLifetime, cfg.SessionRememberMeLifetime)
+	return int(lifetime.Seconds())
+}
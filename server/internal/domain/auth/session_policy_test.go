@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"server/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionPolicy(t *testing.T) {
+	cfg := &config.Config{
+		SessionLifetime:              24 * time.Hour,
+		SessionIdleTimeout:           2 * time.Hour,
+		SessionRememberMeLifetime:    720 * time.Hour,
+		SessionRememberMeIdleTimeout: 336 * time.Hour,
+	}
+	createdAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		policy       SessionPolicy
+		lastActiveAt time.Time
+		expected     time.Time
+	}{
+		{
+			name:         "expires when idle",
+			policy:       NewSessionPolicy(cfg, false),
+			lastActiveAt: createdAt.Add(time.Hour),
+			expected:     createdAt.Add(3 * time.Hour),
+		},
+		{
+			name:         "caps the idle expiry at the lifetime",
+			policy:       NewSessionPolicy(cfg, false),
+			lastActiveAt: createdAt.Add(23 * time.Hour),
+			expected:     createdAt.Add(24 * time.Hour),
+		},
+		{
+			name:         "remember me lasts longer",
+			policy:       NewSessionPolicy(cfg, true),
+			lastActiveAt: createdAt,
+			expected:     createdAt.Add(336 * time.Hour),
+		},
+		{
+			name:         "without an idle timeout",
+			policy:       SessionPolicy{Lifetime: 24 * time.Hour},
+			lastActiveAt: createdAt,
+			expected:     createdAt.Add(24 * time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.ExpiresAt(createdAt, tt.lastActiveAt))
+		})
+	}
+
+	assert.Equal(t, 720*60*60, SessionCookieMaxAge(cfg))
+}
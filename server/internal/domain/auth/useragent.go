@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Device types of a session
+const (
+	DeviceTypeDesktop = "desktop"
+	DeviceTypeMobile  = "mobile"
+	DeviceTypeTablet  = "tablet"
+	DeviceTypeBot     = "bot"
+	DeviceTypeUnknown = "unknown"
+)
+
+// Device describes the device a session was created from, as told by its user agent
+type Device struct {
+	// Browser is the browser name and major version, such as "Chrome 120", empty if unknown
+	Browser string
+	// OS is the operating system name and version if known, such as "Android 14", empty if unknown
+	OS string
+	// Type is one of the DeviceType constants
+	Type string
+}
+
+// userAgentPattern matches a user agent product token, ordered so that browsers built on
+// another browser's engine are matched before the browser whose token they also send
+type userAgentPattern struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+var browserPatterns = []userAgentPattern{
+	{"Edge", regexp.MustCompile(`\bEdg(?:e|A|iOS)?/(\d+)`)},
+	{"Opera", regexp.MustCompile(`\b(?:OPR|OPiOS)/(\d+)`)},
+	{"Samsung Internet", regexp.MustCompile(`\bSamsungBrowser/(\d+)`)},
+	{"Firefox", regexp.MustCompile(`\b(?:Firefox|FxiOS)/(\d+)`)},
+	{"Chrome", regexp.MustCompile(`\b(?:Chrome|CriOS|Chromium)/(\d+)`)},
+	{"Safari", regexp.MustCompile(`\bVersion/(\d+)[.\d]* (?:Mobile/\S+ )?Safari/`)},
+}
+
+var osPatterns = []userAgentPattern{
+	{"iPadOS", regexp.MustCompile(`\biPad\b.*? OS (\d+)`)},
+	{"iOS", regexp.MustCompile(`\b(?:iPhone|iPod)\b.*? OS (\d+)`)},
+	{"Android", regexp.MustCompile(`\bAndroid (\d+)`)},
+	{"Windows", regexp.MustCompile(`\bWindows (?:NT|Phone)\b()`)},
+	{"ChromeOS", regexp.MustCompile(`\bCrOS\b()`)},
+	{"macOS", regexp.MustCompile(`\bMac OS X\b()`)},
+	{"Linux", regexp.MustCompile(`\bLinux\b()`)},
+}
+
+// botPattern matches crawlers, monitoring agents and command line HTTP clients
+var botPattern = regexp.MustCompile(`(?i)bot\b|crawl|spider|slurp|headless|\bcurl/|\bwget/|python-requests|\bokhttp/|\bGo-http-client/`)
+
+// ParseUserAgent tells the browser, operating system and device type from a user agent
+//
+// The parsing is best effort: unknown user agents yield empty names and DeviceTypeUnknown.
+func ParseUserAgent(userAgent string) Device {
+	device := Device{Type: DeviceTypeUnknown}
+	if strings.TrimSpace(userAgent) == "" {
+		return device
+	}
+
+	device.Browser = matchUserAgent(userAgent, browserPatterns)
+	device.OS = matchUserAgent(userAgent, osPatterns)
+
+	switch {
+	case botPattern.MatchString(userAgent):
+		device.Type = DeviceTypeBot
+	case strings.Contains(userAgent, "iPad") || strings.Contains(userAgent, "Tablet") ||
+		(strings.Contains(userAgent, "Android") && !strings.Contains(userAgent, "Mobile")):
+		device.Type = DeviceTypeTablet
+	case strings.Contains(userAgent, "Mobi") || strings.Contains(userAgent, "iPhone") || strings.Contains(userAgent, "iPod"):
+		device.Type = DeviceTypeMobile
+	case device.OS != "":
+		device.Type = DeviceTypeDesktop
+	}
+
+	return device
+}
+
+// matchUserAgent returns the name of the first pattern matching the user agent, with the version it captured
+func matchUserAgent(userAgent string, patterns []userAgentPattern) string {
+	for _, p := range patterns {
+		match := p.pattern.FindStringSubmatch(userAgent)
+		if match == nil {
+			continue
+		}
+		if match[1] == "" {
+			return p.name
+		}
+		return p.name + " " + match[1]
+	}
+	return ""
+}
+
+// isSameDevice tells whether a session was created from the same kind of device
+//
+// Browser and operating system versions are ignored, so updates don't make a device new.
+func isSameDevice(session *Session, device Device) bool {
+	return session.DeviceType == device.Type &&
+		withoutVersion(session.Browser) == withoutVersion(device.Browser) &&
+		withoutVersion(session.OS) == withoutVersion(device.OS)
+}
+
+// withoutVersion removes the trailing version from a browser or operating system name
+func withoutVersion(name string) string {
+	if i := strings.LastIndexByte(name, ' '); i >= 0 && strings.Trim(name[i+1:], "0123456789") == "" {
+		return name[:i]
+	}
+	return name
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		expected  Device
+	}{
+		{
+			name:      "Chrome on Windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			expected:  Device{Browser: "Chrome 120", OS: "Windows", Type: DeviceTypeDesktop},
+		},
+		{
+			name:      "Edge on Windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			expected:  Device{Browser: "Edge 120", OS: "Windows", Type: DeviceTypeDesktop},
+		},
+		{
+			name:      "Safari on macOS",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Safari/605.1.15",
+			expected:  Device{Browser: "Safari 17", OS: "macOS", Type: DeviceTypeDesktop},
+		},
+		{
+			name:      "Firefox on Linux",
+			userAgent: "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			expected:  Device{Browser: "Firefox 121", OS: "Linux", Type: DeviceTypeDesktop},
+		},
+		{
+			name:      "Safari on iPhone",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1",
+			expected:  Device{Browser: "Safari 17", OS: "iOS 17", Type: DeviceTypeMobile},
+		},
+		{
+			name:      "Chrome on iPad",
+			userAgent: "Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/120.0.6099.119 Mobile/15E148 Safari/604.1",
+			expected:  Device{Browser: "Chrome 120", OS: "iPadOS 16", Type: DeviceTypeTablet},
+		},
+		{
+			name:      "Samsung Internet on Android phone",
+			userAgent: "Mozilla/5.0 (Linux; Android 14; SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36",
+			expected:  Device{Browser: "Samsung Internet 23", OS: "Android 14", Type: DeviceTypeMobile},
+		},
+		{
+			name:      "Chrome on Android tablet",
+			userAgent: "Mozilla/5.0 (Linux; Android 13; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			expected:  Device{Browser: "Chrome 120", OS: "Android 13", Type: DeviceTypeTablet},
+		},
+		{
+			name:      "crawler",
+			userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			expected:  Device{Type: DeviceTypeBot},
+		},
+		{
+			name:      "command line client",
+			userAgent: "curl/8.4.0",
+			expected:  Device{Type: DeviceTypeBot},
+		},
+		{
+			name:      "unknown",
+			userAgent: "Mozilla/5.0",
+			expected:  Device{Type: DeviceTypeUnknown},
+		},
+		{
+			name:     "empty",
+			expected: Device{Type: DeviceTypeUnknown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseUserAgent(tt.userAgent))
+		})
+	}
+}
+
+func TestIsSameDevice(t *testing.T) {
+	session := &Session{Browser: "Samsung Internet 22", OS: "Android 13", DeviceType: DeviceTypeMobile}
+
+	assert.True(t, isSameDevice(session, Device{Browser: "Samsung Internet 23", OS: "Android 14", Type: DeviceTypeMobile}))
+	assert.False(t, isSameDevice(session, Device{Browser: "Chrome 120", OS: "Android 14", Type: DeviceTypeMobile}))
+	assert.False(t, isSameDevice(session, Device{Browser: "Samsung Internet 23", OS: "Android 14", Type: DeviceTypeTablet}))
+	assert.True(t, isSameDevice(&Session{DeviceType: DeviceTypeUnknown}, Device{Type: DeviceTypeUnknown}))
+}
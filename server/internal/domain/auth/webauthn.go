@@ -0,0 +1,402 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"server/internal/config"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultWebAuthnTimeout = 5 * time.Minute
+
+	// Device types stored in WebAuthnCredential.DeviceType
+	WebAuthnDeviceTypeSingleDevice = "single_device"
+	WebAuthnDeviceTypeMultiDevice  = "multi_device"
+)
+
+// WebAuthnRegistration is the verified result of a registration ceremony
+type WebAuthnRegistration struct {
+	// AccountID is the account the credential was generated for
+	AccountID  int64
+	Credential *webauthn.Credential
+}
+
+// WebAuthnService runs WebAuthn registration and authentication ceremonies
+//
+// Challenges are persisted with WebAuthnChallengeRepo so that ceremonies can span
+// multiple requests, and each challenge can only be used once.
+type WebAuthnService struct {
+	webAuthn               *webauthn.WebAuthn
+	webAuthnCredentialRepo WebAuthnCredentialRepo
+	webAuthnChallengeRepo  WebAuthnChallengeRepo
+	registrationTimeout    time.Duration
+	loginTimeout           time.Duration
+	logger                 *zap.Logger
+}
+
+func NewWebAuthnService(
+	cfg *config.Config,
+	webAuthnCredentialRepo WebAuthnCredentialRepo,
+	webAuthnChallengeRepo WebAuthnChallengeRepo,
+	logger *zap.Logger,
+) (*WebAuthnService, error) {
+	registrationTimeout := cfg.WebAuthnRegistrationTimeout
+	if registrationTimeout <= 0 {
+		registrationTimeout = DefaultWebAuthnTimeout
+	}
+	loginTimeout := cfg.WebAuthnLoginTimeout
+	if loginTimeout <= 0 {
+		loginTimeout = DefaultWebAuthnTimeout
+	}
+
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          cfg.WebAuthnRPID,
+		RPDisplayName: cfg.WebAuthnRPName,
+		RPOrigins:     cfg.WebAuthnRPOrigins,
+		// Passkeys must be discoverable so that users can log in without an email
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			RequireResidentKey: protocol.ResidentKeyRequired(),
+			ResidentKey:        protocol.ResidentKeyRequirementRequired,
+			UserVerification:   protocol.VerificationPreferred,
+		},
+		AttestationPreference: protocol.PreferNoAttestation,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login: webauthn.TimeoutConfig{
+				Enforce:    true,
+				Timeout:    loginTimeout,
+				TimeoutUVD: loginTimeout,
+			},
+			Registration: webauthn.TimeoutConfig{
+				Enforce:    true,
+				Timeout:    registrationTimeout,
+				TimeoutUVD: registrationTimeout,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure webauthn: %w", err)
+	}
+
+	return &WebAuthnService{
+		webAuthn:               webAuthn,
+		webAuthnCredentialRepo: webAuthnCredentialRepo,
+		webAuthnChallengeRepo:  webAuthnChallengeRepo,
+		registrationTimeout:    registrationTimeout,
+		loginTimeout:           loginTimeout,
+		logger:                 logger,
+	}, nil
+}
+
+// BeginRegistration generates credential creation options for the given account
+//
+// The account does not need to exist yet: for new users the ID is generated up front
+// and used as the WebAuthn user handle, then reused when the account is created.
+//
+// Returns:
+//   - string: JSON encoded PublicKeyCredentialCreationOptions
+//   - error: Any error storing the challenge
+func (s *WebAuthnService) BeginRegistration(ctx context.Context, accountID int64, email string, fullName string, excludeCredentials []*WebAuthnCredential) (string, error) {
+	user := &webAuthnUser{accountID: accountID, name: email, displayName: fullName}
+
+	var opts []webauthn.RegistrationOption
+	if len(excludeCredentials) > 0 {
+		opts = append(opts, webauthn.WithExclusions(credentialDescriptors(excludeCredentials)))
+	}
+
+	creation, session, err := s.webAuthn.BeginRegistration(user, opts...)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin webauthn registration: %w", err)
+	}
+
+	if err := s.storeChallenge(ctx, session.Challenge, accountID, s.registrationTimeout); err != nil {
+		return "", err
+	}
+
+	options, err := json.Marshal(creation.Response)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode webauthn registration options: %w", err)
+	}
+
+	return string(options), nil
+}
+
+// FinishRegistration verifies an attestation response against its stored challenge
+//
+// If expectedAccountID is set, the challenge must have been generated for that account.
+// The credential is not stored; use SaveCredential once the account exists.
+//
+// Returns:
+//   - *WebAuthnRegistration: The verified credential and the account it was generated for
+//   - error: ErrChallengeNotFound or ErrInvalidWebAuthnResponse
+func (s *WebAuthnService) FinishRegistration(ctx context.Context, registrationResponse string, expectedAccountID *int64) (*WebAuthnRegistration, error) {
+	parsedResponse, err := protocol.ParseCredentialCreationResponseBytes([]byte(registrationResponse))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebAuthnResponse, err)
+	}
+
+	challenge, err := s.consumeChallenge(ctx, parsedResponse.Response.CollectedClientData.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	if expectedAccountID != nil && challenge.GeneratedAccountId != *expectedAccountID {
+		return nil, ErrChallengeNotFound
+	}
+
+	user := &webAuthnUser{accountID: challenge.GeneratedAccountId}
+	session := webauthn.SessionData{
+		Challenge:        parsedResponse.Response.CollectedClientData.Challenge,
+		RelyingPartyID:   s.webAuthn.Config.RPID,
+		UserID:           user.WebAuthnID(),
+		UserVerification: s.webAuthn.Config.AuthenticatorSelection.UserVerification,
+		CredParams:       webauthn.CredentialParametersDefault(),
+		Expires:          time.Unix(challenge.ExpiresAt, 0),
+	}
+
+	credential, err := s.webAuthn.CreateCredential(user, session, parsedResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebAuthnResponse, err)
+	}
+
+	// A credential can only be registered once
+	_, err = s.webAuthnCredentialRepo.Get(ctx, credential.ID, false)
+	if err == nil {
+		return nil, fmt.Errorf("%w: credential is already registered", ErrInvalidWebAuthnResponse)
+	}
+	if !errors.Is(err, ErrWebAuthnCredentialNotFound) {
+		return nil, fmt.Errorf("failed to get webauthn credential: %w", err)
+	}
+
+	return &WebAuthnRegistration{
+		AccountID:  challenge.GeneratedAccountId,
+		Credential: credential,
+	}, nil
+}
+
+// SaveCredential stores a credential verified by FinishRegistration for the given account
+func (s *WebAuthnService) SaveCredential(ctx context.Context, accountID int64, credential *webauthn.Credential, nickname string) (*WebAuthnCredential, error) {
+	deviceType := WebAuthnDeviceTypeSingleDevice
+	if credential.Flags.BackupEligible {
+		deviceType = WebAuthnDeviceTypeMultiDevice
+	}
+
+	transports := make([]string, 0, len(credential.Transport))
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	return s.webAuthnCredentialRepo.Create(
+		ctx,
+		accountID,
+		credential.ID,
+		credential.PublicKey,
+		credential.Authenticator.SignCount,
+		deviceType,
+		credential.Flags.BackupState,
+		transports,
+		nickname,
+	)
+}
+
+// BeginLogin generates credential request options
+//
+// When accountID is zero the options allow any discoverable credential, otherwise
+// the assertion must come from one of allowCredentials belonging to that account.
+//
+// Returns:
+//   - string: JSON encoded PublicKeyCredentialRequestOptions
+//   - error: Any error storing the challenge
+func (s *WebAuthnService) BeginLogin(ctx context.Context, accountID int64, allowCredentials []*WebAuthnCredential) (string, error) {
+	var opts []webauthn.LoginOption
+	if len(allowCredentials) > 0 {
+		opts = append(opts, webauthn.WithAllowedCredentials(credentialDescriptors(allowCredentials)))
+	}
+
+	assertion, session, err := s.webAuthn.BeginDiscoverableLogin(opts...)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin webauthn login: %w", err)
+	}
+
+	if err := s.storeChallenge(ctx, session.Challenge, accountID, s.loginTimeout); err != nil {
+		return "", err
+	}
+
+	options, err := json.Marshal(assertion.Response)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode webauthn login options: %w", err)
+	}
+
+	return string(options), nil
+}
+
+// FinishLogin verifies an assertion response and updates the credential's signature counter
+//
+// An assertion whose signature counter did not increase is rejected, as it indicates
+// that the authenticator may have been cloned.
+//
+// Returns:
+//   - *WebAuthnCredential: The stored credential, with its account loaded
+//   - error: ErrChallengeNotFound, ErrInvalidWebAuthnResponse or ErrWebAuthnCredentialCloned
+func (s *WebAuthnService) FinishLogin(ctx context.Context, authenticationResponse string) (*WebAuthnCredential, error) {
+	parsedResponse, err := protocol.ParseCredentialRequestResponseBytes([]byte(authenticationResponse))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebAuthnResponse, err)
+	}
+
+	challenge, err := s.consumeChallenge(ctx, parsedResponse.Response.CollectedClientData.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	storedCredential, err := s.webAuthnCredentialRepo.Get(ctx, parsedResponse.RawID, true)
+	if err != nil {
+		if errors.Is(err, ErrWebAuthnCredentialNotFound) {
+			return nil, fmt.Errorf("%w: unknown credential", ErrInvalidWebAuthnResponse)
+		}
+		return nil, fmt.Errorf("failed to get webauthn credential: %w", err)
+	}
+
+	if challenge.GeneratedAccountId != 0 && storedCredential.AccountId != challenge.GeneratedAccountId {
+		return nil, fmt.Errorf("%w: credential does not belong to the account", ErrInvalidWebAuthnResponse)
+	}
+
+	user := &webAuthnUser{
+		accountID:   storedCredential.AccountId,
+		credentials: []webauthn.Credential{storedCredential.toWebAuthn()},
+	}
+	session := webauthn.SessionData{
+		Challenge:        parsedResponse.Response.CollectedClientData.Challenge,
+		RelyingPartyID:   s.webAuthn.Config.RPID,
+		UserID:           user.WebAuthnID(),
+		UserVerification: s.webAuthn.Config.AuthenticatorSelection.UserVerification,
+		Expires:          time.Unix(challenge.ExpiresAt, 0),
+	}
+
+	credential, err := s.webAuthn.ValidateLogin(user, session, parsedResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebAuthnResponse, err)
+	}
+
+	if credential.Authenticator.CloneWarning {
+		s.logger.Warn("WebAuthn signature counter did not increase, authenticator may be cloned",
+			zap.Int64("credential_id", storedCredential.ID),
+			zap.Int64("account_id", storedCredential.AccountId),
+			zap.Uint32("stored_sign_count", storedCredential.SignCount),
+			zap.Uint32("received_sign_count", parsedResponse.Response.AuthenticatorData.Counter),
+		)
+		return nil, ErrWebAuthnCredentialCloned
+	}
+
+	if err := s.webAuthnCredentialRepo.UpdateSignCount(ctx, storedCredential.CredentialID, credential.Authenticator.SignCount); err != nil {
+		return nil, err
+	}
+	storedCredential.SignCount = credential.Authenticator.SignCount
+
+	return storedCredential, nil
+}
+
+// storeChallenge persists a base64url encoded challenge for later verification
+func (s *WebAuthnService) storeChallenge(ctx context.Context, encodedChallenge string, accountID int64, timeout time.Duration) error {
+	challenge, err := base64.RawURLEncoding.DecodeString(encodedChallenge)
+	if err != nil {
+		return fmt.Errorf("failed to decode webauthn challenge: %w", err)
+	}
+
+	if _, err := s.webAuthnChallengeRepo.Create(ctx, challenge, accountID, time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// consumeChallenge looks up a stored challenge and deletes it so that it cannot be reused
+func (s *WebAuthnService) consumeChallenge(ctx context.Context, encodedChallenge string) (*WebAuthnChallenge, error) {
+	challenge, err := base64.RawURLEncoding.DecodeString(encodedChallenge)
+	if err != nil {
+		return nil, ErrChallengeNotFound
+	}
+
+	webAuthnChallenge, err := s.webAuthnChallengeRepo.Get(ctx, challenge)
+	if err != nil {
+		if errors.Is(err, ErrChallengeNotFound) || errors.Is(err, ErrTokenExpired) {
+			return nil, ErrChallengeNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.webAuthnChallengeRepo.Delete(ctx, webAuthnChallenge); err != nil {
+		return nil, err
+	}
+
+	return webAuthnChallenge, nil
+}
+
+// toWebAuthn converts a stored credential into the form used by the webauthn library
+func (w *WebAuthnCredential) toWebAuthn() webauthn.Credential {
+	transports := make([]protocol.AuthenticatorTransport, 0, len(w.Transports))
+	for _, transport := range w.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(transport))
+	}
+
+	return webauthn.Credential{
+		ID:        w.CredentialID,
+		PublicKey: w.PublicKey,
+		Transport: transports,
+		Flags: webauthn.CredentialFlags{
+			BackupEligible: w.DeviceType == WebAuthnDeviceTypeMultiDevice,
+			BackupState:    w.BackedUp,
+		},
+		Authenticator: webauthn.Authenticator{
+			SignCount: w.SignCount,
+		},
+	}
+}
+
+// credentialDescriptors converts stored credentials into allow/exclude list entries
+func credentialDescriptors(credentials []*WebAuthnCredential) []protocol.CredentialDescriptor {
+	descriptors := make([]protocol.CredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		descriptors = append(descriptors, credential.toWebAuthn().Descriptor())
+	}
+	return descriptors
+}
+
+// webAuthnUserHandle encodes an account ID as a WebAuthn user handle
+func webAuthnUserHandle(accountID int64) []byte {
+	handle := make([]byte, 8)
+	binary.BigEndian.PutUint64(handle, uint64(accountID))
+	return handle
+}
+
+// webAuthnUser adapts an account to the webauthn.User interface
+type webAuthnUser struct {
+	accountID   int64
+	name        string
+	displayName string
+	credentials []webauthn.Credential
+}
+
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return webAuthnUserHandle(u.accountID)
+}
+
+func (u *webAuthnUser) WebAuthnName() string {
+	return u.name
+}
+
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	return u.displayName
+}
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
@@ -0,0 +1,484 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"server/internal/config"
+	"server/internal/domain/core"
+	"server/internal/infrastructure/db"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testWebAuthnOrigin = "https://app.example.com"
+
+// softwareAuthenticator is an in-memory passkey authenticator for exercising WebAuthn ceremonies
+type softwareAuthenticator struct {
+	t            *testing.T
+	privateKey   *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+	origin       string
+}
+
+func newSoftwareAuthenticator(t *testing.T) *softwareAuthenticator {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	credentialID := make([]byte, 16)
+	_, err = rand.Read(credentialID)
+	require.NoError(t, err)
+
+	return &softwareAuthenticator{
+		t:            t,
+		privateKey:   privateKey,
+		credentialID: credentialID,
+		origin:       testWebAuthnOrigin,
+	}
+}
+
+// createCredential responds to PublicKeyCredentialCreationOptions with a "none" attestation
+func (a *softwareAuthenticator) createCredential(optionsJSON string) string {
+	var options struct {
+		Challenge string `json:"challenge"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	}
+	require.NoError(a.t, json.Unmarshal([]byte(optionsJSON), &options))
+
+	userHandle, err := base64.RawURLEncoding.DecodeString(options.User.ID)
+	require.NoError(a.t, err)
+	a.userHandle = userHandle
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.privateKey.PublicKey.X.FillBytes(make([]byte, 32)),
+		YCoord: a.privateKey.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(a.t, err)
+
+	// flags: user present, user verified, attested credential data
+	authData := a.authenticatorData(options.RP.ID, 0x45)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, publicKey...)
+
+	attestationObject, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	require.NoError(a.t, err)
+
+	return a.encodeResponse(map[string]any{
+		"clientDataJSON":    a.clientData("webauthn.create", options.Challenge),
+		"attestationObject": base64.RawURLEncoding.EncodeToString(attestationObject),
+		"transports":        []string{"internal"},
+	})
+}
+
+// getAssertion responds to PublicKeyCredentialRequestOptions with a signed assertion
+func (a *softwareAuthenticator) getAssertion(optionsJSON string) string {
+	var options struct {
+		Challenge string `json:"challenge"`
+		RPID      string `json:"rpId"`
+	}
+	require.NoError(a.t, json.Unmarshal([]byte(optionsJSON), &options))
+
+	a.signCount++
+	// flags: user present, user verified
+	authData := a.authenticatorData(options.RPID, 0x05)
+	clientDataJSON := a.clientData("webauthn.get", options.Challenge)
+
+	rawClientData, err := base64.RawURLEncoding.DecodeString(clientDataJSON)
+	require.NoError(a.t, err)
+	clientDataHash := sha256.Sum256(rawClientData)
+	digest := sha256.Sum256(append(bytes.Clone(authData), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.privateKey, digest[:])
+	require.NoError(a.t, err)
+
+	return a.encodeResponse(map[string]any{
+		"clientDataJSON":    clientDataJSON,
+		"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+		"signature":         base64.RawURLEncoding.EncodeToString(signature),
+		"userHandle":        base64.RawURLEncoding.EncodeToString(a.userHandle),
+	})
+}
+
+func (a *softwareAuthenticator) authenticatorData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(authData, a.signCount)
+}
+
+func (a *softwareAuthenticator) clientData(ceremonyType string, challenge string) string {
+	clientData, err := json.Marshal(map[string]any{
+		"type":        ceremonyType,
+		"challenge":   challenge,
+		"origin":      a.origin,
+		"crossOrigin": false,
+	})
+	require.NoError(a.t, err)
+	return base64.RawURLEncoding.EncodeToString(clientData)
+}
+
+func (a *softwareAuthenticator) encodeResponse(response map[string]any) string {
+	credentialID := base64.RawURLEncoding.EncodeToString(a.credentialID)
+	encoded, err := json.Marshal(map[string]any{
+		"id":                      credentialID,
+		"rawId":                   credentialID,
+		"type":                    "public-key",
+		"authenticatorAttachment": "platform",
+		"clientExtensionResults":  map[string]any{},
+		"response":                response,
+	})
+	require.NoError(a.t, err)
+	return string(encoded)
+}
+
+// fakeWebAuthnChallengeRepo is an in-memory WebAuthnChallengeRepo for testing
+type fakeWebAuthnChallengeRepo struct {
+	mu         sync.Mutex
+	nextID     int64
+	challenges map[string]*WebAuthnChallenge
+}
+
+func newFakeWebAuthnChallengeRepo() *fakeWebAuthnChallengeRepo {
+	return &fakeWebAuthnChallengeRepo{challenges: make(map[string]*WebAuthnChallenge)}
+}
+
+func (r *fakeWebAuthnChallengeRepo) Create(ctx context.Context, challenge []byte, generatedAccountId int64, expiresAt time.Time) (*WebAuthnChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	webAuthnChallenge := &WebAuthnChallenge{
+		CoreModel:          core.CoreModel{ID: r.nextID},
+		Challenge:          challenge,
+		ExpiresAt:          expiresAt.Unix(),
+		GeneratedAccountId: generatedAccountId,
+	}
+	r.challenges[string(challenge)] = webAuthnChallenge
+	return webAuthnChallenge, nil
+}
+
+func (r *fakeWebAuthnChallengeRepo) Get(ctx context.Context, challenge []byte) (*WebAuthnChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	webAuthnChallenge, ok := r.challenges[string(challenge)]
+	if !ok {
+		return nil, ErrChallengeNotFound
+	}
+	if time.Now().Unix() > webAuthnChallenge.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return webAuthnChallenge, nil
+}
+
+func (r *fakeWebAuthnChallengeRepo) Delete(ctx context.Context, webauthnChallenge *WebAuthnChallenge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.challenges, string(webauthnChallenge.Challenge))
+	return nil
+}
+
+// fakeWebAuthnCredentialRepo is an in-memory WebAuthnCredentialRepo for testing
+type fakeWebAuthnCredentialRepo struct {
+	mu          sync.Mutex
+	nextID      int64
+	credentials []*WebAuthnCredential
+}
+
+func (r *fakeWebAuthnCredentialRepo) Create(ctx context.Context, accountId int64, credentialId []byte, credentialPublicKey []byte, signCount uint32, deviceType string, backedUp bool, transports []string, nickname string) (*WebAuthnCredential, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	credential := &WebAuthnCredential{
+		CoreModel:    core.CoreModel{ID: r.nextID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		CredentialID: credentialId,
+		PublicKey:    credentialPublicKey,
+		SignCount:    signCount,
+		DeviceType:   deviceType,
+		BackedUp:     backedUp,
+		Transports:   transports,
+		Nickname:     nickname,
+		AccountId:    accountId,
+	}
+	r.credentials = append(r.credentials, credential)
+	return credential, nil
+}
+
+func (r *fakeWebAuthnCredentialRepo) UpdateSignCount(ctx context.Context, credentialId []byte, signCount uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, credential := range r.credentials {
+		if bytes.Equal(credential.CredentialID, credentialId) {
+			credential.SignCount = signCount
+		}
+	}
+	return nil
+}
+
+func (r *fakeWebAuthnCredentialRepo) Get(ctx context.Context, credentialId []byte, fetchAccount bool) (*WebAuthnCredential, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, credential := range r.credentials {
+		if bytes.Equal(credential.CredentialID, credentialId) {
+			stored := *credential
+			return &stored, nil
+		}
+	}
+	return nil, ErrWebAuthnCredentialNotFound
+}
+
+func (r *fakeWebAuthnCredentialRepo) GetByAccountCredentialId(ctx context.Context, accountId int64, webAuthnCredentialId int64) (*WebAuthnCredential, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, credential := range r.credentials {
+		if credential.ID == webAuthnCredentialId && credential.AccountId == accountId {
+			return credential, nil
+		}
+	}
+	return nil, ErrWebAuthnCredentialNotFound
+}
+
+func (r *fakeWebAuthnCredentialRepo) Delete(ctx context.Context, credential *WebAuthnCredential) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, stored := range r.credentials {
+		if stored.ID == credential.ID {
+			r.credentials = append(r.credentials[:i], r.credentials[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (r *fakeWebAuthnCredentialRepo) GetAllByAccountList(ctx context.Context, accountId int64) ([]*WebAuthnCredential, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	credentials := make([]*WebAuthnCredential, 0)
+	for _, credential := range r.credentials {
+		if credential.AccountId == accountId {
+			credentials = append(credentials, credential)
+		}
+	}
+	return credentials, nil
+}
+
+func (r *fakeWebAuthnCredentialRepo) Update(ctx context.Context, webAuthnCredentialId int64, nickname string) (*WebAuthnCredential, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, credential := range r.credentials {
+		if credential.ID == webAuthnCredentialId {
+			credential.Nickname = nickname
+			return credential, nil
+		}
+	}
+	return nil, ErrWebAuthnCredentialNotFound
+}
+
+func (r *fakeWebAuthnCredentialRepo) GetAllByAccountId(ctx context.Context, accountId int64, first *int, last *int, before *string, after *string) (*db.PaginatedResult[*WebAuthnCredential, int64], error) {
+	credentials, _ := r.GetAllByAccountList(ctx, accountId)
+	result := db.ProcessPaginatedResult[*WebAuthnCredential, int64](credentials, first, last)
+	return &result, nil
+}
+
+func newTestWebAuthnService(t *testing.T) (*WebAuthnService, *fakeWebAuthnCredentialRepo, *fakeWebAuthnChallengeRepo) {
+	cfg := &config.Config{
+		WebAuthnRPID:      "app.example.com",
+		WebAuthnRPName:    "Test App",
+		WebAuthnRPOrigins: []string{testWebAuthnOrigin},
+	}
+	credentialRepo := &fakeWebAuthnCredentialRepo{}
+	challengeRepo := newFakeWebAuthnChallengeRepo()
+
+	service, err := NewWebAuthnService(cfg, credentialRepo, challengeRepo, zap.NewNop())
+	require.NoError(t, err)
+	return service, credentialRepo, challengeRepo
+}
+
+func TestWebAuthnService_RegistrationAndLogin(t *testing.T) {
+	ctx := context.Background()
+	service, credentialRepo, challengeRepo := newTestWebAuthnService(t)
+	authenticator := newSoftwareAuthenticator(t)
+
+	registrationOptions, err := service.BeginRegistration(ctx, 42, "test@example.com", "Test User", nil)
+	require.NoError(t, err)
+	assert.Len(t, challengeRepo.challenges, 1)
+
+	registration, err := service.FinishRegistration(ctx, authenticator.createCredential(registrationOptions), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), registration.AccountID)
+	assert.Empty(t, challengeRepo.challenges, "challenge should be consumed")
+
+	stored, err := service.SaveCredential(ctx, registration.AccountID, registration.Credential, "My passkey")
+	require.NoError(t, err)
+	assert.Equal(t, authenticator.credentialID, stored.CredentialID)
+	assert.Equal(t, WebAuthnDeviceTypeSingleDevice, stored.DeviceType)
+	assert.Equal(t, []string{"internal"}, stored.Transports)
+
+	for i := 0; i < 2; i++ {
+		loginOptions, err := service.BeginLogin(ctx, 0, nil)
+		require.NoError(t, err)
+
+		credential, err := service.FinishLogin(ctx, authenticator.getAssertion(loginOptions))
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), credential.AccountId)
+		assert.Equal(t, authenticator.signCount, credential.SignCount)
+	}
+	assert.Equal(t, uint32(2), credentialRepo.credentials[0].SignCount)
+}
+
+func TestWebAuthnService_FinishRegistration(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejects reused challenges", func(t *testing.T) {
+		service, _, _ := newTestWebAuthnService(t)
+		authenticator := newSoftwareAuthenticator(t)
+
+		options, err := service.BeginRegistration(ctx, 42, "test@example.com", "Test User", nil)
+		require.NoError(t, err)
+		response := authenticator.createCredential(options)
+
+		_, err = service.FinishRegistration(ctx, response, nil)
+		require.NoError(t, err)
+
+		_, err = service.FinishRegistration(ctx, response, nil)
+		assert.ErrorIs(t, err, ErrChallengeNotFound)
+	})
+
+	t.Run("rejects unexpected origins", func(t *testing.T) {
+		service, _, _ := newTestWebAuthnService(t)
+		authenticator := newSoftwareAuthenticator(t)
+		authenticator.origin = "https://evil.example.com"
+
+		options, err := service.BeginRegistration(ctx, 42, "test@example.com", "Test User", nil)
+		require.NoError(t, err)
+
+		_, err = service.FinishRegistration(ctx, authenticator.createCredential(options), nil)
+		assert.ErrorIs(t, err, ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("rejects challenges generated for another account", func(t *testing.T) {
+		service, _, _ := newTestWebAuthnService(t)
+		authenticator := newSoftwareAuthenticator(t)
+
+		options, err := service.BeginRegistration(ctx, 42, "test@example.com", "Test User", nil)
+		require.NoError(t, err)
+
+		otherAccountID := int64(7)
+		_, err = service.FinishRegistration(ctx, authenticator.createCredential(options), &otherAccountID)
+		assert.ErrorIs(t, err, ErrChallengeNotFound)
+	})
+
+	t.Run("rejects credentials that are already registered", func(t *testing.T) {
+		service, _, _ := newTestWebAuthnService(t)
+		authenticator := newSoftwareAuthenticator(t)
+
+		options, err := service.BeginRegistration(ctx, 42, "test@example.com", "Test User", nil)
+		require.NoError(t, err)
+		registration, err := service.FinishRegistration(ctx, authenticator.createCredential(options), nil)
+		require.NoError(t, err)
+		_, err = service.SaveCredential(ctx, 42, registration.Credential, "My passkey")
+		require.NoError(t, err)
+
+		options, err = service.BeginRegistration(ctx, 42, "test@example.com", "Test User", nil)
+		require.NoError(t, err)
+		_, err = service.FinishRegistration(ctx, authenticator.createCredential(options), nil)
+		assert.ErrorIs(t, err, ErrInvalidWebAuthnResponse)
+	})
+}
+
+func TestWebAuthnService_FinishLogin(t *testing.T) {
+	ctx := context.Background()
+
+	register := func(t *testing.T, service *WebAuthnService, authenticator *softwareAuthenticator, accountID int64) {
+		options, err := service.BeginRegistration(ctx, accountID, "test@example.com", "Test User", nil)
+		require.NoError(t, err)
+		registration, err := service.FinishRegistration(ctx, authenticator.createCredential(options), nil)
+		require.NoError(t, err)
+		_, err = service.SaveCredential(ctx, accountID, registration.Credential, "My passkey")
+		require.NoError(t, err)
+	}
+
+	t.Run("detects cloned authenticators", func(t *testing.T) {
+		service, credentialRepo, _ := newTestWebAuthnService(t)
+		authenticator := newSoftwareAuthenticator(t)
+		register(t, service, authenticator, 42)
+
+		options, err := service.BeginLogin(ctx, 0, nil)
+		require.NoError(t, err)
+		_, err = service.FinishLogin(ctx, authenticator.getAssertion(options))
+		require.NoError(t, err)
+
+		// A clone reports a counter that has already been seen
+		authenticator.signCount = 0
+		options, err = service.BeginLogin(ctx, 0, nil)
+		require.NoError(t, err)
+		_, err = service.FinishLogin(ctx, authenticator.getAssertion(options))
+		assert.ErrorIs(t, err, ErrWebAuthnCredentialCloned)
+		assert.Equal(t, uint32(1), credentialRepo.credentials[0].SignCount)
+	})
+
+	t.Run("rejects credentials of another account", func(t *testing.T) {
+		service, _, _ := newTestWebAuthnService(t)
+		authenticator := newSoftwareAuthenticator(t)
+		register(t, service, authenticator, 42)
+
+		options, err := service.BeginLogin(ctx, 7, nil)
+		require.NoError(t, err)
+		_, err = service.FinishLogin(ctx, authenticator.getAssertion(options))
+		assert.ErrorIs(t, err, ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("rejects unknown credentials", func(t *testing.T) {
+		service, _, _ := newTestWebAuthnService(t)
+		authenticator := newSoftwareAuthenticator(t)
+		authenticator.userHandle = webAuthnUserHandle(42)
+
+		options, err := service.BeginLogin(ctx, 0, nil)
+		require.NoError(t, err)
+		_, err = service.FinishLogin(ctx, authenticator.getAssertion(options))
+		assert.ErrorIs(t, err, ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("rejects expired challenges", func(t *testing.T) {
+		service, _, challengeRepo := newTestWebAuthnService(t)
+		authenticator := newSoftwareAuthenticator(t)
+		register(t, service, authenticator, 42)
+
+		options, err := service.BeginLogin(ctx, 0, nil)
+		require.NoError(t, err)
+		for _, challenge := range challengeRepo.challenges {
+			challenge.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+		}
+
+		_, err = service.FinishLogin(ctx, authenticator.getAssertion(options))
+		assert.ErrorIs(t, err, ErrChallengeNotFound)
+	})
+}
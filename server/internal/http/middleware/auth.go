@@ -0,0 +1,134 @@
+package httpmiddleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"server/internal/domain/auth"
+
+	"go.uber.org/zap"
+)
+
+// ViewerSessionLoader loads the viewer a session token, an access token or a personal access token belongs to
+type ViewerSessionLoader interface {
+	GetViewerSession(ctx context.Context, sessionToken string) (*auth.Session, error)
+	GetBearerViewerSession(ctx context.Context, accessToken string) (*auth.Session, error)
+	GetPersonalAccessTokenViewer(ctx context.Context, token string) (*auth.PersonalAccessToken, error)
+}
+
+// NewAuthMiddleware resolves the viewer's session from an access token or the session cookie
+//
+// Native clients send an access token in an "Authorization: Bearer" header, browsers the
+// session token stored in the session cookie. A request with a bearer header is authenticated
+// by it alone. Both yield the same viewer session and token data, so the GraphQL auth
+// directives treat them alike.
+//
+// Scripts and CI jobs send a personal access token in the same header, told apart by its
+// prefix. It yields no viewer session, only the token and the token data with its scopes,
+// so that it only reaches the fields its scopes allow.
+//
+// It must be registered after the session middleware. Stale session tokens are removed
+// from the session data so that the cookie is cleaned up, stale bearer tokens are answered
+// with a WWW-Authenticate header telling the client to refresh them.
+func NewAuthMiddleware(loader ViewerSessionLoader, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if token, ok := bearerToken(r); ok {
+				var err error
+				if strings.HasPrefix(token, auth.PersonalAccessTokenPrefix) {
+					var personalAccessToken *auth.PersonalAccessToken
+					if personalAccessToken, err = loader.GetPersonalAccessTokenViewer(ctx, token); err == nil {
+						ctx = withViewerPersonalAccessToken(ctx, personalAccessToken)
+					}
+				} else {
+					var session *auth.Session
+					if session, err = loader.GetBearerViewerSession(ctx, token); err == nil {
+						ctx = withViewerSession(ctx, session)
+					}
+				}
+
+				if err != nil {
+					if errors.Is(err, auth.ErrSessionNotFound) || errors.Is(err, auth.ErrPersonalAccessTokenNotFound) {
+						w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+					} else {
+						logger.Error("Failed to load bearer token viewer", zap.Error(err))
+					}
+				}
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			sessionToken, ok := GetSessionToken(ctx)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session, err := loader.GetViewerSession(ctx, sessionToken)
+			if err != nil {
+				if errors.Is(err, auth.ErrSessionNotFound) {
+					if sessionData, ok := GetSessionData(ctx); ok {
+						delete(sessionData, SessionTokenKey)
+					}
+				} else {
+					logger.Error("Failed to load viewer session", zap.Error(err))
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(withViewerSession(ctx, session)))
+		})
+	}
+}
+
+// bearerToken returns the token of an "Authorization: Bearer" header, if any
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// withViewerSession stores the viewer's session, and the token data read by the GraphQL auth directives
+func withViewerSession(ctx context.Context, session *auth.Session) context.Context {
+	tokenData := map[string]interface{}{
+		"user_id":    session.AccountId,
+		"session_id": session.ID,
+	}
+	if session.HasSudoMode() {
+		tokenData["sudo_mode_expires_at"] = session.SudoModeExpiresAt.UTC().Format(time.RFC3339)
+	}
+	ctx = context.WithValue(ctx, "session_token_data", tokenData)
+	return context.WithValue(ctx, "viewer_session", session)
+}
+
+// withViewerPersonalAccessToken stores the viewer's personal access token, and the token data read by the GraphQL auth directives
+func withViewerPersonalAccessToken(ctx context.Context, personalAccessToken *auth.PersonalAccessToken) context.Context {
+	tokenData := map[string]interface{}{
+		"user_id":                  personalAccessToken.AccountId,
+		"personal_access_token_id": personalAccessToken.ID,
+		"scopes":                   personalAccessToken.Scopes,
+	}
+	ctx = context.WithValue(ctx, "session_token_data", tokenData)
+	return context.WithValue(ctx, "viewer_personal_access_token", personalAccessToken)
+}
+
+// GetViewerSession returns the authenticated viewer's session, with its account loaded
+func GetViewerSession(ctx context.Context) (*auth.Session, bool) {
+	session, ok := ctx.Value("viewer_session").(*auth.Session)
+	return session, ok && session != nil
+}
+
+// GetViewerPersonalAccessToken returns the personal access token the viewer authenticated with, with its account loaded
+func GetViewerPersonalAccessToken(ctx context.Context) (*auth.PersonalAccessToken, bool) {
+	personalAccessToken, ok := ctx.Value("viewer_personal_access_token").(*auth.PersonalAccessToken)
+	return personalAccessToken, ok && personalAccessToken != nil
+}
@@ -0,0 +1,150 @@
+package httpmiddleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"server/internal/domain/auth"
+	"server/internal/domain/core"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+// fakeViewerSessionLoader resolves session tokens and access tokens from a fixed map
+type fakeViewerSessionLoader map[string]*auth.Session
+
+func (f fakeViewerSessionLoader) GetViewerSession(ctx context.Context, sessionToken string) (*auth.Session, error) {
+	session, ok := f[sessionToken]
+	if !ok {
+		return nil, auth.ErrSessionNotFound
+	}
+	return session, nil
+}
+
+func (f fakeViewerSessionLoader) GetBearerViewerSession(ctx context.Context, accessToken string) (*auth.Session, error) {
+	return f.GetViewerSession(ctx, "bearer:"+accessToken)
+}
+
+func (f fakeViewerSessionLoader) GetPersonalAccessTokenViewer(ctx context.Context, token string) (*auth.PersonalAccessToken, error) {
+	if token != auth.PersonalAccessTokenPrefix+"valid" {
+		return nil, auth.ErrPersonalAccessTokenNotFound
+	}
+	return &auth.PersonalAccessToken{CoreModel: core.CoreModel{ID: 9}, AccountId: 7, Scopes: []string{auth.ScopeReadAccount}}, nil
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	session := &auth.Session{CoreModel: core.CoreModel{ID: 3}, AccountId: 7}
+	sudoModeExpiresAt := time.Now().Add(10 * time.Minute)
+	sudoSession := &auth.Session{CoreModel: core.CoreModel{ID: 4}, AccountId: 7, SudoModeExpiresAt: &sudoModeExpiresAt}
+	bearerSession := &auth.Session{CoreModel: core.CoreModel{ID: 5}, AccountId: 7}
+	middleware := NewAuthMiddleware(fakeViewerSessionLoader{"valid": session, "sudo": sudoSession, "bearer:access": bearerSession}, zaptest.NewLogger(t))
+
+	serveRequest := func(sessionData map[string]interface{}, authorization string) (context.Context, *httptest.ResponseRecorder) {
+		var handlerCtx context.Context
+		handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerCtx = r.Context()
+		}))
+
+		req := httptest.NewRequest("POST", "/graphql", nil)
+		req = req.WithContext(context.WithValue(req.Context(), "session_data", sessionData))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return handlerCtx, recorder
+	}
+	serve := func(sessionData map[string]interface{}) context.Context {
+		ctx, _ := serveRequest(sessionData, "")
+		return ctx
+	}
+
+	t.Run("resolves the viewer session", func(t *testing.T) {
+		ctx := serve(map[string]interface{}{SessionTokenKey: "valid"})
+
+		viewerSession, ok := GetViewerSession(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, session, viewerSession)
+		assert.Equal(t, map[string]interface{}{"user_id": int64(7), "session_id": int64(3)}, ctx.Value("session_token_data"))
+	})
+
+	t.Run("exposes active sudo mode grants", func(t *testing.T) {
+		ctx := serve(map[string]interface{}{SessionTokenKey: "sudo"})
+
+		tokenData, ok := ctx.Value("session_token_data").(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, sudoModeExpiresAt.UTC().Format(time.RFC3339), tokenData["sudo_mode_expires_at"])
+	})
+
+	t.Run("removes stale session tokens", func(t *testing.T) {
+		sessionData := map[string]interface{}{SessionTokenKey: "stale", "other": "value"}
+		ctx := serve(sessionData)
+
+		_, ok := GetViewerSession(ctx)
+		assert.False(t, ok)
+		assert.Nil(t, ctx.Value("session_token_data"))
+		assert.Equal(t, map[string]interface{}{"other": "value"}, sessionData)
+	})
+
+	t.Run("resolves bearer viewers like cookie viewers", func(t *testing.T) {
+		ctx, _ := serveRequest(map[string]interface{}{}, "Bearer access")
+
+		viewerSession, ok := GetViewerSession(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, bearerSession, viewerSession)
+		assert.Equal(t, map[string]interface{}{"user_id": int64(7), "session_id": int64(5)}, ctx.Value("session_token_data"))
+	})
+
+	t.Run("authenticates by the bearer token alone", func(t *testing.T) {
+		sessionData := map[string]interface{}{SessionTokenKey: "valid"}
+		ctx, recorder := serveRequest(sessionData, "Bearer stale")
+
+		_, ok := GetViewerSession(ctx)
+		assert.False(t, ok)
+		assert.Equal(t, `Bearer error="invalid_token"`, recorder.Header().Get("WWW-Authenticate"))
+		assert.Equal(t, map[string]interface{}{SessionTokenKey: "valid"}, sessionData)
+	})
+
+	t.Run("resolves personal access tokens without a viewer session", func(t *testing.T) {
+		ctx, _ := serveRequest(map[string]interface{}{}, "Bearer "+auth.PersonalAccessTokenPrefix+"valid")
+
+		_, ok := GetViewerSession(ctx)
+		assert.False(t, ok)
+		personalAccessToken, ok := GetViewerPersonalAccessToken(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, int64(9), personalAccessToken.ID)
+		assert.Equal(t, map[string]interface{}{
+			"user_id":                  int64(7),
+			"personal_access_token_id": int64(9),
+			"scopes":                   []string{auth.ScopeReadAccount},
+		}, ctx.Value("session_token_data"))
+	})
+
+	t.Run("rejects unknown personal access tokens", func(t *testing.T) {
+		ctx, recorder := serveRequest(map[string]interface{}{}, "Bearer "+auth.PersonalAccessTokenPrefix+"revoked")
+
+		_, ok := GetViewerPersonalAccessToken(ctx)
+		assert.False(t, ok)
+		assert.Nil(t, ctx.Value("session_token_data"))
+		assert.Equal(t, `Bearer error="invalid_token"`, recorder.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("ignores other authorization schemes", func(t *testing.T) {
+		ctx, _ := serveRequest(map[string]interface{}{SessionTokenKey: "valid"}, "Basic dXNlcjpwYXNz")
+
+		viewerSession, ok := GetViewerSession(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, session, viewerSession)
+	})
+
+	t.Run("passes anonymous requests through", func(t *testing.T) {
+		ctx := serve(map[string]interface{}{})
+
+		_, ok := GetViewerSession(ctx)
+		assert.False(t, ok)
+	})
+}
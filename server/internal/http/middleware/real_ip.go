@@ -0,0 +1,97 @@
+package httpmiddleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// NewRealIPMiddleware replaces the request's RemoteAddr with the client address forwarded by a trusted proxy
+//
+// The X-Forwarded-For and X-Real-IP headers are only honoured when the request comes from one
+// of the trusted proxies, given as IP addresses or CIDR ranges. Otherwise anyone could pick the
+// address their requests are rate limited and locked out by. X-Forwarded-For is read from the
+// right, skipping the trusted proxies, as clients can prepend any addresses to it.
+func NewRealIPMiddleware(trustedProxies []string, log *zap.Logger) func(http.Handler) http.Handler {
+	var networks []*net.IPNet
+	for _, proxy := range trustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		network, err := parseNetwork(proxy)
+		if err != nil {
+			log.Warn("Ignoring invalid trusted proxy", zap.String("proxy", proxy), zap.Error(err))
+			continue
+		}
+		networks = append(networks, network)
+	}
+
+	isTrusted := func(ip net.IP) bool {
+		for _, network := range networks {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			remote := net.ParseIP(remoteIP(r.RemoteAddr))
+			if remote != nil && isTrusted(remote) {
+				if clientIP := forwardedClientIP(r, isTrusted); clientIP != "" {
+					r.RemoteAddr = clientIP
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedClientIP returns the client address of a request forwarded by a trusted proxy, if any
+//
+// The nearest untrusted address of X-Forwarded-For is the client, an invalid one ends the search.
+func forwardedClientIP(r *http.Request, isTrusted func(net.IP) bool) string {
+	if forwardedFor := r.Header.Values("X-Forwarded-For"); len(forwardedFor) > 0 {
+		addresses := strings.Split(strings.Join(forwardedFor, ","), ",")
+		clientIP := ""
+		for i := len(addresses) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(addresses[i]))
+			if ip == nil {
+				break
+			}
+			clientIP = ip.String()
+			if !isTrusted(ip) {
+				break
+			}
+		}
+		return clientIP
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+	return ""
+}
+
+// parseNetwork parses a CIDR range, or a single IP address as the range holding only it
+func parseNetwork(proxy string) (*net.IPNet, error) {
+	if strings.Contains(proxy, "/") {
+		_, network, err := net.ParseCIDR(proxy)
+		return network, err
+	}
+
+	ip := net.ParseIP(proxy)
+	if ip == nil {
+		return nil, &net.ParseError{Type: "IP address", Text: proxy}
+	}
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 8 * net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
@@ -0,0 +1,51 @@
+package httpmiddleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRealIPMiddleware(t *testing.T) {
+	middleware := NewRealIPMiddleware([]string{"10.0.0.0/8", "192.0.2.1", "not-an-address"}, zaptest.NewLogger(t))
+
+	serveRequest := func(remoteAddr string, headers map[string]string) string {
+		var info RequestInfo
+		handler := middleware(RequestInfoMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			info = GetRequestInfo(r.Context())
+		})))
+
+		req := httptest.NewRequest("POST", "/graphql", nil)
+		req.RemoteAddr = remoteAddr
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return info.IPAddress
+	}
+
+	t.Run("ignores forwarding headers of untrusted peers", func(t *testing.T) {
+		ipAddress := serveRequest("203.0.113.7:1234", map[string]string{"X-Forwarded-For": "198.51.100.1", "X-Real-IP": "198.51.100.2"})
+
+		assert.Equal(t, "203.0.113.7", ipAddress)
+	})
+
+	t.Run("uses the address forwarded by a trusted proxy", func(t *testing.T) {
+		assert.Equal(t, "198.51.100.1", serveRequest("10.1.2.3:1234", map[string]string{"X-Forwarded-For": "198.51.100.1"}))
+		assert.Equal(t, "198.51.100.2", serveRequest("192.0.2.1:1234", map[string]string{"X-Real-IP": "198.51.100.2"}))
+	})
+
+	t.Run("skips trusted proxies and ignores addresses prepended by the client", func(t *testing.T) {
+		ipAddress := serveRequest("10.1.2.3:1234", map[string]string{"X-Forwarded-For": "1.1.1.1, 198.51.100.1, 10.4.5.6"})
+
+		assert.Equal(t, "198.51.100.1", ipAddress)
+	})
+
+	t.Run("keeps the proxy address without a valid forwarded address", func(t *testing.T) {
+		assert.Equal(t, "10.1.2.3", serveRequest("10.1.2.3:1234", nil))
+		assert.Equal(t, "10.1.2.3", serveRequest("10.1.2.3:1234", map[string]string{"X-Forwarded-For": "garbage"}))
+	})
+}
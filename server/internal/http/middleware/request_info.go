@@ -0,0 +1,42 @@
+package httpmiddleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+// RequestInfo holds client details of the current request
+type RequestInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// RequestInfoMiddleware stores the client's user agent and IP address in the request context
+//
+// It should be registered after NewRealIPMiddleware so that RemoteAddr reflects the real client.
+func RequestInfoMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info := RequestInfo{
+			UserAgent: r.UserAgent(),
+			IPAddress: remoteIP(r.RemoteAddr),
+		}
+		ctx := context.WithValue(r.Context(), "request_info", info)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// GetRequestInfo returns the request info stored by RequestInfoMiddleware
+func GetRequestInfo(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value("request_info").(RequestInfo)
+	return info
+}
+
+// remoteIP strips the port from a remote address, if present
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
@@ -59,16 +59,20 @@ func (sm *SessionMiddleware) Handler(next http.Handler) http.Handler {
 		// Inject session data into context
 		ctx = context.WithValue(ctx, "session_data", sessionData)
 
+		// The wrapper shares the request passed downstream so that context updates
+		// made by later handlers are visible when the cookie is written
+		req := r.WithContext(ctx)
+
 		// Create a response writer wrapper to capture session modifications
 		wrapper := &sessionResponseWriter{
 			ResponseWriter: w,
-			request:        r,
+			request:        req,
 			middleware:     sm,
-			sessionData:    sessionData,
+			hadSession:     len(sessionData) > 0,
 		}
 
 		// Continue to next handler with our custom response writer
-		next.ServeHTTP(wrapper, r.WithContext(ctx))
+		next.ServeHTTP(wrapper, req)
 	})
 }
 
@@ -135,10 +139,10 @@ func (sm *SessionMiddleware) encryptSession(sessionData map[string]interface{})
 // sessionResponseWriter wraps http.ResponseWriter to handle session cookie operations
 type sessionResponseWriter struct {
 	http.ResponseWriter
-	request     *http.Request
-	middleware  *SessionMiddleware
-	sessionData map[string]interface{}
-	written     bool
+	request    *http.Request
+	middleware *SessionMiddleware
+	hadSession bool
+	written    bool
 }
 
 // WriteHeader intercepts the response headers to set session cookies
@@ -188,7 +192,7 @@ func (srw *sessionResponseWriter) handleSessionCookie() {
 			Domain:   srw.middleware.domain,
 		}
 		http.SetCookie(srw.ResponseWriter, cookie)
-	} else if len(srw.sessionData) > 0 && len(currentSessionData) == 0 {
+	} else if srw.hadSession {
 		// If session was cleared during the request (initially had data, now empty), delete the cookie
 		cookie := &http.Cookie{
 			Name:     srw.middleware.sessionCookie,
@@ -202,3 +206,52 @@ func (srw *sessionResponseWriter) handleSessionCookie() {
 		http.SetCookie(srw.ResponseWriter, cookie)
 	}
 }
+
+// Session data keys shared by the GraphQL resolvers and the HTTP handlers
+const (
+	// SessionTokenKey holds the plaintext session token
+	SessionTokenKey = "session_token"
+	// TwoFactorChallengeKey holds the challenge of a login pending 2FA verification
+	TwoFactorChallengeKey = "2fa_challenge"
+	// OAuthStateKey holds the state of a pending social login, binding it to the browser
+	OAuthStateKey = "oauth_state"
+	// OAuthLinkStateKey holds the state of a pending social identity link, binding it to the browser
+	OAuthLinkStateKey = "oauth_link_state"
+)
+
+// GetSessionData returns the mutable session data map for the current request
+func GetSessionData(ctx context.Context) (map[string]interface{}, bool) {
+	sessionData, ok := ctx.Value("session_data").(map[string]interface{})
+	return sessionData, ok
+}
+
+// GetSessionToken returns the session token stored in the session cookie, if any
+func GetSessionToken(ctx context.Context) (string, bool) {
+	sessionData, ok := GetSessionData(ctx)
+	if !ok {
+		return "", false
+	}
+	token, ok := sessionData[SessionTokenKey].(string)
+	return token, ok && token != ""
+}
+
+// SetSessionToken stores the session token so that it is written to the session cookie
+func SetSessionToken(ctx context.Context, token string) bool {
+	sessionData, ok := GetSessionData(ctx)
+	if !ok {
+		return false
+	}
+	sessionData[SessionTokenKey] = token
+	return true
+}
+
+// ClearSession removes all session data so that the session cookie is deleted
+func ClearSession(ctx context.Context) {
+	sessionData, ok := GetSessionData(ctx)
+	if !ok {
+		return
+	}
+	for key := range sessionData {
+		delete(sessionData, key)
+	}
+}
@@ -0,0 +1,198 @@
+package http
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"server/internal/config"
+	"server/internal/domain/auth"
+	httpmiddleware "server/internal/http/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// Accounts frontend pages the social login callback redirects to
+const (
+	oauthLoginPath     = "/auth/login"
+	oauthTwoFactorPath = "/auth/two-factor"
+	oauthLinkedPath    = "/settings/linked-accounts"
+)
+
+// Error codes passed to the login page when a social login fails
+const (
+	oauthErrorAccessDenied     = "oauth_access_denied"
+	oauthErrorStateInvalid     = "oauth_state_invalid"
+	oauthErrorTokenInvalid     = "oauth_token_invalid"
+	oauthErrorEmailNotVerified = "oauth_email_not_verified"
+	oauthErrorAlreadyLinked    = "oauth_already_linked"
+	oauthErrorAccountExists    = "oauth_account_exists"
+	oauthErrorServer           = "oauth_server_error"
+)
+
+// oauthHandler serves the authorization code flow redirect endpoints of the social login providers
+type oauthHandler struct {
+	authService     *auth.AuthService
+	accountsBaseURL string
+	logger          *zap.Logger
+}
+
+// AddOAuthHandlers registers the social login redirect endpoints
+//
+// GET /auth/oauth/{provider} redirects to the provider, which redirects back to
+// GET /auth/oauth/{provider}/callback. The callback logs the user in and redirects
+// to the accounts frontend, with a "remember me" session when the login was started
+// with ?remember_me=true. Links started by the linkOAuthIdentity mutation share the
+// same callback, which then links the identity to the viewer's account instead.
+func AddOAuthHandlers(r *chi.Mux, cfg *config.Config, authService *auth.AuthService, log *zap.Logger) {
+	h := &oauthHandler{
+		authService:     authService,
+		accountsBaseURL: strings.TrimRight(cfg.AccountsBaseURL, "/"),
+		logger:          log,
+	}
+
+	r.Get("/auth/oauth/{provider}", h.start)
+	r.Get("/auth/oauth/{provider}/callback", h.callback)
+}
+
+// start creates a pending login and redirects to the provider
+func (h *oauthHandler) start(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	providerName := chi.URLParam(r, "provider")
+	rememberMe, _ := strconv.ParseBool(r.URL.Query().Get("remember_me"))
+
+	authURL, state, err := h.authService.StartOAuthLogin(ctx, providerName, rememberMe)
+	if err != nil {
+		if errors.Is(err, auth.ErrOAuthProviderUnsupported) {
+			http.NotFound(w, r)
+			return
+		}
+		h.logger.Error("Failed to start social login", zap.String("provider", providerName), zap.Error(err))
+		h.redirectToLogin(w, r, oauthErrorServer)
+		return
+	}
+
+	if sessionData, ok := httpmiddleware.GetSessionData(ctx); ok {
+		sessionData[httpmiddleware.OAuthStateKey] = state
+	}
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// callback completes the pending login or link with the authorization code returned by the provider
+func (h *oauthHandler) callback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	providerName := chi.URLParam(r, "provider")
+	query := r.URL.Query()
+
+	// The pending login or link can only be completed once, in the browser that started it
+	var expectedState, expectedLinkState string
+	if sessionData, ok := httpmiddleware.GetSessionData(ctx); ok {
+		expectedState, _ = sessionData[httpmiddleware.OAuthStateKey].(string)
+		expectedLinkState, _ = sessionData[httpmiddleware.OAuthLinkStateKey].(string)
+		delete(sessionData, httpmiddleware.OAuthStateKey)
+		delete(sessionData, httpmiddleware.OAuthLinkStateKey)
+	}
+
+	state := query.Get("state")
+	isLink := state != "" && subtle.ConstantTimeCompare([]byte(state), []byte(expectedLinkState)) == 1
+
+	if query.Get("error") != "" {
+		h.redirectWithError(w, r, isLink, oauthErrorAccessDenied)
+		return
+	}
+
+	if isLink {
+		h.finishLink(w, r, providerName, state, query.Get("code"))
+		return
+	}
+
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(expectedState)) != 1 {
+		h.redirectToLogin(w, r, oauthErrorStateInvalid)
+		return
+	}
+
+	requestInfo := httpmiddleware.GetRequestInfo(ctx)
+	_, sessionToken, err := h.authService.FinishOAuthLogin(ctx, providerName, state, query.Get("code"), requestInfo.UserAgent, requestInfo.IPAddress)
+	if err != nil {
+		var twoFactorErr *auth.TwoFactorRequiredError
+		switch {
+		case errors.Is(err, auth.ErrOAuthProviderUnsupported):
+			http.NotFound(w, r)
+		case errors.Is(err, auth.ErrOAuthStateNotFound):
+			h.redirectToLogin(w, r, oauthErrorStateInvalid)
+		case errors.Is(err, auth.ErrOAuthTokenInvalid):
+			h.redirectToLogin(w, r, oauthErrorTokenInvalid)
+		case errors.Is(err, auth.ErrEmailNotVerified), errors.Is(err, auth.ErrInvalidEmail):
+			h.redirectToLogin(w, r, oauthErrorEmailNotVerified)
+		case errors.Is(err, auth.ErrOAuthAccountExists):
+			h.redirectToLogin(w, r, oauthErrorAccountExists)
+		case errors.As(err, &twoFactorErr):
+			if sessionData, ok := httpmiddleware.GetSessionData(ctx); ok {
+				sessionData[httpmiddleware.TwoFactorChallengeKey] = twoFactorErr.Challenge
+			}
+			http.Redirect(w, r, h.accountsBaseURL+oauthTwoFactorPath, http.StatusFound)
+		default:
+			h.logger.Error("Failed to finish social login", zap.String("provider", providerName), zap.Error(err))
+			h.redirectToLogin(w, r, oauthErrorServer)
+		}
+		return
+	}
+
+	httpmiddleware.SetSessionToken(ctx, sessionToken)
+
+	http.Redirect(w, r, h.accountsBaseURL+"/", http.StatusFound)
+}
+
+// finishLink links the provider's identity to the viewer's account, which must be the one that started the link
+func (h *oauthHandler) finishLink(w http.ResponseWriter, r *http.Request, providerName string, state string, code string) {
+	ctx := r.Context()
+
+	session, ok := httpmiddleware.GetViewerSession(ctx)
+	if !ok {
+		h.redirectToLogin(w, r, oauthErrorStateInvalid)
+		return
+	}
+
+	if _, err := h.authService.FinishOAuthLink(ctx, session.Account, providerName, state, code); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrOAuthProviderUnsupported):
+			http.NotFound(w, r)
+		case errors.Is(err, auth.ErrOAuthStateNotFound):
+			h.redirectToLinkedAccounts(w, r, oauthErrorStateInvalid)
+		case errors.Is(err, auth.ErrOAuthTokenInvalid):
+			h.redirectToLinkedAccounts(w, r, oauthErrorTokenInvalid)
+		case errors.Is(err, auth.ErrOAuthCredentialAlreadyExists):
+			h.redirectToLinkedAccounts(w, r, oauthErrorAlreadyLinked)
+		default:
+			h.logger.Error("Failed to link social identity", zap.String("provider", providerName), zap.Error(err))
+			h.redirectToLinkedAccounts(w, r, oauthErrorServer)
+		}
+		return
+	}
+
+	http.Redirect(w, r, h.accountsBaseURL+oauthLinkedPath, http.StatusFound)
+}
+
+// redirectWithError redirects to the page the pending login or link started from with the given error code
+func (h *oauthHandler) redirectWithError(w http.ResponseWriter, r *http.Request, isLink bool, errorCode string) {
+	if isLink {
+		h.redirectToLinkedAccounts(w, r, errorCode)
+		return
+	}
+	h.redirectToLogin(w, r, errorCode)
+}
+
+// redirectToLogin redirects to the accounts frontend login page with the given error code
+func (h *oauthHandler) redirectToLogin(w http.ResponseWriter, r *http.Request, errorCode string) {
+	http.Redirect(w, r, h.accountsBaseURL+oauthLoginPath+"?"+url.Values{"error": {errorCode}}.Encode(), http.StatusFound)
+}
+
+// redirectToLinkedAccounts redirects to the accounts frontend linked accounts page with the given error code
+func (h *oauthHandler) redirectToLinkedAccounts(w http.ResponseWriter, r *http.Request, errorCode string) {
+	http.Redirect(w, r, h.accountsBaseURL+oauthLinkedPath+"?"+url.Values{"error": {errorCode}}.Encode(), http.StatusFound)
+}
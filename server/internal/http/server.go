@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/http"
 	"server/internal/config"
+	"server/internal/domain/auth"
 	httpmiddleware "server/internal/http/middleware"
 
 	"github.com/go-chi/chi/v5"
@@ -14,14 +15,16 @@ import (
 	"go.uber.org/zap"
 )
 
-func addMiddleware(r *chi.Mux, cfg *config.Config, log *zap.Logger) {
+func addMiddleware(r *chi.Mux, cfg *config.Config, log *zap.Logger, authService *auth.AuthService) {
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(httpmiddleware.NewRealIPMiddleware(cfg.TrustedProxies, log))
+	r.Use(httpmiddleware.RequestInfoMiddleware)
 	r.Use(httpmiddleware.LoggerMiddleware(log))
 	r.Use(middleware.AllowContentType("application/json"))
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"}, // Native clients send bearer tokens
 		AllowCredentials: true,
 	}))
 	r.Use(middleware.Heartbeat("/ping"))
@@ -30,17 +33,18 @@ func addMiddleware(r *chi.Mux, cfg *config.Config, log *zap.Logger) {
 	r.Use(httpmiddleware.NewSessionMiddleware(httpmiddleware.SessionConfig{
 		JWESecretKey:  cfg.JWESecret,
 		SessionCookie: "session",
-		MaxAge:        14 * 24 * 60 * 60, // 14 days
+		MaxAge:        auth.SessionCookieMaxAge(cfg), // Outlives every session it can hold
 		Path:          "/",
 		SameSite:      http.SameSiteLaxMode,
 		Secure:        false, // Set to true in production with HTTPS
 		Domain:        "",
 	}, log))
+	r.Use(httpmiddleware.NewAuthMiddleware(authService, log))
 }
 
-func NewRouter(lc fx.Lifecycle, cfg *config.Config, log *zap.Logger) *chi.Mux {
+func NewRouter(lc fx.Lifecycle, cfg *config.Config, log *zap.Logger, authService *auth.AuthService) *chi.Mux {
 	r := chi.NewRouter()
-	addMiddleware(r, cfg, log)
+	addMiddleware(r, cfg, log, authService)
 
 	srv := &http.Server{Addr: ":" + cfg.ServerPort, Handler: r}
 
@@ -315,15 +315,24 @@ type PongoTemplateManager struct {
 	templatePath string
 	templates    sync.Map
 	loader       pongo2.TemplateLoader
+	set          *pongo2.TemplateSet
 }
 
 // NewPongoTemplateManager creates a new pongo2-based template manager
 func NewPongoTemplateManager(templatePath string) *PongoTemplateManager {
 	// Create a template loader that can resolve relative paths
-	loader, _ := pongo2.NewLocalFileSystemLoader(templatePath)
+	loader, err := pongo2.NewLocalFileSystemLoader(templatePath)
+
+	// Resolve template names against the template path when it is available
+	set := pongo2.DefaultSet
+	if err == nil && loader != nil {
+		set = pongo2.NewSet("emails", loader)
+	}
+
 	return &PongoTemplateManager{
 		templatePath: templatePath,
 		loader:       loader,
+		set:          set,
 	}
 }
 
@@ -344,7 +353,7 @@ func (ptm *PongoTemplateManager) RenderTemplate(templateName string, data interf
 	}
 
 	// Load template from filesystem
-	tmpl, err := pongo2.FromFile(templateName)
+	tmpl, err := ptm.set.FromFile(templateName)
 	if err != nil {
 		return "", fmt.Errorf("failed to load template %s: %w", templateName, err)
 	}
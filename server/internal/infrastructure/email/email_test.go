@@ -631,4 +631,182 @@ func TestTemplateHelpers(t *testing.T) {
 	if dataMap["custom_field"] != "custom_value" {
 		t.Error("ToMap not including custom fields correctly")
 	}
-}
\ No newline at end of file
+}
+func TestRenderEmailFromTemplateFiles(t *testing.T) {
+	cfg := &appconfig.Config{
+		EmailProvider:     "dummy",
+		EmailTemplatePath: "../../../templates/emails",
+	}
+
+	client, err := NewEmailClient(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create email client: %v", err)
+	}
+
+	data := EmailVerificationData(cfg, "test@example.com", "verification-token-123", "Mozilla/5.0")
+
+	subject, err := client.RenderSubject("email-verification/subject.txt", data)
+	if err != nil {
+		t.Fatalf("Failed to render subject: %v", err)
+	}
+	if !strings.Contains(subject, "Email Verification") {
+		t.Errorf("Unexpected subject: %q", subject)
+	}
+
+	htmlContent, textContent, err := client.RenderEmail("email-verification", data)
+	if err != nil {
+		t.Fatalf("Failed to render email: %v", err)
+	}
+	if !strings.Contains(htmlContent, "verification-token-123") {
+		t.Error("HTML content should contain the verification token")
+	}
+	if !strings.Contains(textContent, "verification-token-123") {
+		t.Error("Text content should contain the verification token")
+	}
+
+	err = client.SendEmailVerification(context.Background(), cfg, "test@example.com", "verification-token-123", "Mozilla/5.0")
+	if err != nil {
+		t.Errorf("Failed to send email verification: %v", err)
+	}
+}
+
+func TestRenderEmailLoginCodeTemplate(t *testing.T) {
+	cfg := &appconfig.Config{
+		EmailProvider:     "dummy",
+		EmailTemplatePath: "../../../templates/emails",
+	}
+
+	client, err := NewEmailClient(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create email client: %v", err)
+	}
+
+	loginLink := "https://example.com/auth/login/email?code=login-token-123"
+	data := EmailLoginCodeData(cfg, "test@example.com", "482913", loginLink, "Mozilla/5.0")
+
+	subject, err := client.RenderSubject("email-login-code/subject.txt", data)
+	if err != nil {
+		t.Fatalf("Failed to render subject: %v", err)
+	}
+	if !strings.Contains(subject, "Login Code") {
+		t.Errorf("Unexpected subject: %q", subject)
+	}
+
+	htmlContent, textContent, err := client.RenderEmail("email-login-code", data)
+	if err != nil {
+		t.Fatalf("Failed to render email: %v", err)
+	}
+	for _, content := range []string{htmlContent, textContent} {
+		if !strings.Contains(content, "482913") {
+			t.Error("Content should contain the login code")
+		}
+		if !strings.Contains(content, "login-token-123") {
+			t.Error("Content should contain the login link")
+		}
+	}
+
+	err = client.SendEmailLoginCode(context.Background(), cfg, "test@example.com", "482913", loginLink, "Mozilla/5.0")
+	if err != nil {
+		t.Errorf("Failed to send email login code: %v", err)
+	}
+}
+
+func TestRenderAccountUnlockTemplate(t *testing.T) {
+	cfg := &appconfig.Config{
+		EmailProvider:     "dummy",
+		EmailTemplatePath: "../../../templates/emails",
+	}
+
+	client, err := NewEmailClient(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create email client: %v", err)
+	}
+
+	unlockLink := "https://example.com/auth/unlock-account/unlock-token-123?email=test%40example.com"
+	data := AccountUnlockData(cfg, "test@example.com", unlockLink, "January 2, 2006 at 15:04 UTC", "203.0.113.7")
+
+	subject, err := client.RenderSubject("account-unlock/subject.txt", data)
+	if err != nil {
+		t.Fatalf("Failed to render subject: %v", err)
+	}
+	if !strings.Contains(subject, "locked") {
+		t.Errorf("Unexpected subject: %q", subject)
+	}
+
+	htmlContent, textContent, err := client.RenderEmail("account-unlock", data)
+	if err != nil {
+		t.Fatalf("Failed to render email: %v", err)
+	}
+	for _, content := range []string{htmlContent, textContent} {
+		if !strings.Contains(content, "unlock-token-123") {
+			t.Error("Content should contain the unlock link")
+		}
+		if !strings.Contains(content, "January 2, 2006 at 15:04 UTC") {
+			t.Error("Content should contain the lock expiry")
+		}
+		if !strings.Contains(content, "203.0.113.7") {
+			t.Error("Content should contain the IP address")
+		}
+	}
+
+	err = client.SendAccountUnlock(context.Background(), cfg, "test@example.com", unlockLink, "January 2, 2006 at 15:04 UTC", "203.0.113.7")
+	if err != nil {
+		t.Errorf("Failed to send account unlock email: %v", err)
+	}
+}
+
+func TestRenderSecurityNotificationTemplate(t *testing.T) {
+	cfg := &appconfig.Config{
+		EmailProvider:     "dummy",
+		EmailTemplatePath: "../../../templates/emails",
+	}
+
+	client, err := NewEmailClient(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create email client: %v", err)
+	}
+
+	revokeLink := "https://example.com/auth/revoke-sessions/revoke-token-123"
+	tests := []struct {
+		eventType       string
+		detail          string
+		expectedSubject string
+		expectedContent string
+	}{
+		{eventType: "new-device-sign-in", expectedSubject: "New sign in", expectedContent: "a device it wasn't used on before"},
+		{eventType: "password-changed", expectedSubject: "password was changed", expectedContent: "password of your"},
+		{eventType: "passkey-added", detail: "Work laptop", expectedSubject: "passkey was added", expectedContent: "Work laptop"},
+		{eventType: "phone-number-changed", detail: "••••••••90", expectedSubject: "phone number", expectedContent: "changed to"},
+		{eventType: "phone-number-changed", expectedSubject: "phone number", expectedContent: "was just removed"},
+		{eventType: "personal-access-token-created", detail: "CI deploys", expectedSubject: "personal access token was created", expectedContent: "CI deploys"},
+	}
+
+	for _, tt := range tests {
+		data := SecurityNotificationData(cfg, "test@example.com", tt.eventType, tt.detail, "Chrome 120 on Windows (desktop)", "203.0.113.7", "Berlin, Germany", "January 2, 2006 at 15:04 UTC", revokeLink)
+
+		subject, err := client.RenderSubject("security-notification/subject.txt", data)
+		if err != nil {
+			t.Fatalf("Failed to render subject: %v", err)
+		}
+		if !strings.Contains(subject, tt.expectedSubject) {
+			t.Errorf("Unexpected subject for %s: %q", tt.eventType, subject)
+		}
+
+		htmlContent, textContent, err := client.RenderEmail("security-notification", data)
+		if err != nil {
+			t.Fatalf("Failed to render email: %v", err)
+		}
+		for _, content := range []string{htmlContent, textContent} {
+			for _, expected := range []string{tt.expectedContent, revokeLink, "clears your password", "Chrome 120 on Windows (desktop)", "203.0.113.7 (Berlin, Germany)"} {
+				if !strings.Contains(content, expected) {
+					t.Errorf("Content for %s should contain %q", tt.eventType, expected)
+				}
+			}
+		}
+	}
+
+	err = client.SendSecurityNotification(context.Background(), cfg, "test@example.com", "password-changed", "", "Chrome 120 on Windows (desktop)", "203.0.113.7", "", "January 2, 2006 at 15:04 UTC", revokeLink)
+	if err != nil {
+		t.Errorf("Failed to send security notification: %v", err)
+	}
+}
@@ -87,6 +87,50 @@ func PasswordResetData(cfg *appconfig.Config, resetLink, userAgent string, isIni
 	return data.ToMap()
 }
 
+// EmailLoginCodeData creates template data for a passwordless login code
+func EmailLoginCodeData(cfg *appconfig.Config, email, code, loginLink, userAgent string) map[string]interface{} {
+	data := NewEmailTemplateData(cfg)
+
+	data.SetField("email", email)
+	data.SetField("login_code", code)
+	data.SetField("login_link", loginLink)
+	data.SetField("code_expires_in", "10 minutes")
+	data.SetField("user_agent", userAgent)
+
+	return data.ToMap()
+}
+
+// AccountUnlockData creates template data for the unlock link of a locked account
+func AccountUnlockData(cfg *appconfig.Config, email, unlockLink, lockedUntil, ipAddress string) map[string]interface{} {
+	data := NewEmailTemplateData(cfg)
+
+	data.SetField("email", email)
+	data.SetField("unlock_link", unlockLink)
+	data.SetField("locked_until", lockedUntil)
+	data.SetField("ip_address", ipAddress)
+
+	return data.ToMap()
+}
+
+// SecurityNotificationData creates template data for a security notification
+//
+// eventType selects the wording of the notification, detail names what changed where it helps,
+// and location is empty when the IP address couldn't be located.
+func SecurityNotificationData(cfg *appconfig.Config, email, eventType, detail, device, ipAddress, location, occurredAt, revokeLink string) map[string]interface{} {
+	data := NewEmailTemplateData(cfg)
+
+	data.SetField("email", email)
+	data.SetField("event_type", eventType)
+	data.SetField("detail", detail)
+	data.SetField("device", device)
+	data.SetField("ip_address", ipAddress)
+	data.SetField("location", location)
+	data.SetField("occurred_at", occurredAt)
+	data.SetField("revoke_link", revokeLink)
+
+	return data.ToMap()
+}
+
 // RenderSubject renders an email subject template
 func (ec *EmailClient) RenderSubject(templateName string, data map[string]interface{}) (string, error) {
 	// For simple templates like subjects, use direct string rendering
@@ -98,7 +142,7 @@ func (ec *EmailClient) RenderSubject(templateName string, data map[string]interf
 func (ec *EmailClient) RenderEmail(templatePath string, data map[string]interface{}) (htmlContent, textContent string, err error) {
 	// Render HTML version
 	htmlTemplate := templatePath + "/body.mjml"
-	htmlContent, err = ec.templateMgr.RenderMJMLToHTML(htmlTemplate, data)
+	htmlContent, err = ec.templateMgr.RenderTemplate(htmlTemplate, data)
 	if err != nil {
 		return "", "", err
 	}
@@ -142,11 +186,28 @@ func (ec *EmailClient) SendEmailTemplate(ctx context.Context, templatePath strin
 // SendEmailVerification sends an email verification email
 func (ec *EmailClient) SendEmailVerification(ctx context.Context, cfg *appconfig.Config, email, token, userAgent string) error {
 	data := EmailVerificationData(cfg, email, token, userAgent)
-	return ec.SendEmailTemplate(ctx, "emails/email-verification", data, []string{email})
+	return ec.SendEmailTemplate(ctx, "email-verification", data, []string{email})
 }
 
 // SendPasswordReset sends a password reset email
 func (ec *EmailClient) SendPasswordReset(ctx context.Context, cfg *appconfig.Config, resetLink, userAgent string, isInitial bool, toEmail string) error {
 	data := PasswordResetData(cfg, resetLink, userAgent, isInitial)
-	return ec.SendEmailTemplate(ctx, "emails/password-reset", data, []string{toEmail})
-}
\ No newline at end of file
+	return ec.SendEmailTemplate(ctx, "password-reset", data, []string{toEmail})
+}
+// SendEmailLoginCode sends a passwordless login code and link
+func (ec *EmailClient) SendEmailLoginCode(ctx context.Context, cfg *appconfig.Config, email, code, loginLink, userAgent string) error {
+	data := EmailLoginCodeData(cfg, email, code, loginLink, userAgent)
+	return ec.SendEmailTemplate(ctx, "email-login-code", data, []string{email})
+}
+
+// SendAccountUnlock sends the unlock link of a locked account
+func (ec *EmailClient) SendAccountUnlock(ctx context.Context, cfg *appconfig.Config, email, unlockLink, lockedUntil, ipAddress string) error {
+	data := AccountUnlockData(cfg, email, unlockLink, lockedUntil, ipAddress)
+	return ec.SendEmailTemplate(ctx, "account-unlock", data, []string{email})
+}
+
+// SendSecurityNotification tells the account owner about a sign in or a sensitive account change
+func (ec *EmailClient) SendSecurityNotification(ctx context.Context, cfg *appconfig.Config, email, eventType, detail, device, ipAddress, location, occurredAt, revokeLink string) error {
+	data := SecurityNotificationData(cfg, email, eventType, detail, device, ipAddress, location, occurredAt, revokeLink)
+	return ec.SendEmailTemplate(ctx, "security-notification", data, []string{email})
+}
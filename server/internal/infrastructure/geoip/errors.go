@@ -0,0 +1,8 @@
+package geoip
+
+import "errors"
+
+var (
+	// ErrInvalidDatabase is returned when a database file is not in the MaxMind DB format
+	ErrInvalidDatabase = errors.New("invalid MaxMind database")
+)
@@ -0,0 +1,262 @@
+package geoip
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode is a search tree node of a database written by writeTestDatabase
+type testNode struct {
+	children [2]*testNode
+	data     [2][]byte
+}
+
+// encodeTestValue encodes a value of the data section, supporting the types the test databases use
+func encodeTestValue(t *testing.T, value any) []byte {
+	control := func(kind int, size int) []byte {
+		require.Less(t, size, 29)
+		if kind > 7 {
+			return []byte{byte(size), byte(kind - 7)}
+		}
+		return []byte{byte(kind<<5 | size)}
+	}
+	unsigned := func(kind int, value uint64) []byte {
+		var b []byte
+		for ; value > 0; value >>= 8 {
+			b = append([]byte{byte(value)}, b...)
+		}
+		return append(control(kind, len(b)), b...)
+	}
+
+	switch v := value.(type) {
+	case string:
+		return append(control(typeString, len(v)), v...)
+	case uint16:
+		return unsigned(typeUint16, uint64(v))
+	case uint32:
+		return unsigned(typeUint32, uint64(v))
+	case []any:
+		encoded := control(typeArray, len(v))
+		for _, item := range v {
+			encoded = append(encoded, encodeTestValue(t, item)...)
+		}
+		return encoded
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		encoded := control(typeMap, len(v))
+		for _, key := range keys {
+			encoded = append(encoded, encodeTestValue(t, key)...)
+			encoded = append(encoded, encodeTestValue(t, v[key])...)
+		}
+		return encoded
+	}
+
+	require.Failf(t, "unsupported test value", "%T", value)
+	return nil
+}
+
+// writeTestDatabase writes a database with 24 bit records mapping the networks to their records
+func writeTestDatabase(t *testing.T, ipVersion int, networks map[string]map[string]any) string {
+	root := &testNode{}
+	for network, record := range networks {
+		prefix := netip.MustParsePrefix(network)
+
+		address := prefix.Addr().AsSlice()
+		bits := prefix.Bits()
+		if ipVersion == 6 && prefix.Addr().Is4() {
+			// IPv4 addresses follow 96 zero bits in an IPv6 tree
+			address = append(make([]byte, 12), address...)
+			bits += 96
+		}
+
+		node := root
+		for i := 0; i < bits; i++ {
+			bit := address[i/8] >> (7 - i%8) & 1
+			if i == bits-1 {
+				node.data[bit] = encodeTestValue(t, record)
+				break
+			}
+			if node.children[bit] == nil {
+				node.children[bit] = &testNode{}
+			}
+			node = node.children[bit]
+		}
+	}
+
+	// Number the nodes breadth first, the root being node 0
+	nodes := []*testNode{root}
+	numbers := map[*testNode]int{root: 0}
+	for i := 0; i < len(nodes); i++ {
+		for _, child := range nodes[i].children {
+			if child != nil {
+				numbers[child] = len(nodes)
+				nodes = append(nodes, child)
+			}
+		}
+	}
+	nodeCount := len(nodes)
+
+	var tree, data []byte
+	for _, node := range nodes {
+		for bit := range 2 {
+			record := nodeCount
+			switch {
+			case node.children[bit] != nil:
+				record = numbers[node.children[bit]]
+			case node.data[bit] != nil:
+				record = nodeCount + dataSectionSeparatorSize + len(data)
+				data = append(data, node.data[bit]...)
+			}
+			tree = append(tree, byte(record>>16), byte(record>>8), byte(record))
+		}
+	}
+
+	file := append(tree, make([]byte, dataSectionSeparatorSize)...)
+	file = append(file, data...)
+	file = append(file, metadataStartMarker...)
+	file = append(file, encodeTestValue(t, map[string]any{
+		"node_count":    uint32(nodeCount),
+		"record_size":   uint16(24),
+		"ip_version":    uint16(ipVersion),
+		"database_type": "GeoLite2-City",
+		"languages":     []any{"en"},
+	})...)
+
+	path := filepath.Join(t.TempDir(), "test.mmdb")
+	require.NoError(t, os.WriteFile(path, file, 0o600))
+	return path
+}
+
+// testCityRecord returns a GeoLite2 City record
+func testCityRecord(city string, country string, countryCode string) map[string]any {
+	record := map[string]any{
+		"country": map[string]any{
+			"iso_code": countryCode,
+			"names":    map[string]any{"en": country, "de": country + " (de)"},
+		},
+	}
+	if city != "" {
+		record["city"] = map[string]any{"names": map[string]any{"en": city}}
+	}
+	return record
+}
+
+func TestDatabaseLocator(t *testing.T) {
+	networks := map[string]map[string]any{
+		"203.0.113.0/24":  testCityRecord("Berlin", "Germany", "DE"),
+		"198.51.100.0/25": testCityRecord("", "France", "FR"),
+		"2001:db8::/32":   testCityRecord("Tokyo", "Japan", "JP"),
+	}
+
+	tests := []struct {
+		name      string
+		ipAddress string
+		expected  *Location
+	}{
+		{name: "city", ipAddress: "203.0.113.7", expected: &Location{City: "Berlin", Country: "Germany", CountryCode: "DE"}},
+		{name: "IPv4-mapped IPv6 address", ipAddress: "::ffff:203.0.113.7", expected: &Location{City: "Berlin", Country: "Germany", CountryCode: "DE"}},
+		{name: "country only", ipAddress: "198.51.100.1", expected: &Location{Country: "France", CountryCode: "FR"}},
+		{name: "outside of the network", ipAddress: "198.51.100.200"},
+		{name: "IPv6 address", ipAddress: "2001:db8::1", expected: &Location{City: "Tokyo", Country: "Japan", CountryCode: "JP"}},
+		{name: "unknown address", ipAddress: "192.0.2.1"},
+		{name: "invalid address", ipAddress: ""},
+	}
+
+	locator, err := NewDatabaseLocator(writeTestDatabase(t, 6, networks))
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location, err := locator.Locate(tt.ipAddress)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, location)
+		})
+	}
+
+	t.Run("IPv4 database", func(t *testing.T) {
+		locator, err := NewDatabaseLocator(writeTestDatabase(t, 4, map[string]map[string]any{
+			"203.0.113.0/24": testCityRecord("Berlin", "Germany", "DE"),
+		}))
+		require.NoError(t, err)
+
+		location, err := locator.Locate("203.0.113.7")
+		require.NoError(t, err)
+		assert.Equal(t, &Location{City: "Berlin", Country: "Germany", CountryCode: "DE"}, location)
+
+		location, err = locator.Locate("2001:db8::1")
+		require.NoError(t, err)
+		assert.Nil(t, location)
+	})
+}
+
+func TestNewReader(t *testing.T) {
+	_, err := NewReader([]byte("not a database"))
+	assert.ErrorIs(t, err, ErrInvalidDatabase)
+
+	// A tree larger than the file
+	metadata := encodeTestValue(t, map[string]any{
+		"node_count":  uint32(1000),
+		"record_size": uint16(24),
+		"ip_version":  uint16(4),
+	})
+	_, err = NewReader(append(append(make([]byte, 16), metadataStartMarker...), metadata...))
+	assert.ErrorIs(t, err, ErrInvalidDatabase)
+}
+
+func TestDecoder(t *testing.T) {
+	t.Run("follows pointers", func(t *testing.T) {
+		d := decoder{buffer: append(encodeTestValue(t, "shared"), 0x20, 0x00)}
+
+		value, next, err := d.decode(7)
+		require.NoError(t, err)
+		assert.Equal(t, "shared", value)
+		assert.Equal(t, uint(9), next)
+	})
+
+	t.Run("decodes extended sizes", func(t *testing.T) {
+		long := make([]byte, 300)
+		for i := range long {
+			long[i] = 'a'
+		}
+		// Sizes from 285 take two extra bytes
+		d := decoder{buffer: append([]byte{typeString<<5 | 30, 0x00, 15}, long...)}
+
+		value, next, err := d.decode(0)
+		require.NoError(t, err)
+		assert.Equal(t, string(long), value)
+		assert.Equal(t, uint(303), next)
+	})
+
+	t.Run("decodes numbers", func(t *testing.T) {
+		double := make([]byte, 8)
+		binary.BigEndian.PutUint64(double, 0x400921fb54442d18)
+		d := decoder{buffer: append([]byte{typeDouble<<5 | 8}, double...)}
+
+		value, _, err := d.decode(0)
+		require.NoError(t, err)
+		assert.InDelta(t, 3.14159, value, 0.0001)
+
+		d = decoder{buffer: []byte{0x04, 0x01, 0xff, 0xff, 0xff, 0xfe}}
+		value, _, err = d.decode(0)
+		require.NoError(t, err)
+		assert.Equal(t, int32(-2), value)
+	})
+
+	t.Run("rejects truncated values", func(t *testing.T) {
+		d := decoder{buffer: []byte{typeString<<5 | 10, 'a'}}
+		_, _, err := d.decode(0)
+		assert.ErrorIs(t, err, ErrInvalidDatabase)
+	})
+}
@@ -0,0 +1,90 @@
+package geoip
+
+import (
+	"net/netip"
+)
+
+// nameLanguage is the language of the place names read from the database
+const nameLanguage = "en"
+
+// Location is the approximate location of an IP address
+type Location struct {
+	// City is the English city name, empty if only the country is known
+	City string
+	// Country is the English country name
+	Country string
+	// CountryCode is the ISO 3166-1 alpha-2 country code
+	CountryCode string
+}
+
+// Locator resolves IP addresses to approximate locations.
+type Locator interface {
+	// Locate returns the location of the IP address, nil if it is unknown
+	// Returns an error if the location database cannot be read
+	Locate(ipAddress string) (*Location, error)
+}
+
+// DisabledLocator never knows the location of an IP address.
+type DisabledLocator struct{}
+
+// Locate implements Locator and always returns nil.
+func (DisabledLocator) Locate(ipAddress string) (*Location, error) {
+	return nil, nil
+}
+
+// DatabaseLocator implements Locator using a GeoIP2 or GeoLite2 City or Country database.
+type DatabaseLocator struct {
+	reader *Reader
+}
+
+// NewDatabaseLocator opens the database file at the given path.
+func NewDatabaseLocator(path string) (*DatabaseLocator, error) {
+	reader, err := OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	return &DatabaseLocator{reader: reader}, nil
+}
+
+// Locate implements Locator by looking the IP address up in the database.
+//
+// Addresses that can't be parsed, such as an empty one, are unknown.
+func (l *DatabaseLocator) Locate(ipAddress string) (*Location, error) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return nil, nil
+	}
+
+	value, err := l.reader.Lookup(addr)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := value.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	country, _ := record["country"].(map[string]any)
+	if country == nil {
+		// Anycast and satellite networks only have the country they are registered in
+		country, _ = record["registered_country"].(map[string]any)
+	}
+	if country == nil {
+		return nil, nil
+	}
+
+	city, _ := record["city"].(map[string]any)
+	countryCode, _ := country["iso_code"].(string)
+	return &Location{
+		City:        localizedName(city),
+		Country:     localizedName(country),
+		CountryCode: countryCode,
+	}, nil
+}
+
+// localizedName returns the name of a place record in the name language
+func localizedName(place map[string]any) string {
+	names, _ := place["names"].(map[string]any)
+	name, _ := names[nameLanguage].(string)
+	return name
+}
@@ -0,0 +1,22 @@
+package geoip
+
+import (
+	"go.uber.org/fx"
+
+	appconfig "server/internal/config"
+)
+
+// ProviderModule provides the IP address locator using dependency injection
+var ProviderModule = fx.Module("geoip",
+	fx.Provide(NewLocatorProvider),
+)
+
+// NewLocatorProvider creates the IP address locator from the GEOIP_* configuration.
+//
+// Without a GeoIPDatabasePath, session locations are left unknown.
+func NewLocatorProvider(cfg *appconfig.Config) (Locator, error) {
+	if cfg.GeoIPDatabasePath == "" {
+		return DisabledLocator{}, nil
+	}
+	return NewDatabaseLocator(cfg.GeoIPDatabasePath)
+}
@@ -0,0 +1,366 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"os"
+)
+
+// metadataStartMarker precedes the metadata section at the end of a MaxMind DB file
+var metadataStartMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// dataSectionSeparatorSize is the number of zero bytes between the search tree and the data section
+const dataSectionSeparatorSize = 16
+
+// Data types of the MaxMind DB data section
+const (
+	typeExtended = iota
+	typePointer
+	typeString
+	typeDouble
+	typeBytes
+	typeUint16
+	typeUint32
+	typeMap
+	typeInt32
+	typeUint64
+	typeUint128
+	typeArray
+	typeContainer
+	typeEndMarker
+	typeBool
+	typeFloat
+)
+
+// Reader looks up IP addresses in a database in the MaxMind DB format, such as GeoLite2 City.
+//
+// The database is read into memory once. Lookups decode the record of an address into
+// generic values: map[string]any, []any, string, []byte, uint64, int32, *big.Int, float32,
+// float64 and bool.
+type Reader struct {
+	tree       []byte
+	data       decoder
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	// ipv4Start is the node IPv4 lookups start from in an IPv6 tree, past the 96 leading zero bits
+	ipv4Start uint
+}
+
+// OpenReader reads the database file at the given path.
+func OpenReader(path string) (*Reader, error) {
+	buffer, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read MaxMind database: %w", err)
+	}
+	return NewReader(buffer)
+}
+
+// NewReader reads a database from its content.
+func NewReader(buffer []byte) (*Reader, error) {
+	metadataStart := bytes.LastIndex(buffer, metadataStartMarker)
+	if metadataStart < 0 {
+		return nil, fmt.Errorf("%w: metadata not found", ErrInvalidDatabase)
+	}
+
+	metadataDecoder := decoder{buffer: buffer[metadataStart+len(metadataStartMarker):]}
+	value, _, err := metadataDecoder.decode(0)
+	if err != nil {
+		return nil, err
+	}
+	metadata, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: metadata is not a map", ErrInvalidDatabase)
+	}
+
+	nodeCount, _ := metadata["node_count"].(uint64)
+	recordSize, _ := metadata["record_size"].(uint64)
+	ipVersion, _ := metadata["ip_version"].(uint64)
+	if recordSize != 24 && recordSize != 28 && recordSize != 32 {
+		return nil, fmt.Errorf("%w: unsupported record size %d", ErrInvalidDatabase, recordSize)
+	}
+	if ipVersion != 4 && ipVersion != 6 {
+		return nil, fmt.Errorf("%w: unsupported IP version %d", ErrInvalidDatabase, ipVersion)
+	}
+
+	// Each node holds two records
+	treeSize := nodeCount * recordSize / 4
+	if treeSize+dataSectionSeparatorSize > uint64(metadataStart) {
+		return nil, fmt.Errorf("%w: search tree exceeds the file", ErrInvalidDatabase)
+	}
+
+	r := &Reader{
+		tree:       buffer[:treeSize],
+		data:       decoder{buffer: buffer[treeSize+dataSectionSeparatorSize : metadataStart]},
+		nodeCount:  uint(nodeCount),
+		recordSize: uint(recordSize),
+		ipVersion:  uint(ipVersion),
+	}
+
+	if r.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < r.nodeCount; i++ {
+			node = r.readRecord(node, 0)
+		}
+		r.ipv4Start = node
+	}
+
+	return r, nil
+}
+
+// Lookup returns the record of the IP address, nil if the database holds none.
+func (r *Reader) Lookup(addr netip.Addr) (any, error) {
+	addr = addr.Unmap()
+
+	var address []byte
+	node := uint(0)
+	switch {
+	case addr.Is4():
+		ipv4 := addr.As4()
+		address = ipv4[:]
+		if r.ipVersion == 6 {
+			node = r.ipv4Start
+		}
+	case addr.Is6() && r.ipVersion == 6:
+		ipv6 := addr.As16()
+		address = ipv6[:]
+	default:
+		return nil, nil
+	}
+
+	for i := 0; i < len(address)*8 && node < r.nodeCount; i++ {
+		bit := uint(address[i/8]>>(7-i%8)) & 1
+		node = r.readRecord(node, bit)
+	}
+
+	switch {
+	case node == r.nodeCount:
+		return nil, nil
+	case node < r.nodeCount:
+		return nil, fmt.Errorf("%w: search tree is deeper than the address", ErrInvalidDatabase)
+	}
+
+	offset := node - r.nodeCount - dataSectionSeparatorSize
+	value, _, err := r.data.decode(offset)
+	return value, err
+}
+
+// readRecord returns the left (bit 0) or right (bit 1) record of a search tree node
+func (r *Reader) readRecord(node uint, bit uint) uint {
+	b := r.tree
+	switch r.recordSize {
+	case 24:
+		offset := node*6 + bit*3
+		return uint(b[offset])<<16 | uint(b[offset+1])<<8 | uint(b[offset+2])
+	case 28:
+		offset := node * 7
+		if bit == 0 {
+			return uint(b[offset+3]&0xf0)<<20 | uint(b[offset])<<16 | uint(b[offset+1])<<8 | uint(b[offset+2])
+		}
+		return uint(b[offset+3]&0x0f)<<24 | uint(b[offset+4])<<16 | uint(b[offset+5])<<8 | uint(b[offset+6])
+	default:
+		offset := node*8 + bit*4
+		return uint(binary.BigEndian.Uint32(b[offset:]))
+	}
+}
+
+// decoder decodes the values of a MaxMind DB data or metadata section
+type decoder struct {
+	buffer []byte
+}
+
+// decode decodes the value at the offset, returning it with the offset following it
+func (d *decoder) decode(offset uint) (any, uint, error) {
+	kind, size, offset, err := d.decodeControl(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if kind == typePointer {
+		pointer, next, err := d.decodePointer(size, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		value, _, err := d.decodeValue(pointer)
+		return value, next, err
+	}
+
+	return d.decodeContent(kind, size, offset)
+}
+
+// decodeValue decodes the value a pointer points to, which can't be a pointer itself
+func (d *decoder) decodeValue(offset uint) (any, uint, error) {
+	kind, size, offset, err := d.decodeControl(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	if kind == typePointer {
+		return nil, 0, fmt.Errorf("%w: pointer to a pointer", ErrInvalidDatabase)
+	}
+	return d.decodeContent(kind, size, offset)
+}
+
+// decodeControl decodes the control byte at the offset into the type and size of the value following it
+//
+// The size of pointers is returned raw, as their size bits are part of the pointer.
+func (d *decoder) decodeControl(offset uint) (uint, uint, uint, error) {
+	control, err := d.read(offset, 1)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	offset++
+
+	kind := uint(control[0] >> 5)
+	size := uint(control[0] & 0x1f)
+	if kind == typeExtended {
+		extended, err := d.read(offset, 1)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		kind = 7 + uint(extended[0])
+		offset++
+	}
+	if kind == typePointer || size < 29 {
+		return kind, size, offset, nil
+	}
+
+	// Larger sizes follow in the next one to three bytes
+	extraBytes := size - 28
+	extra, err := d.read(offset, extraBytes)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	offset += extraBytes
+
+	value := uint(0)
+	for _, b := range extra {
+		value = value<<8 | uint(b)
+	}
+	switch extraBytes {
+	case 1:
+		size = 29 + value
+	case 2:
+		size = 285 + value
+	default:
+		size = 65821 + value
+	}
+
+	return kind, size, offset, nil
+}
+
+// decodePointer decodes the pointer at the offset into an offset of the section
+func (d *decoder) decodePointer(size uint, offset uint) (uint, uint, error) {
+	pointerSize := (size>>3)&0x3 + 1
+	b, err := d.read(offset, pointerSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var pointer uint
+	if pointerSize == 4 {
+		pointer = uint(binary.BigEndian.Uint32(b))
+	} else {
+		pointer = size & 0x7
+		for _, c := range b {
+			pointer = pointer<<8 | uint(c)
+		}
+	}
+
+	switch pointerSize {
+	case 2:
+		pointer += 2048
+	case 3:
+		pointer += 526336
+	}
+
+	return pointer, offset + pointerSize, nil
+}
+
+// decodeContent decodes a value of the type and size starting at the offset
+func (d *decoder) decodeContent(kind uint, size uint, offset uint) (any, uint, error) {
+	switch kind {
+	case typeMap:
+		value := make(map[string]any, size)
+		for range size {
+			key, next, err := d.decode(offset)
+			if err != nil {
+				return nil, 0, err
+			}
+			keyString, ok := key.(string)
+			if !ok {
+				return nil, 0, fmt.Errorf("%w: map key is not a string", ErrInvalidDatabase)
+			}
+			value[keyString], offset, err = d.decode(next)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		return value, offset, nil
+
+	case typeArray:
+		value := make([]any, 0, size)
+		for range size {
+			item, next, err := d.decode(offset)
+			if err != nil {
+				return nil, 0, err
+			}
+			value = append(value, item)
+			offset = next
+		}
+		return value, offset, nil
+
+	case typeBool:
+		return size != 0, offset, nil
+	}
+
+	b, err := d.read(offset, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset += size
+
+	switch kind {
+	case typeString:
+		return string(b), offset, nil
+	case typeBytes:
+		return bytes.Clone(b), offset, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("%w: double of %d bytes", ErrInvalidDatabase, size)
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), offset, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("%w: float of %d bytes", ErrInvalidDatabase, size)
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(b)), offset, nil
+	case typeUint16, typeUint32, typeUint64, typeInt32:
+		if size > 8 {
+			return nil, 0, fmt.Errorf("%w: integer of %d bytes", ErrInvalidDatabase, size)
+		}
+		value := uint64(0)
+		for _, c := range b {
+			value = value<<8 | uint64(c)
+		}
+		if kind == typeInt32 {
+			return int32(uint32(value)), offset, nil
+		}
+		return value, offset, nil
+	case typeUint128:
+		return new(big.Int).SetBytes(b), offset, nil
+	default:
+		return nil, 0, fmt.Errorf("%w: unsupported data type %d", ErrInvalidDatabase, kind)
+	}
+}
+
+// read returns size bytes of the section from the offset
+func (d *decoder) read(offset uint, size uint) ([]byte, error) {
+	if offset > uint(len(d.buffer)) || size > uint(len(d.buffer))-offset {
+		return nil, fmt.Errorf("%w: value exceeds its section", ErrInvalidDatabase)
+	}
+	return d.buffer[offset : offset+size], nil
+}
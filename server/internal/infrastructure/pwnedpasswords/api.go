@@ -0,0 +1,74 @@
+package pwnedpasswords
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultAPIURL is the base URL of the Have I Been Pwned Pwned Passwords API
+const DefaultAPIURL = "https://api.pwnedpasswords.com"
+
+// hashPrefixLength is the number of hash characters sent to the range API
+const hashPrefixLength = 5
+
+// RangeAPIChecker implements Checker using the k-anonymity range API of Have I Been Pwned.
+// Only the first five characters of the password's SHA-1 hash leave the server, the
+// matching suffixes are compared locally.
+type RangeAPIChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewRangeAPIChecker creates a checker querying the range API below the base URL.
+func NewRangeAPIChecker(baseURL string) *RangeAPIChecker {
+	return &RangeAPIChecker{
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// IsPwned implements Checker by looking up the hash suffix in the hash prefix's range.
+func (c *RangeAPIChecker) IsPwned(ctx context.Context, password string) (bool, error) {
+	hash := hashPassword(password)
+	prefix, suffix := hash[:hashPrefixLength], hash[hashPrefixLength:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/range/"+prefix, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Padding hides the number of suffixes in the range from anyone observing the response size
+	req.Header.Set("Add-Padding", "true")
+	req.Header.Set("User-Agent", "go-auth-template/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("%w: status %d", ErrUnexpectedResponse, resp.StatusCode)
+	}
+
+	// Each line is "<hash suffix>:<count>", padding lines have a count of 0
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lineSuffix, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !found || !strings.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+		return count != "0", nil
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return false, nil
+}
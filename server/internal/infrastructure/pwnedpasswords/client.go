@@ -0,0 +1,31 @@
+package pwnedpasswords
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+)
+
+// Checker reports whether a password appears in a known data breach.
+// Implementations only ever see the SHA-1 hash of the password, and range
+// lookups only send its first five hex characters.
+type Checker interface {
+	// IsPwned returns true if the password appears in the breach corpus
+	// Returns an error if the corpus cannot be queried
+	IsPwned(ctx context.Context, password string) (bool, error)
+}
+
+// DisabledChecker never reports a password as breached.
+type DisabledChecker struct{}
+
+// IsPwned implements Checker and always returns false.
+func (DisabledChecker) IsPwned(ctx context.Context, password string) (bool, error) {
+	return false, nil
+}
+
+// hashPassword returns the uppercase hex SHA-1 hash used by the Pwned Passwords corpus
+func hashPassword(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,14 @@
+package pwnedpasswords
+
+import "errors"
+
+var (
+	// ErrUnexpectedResponse is returned when the range API responds with an error status
+	ErrUnexpectedResponse = errors.New("unexpected pwned passwords API response")
+
+	// ErrMissingFile is returned when the file mode is selected without a hash file
+	ErrMissingFile = errors.New("pwned passwords file is required")
+
+	// ErrUnsupportedMode is returned when an unsupported check mode is specified
+	ErrUnsupportedMode = errors.New("unsupported pwned passwords mode")
+)
@@ -0,0 +1,110 @@
+package pwnedpasswords
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// maxLineLength bounds the length of a "<hash>:<count>" line of the hash file
+const maxLineLength = 256
+
+// FileChecker implements Checker using a local copy of the Pwned Passwords SHA-1 corpus.
+//
+// The file holds one "<SHA-1 hash>:<count>" line per password sorted by hash, as written by
+// the PwnedPasswordsDownloader in single file mode. It is binary searched on every lookup,
+// so the multi-gigabyte corpus is never loaded into memory.
+type FileChecker struct {
+	file *os.File
+	size int64
+}
+
+// NewFileChecker opens the hash file at the given path.
+func NewFileChecker(path string) (*FileChecker, error) {
+	if path == "" {
+		return nil, ErrMissingFile
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pwned passwords file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat pwned passwords file: %w", err)
+	}
+
+	return &FileChecker{file: file, size: info.Size()}, nil
+}
+
+// IsPwned implements Checker by binary searching the hash file for the password's hash.
+func (c *FileChecker) IsPwned(ctx context.Context, password string) (bool, error) {
+	hash := hashPassword(password)
+
+	low, high := int64(0), c.size
+	for low < high {
+		mid := low + (high-low)/2
+
+		start, line, err := c.lineFrom(mid)
+		if err == io.EOF {
+			high = mid
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+
+		lineHash, count, _ := strings.Cut(line, ":")
+		switch strings.Compare(strings.ToUpper(lineHash), hash) {
+		case 0:
+			return count != "0", nil
+		case -1:
+			low = start + int64(len(line)) + 1
+		default:
+			high = mid
+		}
+	}
+
+	return false, nil
+}
+
+// Close closes the hash file.
+func (c *FileChecker) Close() error {
+	return c.file.Close()
+}
+
+// lineFrom returns the offset and content of the first line starting at or after the offset
+func (c *FileChecker) lineFrom(offset int64) (int64, string, error) {
+	// Read from the byte before the offset to know whether a line starts at the offset
+	readFrom := max(offset-1, 0)
+	buf := make([]byte, 2*maxLineLength)
+	n, err := c.file.ReadAt(buf, readFrom)
+	if err != nil && err != io.EOF {
+		return 0, "", fmt.Errorf("failed to read pwned passwords file: %w", err)
+	}
+	buf = buf[:n]
+
+	start := 0
+	if offset > 0 {
+		newline := bytes.IndexByte(buf, '\n')
+		if newline < 0 {
+			return 0, "", io.EOF
+		}
+		start = newline + 1
+	}
+	if start >= len(buf) {
+		return 0, "", io.EOF
+	}
+
+	line := buf[start:]
+	if end := bytes.IndexByte(line, '\n'); end >= 0 {
+		line = line[:end]
+	}
+
+	return readFrom + int64(start), strings.TrimRight(string(line), "\r"), nil
+}
@@ -0,0 +1,61 @@
+package pwnedpasswords
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	appconfig "server/internal/config"
+)
+
+const (
+	// ModeAPI queries the Have I Been Pwned range API
+	ModeAPI = "api"
+
+	// ModeFile searches a local copy of the hash corpus
+	ModeFile = "file"
+
+	// ModeDisabled skips the breached password check
+	ModeDisabled = "disabled"
+)
+
+// ProviderModule provides the breached password checker using dependency injection
+var ProviderModule = fx.Module("pwnedpasswords",
+	fx.Provide(NewCheckerProvider),
+)
+
+// NewCheckerProvider creates the breached password checker from the PWNED_PASSWORDS_* configuration.
+//
+// Mode selection logic:
+// - "api" or empty → range API below PwnedPasswordsAPIURL
+// - "file" → binary search of the hash file at PwnedPasswordsFile, for offline deployments
+// - "disabled" → no check
+// - Other values → Error
+func NewCheckerProvider(cfg *appconfig.Config, lc fx.Lifecycle) (Checker, error) {
+	switch cfg.PwnedPasswordsMode {
+	case ModeAPI, "":
+		apiURL := cfg.PwnedPasswordsAPIURL
+		if apiURL == "" {
+			apiURL = DefaultAPIURL
+		}
+		return NewRangeAPIChecker(apiURL), nil
+
+	case ModeFile:
+		checker, err := NewFileChecker(cfg.PwnedPasswordsFile)
+		if err != nil {
+			return nil, err
+		}
+		lc.Append(fx.Hook{
+			OnStop: func(context.Context) error {
+				return checker.Close()
+			},
+		})
+		return checker, nil
+
+	case ModeDisabled:
+		return DisabledChecker{}, nil
+
+	default:
+		return nil, ErrUnsupportedMode
+	}
+}
@@ -0,0 +1,106 @@
+package pwnedpasswords
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashPassword(t *testing.T) {
+	assert.Equal(t, "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8", hashPassword("password"))
+}
+
+func TestRangeAPIChecker(t *testing.T) {
+	hash := hashPassword("password")
+
+	var requestedPaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPaths = append(requestedPaths, r.URL.Path)
+		assert.Equal(t, "true", r.Header.Get("Add-Padding"))
+
+		if r.URL.Path == "/range/"+hashPassword("rate-limited")[:5] {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		fmt.Fprintf(w, "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n")
+		fmt.Fprintf(w, "%s:0\r\n", strings.ToLower(hashPassword("padding")[5:]))
+		if r.URL.Path == "/range/"+hash[:5] {
+			fmt.Fprintf(w, "%s:9659365\r\n", hash[5:])
+		}
+	}))
+	defer server.Close()
+
+	checker := NewRangeAPIChecker(server.URL + "/")
+	ctx := context.Background()
+
+	pwned, err := checker.IsPwned(ctx, "password")
+	require.NoError(t, err)
+	assert.True(t, pwned)
+	assert.Equal(t, "/range/"+hash[:5], requestedPaths[0])
+
+	pwned, err = checker.IsPwned(ctx, "Nimbus-Quartz-Lantern-42")
+	require.NoError(t, err)
+	assert.False(t, pwned)
+
+	t.Run("ignores padding entries", func(t *testing.T) {
+		pwned, err := checker.IsPwned(ctx, "padding")
+		require.NoError(t, err)
+		assert.False(t, pwned)
+	})
+
+	t.Run("returns errors for error responses", func(t *testing.T) {
+		_, err := checker.IsPwned(ctx, "rate-limited")
+		assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	})
+}
+
+func TestFileChecker(t *testing.T) {
+	pwnedPasswords := []string{"password", "123456", "qwerty", "letmein", "dragon", "monkey", "iloveyou"}
+	for i := range 500 {
+		pwnedPasswords = append(pwnedPasswords, fmt.Sprintf("leaked-%d", i))
+	}
+
+	lines := make([]string, 0, len(pwnedPasswords))
+	for i, password := range pwnedPasswords {
+		lines = append(lines, fmt.Sprintf("%s:%d", hashPassword(password), i+1))
+	}
+	sort.Strings(lines)
+
+	path := filepath.Join(t.TempDir(), "pwned-passwords-sha1-ordered-by-hash.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\r\n")+"\r\n"), 0o600))
+
+	checker, err := NewFileChecker(path)
+	require.NoError(t, err)
+	defer checker.Close()
+
+	ctx := context.Background()
+	for _, password := range pwnedPasswords {
+		pwned, err := checker.IsPwned(ctx, password)
+		require.NoError(t, err)
+		assert.True(t, pwned, password)
+	}
+
+	for _, password := range []string{"Nimbus-Quartz-Lantern-42", "leaked-500", ""} {
+		pwned, err := checker.IsPwned(ctx, password)
+		require.NoError(t, err)
+		assert.False(t, pwned, password)
+	}
+
+	t.Run("requires a file", func(t *testing.T) {
+		_, err := NewFileChecker("")
+		assert.ErrorIs(t, err, ErrMissingFile)
+
+		_, err = NewFileChecker(filepath.Join(t.TempDir(), "missing.txt"))
+		assert.Error(t, err)
+	})
+}
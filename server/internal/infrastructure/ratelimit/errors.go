@@ -0,0 +1,8 @@
+package ratelimit
+
+import "errors"
+
+var (
+	// ErrUnsupportedStore is returned when an unsupported rate limit store is specified
+	ErrUnsupportedStore = errors.New("unsupported rate limit store")
+)
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps a log of the allowed requests of every key in memory.
+// Counts aren't shared between processes, so it only suits single instance deployments.
+type MemoryStore struct {
+	mu        sync.Mutex
+	logs      map[string]*requestLog
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// requestLog holds the times of a key's allowed requests, oldest first
+type requestLog struct {
+	requests  []time.Time
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		logs: make(map[string]*requestLog),
+		now:  time.Now,
+	}
+}
+
+// Allow implements Store
+func (s *MemoryStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	log, ok := s.logs[key]
+	if !ok {
+		log = &requestLog{}
+		s.logs[key] = log
+	}
+
+	// Forget the requests that left the window
+	cutoff := now.Add(-window)
+	expired := 0
+	for expired < len(log.requests) && !log.requests[expired].After(cutoff) {
+		expired++
+	}
+	log.requests = log.requests[expired:]
+
+	if limit <= 0 {
+		return window, nil
+	}
+	if len(log.requests) >= limit {
+		return log.requests[len(log.requests)-limit].Add(window).Sub(now), nil
+	}
+
+	log.requests = append(log.requests, now)
+	log.expiresAt = now.Add(window)
+	return 0, nil
+}
+
+// sweep drops the logs whose requests all left their window, at most once per sweep interval
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, log := range s.logs {
+		if !now.Before(log.expiresAt) {
+			delete(s.logs, key)
+		}
+	}
+}
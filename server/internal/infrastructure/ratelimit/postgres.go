@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// RateLimitHit is a request allowed by the PostgresStore
+type RateLimitHit struct {
+	bun.BaseModel `bun:"table:rate_limit_hits,alias:rlh"`
+
+	ID        int64     `bun:"id,pk,autoincrement"`
+	Key       string    `bun:"key,notnull"`
+	HitAt     time.Time `bun:"hit_at,notnull"`
+	ExpiresAt time.Time `bun:"expires_at,notnull"`
+}
+
+// PostgresStore keeps the allowed requests in the rate_limit_hits table, so that
+// every replica of the server counts against the same limits.
+type PostgresStore struct {
+	db        *bun.DB
+	lastSweep atomic.Int64
+}
+
+// NewPostgresStore creates a store backed by the rate_limit_hits table
+func NewPostgresStore(db *bun.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Allow implements Store
+//
+// Requests for the same key are serialized with a transaction-level advisory lock,
+// so that concurrent requests on different replicas can't both take the last slot.
+func (s *PostgresStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (time.Duration, error) {
+	if limit <= 0 {
+		return window, nil
+	}
+
+	now := time.Now()
+	if err := s.sweep(ctx, now); err != nil {
+		return 0, err
+	}
+
+	var retryAfter time.Duration
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", key); err != nil {
+			return fmt.Errorf("failed to lock rate limit key: %w", err)
+		}
+
+		var hits []time.Time
+		err := tx.NewSelect().
+			Model((*RateLimitHit)(nil)).
+			Column("hit_at").
+			Where("key = ?", key).
+			Where("hit_at > ?", now.Add(-window)).
+			Order("hit_at DESC").
+			Limit(limit).
+			Scan(ctx, &hits)
+		if err != nil {
+			return fmt.Errorf("failed to count rate limit hits: %w", err)
+		}
+
+		if len(hits) >= limit {
+			retryAfter = hits[limit-1].Add(window).Sub(now)
+			return nil
+		}
+
+		_, err = tx.NewInsert().
+			Model(&RateLimitHit{Key: key, HitAt: now, ExpiresAt: now.Add(window)}).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record rate limit hit: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return retryAfter, nil
+}
+
+// sweep deletes the hits of expired windows, at most once per sweep interval per process
+func (s *PostgresStore) sweep(ctx context.Context, now time.Time) error {
+	last := s.lastSweep.Load()
+	if now.Sub(time.Unix(0, last)) < sweepInterval || !s.lastSweep.CompareAndSwap(last, now.UnixNano()) {
+		return nil
+	}
+
+	_, err := s.db.NewDelete().
+		Model((*RateLimitHit)(nil)).
+		Where("expires_at <= ?", now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired rate limit hits: %w", err)
+	}
+	return nil
+}
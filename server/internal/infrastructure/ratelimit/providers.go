@@ -0,0 +1,40 @@
+package ratelimit
+
+import (
+	"github.com/uptrace/bun"
+	"go.uber.org/fx"
+
+	appconfig "server/internal/config"
+)
+
+const (
+	// StoreMemory counts requests in process memory
+	StoreMemory = "memory"
+
+	// StorePostgres counts requests in the database, shared between replicas
+	StorePostgres = "postgres"
+)
+
+// ProviderModule provides the rate limit store using dependency injection
+var ProviderModule = fx.Module("ratelimit",
+	fx.Provide(NewStoreProvider),
+)
+
+// NewStoreProvider creates the rate limit store from the RATE_LIMIT_STORE configuration.
+//
+// Store selection logic:
+// - "memory" or empty → MemoryStore, for single instance deployments
+// - "postgres" → PostgresStore, for deployments with several replicas
+// - Other values → Error
+func NewStoreProvider(cfg *appconfig.Config, db *bun.DB) (Store, error) {
+	switch cfg.RateLimitStore {
+	case StoreMemory, "":
+		return NewMemoryStore(), nil
+
+	case StorePostgres:
+		return NewPostgresStore(db), nil
+
+	default:
+		return nil, ErrUnsupportedStore
+	}
+}
@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	for range 3 {
+		retryAfter, err := store.Allow(ctx, "ip:203.0.113.7", 3, time.Minute)
+		require.NoError(t, err)
+		assert.Zero(t, retryAfter)
+		now = now.Add(10 * time.Second)
+	}
+
+	retryAfter, err := store.Allow(ctx, "ip:203.0.113.7", 3, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, retryAfter)
+
+	// Other keys have their own window
+	retryAfter, err = store.Allow(ctx, "ip:198.51.100.1", 3, time.Minute)
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	t.Run("the window slides", func(t *testing.T) {
+		// The first request leaves the window, the two others are still counted
+		now = now.Add(30 * time.Second)
+		retryAfter, err := store.Allow(ctx, "ip:203.0.113.7", 3, time.Minute)
+		require.NoError(t, err)
+		assert.Zero(t, retryAfter)
+
+		retryAfter, err = store.Allow(ctx, "ip:203.0.113.7", 3, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 10*time.Second, retryAfter)
+	})
+
+	t.Run("sweeps expired keys", func(t *testing.T) {
+		now = now.Add(2 * time.Minute)
+		_, err := store.Allow(ctx, "ip:192.0.2.1", 3, time.Minute)
+		require.NoError(t, err)
+
+		assert.Len(t, store.logs, 1)
+		assert.Contains(t, store.logs, "ip:192.0.2.1")
+	})
+
+	t.Run("a zero limit allows nothing", func(t *testing.T) {
+		retryAfter, err := store.Allow(ctx, "ip:192.0.2.2", 0, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, retryAfter)
+	})
+}
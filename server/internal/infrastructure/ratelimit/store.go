@@ -0,0 +1,18 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Store counts requests per key in sliding windows.
+// A request is allowed while fewer than limit requests were allowed for its key
+// during the preceding window.
+type Store interface {
+	// Allow records a request for the key unless the limit is reached
+	// Returns how long until the next request is allowed, zero when this request is allowed
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (time.Duration, error)
+}
+
+// sweepInterval is how often stores drop the requests of expired windows
+const sweepInterval = time.Minute
@@ -0,0 +1,94 @@
+package tokenhash
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+)
+
+// ErrMissingPepper is returned when no pepper is configured for the token hasher
+var ErrMissingPepper = errors.New("token hash pepper is required")
+
+// ErrDevelopmentPepper is returned when the development pepper is configured outside development
+var ErrDevelopmentPepper = errors.New("token hash pepper must be changed from the development default")
+
+// legacyHashLength is the length of the hex encoded MD5 digests stored before HMAC-SHA256
+const legacyHashLength = md5.Size * 2
+
+// TokenHasher hashes opaque secrets such as session tokens, reset tokens, challenges and
+// one-time codes before they are stored.
+//
+// Digests are HMAC-SHA256 keyed by a server-side pepper, so a leaked table can't be
+// brute forced without the pepper. While legacy hashes are accepted, unsalted MD5 digests
+// written by earlier releases still match so that existing rows keep working until they
+// are rewritten or expire.
+type TokenHasher interface {
+	// Hash returns the digest to store for the token
+	Hash(token string) string
+
+	// Candidates returns every digest a stored row may hold for the token, the current one first
+	// Repositories look rows up with all candidates and rewrite the ones needing a rehash.
+	Candidates(token string) []string
+
+	// Verify reports whether the token matches the stored digest using constant-time comparison
+	Verify(token string, hash string) bool
+
+	// NeedsRehash reports whether the stored digest was written by a retired scheme
+	NeedsRehash(hash string) bool
+}
+
+type hmacTokenHasher struct {
+	pepper          []byte
+	acceptLegacyMD5 bool
+}
+
+// NewTokenHasher creates a HMAC-SHA256 token hasher keyed by the pepper
+//
+// acceptLegacyMD5 enables the dual-read of MD5 digests, it should be turned off once
+// no legacy rows remain.
+func NewTokenHasher(pepper string, acceptLegacyMD5 bool) (TokenHasher, error) {
+	if pepper == "" {
+		return nil, ErrMissingPepper
+	}
+
+	return &hmacTokenHasher{
+		pepper:          []byte(pepper),
+		acceptLegacyMD5: acceptLegacyMD5,
+	}, nil
+}
+
+func (h *hmacTokenHasher) Hash(token string) string {
+	mac := hmac.New(sha256.New, h.pepper)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (h *hmacTokenHasher) Candidates(token string) []string {
+	if !h.acceptLegacyMD5 {
+		return []string{h.Hash(token)}
+	}
+	return []string{h.Hash(token), legacyHash(token)}
+}
+
+func (h *hmacTokenHasher) Verify(token string, hash string) bool {
+	if subtle.ConstantTimeCompare([]byte(h.Hash(token)), []byte(hash)) == 1 {
+		return true
+	}
+	if h.acceptLegacyMD5 && len(hash) == legacyHashLength {
+		return subtle.ConstantTimeCompare([]byte(legacyHash(token)), []byte(hash)) == 1
+	}
+	return false
+}
+
+func (h *hmacTokenHasher) NeedsRehash(hash string) bool {
+	return len(hash) == legacyHashLength
+}
+
+// legacyHash returns the unsalted MD5 digest stored by earlier releases
+func legacyHash(token string) string {
+	hash := md5.Sum([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
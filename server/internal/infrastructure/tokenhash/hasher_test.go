@@ -0,0 +1,83 @@
+package tokenhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	appconfig "server/internal/config"
+)
+
+func TestNewTokenHasher(t *testing.T) {
+	_, err := NewTokenHasher("", true)
+	assert.ErrorIs(t, err, ErrMissingPepper)
+}
+
+func TestNewTokenHasherProvider(t *testing.T) {
+	newConfig := func(environment string, pepper string) *appconfig.Config {
+		return &appconfig.Config{Environment: environment, TokenHashPepper: pepper}
+	}
+
+	t.Run("accepts the development pepper in development", func(t *testing.T) {
+		_, err := NewTokenHasherProvider(newConfig("development", appconfig.DevelopmentTokenHashPepper), zap.NewNop())
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects the development pepper elsewhere", func(t *testing.T) {
+		_, err := NewTokenHasherProvider(newConfig("production", appconfig.DevelopmentTokenHashPepper), zap.NewNop())
+		assert.ErrorIs(t, err, ErrDevelopmentPepper)
+	})
+
+	t.Run("rejects a missing pepper", func(t *testing.T) {
+		_, err := NewTokenHasherProvider(newConfig("production", ""), zap.NewNop())
+		assert.ErrorIs(t, err, ErrMissingPepper)
+	})
+
+	t.Run("accepts a configured pepper", func(t *testing.T) {
+		_, err := NewTokenHasherProvider(newConfig("production", "production-pepper"), zap.NewNop())
+		assert.NoError(t, err)
+	})
+}
+
+func TestTokenHasher(t *testing.T) {
+	hasher, err := NewTokenHasher("pepper", true)
+	require.NoError(t, err)
+
+	t.Run("Hash is keyed by the pepper", func(t *testing.T) {
+		otherHasher, err := NewTokenHasher("other-pepper", true)
+		require.NoError(t, err)
+
+		hash := hasher.Hash("token")
+		assert.Len(t, hash, 64)
+		assert.Equal(t, hash, hasher.Hash("token"))
+		assert.NotEqual(t, hash, hasher.Hash("other-token"))
+		assert.NotEqual(t, hash, otherHasher.Hash("token"))
+	})
+
+	t.Run("Verify accepts current and legacy digests", func(t *testing.T) {
+		assert.True(t, hasher.Verify("token", hasher.Hash("token")))
+		assert.True(t, hasher.Verify("123456", "e10adc3949ba59abbe56e057f20f883e"))
+		assert.False(t, hasher.Verify("token", hasher.Hash("other-token")))
+		assert.False(t, hasher.Verify("654321", "e10adc3949ba59abbe56e057f20f883e"))
+	})
+
+	t.Run("Candidates lists the current digest first", func(t *testing.T) {
+		assert.Equal(t, []string{hasher.Hash("123456"), "e10adc3949ba59abbe56e057f20f883e"}, hasher.Candidates("123456"))
+	})
+
+	t.Run("NeedsRehash detects legacy digests", func(t *testing.T) {
+		assert.True(t, hasher.NeedsRehash("e10adc3949ba59abbe56e057f20f883e"))
+		assert.False(t, hasher.NeedsRehash(hasher.Hash("123456")))
+	})
+}
+
+func TestTokenHasherWithoutLegacyMD5(t *testing.T) {
+	hasher, err := NewTokenHasher("pepper", false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{hasher.Hash("123456")}, hasher.Candidates("123456"))
+	assert.False(t, hasher.Verify("123456", "e10adc3949ba59abbe56e057f20f883e"))
+	assert.True(t, hasher.Verify("123456", hasher.Hash("123456")))
+}
@@ -0,0 +1,28 @@
+package tokenhash
+
+import (
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	appconfig "server/internal/config"
+)
+
+// ProviderModule provides the token hasher shared by the repositories using dependency injection
+var ProviderModule = fx.Module("tokenhash",
+	fx.Provide(NewTokenHasherProvider),
+)
+
+// NewTokenHasherProvider creates the token hasher from the TOKEN_HASH_* configuration
+//
+// The development pepper is only accepted in development, anywhere else it would let
+// whoever knows it brute force the stored digests.
+func NewTokenHasherProvider(cfg *appconfig.Config, log *zap.Logger) (TokenHasher, error) {
+	if cfg.Environment != "development" && cfg.TokenHashPepper == appconfig.DevelopmentTokenHashPepper {
+		return nil, ErrDevelopmentPepper
+	}
+	if cfg.TokenHashAcceptLegacyMD5 {
+		log.Warn("Legacy MD5 token hashes are accepted, disable TOKEN_HASH_ACCEPT_LEGACY_MD5 once no rows written by earlier releases remain")
+	}
+
+	return NewTokenHasher(cfg.TokenHashPepper, cfg.TokenHashAcceptLegacyMD5)
+}
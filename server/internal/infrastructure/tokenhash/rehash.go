@@ -0,0 +1,32 @@
+package tokenhash
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+// Rehash rewrites a digest written by a retired scheme with the current one
+//
+// model is the row that was looked up with the token, storedHash points to its digest
+// field stored in column and is updated in place. Rows already using the current scheme
+// are left untouched.
+func Rehash(ctx context.Context, db bun.IDB, hasher TokenHasher, model any, column string, storedHash *string, token string) error {
+	if !hasher.NeedsRehash(*storedHash) {
+		return nil
+	}
+
+	hash := hasher.Hash(token)
+	_, err := db.NewUpdate().
+		Model(model).
+		Set("? = ?", bun.Ident(column), hash).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to rehash legacy token: %w", err)
+	}
+
+	*storedHash = hash
+	return nil
+}